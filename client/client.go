@@ -0,0 +1,262 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		client/client.go
+
+	Module Description:
+		Typed Go client of the review API for internal tools (pipeline, render farm).
+
+	Details:
+	- Client wraps the HTTP API below BaseURL (e.g. "https://central/api"): the asset
+	  pivot (ListAssetsPivot, Assets), status updates (UpdateStatus) and the review
+	  event stream (StreamEvents). The response types are the server's own
+	  (usecase.PivotResponse, entity.ReviewInfo, entity.ReviewEvent), so they cannot
+	  drift from the handlers.
+	- Every call takes a context; Timeout bounds each request unless the context
+	  already has a deadline. The event stream is bounded by its context only.
+	- Reads are retried on network errors and on 429, 502, 503 and 504, waiting
+	  Retry-After when the server sends one and doubling RetryBackoff otherwise.
+	  Writes are retried only on 429 and 503, which the rate limiter and the circuit
+	  breaker answer before the handler runs.
+	- Error responses come back as *Error with the fields of the error envelope
+	  (code, message, details, request_id).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - DefaultConfig: Returns the default client configuration.
+	* - New: Creates a client.
+	────────────────────────────────────────────────────────────────────────── */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Config struct {
+	BaseURL      string        // API root, e.g. "https://central/api"
+	Token        string        // bearer token sent with every request
+	HTTPClient   *http.Client  // nil: a client without its own timeout, which would cut StreamEvents
+	Timeout      time.Duration // per request, unless the context has a deadline
+	MaxRetries   int           // retries after the first attempt
+	RetryBackoff time.Duration // first retry delay, doubled on each retry
+	UserAgent    string
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Timeout:      30 * time.Second,
+		MaxRetries:   3,
+		RetryBackoff: 500 * time.Millisecond,
+		UserAgent:    "central-review-client",
+	}
+}
+
+// maxRetryDelay caps the delay between retries, Retry-After included.
+const maxRetryDelay = 30 * time.Second
+
+type Client struct {
+	base *url.URL
+	cfg  Config
+	http *http.Client
+}
+
+// New returns a client of the API at cfg.BaseURL.
+func New(cfg Config) (*Client, error) {
+	base, err := url.Parse(strings.TrimSuffix(cfg.BaseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("client: invalid base URL: %w", err)
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return nil, fmt.Errorf("client: base URL %q is not absolute", cfg.BaseURL)
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	return &Client{base: base, cfg: cfg, http: httpClient}, nil
+}
+
+// Error is an error response of the API.
+type Error struct {
+	Status    int             `json:"-"`
+	Code      string          `json:"code"`
+	Message   string          `json:"message"`
+	Details   json.RawMessage `json:"details,omitempty"`
+	RequestID string          `json:"request_id,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("%d %s: %s (request %s)", e.Status, e.Code, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("%d %s: %s", e.Status, e.Code, e.Message)
+}
+
+// StatusCode returns the HTTP status of an *Error in err's chain, 0 otherwise.
+func StatusCode(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Status
+	}
+	return 0
+}
+
+// endpoint returns the URL of the path segments below the base URL, each escaped.
+func (c *Client) endpoint(query url.Values, segments ...string) string {
+	escaped := make([]string, len(segments))
+	for i, s := range segments {
+		escaped[i] = url.PathEscape(s)
+	}
+	u := c.base.JoinPath(escaped...)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// newRequest returns an authenticated request.
+func (c *Client) newRequest(ctx context.Context, method, target string, body []byte) (*http.Request, error) {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, r)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.cfg.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.Token)
+	}
+	if c.cfg.UserAgent != "" {
+		req.Header.Set("User-Agent", c.cfg.UserAgent)
+	}
+	return req, nil
+}
+
+// do sends a request, retrying as described in Details, and decodes a 2xx JSON
+// response into out (when not nil).
+func (c *Client) do(
+	ctx context.Context,
+	method, target string,
+	header http.Header,
+	in, out any,
+) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	if _, ok := ctx.Deadline(); !ok && c.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.Timeout)
+		defer cancel()
+	}
+	read := method == http.MethodGet || method == http.MethodHead
+
+	for attempt := 0; ; attempt++ {
+		req, err := c.newRequest(ctx, method, target, body)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Accept", "application/json")
+		for k, v := range header {
+			req.Header[k] = v
+		}
+
+		res, err := c.http.Do(req)
+		if err != nil {
+			if ctx.Err() == nil && read && attempt < c.cfg.MaxRetries {
+				if err := sleep(ctx, c.retryDelay(attempt, nil)); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+		if retryStatus(res.StatusCode, read) && attempt < c.cfg.MaxRetries {
+			delay := c.retryDelay(attempt, res)
+			drain(res)
+			if err := sleep(ctx, delay); err != nil {
+				return err
+			}
+			continue
+		}
+		return decode(res, out)
+	}
+}
+
+// retryStatus reports whether a response status is worth retrying; writes only when
+// the server refused the request before running it.
+func retryStatus(status int, read bool) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return read
+	}
+	return false
+}
+
+// retryDelay returns the delay before retry attempt+1: the Retry-After of res if any,
+// RetryBackoff doubled per attempt otherwise, at most maxRetryDelay.
+func (c *Client) retryDelay(attempt int, res *http.Response) time.Duration {
+	delay := c.cfg.RetryBackoff << attempt
+	if res != nil {
+		if s, err := strconv.Atoi(res.Header.Get("Retry-After")); err == nil && s >= 0 {
+			delay = time.Duration(s) * time.Second
+		}
+	}
+	if delay < 0 || delay > maxRetryDelay { // negative: the shift overflowed
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// decode reads res into out, or returns its *Error for a status of 400 and above.
+func decode(res *http.Response, out any) error {
+	defer drain(res)
+	if res.StatusCode >= http.StatusBadRequest {
+		apiErr := &Error{Status: res.StatusCode}
+		if err := json.NewDecoder(res.Body).Decode(apiErr); err != nil || apiErr.Code == "" {
+			apiErr.Code = strings.ToLower(strings.ReplaceAll(http.StatusText(res.StatusCode), " ", "_"))
+			apiErr.Message = http.StatusText(res.StatusCode)
+		}
+		return apiErr
+	}
+	if out == nil || res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
+
+// drain reads what is left of a response body, so the connection can be reused.
+func drain(res *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 1<<20))
+	_ = res.Body.Close()
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
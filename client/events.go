@@ -0,0 +1,140 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		client/events.go
+
+	Module Description:
+		Review event stream of the review API client.
+
+	Details:
+	- StreamEvents reads GET /projects/:project/reviews/events (server-sent events) and
+	  hands each event to a callback, reconnecting when the stream drops, with the
+	  retry delays of the other calls (reset once a connection succeeds).
+	- The server keeps no backlog: events committed while the client is reconnecting
+	  are missed. Tools that must not miss any should re-read the pivot after a
+	  reconnect, or register a webhook.
+	- A 4xx answer (bad token, unknown project) is not retried; 429 is.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (Client) StreamEvents: Calls a function with each review event of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// maxEventSize is the largest event line accepted; an event carries a full review info.
+const maxEventSize = 4 << 20
+
+// StreamEvents calls fn with each review event of project, of the given types (all
+// when none), until ctx is done (returning ctx.Err()), fn returns an error (returned
+// as is) or the server refuses the stream.
+func (c *Client) StreamEvents(
+	ctx context.Context,
+	project string,
+	fn func(*entity.ReviewEvent) error,
+	types ...string,
+) error {
+	query := url.Values{}
+	if len(types) > 0 {
+		query.Set("type", strings.Join(types, ","))
+	}
+	target := c.endpoint(query, "projects", project, "reviews", "events")
+
+	for attempt := 0; ; attempt++ {
+		connected, err := c.readEvents(ctx, target, fn)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var apiErr *Error
+		if errors.As(err, &apiErr) && !retryStatus(apiErr.Status, true) {
+			return err
+		}
+		var cbErr *callbackError
+		if errors.As(err, &cbErr) {
+			return cbErr.err
+		}
+		if connected {
+			attempt = 0
+		}
+		if err := sleep(ctx, c.retryDelay(attempt, nil)); err != nil {
+			return err
+		}
+	}
+}
+
+// callbackError marks an error returned by the StreamEvents callback.
+type callbackError struct{ err error }
+
+func (e *callbackError) Error() string { return e.err.Error() }
+
+// readEvents reads one connection of the stream; connected reports whether the server
+// accepted it.
+func (c *Client) readEvents(
+	ctx context.Context,
+	target string,
+	fn func(*entity.ReviewEvent) error,
+) (connected bool, err error) {
+	req, err := c.newRequest(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	res, err := c.http.Do(req)
+	if err != nil {
+		return false, err
+	}
+	if res.StatusCode != http.StatusOK {
+		if err := decode(res, nil); err != nil {
+			return false, err
+		}
+		return false, fmt.Errorf("client: event stream answered %s", res.Status)
+	}
+	defer drain(res)
+
+	scanner := bufio.NewScanner(res.Body)
+	scanner.Buffer(make([]byte, 64<<10), maxEventSize)
+	var data bytes.Buffer
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		switch {
+		case len(line) == 0: // end of an event
+			if data.Len() == 0 {
+				continue
+			}
+			var event entity.ReviewEvent
+			err := json.Unmarshal(data.Bytes(), &event)
+			data.Reset()
+			if err != nil {
+				return true, err
+			}
+			if err := fn(&event); err != nil {
+				return true, &callbackError{err: err}
+			}
+		case bytes.HasPrefix(line, []byte("data:")):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.Write(bytes.TrimPrefix(bytes.TrimPrefix(line, []byte("data:")), []byte(" ")))
+		}
+		// id, event and comment (keep-alive) lines: the type is in the data as well
+	}
+	if err := scanner.Err(); err != nil {
+		return true, err
+	}
+	return true, nil
+}
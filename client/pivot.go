@@ -0,0 +1,188 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		client/pivot.go
+
+	Module Description:
+		Asset pivot calls of the review API client.
+
+	Details:
+	- ListAssetsPivot fetches one page of the list view
+	  (GET /projects/:project/reviews/assets/pivot); the grouped view is for the web
+	  UI and is not offered here.
+	- Assets walks every page of a query by next_cursor, like the CSV export, so rows
+	  changing during the walk neither repeat nor go missing. It skips the total
+	  (count=false), which the walk does not need.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (Client) ListAssetsPivot: Fetches one page of the asset pivot.
+	* - (Client) Assets: Returns an iterator over every asset of a pivot query.
+	────────────────────────────────────────────────────────────────────────── */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+)
+
+// PivotQuery is the query of the asset pivot; zero fields are left to the server (and
+// the project's pivot defaults). See GET /openapi.json for the values.
+type PivotQuery struct {
+	Root    string // assets (default), shots or a custom root
+	Sort    string // e.g. group_1, mdl_submitted, latest_activity, due_date
+	Dir     string // asc | desc
+	Nulls   string // first | last
+	Natural bool
+	Phase   string
+	Name    string
+	Page    int
+	PerPage int
+	Cursor  string // next_cursor of a previous page; overrides Page
+
+	ApprovalStatuses     []string
+	WorkStatuses         []string
+	SubmittedUsers       []string
+	ApprovalUpdatedUsers []string
+	Studios              []string
+	Relations            []string
+	RelationMode         string // exact | prefix
+	Tags                 []string
+	OverallStatuses      []string
+	Overdue              bool
+
+	Fields    []string   // phases to return, e.g. mdl, rig
+	Include   []string   // comment_count, thumbnails, studio, notes
+	AsOf      *time.Time // the pivot as it was at this time
+	SkipCount bool       // count=false
+}
+
+func (q *PivotQuery) values() url.Values {
+	v := url.Values{"view": {"list"}}
+	set := func(key, value string) {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+	list := func(key string, values []string) {
+		if len(values) > 0 {
+			v.Set(key, strings.Join(values, ","))
+		}
+	}
+	set("root", q.Root)
+	set("sort", q.Sort)
+	set("dir", q.Dir)
+	set("nulls", q.Nulls)
+	set("phase", q.Phase)
+	set("name", q.Name)
+	set("cursor", q.Cursor)
+	set("relation_mode", q.RelationMode)
+	if q.Natural {
+		v.Set("natural", "true")
+	}
+	if q.Page > 0 {
+		v.Set("page", strconv.Itoa(q.Page))
+	}
+	if q.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(q.PerPage))
+	}
+	list("approval_status", q.ApprovalStatuses)
+	list("work_status", q.WorkStatuses)
+	list("submitted_user", q.SubmittedUsers)
+	list("approval_status_updated_user", q.ApprovalUpdatedUsers)
+	list("studio", q.Studios)
+	list("relation", q.Relations)
+	list("tags", q.Tags)
+	list("overall_status", q.OverallStatuses)
+	if q.Overdue {
+		v.Set("overdue", "true")
+	}
+	list("fields", q.Fields)
+	list("include", q.Include)
+	if q.AsOf != nil {
+		v.Set("as_of", q.AsOf.UTC().Format(time.RFC3339))
+	}
+	if q.SkipCount {
+		v.Set("count", "false")
+	}
+	return v
+}
+
+// ListAssetsPivot returns one page of the asset pivot of project.
+func (c *Client) ListAssetsPivot(ctx context.Context, project string, q PivotQuery) (*usecase.PivotResponse, error) {
+	var res usecase.PivotResponse
+	target := c.endpoint(q.values(), "projects", project, "reviews", "assets", "pivot")
+	if err := c.do(ctx, http.MethodGet, target, nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Assets returns an iterator over every asset of the pivot query q of project; Page
+// and Cursor of q are ignored.
+//
+//	it := c.Assets("myproject", client.PivotQuery{PerPage: 200})
+//	for it.Next(ctx) {
+//		a := it.Asset()
+//	}
+//	if err := it.Err(); err != nil {
+func (c *Client) Assets(project string, q PivotQuery) *AssetIterator {
+	q.Page, q.Cursor, q.SkipCount = 0, "", true
+	return &AssetIterator{c: c, project: project, q: q}
+}
+
+// AssetIterator walks the pages of a pivot query; see Client.Assets.
+type AssetIterator struct {
+	c       *Client
+	project string
+	q       PivotQuery
+	page    []repository.AssetPivot
+	i       int
+	done    bool
+	err     error
+}
+
+// Next advances to the next asset, fetching the following page when needed. It
+// returns false at the end or on an error (see Err).
+func (it *AssetIterator) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+	it.i++
+	for it.i >= len(it.page) {
+		if it.done {
+			return false
+		}
+		res, err := it.c.ListAssetsPivot(ctx, it.project, it.q)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.i = res.Assets, 0
+		it.q.Cursor = res.NextCursor
+		it.done = res.NextCursor == "" || len(res.Assets) == 0
+		if len(it.page) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Asset returns the current asset; valid until the next call to Next.
+func (it *AssetIterator) Asset() *repository.AssetPivot {
+	return &it.page[it.i]
+}
+
+// Err returns the error that ended the walk, nil at the end of the results.
+func (it *AssetIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,146 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		client/review.go
+
+	Module Description:
+		Review info calls of the review API client.
+
+	Details:
+	- UpdateStatus sets the approval and/or work status of a review info
+	  (PATCH /projects/:project/reviews/:id). Version is sent as If-Match, so a change
+	  based on an outdated row fails with 409 instead of overwriting it; the *Error
+	  details then hold the current row and its version.
+	- Status changes are not retried on network errors, since the first attempt may
+	  have been applied (see client.go).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added ListReviews for reviewctl.
+
+	Functions:
+	* - (Client) ListReviews: Fetches a page of review infos.
+	* - (Client) GetReview: Fetches a review info.
+	* - (Client) UpdateStatus: Sets the statuses of a review info.
+	────────────────────────────────────────────────────────────────────────── */
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// StatusUpdate is a status change of a review info; nil statuses are kept.
+type StatusUpdate struct {
+	ApprovalStatus *string
+	WorkStatus     *string
+	User           string // recorded as the updating user of the changed statuses
+	Version        string // ETag of the row the change is based on; "" skips the check
+	SessionID      string // live review session the change was made in
+}
+
+type updateStatusBody struct {
+	ApprovalStatus            *string `json:"approval_status,omitempty"`
+	ApprovalStatusUpdatedUser *string `json:"approval_status_updated_user,omitempty"`
+	WorkStatus                *string `json:"work_status,omitempty"`
+	WorkStatusUpdatedUser     *string `json:"work_status_updated_user,omitempty"`
+	SessionID                 *string `json:"session_id,omitempty"`
+}
+
+// GetReview returns the review info id of project.
+func (c *Client) GetReview(ctx context.Context, project string, id int32) (*entity.ReviewInfo, error) {
+	var res entity.ReviewInfo
+	target := c.endpoint(nil, "projects", project, "reviews", strconv.Itoa(int(id)))
+	if err := c.do(ctx, http.MethodGet, target, nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// UpdateStatus applies u to the review info id of project and returns the updated row.
+func (c *Client) UpdateStatus(ctx context.Context, project string, id int32, u StatusUpdate) (*entity.ReviewInfo, error) {
+	body := updateStatusBody{ApprovalStatus: u.ApprovalStatus, WorkStatus: u.WorkStatus}
+	if u.User != "" {
+		if u.ApprovalStatus != nil {
+			body.ApprovalStatusUpdatedUser = &u.User
+		}
+		if u.WorkStatus != nil {
+			body.WorkStatusUpdatedUser = &u.User
+		}
+	}
+	if u.SessionID != "" {
+		body.SessionID = &u.SessionID
+	}
+	header := http.Header{}
+	if u.Version != "" {
+		header.Set("If-Match", `"`+u.Version+`"`)
+	}
+
+	var res entity.ReviewInfo
+	target := c.endpoint(nil, "projects", project, "reviews", strconv.Itoa(int(id)))
+	if err := c.do(ctx, http.MethodPatch, target, header, body, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// ReviewQuery is the query of the review info list; zero fields are not sent.
+type ReviewQuery struct {
+	Studio        string
+	TaskID        string
+	SubtaskID     string
+	Root          string
+	Groups        string // "/"-separated group path
+	Relation      string
+	Phase         string
+	Component     string
+	Take          string
+	ModifiedSince *time.Time
+	Page          int
+	PerPage       int
+}
+
+func (q *ReviewQuery) values() url.Values {
+	v := url.Values{}
+	for key, value := range map[string]string{
+		"studio": q.Studio, "task_id": q.TaskID, "subtask_id": q.SubtaskID, "root": q.Root,
+		"groups": q.Groups, "relation": q.Relation, "phase": q.Phase,
+		"component": q.Component, "take": q.Take,
+	} {
+		if value != "" {
+			v.Set(key, value)
+		}
+	}
+	if q.ModifiedSince != nil {
+		v.Set("modified_since", q.ModifiedSince.UTC().Format(time.RFC3339))
+	}
+	if q.Page > 0 {
+		v.Set("page", strconv.Itoa(q.Page))
+	}
+	if q.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(q.PerPage))
+	}
+	return v
+}
+
+// ReviewList is a page of the review info list.
+type ReviewList struct {
+	Reviews []*entity.ReviewInfo `json:"reviews"`
+	Next    *string              `json:"next"` // URL of the next page, null on the last
+	Total   int64                `json:"total"`
+}
+
+// ListReviews returns one page of the review infos of project.
+func (c *Client) ListReviews(ctx context.Context, project string, q ReviewQuery) (*ReviewList, error) {
+	var res ReviewList
+	target := c.endpoint(q.values(), "projects", project, "reviews")
+	if err := c.do(ctx, http.MethodGet, target, nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,217 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		cmd/reviewctl/main.go
+
+	Module Description:
+		reviewctl: command line access to the review API for TDs and farm scripts.
+
+	Details:
+	- reviewctl <command> [flags]; commands: list, pivot, export, set-status, watch.
+	  Every command takes -url and -token, defaulting to REVIEWCTL_URL and
+	  REVIEWCTL_TOKEN, and -project, defaulting to REVIEWCTL_PROJECT.
+	- Built on the client package: retries, cursor walks and the event stream behave
+	  as documented there.
+	- Output is a table for people (-o table, the default of list and pivot) or JSON
+	  for scripts (-o json: one document, -o jsonl: one row per line). export writes
+	  the columns of the server's CSV export.
+	- Exit status: 0 success, 1 failure, 2 usage error. Ctrl-C cancels the request
+	  (and ends watch).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - main: Runs a command.
+	────────────────────────────────────────────────────────────────────────── */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/client"
+)
+
+const usage = `reviewctl queries and updates review data through the review API.
+
+Usage:
+  reviewctl <command> [flags]
+
+Commands:
+  list        list review infos
+  pivot       list the asset pivot (latest status per phase)
+  export      write the asset pivot as CSV or JSON lines
+  set-status  set the approval and/or work status of a review info
+  watch       print review events as they happen
+
+Run "reviewctl <command> -h" for the flags of a command.
+Environment: REVIEWCTL_URL (e.g. https://central/api), REVIEWCTL_TOKEN, REVIEWCTL_PROJECT.
+`
+
+// errUsage marks an error already reported by a flag set.
+var errUsage = errors.New("usage")
+
+type command struct {
+	name string
+	run  func(ctx context.Context, args []string) error
+}
+
+var commands = []command{
+	{"list", runList},
+	{"pivot", runPivot},
+	{"export", runExport},
+	{"set-status", runSetStatus},
+	{"watch", runWatch},
+}
+
+func main() {
+	if len(os.Args) < 2 || os.Args[1] == "-h" || os.Args[1] == "help" || os.Args[1] == "--help" {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	for _, cmd := range commands {
+		if cmd.name != os.Args[1] {
+			continue
+		}
+		err := cmd.run(ctx, os.Args[2:])
+		switch {
+		case err == nil:
+		case errors.Is(err, errUsage):
+			os.Exit(2)
+		case errors.Is(err, context.Canceled):
+			os.Exit(1)
+		default:
+			fmt.Fprintf(os.Stderr, "reviewctl %s: %v\n", cmd.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+	fmt.Fprintf(os.Stderr, "reviewctl: unknown command %q\n\n%s", os.Args[1], usage)
+	os.Exit(2)
+}
+
+// common are the flags every command takes.
+type common struct {
+	url     string
+	token   string
+	project string
+	timeout time.Duration
+	output  string
+}
+
+// newFlagSet returns the flag set of a command with the common flags; outputs are the
+// accepted -o values, the first one the default ("" for commands without -o).
+func newFlagSet(name, synopsis string, outputs ...string) (*flag.FlagSet, *common) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: reviewctl %s [flags]\n\n%s\n\nFlags:\n", name, synopsis)
+		fs.PrintDefaults()
+	}
+	c := &common{}
+	fs.StringVar(&c.url, "url", os.Getenv("REVIEWCTL_URL"), "API root URL (REVIEWCTL_URL)")
+	fs.StringVar(&c.token, "token", os.Getenv("REVIEWCTL_TOKEN"), "bearer token (REVIEWCTL_TOKEN)")
+	fs.StringVar(&c.project, "project", os.Getenv("REVIEWCTL_PROJECT"), "project key name (REVIEWCTL_PROJECT)")
+	fs.DurationVar(&c.timeout, "timeout", client.DefaultConfig().Timeout, "timeout of each request")
+	if len(outputs) > 0 {
+		fs.StringVar(&c.output, "o", outputs[0], "output: "+strings.Join(outputs, ", "))
+	}
+	return fs, c
+}
+
+// parse parses args and checks the common flags.
+func parse(fs *flag.FlagSet, c *common, args []string, outputs ...string) error {
+	if err := fs.Parse(args); err != nil {
+		return errUsage // reported by fs, -h included
+	}
+	if fs.NArg() > 0 {
+		return usageError(fs, "unexpected arguments: %s", strings.Join(fs.Args(), " "))
+	}
+	if c.url == "" {
+		return usageError(fs, "-url (or REVIEWCTL_URL) is required")
+	}
+	if c.project == "" {
+		return usageError(fs, "-project (or REVIEWCTL_PROJECT) is required")
+	}
+	if len(outputs) > 0 && !contains(outputs, c.output) {
+		return usageError(fs, "-o must be one of: %s", strings.Join(outputs, ", "))
+	}
+	return nil
+}
+
+func usageError(fs *flag.FlagSet, format string, args ...any) error {
+	fmt.Fprintf(fs.Output(), "reviewctl %s: %s\n", fs.Name(), fmt.Sprintf(format, args...))
+	fs.Usage()
+	return errUsage
+}
+
+func (c *common) client() (*client.Client, error) {
+	cfg := client.DefaultConfig()
+	cfg.BaseURL = c.url
+	cfg.Token = c.token
+	cfg.Timeout = c.timeout
+	cfg.UserAgent = "reviewctl"
+	return client.New(cfg)
+}
+
+// csvList is a flag taking comma-separated values, repeatable.
+type csvList []string
+
+func (l *csvList) String() string { return strings.Join(*l, ",") }
+
+func (l *csvList) Set(v string) error {
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			*l = append(*l, s)
+		}
+	}
+	return nil
+}
+
+// timeFlag is a flag taking an RFC 3339 time or a date (2006-01-02, UTC).
+type timeFlag struct{ t *time.Time }
+
+func (f *timeFlag) String() string {
+	if f.t == nil {
+		return ""
+	}
+	return f.t.Format(time.RFC3339)
+}
+
+func (f *timeFlag) Set(v string) error {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			f.t = &t
+			return nil
+		}
+	}
+	return fmt.Errorf("%q is neither RFC 3339 nor YYYY-MM-DD", v)
+}
+
+func contains(values []string, v string) bool {
+	for _, s := range values {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// writeJSON writes v as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
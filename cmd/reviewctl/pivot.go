@@ -0,0 +1,233 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		cmd/reviewctl/pivot.go
+
+	Module Description:
+		reviewctl pivot and export.
+
+	Details:
+	- Both take the filters of the asset pivot (list view). pivot prints one page, or
+	  every page with -all; export always walks every page by cursor.
+	- The table shows each phase's approval status, or its work status before any
+	  approval decision.
+	- export -format csv writes the columns of the server's CSV export
+	  (usecase.ExportHeader), so scripts read either file the same way; -format jsonl
+	  writes one pivot row per line with every field.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - runPivot: The pivot command.
+	* - runExport: The export command.
+	────────────────────────────────────────────────────────────────────────── */
+
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	"github.com/PolygonPictures/central30-web/front/client"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+)
+
+// pivotFlags adds the pivot filters to fs; the returned function completes q after
+// parsing.
+func pivotFlags(fs *flag.FlagSet, q *client.PivotQuery) func() {
+	var (
+		approval, work, submittedBy, approvedBy csvList
+		studios, relations, tags, overall       csvList
+		fields, include                         csvList
+		asOf                                    timeFlag
+	)
+	fs.StringVar(&q.Root, "root", "", "root: assets (default), shots or a custom root")
+	fs.StringVar(&q.Sort, "sort", "", "sort key, e.g. group_1, mdl_submitted, latest_activity")
+	fs.StringVar(&q.Dir, "dir", "", "asc or desc")
+	fs.StringVar(&q.Phase, "phase", "", "preferred phase")
+	fs.StringVar(&q.Name, "name", "", "asset name filter")
+	fs.BoolVar(&q.Natural, "natural", false, "natural name order (asset2 before asset10)")
+	fs.Var(&approval, "approval", "approval statuses (comma-separated)")
+	fs.Var(&work, "work", "work statuses (comma-separated)")
+	fs.Var(&submittedBy, "submitted-by", "submitting users (comma-separated)")
+	fs.Var(&approvedBy, "approved-by", "users who last set the approval status (comma-separated)")
+	fs.Var(&studios, "studio", "studios (comma-separated)")
+	fs.Var(&relations, "relation", "relations (comma-separated)")
+	fs.StringVar(&q.RelationMode, "relation-mode", "", "exact or prefix")
+	fs.Var(&tags, "tags", "tags (comma-separated)")
+	fs.Var(&overall, "overall", "overall statuses: approved, retake, in_progress")
+	fs.BoolVar(&q.Overdue, "overdue", false, "only assets with a phase past its due date")
+	fs.Var(&fields, "fields", "phases to return, e.g. mdl,rig")
+	fs.Var(&include, "include", "extras: comment_count, thumbnails, studio, notes")
+	fs.Var(&asOf, "as-of", "the pivot as it was at this time (RFC 3339 or YYYY-MM-DD)")
+	fs.IntVar(&q.PerPage, "per-page", 100, "assets per page")
+	return func() {
+		q.ApprovalStatuses, q.WorkStatuses = approval, work
+		q.SubmittedUsers, q.ApprovalUpdatedUsers = submittedBy, approvedBy
+		q.Studios, q.Relations, q.Tags, q.OverallStatuses = studios, relations, tags, overall
+		q.Fields, q.Include = fields, include
+		q.AsOf = asOf.t
+	}
+}
+
+func runPivot(ctx context.Context, args []string) error {
+	outputs := []string{"table", "json", "jsonl"}
+	fs, c := newFlagSet("pivot", "Lists the asset pivot: the latest status of each asset per phase.", outputs...)
+	var q client.PivotQuery
+	done := pivotFlags(fs, &q)
+	fs.IntVar(&q.Page, "page", 1, "page")
+	all := fs.Bool("all", false, "every page (walked by cursor; -page is ignored)")
+	if err := parse(fs, c, args, outputs...); err != nil {
+		return err
+	}
+	done()
+	api, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	var assets []repository.AssetPivot
+	var res *usecase.PivotResponse
+	if *all {
+		it := api.Assets(c.project, q)
+		for it.Next(ctx) {
+			assets = append(assets, *it.Asset())
+		}
+		if err := it.Err(); err != nil {
+			return err
+		}
+	} else {
+		if res, err = api.ListAssetsPivot(ctx, c.project, q); err != nil {
+			return err
+		}
+		assets = res.Assets
+	}
+
+	switch c.output {
+	case "json":
+		if res != nil {
+			return writeJSON(os.Stdout, res)
+		}
+		return writeJSON(os.Stdout, map[string]any{"assets": assets})
+	case "jsonl":
+		enc := json.NewEncoder(os.Stdout)
+		for i := range assets {
+			if err := enc.Encode(&assets[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := writePivotTable(os.Stdout, assets); err != nil {
+		return err
+	}
+	if res != nil && res.Total != nil {
+		fmt.Fprintf(os.Stderr, "page %d of %d, %d assets\n", res.Page, derefInt(res.PageLast), *res.Total)
+	}
+	return nil
+}
+
+func writePivotTable(out io.Writer, assets []repository.AssetPivot) error {
+	status := func(approval, work *string) string {
+		switch {
+		case approval != nil && *approval != "":
+			return *approval
+		case work != nil && *work != "":
+			return *work
+		}
+		return "-"
+	}
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "GROUP_1\tRELATION\tMDL\tRIG\tBLD\tDSN\tLDV")
+	for i := range assets {
+		a := &assets[i]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", a.Group1, a.Relation,
+			status(a.MDLApprovalStatus, a.MDLWorkStatus),
+			status(a.RIGApprovalStatus, a.RIGWorkStatus),
+			status(a.BLDApprovalStatus, a.BLDWorkStatus),
+			status(a.DSNApprovalStatus, a.DSNWorkStatus),
+			status(a.LDVApprovalStatus, a.LDVWorkStatus))
+	}
+	return w.Flush()
+}
+
+func runExport(ctx context.Context, args []string) error {
+	fs, c := newFlagSet("export", "Writes every asset of the pivot as CSV or JSON lines.")
+	var q client.PivotQuery
+	done := pivotFlags(fs, &q)
+	format := fs.String("format", "csv", "csv or jsonl")
+	path := fs.String("out", "", "output file (default: standard output)")
+	if err := parse(fs, c, args); err != nil {
+		return err
+	}
+	if *format != "csv" && *format != "jsonl" {
+		return usageError(fs, "-format must be csv or jsonl")
+	}
+	done()
+	api, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	out := io.Writer(os.Stdout)
+	var file *os.File
+	if *path != "" {
+		if file, err = os.Create(*path); err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	var write func(a *repository.AssetPivot) error
+	var flush func() error
+	if *format == "csv" {
+		cw := csv.NewWriter(out)
+		if err := cw.Write(usecase.ExportHeader()); err != nil {
+			return err
+		}
+		write = func(a *repository.AssetPivot) error { return cw.Write(usecase.ExportRecord(a)) }
+		flush = func() error { cw.Flush(); return cw.Error() }
+	} else {
+		enc := json.NewEncoder(out)
+		write = func(a *repository.AssetPivot) error { return enc.Encode(a) }
+		flush = func() error { return nil }
+	}
+
+	rows := 0
+	it := api.Assets(c.project, q)
+	for it.Next(ctx) {
+		if err := write(it.Asset()); err != nil {
+			return err
+		}
+		rows++
+	}
+	if err := it.Err(); err != nil {
+		_ = flush()
+		return fmt.Errorf("after %d rows: %w", rows, err)
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	if file != nil {
+		if err := file.Close(); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%d assets exported\n", rows)
+	return nil
+}
+
+func derefInt(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
@@ -0,0 +1,144 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		cmd/reviewctl/review.go
+
+	Module Description:
+		reviewctl list and set-status.
+
+	Details:
+	- list prints one page of review infos (-page, -per-page), or every page with -all.
+	- set-status changes the approval and/or work status of one review info. With
+	  -version (the ETag of the row as read) a change based on an outdated row fails
+	  with 409 instead of overwriting it.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - runList: The list command.
+	* - runSetStatus: The set-status command.
+	────────────────────────────────────────────────────────────────────────── */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/PolygonPictures/central30-web/front/client"
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+func runList(ctx context.Context, args []string) error {
+	outputs := []string{"table", "json", "jsonl"}
+	fs, c := newFlagSet("list", "Lists review infos, newest first.", outputs...)
+	var q client.ReviewQuery
+	var modifiedSince timeFlag
+	fs.StringVar(&q.Root, "root", "", "root, e.g. assets or shots")
+	fs.StringVar(&q.Groups, "groups", "", "group path, e.g. chr/hero")
+	fs.StringVar(&q.Relation, "relation", "", "relation")
+	fs.StringVar(&q.Phase, "phase", "", "phase, e.g. mdl")
+	fs.StringVar(&q.Component, "component", "", "component")
+	fs.StringVar(&q.Take, "take", "", "take")
+	fs.StringVar(&q.Studio, "studio", "", "studio")
+	fs.Var(&modifiedSince, "modified-since", "only rows modified since (RFC 3339 or YYYY-MM-DD)")
+	fs.IntVar(&q.Page, "page", 1, "page")
+	fs.IntVar(&q.PerPage, "per-page", 50, "rows per page")
+	all := fs.Bool("all", false, "every page from -page on")
+	if err := parse(fs, c, args, outputs...); err != nil {
+		return err
+	}
+	q.ModifiedSince = modifiedSince.t
+	api, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	var reviews []*entity.ReviewInfo
+	var total int64
+	lines := json.NewEncoder(os.Stdout)
+	for {
+		res, err := api.ListReviews(ctx, c.project, q)
+		if err != nil {
+			return err
+		}
+		total = res.Total
+		if c.output == "jsonl" {
+			// stream the rows as they come rather than holding every page
+			for _, r := range res.Reviews {
+				if err := lines.Encode(r); err != nil {
+					return err
+				}
+			}
+		} else {
+			reviews = append(reviews, res.Reviews...)
+		}
+		if !*all || res.Next == nil || len(res.Reviews) == 0 {
+			break
+		}
+		q.Page++
+	}
+
+	switch c.output {
+	case "json":
+		return writeJSON(os.Stdout, map[string]any{"reviews": reviews, "total": total})
+	case "jsonl":
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tGROUPS\tRELATION\tPHASE\tTAKE\tAPPROVAL\tWORK\tSUBMITTED\tSUBMITTED BY")
+	for _, r := range reviews {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.ID, strings.Join(r.Groups, "/"), r.Relation, r.Phase, r.Take, r.ApprovalStatus, r.WorkStatus,
+			r.SubmittedAtUtc.Local().Format("2006-01-02 15:04"), r.SubmittedUser)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "%d of %d review infos\n", len(reviews), total)
+	return nil
+}
+
+func runSetStatus(ctx context.Context, args []string) error {
+	fs, c := newFlagSet("set-status", "Sets the approval and/or work status of a review info.", "table", "json")
+	id := fs.Int("id", 0, "review info ID (required)")
+	approval := fs.String("approval", "", "new approval status")
+	work := fs.String("work", "", "new work status")
+	var u client.StatusUpdate
+	fs.StringVar(&u.User, "user", "", "user recorded as the updater (default: the token's user)")
+	fs.StringVar(&u.Version, "version", "", "ETag the change is based on; fails with 409 if the row changed since")
+	fs.StringVar(&u.SessionID, "session", "", "live review session ID")
+	if err := parse(fs, c, args, "table", "json"); err != nil {
+		return err
+	}
+	if *id <= 0 {
+		return usageError(fs, "-id is required")
+	}
+	if *approval == "" && *work == "" {
+		return usageError(fs, "give -approval, -work or both")
+	}
+	if *approval != "" {
+		u.ApprovalStatus = approval
+	}
+	if *work != "" {
+		u.WorkStatus = work
+	}
+	api, err := c.client()
+	if err != nil {
+		return err
+	}
+	r, err := api.UpdateStatus(ctx, c.project, int32(*id), u)
+	if err != nil {
+		return err
+	}
+	if c.output == "json" {
+		return writeJSON(os.Stdout, r)
+	}
+	fmt.Printf("%d %s/%s %s: approval %s, work %s\n",
+		r.ID, strings.Join(r.Groups, "/"), r.Relation, r.Phase, r.ApprovalStatus, r.WorkStatus)
+	return nil
+}
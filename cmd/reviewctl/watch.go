@@ -0,0 +1,68 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		cmd/reviewctl/watch.go
+
+	Module Description:
+		reviewctl watch.
+
+	Details:
+	- Prints the review events of a project until interrupted, reconnecting when the
+	  stream drops (client.StreamEvents). Events committed while reconnecting are not
+	  printed.
+	- -o text prints one summary line per event, -o jsonl the events as JSON lines for
+	  piping into other tools.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - runWatch: The watch command.
+	────────────────────────────────────────────────────────────────────────── */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+func runWatch(ctx context.Context, args []string) error {
+	outputs := []string{"text", "jsonl"}
+	fs, c := newFlagSet("watch", "Prints review events as they happen, until interrupted.", outputs...)
+	var types csvList
+	fs.Var(&types, "type", "event types, e.g. review.created,review.approval_status_changed")
+	if err := parse(fs, c, args, outputs...); err != nil {
+		return err
+	}
+	api, err := c.client()
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	err = api.StreamEvents(ctx, c.project, func(e *entity.ReviewEvent) error {
+		if c.output == "jsonl" {
+			return enc.Encode(e)
+		}
+		line := e.OccurredAtUtc.Local().Format("2006-01-02 15:04:05") + "  " + e.Type
+		if r := e.Review; r != nil {
+			line += fmt.Sprintf("  %d %s/%s %s %s: approval %s, work %s",
+				r.ID, strings.Join(r.Groups, "/"), r.Relation, r.Phase, r.Take, r.ApprovalStatus, r.WorkStatus)
+		}
+		if e.PreviousApprovalStatus != "" {
+			line += " (was " + e.PreviousApprovalStatus + ")"
+		}
+		_, err := fmt.Println(line)
+		return err
+	}, types...)
+	if errors.Is(err, context.Canceled) {
+		return nil // interrupted
+	}
+	return err
+}
@@ -0,0 +1,198 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/admin.go
+
+	Module Description:
+		Admin API for tuning the asset pivot at run time.
+
+	Details:
+	- Mounted under /api/admin behind the regular API authentication and AdminToken,
+	  which also requires the X-Admin-Token header to match PPI_ADMIN_TOKEN. Without
+	  PPI_ADMIN_TOKEN the admin API is not served.
+	- GET|PUT /admin/projects/:project/query-policy    project query policy (stored in MySQL)
+	- POST    /admin/projects/:project/cache/flush     drops the cached pivot pages and totals
+	- GET|PUT /admin/cache-ttls                        {"pivot_page_seconds", "count_fresh_seconds", "count_stale_seconds"}
+	- GET     /admin/circuit-breakers
+	- PUT     /admin/circuit-breakers/:endpoint        {"failure_threshold", "window_seconds", "open_for_seconds", "half_open_probes"}
+	- Query policies and flushes reach every instance. Cache TTLs and circuit breaker
+	  thresholds only change the instance serving the request and fall back to their
+	  configured values on restart.
+	- Omitted or zero fields of a PUT keep the current value. Every change is logged with
+	  the admin user.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* AdminToken: Returns the middleware checking the admin token.
+		* NewAdmin: Creates a new Admin handler.
+		* (Admin) FlushCache: Drops the cached pivot pages and totals of a project.
+		* (Admin) GetCacheTTLs / PutCacheTTLs: Read and change the pivot cache lifetimes.
+		* (Admin) ListCircuitBreakers / PutCircuitBreaker: Read and change breaker thresholds.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminToken rejects requests whose X-Admin-Token header is not token.
+func AdminToken(token string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		got := c.GetHeader("X-Admin-Token")
+		if token == "" || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			abortWithError(c, http.StatusForbidden, CodeForbidden,
+				errors.New("a valid X-Admin-Token is required"), nil)
+			return
+		}
+		c.Next()
+	}
+}
+
+func NewAdmin(
+	uc *usecase.ReviewInfo,
+	breakers ...*CircuitBreaker,
+) *Admin {
+	h := &Admin{
+		uc:       uc,
+		breakers: map[string]*CircuitBreaker{},
+	}
+	for _, b := range breakers {
+		h.breakers[b.Endpoint()] = b
+	}
+	return h
+}
+
+type Admin struct {
+	uc       *usecase.ReviewInfo
+	breakers map[string]*CircuitBreaker
+}
+
+func (h *Admin) FlushCache(c *gin.Context) {
+	project := c.Param("project")
+	if err := h.uc.FlushPivotCache(c.Request.Context(), project); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	log.Printf("[ADMIN] %s flushed the pivot cache of %s", authUser(c), project)
+	c.PureJSON(http.StatusOK, gin.H{"project": project, "flushed": true})
+}
+
+type cacheTTLsResponse struct {
+	PivotPageSeconds  float64 `json:"pivot_page_seconds"`
+	CountFreshSeconds float64 `json:"count_fresh_seconds"`
+	CountStaleSeconds float64 `json:"count_stale_seconds"`
+}
+
+func newCacheTTLsResponse(t entity.CacheTTLs) cacheTTLsResponse {
+	return cacheTTLsResponse{
+		PivotPageSeconds:  t.PivotPage.Seconds(),
+		CountFreshSeconds: t.CountFresh.Seconds(),
+		CountStaleSeconds: t.CountStale.Seconds(),
+	}
+}
+
+func (h *Admin) GetCacheTTLs(c *gin.Context) {
+	c.PureJSON(http.StatusOK, gin.H{"cache_ttls": newCacheTTLsResponse(h.uc.CacheTTLs())})
+}
+
+type putCacheTTLsParams struct {
+	PivotPageSeconds  int `json:"pivot_page_seconds" binding:"min=0,max=3600"`
+	CountFreshSeconds int `json:"count_fresh_seconds" binding:"min=0,max=3600"`
+	CountStaleSeconds int `json:"count_stale_seconds" binding:"min=0,max=3600"`
+}
+
+func (h *Admin) PutCacheTTLs(c *gin.Context) {
+	var p putCacheTTLsParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	t, err := h.uc.SetCacheTTLs(entity.CacheTTLs{
+		PivotPage:  time.Duration(p.PivotPageSeconds) * time.Second,
+		CountFresh: time.Duration(p.CountFreshSeconds) * time.Second,
+		CountStale: time.Duration(p.CountStaleSeconds) * time.Second,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidCacheTTLs) {
+			badRequest(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	log.Printf("[ADMIN] %s set the pivot cache TTLs", authUser(c))
+	c.PureJSON(http.StatusOK, gin.H{"cache_ttls": newCacheTTLsResponse(t)})
+}
+
+type circuitBreakerResponse struct {
+	Endpoint         string  `json:"endpoint"`
+	FailureThreshold int     `json:"failure_threshold"`
+	WindowSeconds    float64 `json:"window_seconds"`
+	OpenForSeconds   float64 `json:"open_for_seconds"`
+	HalfOpenProbes   int     `json:"half_open_probes"`
+}
+
+func newCircuitBreakerResponse(endpoint string, cfg CircuitBreakerConfig) circuitBreakerResponse {
+	return circuitBreakerResponse{
+		Endpoint:         endpoint,
+		FailureThreshold: cfg.FailureThreshold,
+		WindowSeconds:    cfg.Window.Seconds(),
+		OpenForSeconds:   cfg.OpenFor.Seconds(),
+		HalfOpenProbes:   cfg.HalfOpenProbes,
+	}
+}
+
+func (h *Admin) ListCircuitBreakers(c *gin.Context) {
+	res := make([]circuitBreakerResponse, 0, len(h.breakers))
+	for endpoint, b := range h.breakers {
+		res = append(res, newCircuitBreakerResponse(endpoint, b.Config()))
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Endpoint < res[j].Endpoint })
+	c.PureJSON(http.StatusOK, gin.H{"circuit_breakers": res})
+}
+
+type putCircuitBreakerParams struct {
+	FailureThreshold int `json:"failure_threshold" binding:"min=0,max=1000"`
+	WindowSeconds    int `json:"window_seconds" binding:"min=0,max=3600"`
+	OpenForSeconds   int `json:"open_for_seconds" binding:"min=0,max=3600"`
+	HalfOpenProbes   int `json:"half_open_probes" binding:"min=0,max=100"`
+}
+
+func (h *Admin) PutCircuitBreaker(c *gin.Context) {
+	endpoint := c.Param("endpoint")
+	b, ok := h.breakers[endpoint]
+	if !ok {
+		abortWithError(c, http.StatusNotFound, CodeNotFound,
+			fmt.Errorf("no circuit breaker named %q", endpoint), nil)
+		return
+	}
+	var p putCircuitBreakerParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	cfg := b.SetConfig(CircuitBreakerConfig{
+		FailureThreshold: p.FailureThreshold,
+		Window:           time.Duration(p.WindowSeconds) * time.Second,
+		OpenFor:          time.Duration(p.OpenForSeconds) * time.Second,
+		HalfOpenProbes:   p.HalfOpenProbes,
+	})
+	log.Printf("[ADMIN] %s set the thresholds of circuit breaker %s", authUser(c), endpoint)
+	c.PureJSON(http.StatusOK, gin.H{"circuit_breaker": newCircuitBreakerResponse(endpoint, cfg)})
+}
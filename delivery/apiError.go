@@ -0,0 +1,133 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/apiError.go
+
+	Module Description:
+		Shared error envelope of the API and the middleware writing it.
+
+	Details:
+	- Every error is answered as
+	      {"code": "not_found", "message": "...", "details": {...}, "request_id": "..."}
+	  code is one of the Code* constants and is what clients should branch on; message
+	  is for humans; details is optional and code specific (e.g. retry_after, lock).
+	- Handlers and middlewares call abortWithError, which records the error with the
+	  status it is answered with; ErrorEnvelope writes the response once the chain is
+	  done. The status is set right away, so the circuit breaker and the metrics see it.
+	- Errors recorded with a plain c.Error are mapped by mapError.
+	- Without ErrorEnvelope in the chain, abortWithError writes the envelope itself.
+	- request_id is the trace ID, so a reported error can be looked up in the traces.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Added CodeSyncTokenExpired.
+
+	Functions:
+		* ErrorEnvelope: Returns the middleware writing recorded errors as ErrorResponse.
+		* abortWithError: Aborts the request with an error response.
+		* mapError: Maps an error to its status and ErrorResponse.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// Error codes of ErrorResponse.
+const (
+	CodeBadRequest       = "bad_request"
+	CodeValidationFailed = "validation_failed"
+	CodeUnauthorized     = "unauthorized"
+	CodeForbidden        = "forbidden"
+	CodeNotFound         = "not_found"
+	CodeConflict         = "conflict"
+	CodeReviewLocked     = "review_locked"
+	CodeTimeout          = "timeout"
+	CodeQueryTooComplex  = "query_too_complex"
+	CodeRateLimited      = "rate_limited"
+	CodeSyncTokenExpired = "sync_token_expired"
+	CodeUnavailable      = "unavailable"
+	CodeInternal         = "internal"
+)
+
+type ErrorResponse struct {
+	Code      string `json:"code"`
+	Message   string `json:"message"`
+	Details   any    `json:"details,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// apiError is an error together with the response it is answered with.
+type apiError struct {
+	status  int
+	code    string
+	err     error
+	details any
+}
+
+func (e *apiError) Error() string {
+	return e.err.Error()
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+const errorEnvelopeKey = "delivery.errorEnvelope"
+
+func ErrorEnvelope() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(errorEnvelopeKey, true)
+		c.Next()
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		writeError(c, c.Errors.Last().Err)
+	}
+}
+
+// abortWithError stops the chain and answers err with status, code and details.
+func abortWithError(c *gin.Context, status int, code string, err error, details any) {
+	e := &apiError{status: status, code: code, err: err, details: details}
+	_ = c.Error(e)
+	c.Abort()
+	if _, ok := c.Get(errorEnvelopeKey); !ok {
+		writeError(c, e)
+		return
+	}
+	c.Status(status)
+}
+
+func writeError(c *gin.Context, err error) {
+	status, res := mapError(err)
+	res.RequestID = tracing.TraceID(c.Request.Context())
+	if status >= http.StatusInternalServerError {
+		log.Printf("[ERROR] %s %s: %d %s: %v", c.Request.Method, c.Request.URL.Path, status, res.Code, err)
+	}
+	c.PureJSON(status, res)
+}
+
+func mapError(err error) (int, ErrorResponse) {
+	var e *apiError
+	if errors.As(err, &e) {
+		return e.status, ErrorResponse{Code: e.code, Message: e.err.Error(), Details: e.details}
+	}
+	res := ErrorResponse{Message: err.Error()}
+	switch {
+	case errors.Is(err, entity.ErrRecordNotFound):
+		res.Code = CodeNotFound
+		return http.StatusNotFound, res
+	case errors.Is(err, context.DeadlineExceeded):
+		res.Code = CodeTimeout
+		return http.StatusGatewayTimeout, res
+	}
+	res.Code = CodeInternal
+	return http.StatusInternalServerError, res
+}
@@ -0,0 +1,141 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/assetNote.go
+
+	Module Description:
+		HTTP delivery handlers for the free-form notes of asset relations.
+
+	Details:
+	- GET    /projects/:project/reviews/assets/:asset/:relation/notes      (newest first)
+	- POST   /projects/:project/reviews/assets/:asset/:relation/notes
+	         {"body": "Waiting for the new costume design"}
+	- PATCH  /projects/:project/reviews/assets/:asset/:relation/notes/:id  {"body": "..."}
+	- DELETE /projects/:project/reviews/assets/:asset/:relation/notes/:id
+	- Only the author of a note edits or deletes it (403 otherwise).
+	- ListAssetsPivot accepts include=notes for note_count and latest_note on each row.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) ListNotes: Lists the notes of an asset relation.
+		* (ReviewInfo) CreateNote: Adds a note to an asset relation.
+		* (ReviewInfo) UpdateNote: Replaces the body of a note.
+		* (ReviewInfo) DeleteNote: Deletes a note.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) ListNotes(c *gin.Context) {
+	notes, err := h.uc.ListNotes(c.Request.Context(), &entity.ListAssetNotesParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"notes": notes})
+}
+
+type assetNoteParams struct {
+	Body string `json:"body" binding:"required"`
+}
+
+func (h *ReviewInfo) CreateNote(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	var p assetNoteParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.CreateNote(c.Request.Context(), &entity.CreateAssetNoteParams{
+		Project:   c.Param("project"),
+		Asset:     c.Param("asset"),
+		Relation:  c.Param("relation"),
+		Body:      p.Body,
+		CreatedBy: user,
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusCreated, e)
+}
+
+func (h *ReviewInfo) UpdateNote(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	var p assetNoteParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.UpdateNote(c.Request.Context(), &entity.UpdateAssetNoteParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		ID:       int32(id),
+		Body:     p.Body,
+		User:     user,
+	})
+	if err != nil {
+		assetNoteError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ReviewInfo) DeleteNote(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := h.uc.DeleteNote(c.Request.Context(), &entity.DeleteAssetNoteParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		ID:       int32(id),
+		User:     user,
+	}); err != nil {
+		assetNoteError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func assetNoteError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, entity.ErrRecordNotFound):
+		abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+	case errors.Is(err, entity.ErrAssetNoteNotAuthor):
+		abortWithError(c, http.StatusForbidden, CodeForbidden, err, nil)
+	default:
+		internalServerError(c, err)
+	}
+}
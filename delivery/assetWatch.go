@@ -0,0 +1,141 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/assetWatch.go
+
+	Module Description:
+		HTTP delivery handlers for watching the status changes of an asset.
+
+	Details:
+	- GET    /projects/:project/reviews/assets/:asset/:relation/watch     (the caller's watch)
+	- POST   /projects/:project/reviews/assets/:asset/:relation/watch
+	         {"email": "someone@example.com", "slack_id": "U0123ABCD"}
+	- DELETE /projects/:project/reviews/assets/:asset/:relation/watch
+	- GET    /projects/:project/reviews/assets/:asset/:relation/watchers
+	- At least one of email and slack_id is required; POST again to change them.
+	- Watchers are notified of new submissions and approval / work status changes of
+	  every phase of the asset, except the ones they made themselves.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewAssetWatch: Creates a new AssetWatch handler.
+		* (AssetWatch) Get: Returns the caller's watch on an asset.
+		* (AssetWatch) Watch: Creates or replaces the caller's watch on an asset.
+		* (AssetWatch) Unwatch: Removes the caller's watch on an asset.
+		* (AssetWatch) ListWatchers: Lists the watches of an asset.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewAssetWatch(
+	uc *usecase.AssetWatch,
+) *AssetWatch {
+	return &AssetWatch{
+		uc: uc,
+	}
+}
+
+type AssetWatch struct {
+	uc *usecase.AssetWatch
+}
+
+func (h *AssetWatch) Get(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	e, err := h.uc.Get(c.Request.Context(), &entity.GetAssetWatchParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		User:     user,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			c.PureJSON(http.StatusOK, gin.H{"watch": nil})
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"watch": e})
+}
+
+type watchAssetParams struct {
+	Email   string `json:"email"`
+	SlackID string `json:"slack_id"`
+}
+
+func (h *AssetWatch) Watch(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	var p watchAssetParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Watch(c.Request.Context(), &entity.WatchAssetParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		User:     user,
+		Email:    p.Email,
+		SlackID:  p.SlackID,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) || errors.Is(err, entity.ErrAssetWatchNoChannel) {
+			badRequest(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"watch": e})
+}
+
+func (h *AssetWatch) Unwatch(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	err := h.uc.Unwatch(c.Request.Context(), &entity.UnwatchAssetParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		User:     user,
+	})
+	if err != nil && !errors.Is(err, entity.ErrRecordNotFound) {
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AssetWatch) ListWatchers(c *gin.Context) {
+	watches, err := h.uc.ListWatchers(c.Request.Context(), &entity.ListAssetWatchersParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"watchers": watches})
+}
@@ -0,0 +1,134 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/categoryAccess.go
+
+	Module Description:
+		HTTP delivery handlers for per-role category access rules on the asset pivot.
+
+	Details:
+	- GET    /projects/:project/categoryAccesses?role=
+	- POST   /projects/:project/categoryAccesses   {"role": "viewer", "top_group_node": "prop"}
+	- DELETE /projects/:project/categoryAccesses/:id
+	- Post and Delete need the supervisor role once the project has members (403).
+	- The caller's role is the review role of their project membership, stored in the
+	  gin context by ProjectMember.RoleFromMembership.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - The role comes from project membership instead of an unset auth key.
+		* - 15-10-2026 - Post and Delete answer 403 to callers below supervisor.
+
+	Functions:
+		* NewCategoryAccess: Creates a new CategoryAccess handler.
+		* (CategoryAccess) List: Lists the rules of a project.
+		* (CategoryAccess) Post: Adds a rule.
+		* (CategoryAccess) Delete: Removes a rule.
+		* authRole – utility function: Returns the caller's role from the auth context.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// authRoleKey is the gin context key under which RoleFromMembership stores the role.
+const authRoleKey = "role"
+
+func authRole(c *gin.Context) string {
+	return c.GetString(authRoleKey)
+}
+
+func NewCategoryAccess(
+	uc *usecase.CategoryAccess,
+) *CategoryAccess {
+	return &CategoryAccess{
+		uc: uc,
+	}
+}
+
+type CategoryAccess struct {
+	uc *usecase.CategoryAccess
+}
+
+type listCategoryAccessParams struct {
+	Role *string `form:"role"`
+}
+
+func (h *CategoryAccess) List(c *gin.Context) {
+	var p listCategoryAccessParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	entities, err := h.uc.List(c.Request.Context(), &entity.ListCategoryAccessParams{
+		Project: c.Param("project"),
+		Role:    p.Role,
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, map[string]interface{}{
+		"category_accesses": entities,
+	})
+}
+
+type createCategoryAccessParams struct {
+	Role         string `json:"role" binding:"required"`
+	TopGroupNode string `json:"top_group_node" binding:"required"`
+	CreatedBy    string `json:"created_by"`
+}
+
+func (h *CategoryAccess) Post(c *gin.Context) {
+	var p createCategoryAccessParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Create(c.Request.Context(), &entity.CreateCategoryAccessParams{
+		Project:      c.Param("project"),
+		Role:         p.Role,
+		TopGroupNode: p.TopGroupNode,
+		CreatedBy:    p.CreatedBy,
+	})
+	if err != nil {
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *CategoryAccess) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.DeleteCategoryAccessParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	}
+	if err := h.uc.Delete(c.Request.Context(), params); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("category access with ID %d not found", params.ID))
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,257 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/circuitBreaker.go
+
+	Module Description:
+		Per-route, per-project circuit breaker middleware.
+
+	Details:
+	- A circuit is kept per project (the :project path param), so one project timing out
+	  does not block the others on the same route.
+	- Closed: requests pass. FailureThreshold failures within Window open the circuit.
+	- Open: requests are answered 503 with Retry-After until OpenFor has passed.
+	- Half-open: up to HalfOpenProbes requests pass at once. A successful probe closes the
+	  circuit, a failed one opens it again.
+	- A request fails when IsFailure reports its response status as one; by default the
+	  timeout statuses 408 and 504.
+	- SetConfig changes the thresholds at run time (admin API); open circuits keep the
+	  openUntil they were tripped with.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Answer rejections with the shared error envelope (code unavailable).
+		* - 15-10-2026 - Added Config / SetConfig for run-time tuning.
+
+	Functions:
+		* DefaultCircuitBreakerConfig: Returns the thresholds used by the pivot route.
+		* NewCircuitBreaker: Creates a circuit breaker for one route.
+		* (CircuitBreaker) Middleware: Returns the gin middleware guarding the route.
+		* (CircuitBreaker) Endpoint: Returns the name of the breaker.
+		* (CircuitBreaker) Config / SetConfig: Read and change the thresholds.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+type CircuitBreakerConfig struct {
+	FailureThreshold int                   // failures within Window that open the circuit
+	Window           time.Duration         // failures older than this are forgotten
+	OpenFor          time.Duration         // how long an open circuit rejects requests
+	HalfOpenProbes   int                   // requests let through at once after OpenFor
+	IsFailure        func(status int) bool // nil counts 408 and 504
+}
+
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		FailureThreshold: 3,
+		Window:           60 * time.Second,
+		OpenFor:          30 * time.Second,
+		HalfOpenProbes:   1,
+	}
+}
+
+func isTimeoutStatus(status int) bool {
+	return status == http.StatusRequestTimeout || status == http.StatusGatewayTimeout
+}
+
+type circuit struct {
+	state     circuitState
+	failures  []time.Time // failures while closed, oldest first
+	openUntil time.Time
+	probes    int // probes in flight while half-open
+}
+
+type CircuitBreaker struct {
+	endpoint string
+	cfg      CircuitBreakerConfig
+	mu       sync.Mutex
+	circuits map[string]*circuit
+}
+
+// NewCircuitBreaker creates the breaker of endpoint, which names it in logs and metrics.
+// Zero fields of cfg take the DefaultCircuitBreakerConfig values.
+func NewCircuitBreaker(endpoint string, cfg CircuitBreakerConfig) *CircuitBreaker {
+	b := &CircuitBreaker{
+		endpoint: endpoint,
+		cfg:      cfg.withDefaults(DefaultCircuitBreakerConfig()),
+		circuits: map[string]*circuit{},
+	}
+	// The gauge is 1 while the circuit of any project is open.
+	metrics.RegisterCircuitBreaker(endpoint, b.anyOpen)
+	return b
+}
+
+// withDefaults returns cfg with its zero fields taken from def.
+func (cfg CircuitBreakerConfig) withDefaults(def CircuitBreakerConfig) CircuitBreakerConfig {
+	if cfg.FailureThreshold <= 0 {
+		cfg.FailureThreshold = def.FailureThreshold
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = def.Window
+	}
+	if cfg.OpenFor <= 0 {
+		cfg.OpenFor = def.OpenFor
+	}
+	if cfg.HalfOpenProbes <= 0 {
+		cfg.HalfOpenProbes = def.HalfOpenProbes
+	}
+	if cfg.IsFailure == nil {
+		cfg.IsFailure = def.IsFailure
+	}
+	if cfg.IsFailure == nil {
+		cfg.IsFailure = isTimeoutStatus
+	}
+	return cfg
+}
+
+func (b *CircuitBreaker) Endpoint() string {
+	return b.endpoint
+}
+
+func (b *CircuitBreaker) Config() CircuitBreakerConfig {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.cfg
+}
+
+// SetConfig replaces the thresholds of the breaker; zero fields keep the current value.
+func (b *CircuitBreaker) SetConfig(cfg CircuitBreakerConfig) CircuitBreakerConfig {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cfg = cfg.withDefaults(b.cfg)
+	log.Printf("[CIRCUIT] %s thresholds set: %d failures within %v, open for %v, %d probes",
+		b.endpoint, b.cfg.FailureThreshold, b.cfg.Window, b.cfg.OpenFor, b.cfg.HalfOpenProbes)
+	return b.cfg
+}
+
+func (b *CircuitBreaker) anyOpen() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	for _, ct := range b.circuits {
+		if ct.state == circuitOpen && now.Before(ct.openUntil) {
+			return true
+		}
+	}
+	return false
+}
+
+// allow reports whether a request of key may pass and whether it is a half-open probe.
+// When rejected, retryAfter is how long the caller should wait.
+func (b *CircuitBreaker) allow(key string, now time.Time) (ok, probe bool, retryAfter time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	ct, found := b.circuits[key]
+	if !found {
+		return true, false, 0
+	}
+	if ct.state == circuitOpen {
+		if now.Before(ct.openUntil) {
+			return false, false, ct.openUntil.Sub(now)
+		}
+		ct.state = circuitHalfOpen
+		ct.probes = 0
+		log.Printf("[CIRCUIT] %s/%s half-open - probing", b.endpoint, key)
+	}
+	if ct.state == circuitHalfOpen {
+		if ct.probes >= b.cfg.HalfOpenProbes {
+			return false, false, time.Second
+		}
+		ct.probes++
+		return true, true, 0
+	}
+	return true, false, 0
+}
+
+// record accounts the outcome, the response status, of a request allowed by allow.
+func (b *CircuitBreaker) record(key string, probe bool, status int, now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	failed := b.cfg.IsFailure(status)
+	ct, found := b.circuits[key]
+	if !found {
+		if !failed {
+			return
+		}
+		ct = &circuit{}
+		b.circuits[key] = ct
+	}
+
+	if probe {
+		if ct.state != circuitHalfOpen {
+			return
+		}
+		ct.probes--
+		if failed {
+			b.trip(key, ct, now)
+			return
+		}
+		log.Printf("[CIRCUIT] %s/%s closed - probe succeeded", b.endpoint, key)
+		delete(b.circuits, key)
+		return
+	}
+
+	// Requests admitted before the circuit opened do not count once it has.
+	if ct.state != circuitClosed || !failed {
+		return
+	}
+	cutoff := now.Add(-b.cfg.Window)
+	kept := ct.failures[:0]
+	for _, at := range ct.failures {
+		if at.After(cutoff) {
+			kept = append(kept, at)
+		}
+	}
+	ct.failures = append(kept, now)
+	log.Printf("[CIRCUIT] %s/%s failure (%d within %v)", b.endpoint, key, len(ct.failures), b.cfg.Window)
+	if len(ct.failures) >= b.cfg.FailureThreshold {
+		b.trip(key, ct, now)
+	}
+}
+
+// trip opens ct. Caller holds b.mu.
+func (b *CircuitBreaker) trip(key string, ct *circuit, now time.Time) {
+	ct.state = circuitOpen
+	ct.openUntil = now.Add(b.cfg.OpenFor)
+	ct.failures = nil
+	ct.probes = 0
+	metrics.CircuitBreakerTrips.WithLabelValues(b.endpoint).Inc()
+	log.Printf("[CIRCUIT] %s/%s open for %v", b.endpoint, key, b.cfg.OpenFor)
+}
+
+func (b *CircuitBreaker) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.Param("project")
+		ok, probe, retryAfter := b.allow(key, time.Now())
+		if !ok {
+			seconds := int(math.Ceil(retryAfter.Seconds()))
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			abortWithError(c, http.StatusServiceUnavailable, CodeUnavailable,
+				fmt.Errorf("this service is experiencing high load for project %s, please try again in %d seconds", key, seconds),
+				gin.H{"retry_after": seconds})
+			return
+		}
+		c.Next()
+		b.record(key, probe, c.Writer.Status(), time.Now())
+	}
+}
@@ -0,0 +1,241 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/compression.go
+
+	Module Description:
+		gin middleware compressing responses with brotli or gzip.
+
+	Details:
+	- The encoding follows Accept-Encoding: br is preferred over gzip, q=0 opts out.
+	- Bodies are buffered up to MinSize; smaller responses (errors, 304s, small lists)
+	  go out as they are, since compressing them costs more than it saves.
+	- Only ContentTypes are compressed (JSON, text, CSV, JS, SVG). Responses that
+	  already carry a Content-Encoding, partial content and HEAD requests are skipped.
+	- A compressed response gets a weak ETag, as its bytes differ from the identity
+	  encoding; If-None-Match comparisons ignore the W/ prefix (see etagMatches).
+	- A Flush (streamed CSV downloads) starts compressing without waiting for MinSize.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* DefaultCompressionConfig: Returns the compression settings used by main.
+		* Compression: Returns the response compression middleware.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+type CompressionConfig struct {
+	MinSize      int      // bodies below this many bytes are sent uncompressed
+	GzipLevel    int      // compress/gzip level
+	BrotliLevel  int      // brotli quality, 0-11
+	ContentTypes []string // compressible Content-Type prefixes
+}
+
+func DefaultCompressionConfig() CompressionConfig {
+	return CompressionConfig{
+		MinSize:     1024,
+		GzipLevel:   gzip.DefaultCompression,
+		BrotliLevel: 4, // close to gzip's speed, noticeably smaller on pivot JSON
+		ContentTypes: []string{
+			"application/json",
+			"application/javascript",
+			"application/xml",
+			"image/svg+xml",
+			"text/",
+		},
+	}
+}
+
+// encoder is the part of gzip.Writer and brotli.Writer the middleware uses.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(w io.Writer)
+}
+
+func Compression(cfg CompressionConfig) gin.HandlerFunc {
+	pools := map[string]*sync.Pool{
+		"br": {New: func() any {
+			return brotli.NewWriterLevel(io.Discard, cfg.BrotliLevel)
+		}},
+		"gzip": {New: func() any {
+			w, err := gzip.NewWriterLevel(io.Discard, cfg.GzipLevel)
+			if err != nil {
+				w = gzip.NewWriter(io.Discard)
+			}
+			return w
+		}},
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.Method == http.MethodHead || c.GetHeader("Range") != "" {
+			c.Next()
+			return
+		}
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" {
+			c.Next()
+			return
+		}
+
+		w := &compressWriter{
+			ResponseWriter: c.Writer,
+			cfg:            &cfg,
+			encoding:       encoding,
+			pool:           pools[encoding],
+		}
+		c.Writer = w
+		defer func() {
+			w.finish()
+			c.Writer = w.ResponseWriter
+		}()
+		c.Next()
+	}
+}
+
+// negotiateEncoding picks br or gzip from an Accept-Encoding header, "" for neither.
+func negotiateEncoding(acceptEncoding string) string {
+	q := map[string]float64{}
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		weight := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				weight = f
+			}
+		}
+		q[name] = weight
+	}
+	accepts := func(name string) bool {
+		if w, ok := q[name]; ok {
+			return w > 0
+		}
+		w, ok := q["*"]
+		return ok && w > 0
+	}
+	switch {
+	case accepts("br"):
+		return "br"
+	case accepts("gzip"):
+		return "gzip"
+	}
+	return ""
+}
+
+// compressWriter holds the body back until it knows whether compressing pays off.
+type compressWriter struct {
+	gin.ResponseWriter
+	cfg      *CompressionConfig
+	encoding string
+	pool     *sync.Pool
+
+	buf     []byte
+	decided bool
+	enc     encoder // nil when the body goes out uncompressed
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if w.decided {
+		if w.enc == nil {
+			return w.ResponseWriter.Write(p)
+		}
+		return w.enc.Write(p)
+	}
+	w.buf = append(w.buf, p...)
+	if len(w.buf) < w.cfg.MinSize {
+		return len(p), nil
+	}
+	if err := w.decide(true); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.decide(true)
+	}
+	if w.enc != nil {
+		_ = w.enc.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// decide writes the buffered body, compressing it and everything after when allowed
+// and the response is compressible.
+func (w *compressWriter) decide(allowed bool) error {
+	w.decided = true
+	buf := w.buf
+	w.buf = nil
+	if allowed && w.compressible() {
+		h := w.Header()
+		h.Set("Content-Encoding", w.encoding)
+		h.Del("Content-Length")
+		if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			h.Set("ETag", "W/"+etag)
+		}
+		w.enc = w.pool.Get().(encoder)
+		w.enc.Reset(w.ResponseWriter)
+		_, err := w.enc.Write(buf)
+		return err
+	}
+	if len(buf) == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+func (w *compressWriter) compressible() bool {
+	switch w.Status() {
+	case http.StatusNoContent, http.StatusPartialContent, http.StatusNotModified:
+		return false
+	}
+	h := w.Header()
+	if h.Get("Content-Encoding") != "" {
+		return false
+	}
+	contentType := strings.ToLower(h.Get("Content-Type"))
+	for _, prefix := range w.cfg.ContentTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// finish sends a body still below MinSize as is and closes the encoder.
+func (w *compressWriter) finish() {
+	if !w.decided {
+		_ = w.decide(false)
+		return
+	}
+	if w.enc != nil {
+		_ = w.enc.Close()
+		w.enc.Reset(io.Discard)
+		w.pool.Put(w.enc)
+		w.enc = nil
+	}
+}
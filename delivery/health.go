@@ -0,0 +1,126 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/health.go
+
+	Module Description:
+		Liveness and readiness endpoints with dependency checks.
+
+	Details:
+	- GET /healthz runs the Liveness checks only (the MySQL handle): a failure means
+	  the instance cannot recover by itself and should be restarted.
+	- GET /readyz runs every check: a failing Critical check answers 503 so the
+	  instance gets no traffic; other failures are reported as "degraded" with 200,
+	  since the app works around them (replica → primary, cache → MySQL).
+	- Both answer {"status": "ok"|"degraded"|"fail", "checks": {name: {...}}}.
+	- Checks run concurrently, each bounded by the Health timeout.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewHealth: Creates the health handlers for a set of checks.
+		* (Health) Liveness: Handles /healthz.
+		* (Health) Readiness: Handles /readyz.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Health statuses, per check and overall.
+const (
+	HealthStatusOK       = "ok"
+	HealthStatusDegraded = "degraded"
+	HealthStatusFail     = "fail"
+)
+
+type HealthCheck struct {
+	Name     string
+	Liveness bool // also run by /healthz
+	Critical bool // failing makes the instance not ready
+	Check    func(ctx context.Context) error
+}
+
+type healthCheckResult struct {
+	Status     string  `json:"status"`
+	Error      string  `json:"error,omitempty"`
+	DurationMs float64 `json:"duration_ms"`
+}
+
+type healthResponse struct {
+	Status string                       `json:"status"`
+	Checks map[string]healthCheckResult `json:"checks"`
+}
+
+type Health struct {
+	checks  []HealthCheck
+	timeout time.Duration
+}
+
+func NewHealth(timeout time.Duration, checks ...HealthCheck) *Health {
+	return &Health{
+		checks:  checks,
+		timeout: timeout,
+	}
+}
+
+func (h *Health) Liveness(c *gin.Context) {
+	h.respond(c, true)
+}
+
+func (h *Health) Readiness(c *gin.Context) {
+	h.respond(c, false)
+}
+
+func (h *Health) respond(c *gin.Context, livenessOnly bool) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), h.timeout)
+	defer cancel()
+
+	res := healthResponse{Status: HealthStatusOK, Checks: map[string]healthCheckResult{}}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range h.checks {
+		if livenessOnly && !check.Liveness {
+			continue
+		}
+		wg.Add(1)
+		go func(check HealthCheck) {
+			defer wg.Done()
+			start := time.Now()
+			err := check.Check(ctx)
+			r := healthCheckResult{
+				Status:     HealthStatusOK,
+				DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				r.Error = err.Error()
+				r.Status = HealthStatusDegraded
+				if check.Critical {
+					r.Status = HealthStatusFail
+					res.Status = HealthStatusFail
+				} else if res.Status == HealthStatusOK {
+					res.Status = HealthStatusDegraded
+				}
+				log.Printf("[HEALTH] %s: %s: %v", check.Name, r.Status, err)
+			}
+			res.Checks[check.Name] = r
+		}(check)
+	}
+	wg.Wait()
+
+	code := http.StatusOK
+	if res.Status == HealthStatusFail {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, res)
+}
@@ -0,0 +1,110 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/jsonapi/jsonapi.go
+
+	Module Description:
+		JSON:API (application/vnd.api+json) documents of the list and pivot endpoints.
+
+	Details:
+	- Clients asking for JSON:API in the Accept header get a document of resources
+	  (type, id, attributes, relationships, links) instead of the plain JSON payload.
+	  The handlers keep producing their usecase results; the delivery serializers map
+	  them onto these types.
+	- Attributes are the resource's plain JSON fields, so both outputs name them alike.
+	- Only the top-level document is built here; what a resource is (its type, id and
+	  relationships) is decided by the serializer of each endpoint.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Accepts: Reports whether an Accept header asks for JSON:API.
+	* - Attributes: Returns the JSON fields of a value as resource attributes.
+	* - (Relationship) One / Many: Build relationships to one or several resources.
+	────────────────────────────────────────────────────────────────────────── */
+
+package jsonapi
+
+import (
+	"encoding/json"
+	"mime"
+	"strings"
+)
+
+// MediaType is the JSON:API media type.
+const MediaType = "application/vnd.api+json"
+
+// Document is a JSON:API top-level document; Data is a *Resource or a []Resource.
+type Document struct {
+	Data     any               `json:"data"`
+	Included []Resource        `json:"included,omitempty"`
+	Meta     map[string]any    `json:"meta,omitempty"`
+	Links    map[string]string `json:"links,omitempty"`
+}
+
+// Identifier identifies a resource in a relationship.
+type Identifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Resource is a JSON:API resource object.
+type Resource struct {
+	Type          string                  `json:"type"`
+	ID            string                  `json:"id"`
+	Attributes    map[string]any          `json:"attributes,omitempty"`
+	Relationships map[string]Relationship `json:"relationships,omitempty"`
+	Links         map[string]string       `json:"links,omitempty"`
+}
+
+// Relationship is a relationship object; Data is an *Identifier (nil for an empty
+// to-one relationship) or an []Identifier.
+type Relationship struct {
+	Data  any               `json:"data"`
+	Links map[string]string `json:"links,omitempty"`
+}
+
+// One returns a to-one relationship to id, or an empty one when id is nil.
+func One(id *Identifier) Relationship {
+	if id == nil {
+		return Relationship{Data: nil}
+	}
+	return Relationship{Data: id}
+}
+
+// Many returns a to-many relationship to ids; an empty one is [] rather than null.
+func Many(ids []Identifier) Relationship {
+	if ids == nil {
+		ids = []Identifier{}
+	}
+	return Relationship{Data: ids}
+}
+
+// Accepts reports whether the Accept header accept lists the JSON:API media type.
+// Media types are compared without parameters and case-insensitively.
+func Accepts(accept string) bool {
+	for _, part := range strings.Split(accept, ",") {
+		mt, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err == nil && mt == MediaType {
+			return true
+		}
+	}
+	return false
+}
+
+// Attributes returns the JSON fields of v, without omit (e.g. the fields that
+// become relationships or the id).
+func Attributes(v any, omit ...string) (map[string]any, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	attrs := map[string]any{}
+	if err := json.Unmarshal(body, &attrs); err != nil {
+		return nil, err
+	}
+	for _, k := range omit {
+		delete(attrs, k)
+	}
+	return attrs, nil
+}
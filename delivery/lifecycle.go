@@ -0,0 +1,125 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/lifecycle.go
+
+	Module Description:
+		Graceful shutdown of the HTTP server with in-flight request draining.
+
+	Details:
+	- Shutdown runs in steps:
+	  1. Draining turns true, so /readyz fails and Kubernetes stops routing to the
+	     instance; requests still arriving are served during Delay, as the endpoint
+	     removal takes a moment to propagate.
+	  2. The server stops accepting connections and waits up to Timeout for in-flight
+	     requests (and the repository calls they run) to finish.
+	  3. What is still running then gets its context cancelled, which aborts the MySQL
+	     queries, and gets Cancelled more to return before the process exits.
+	- The request contexts derive from the context passed to Serve (http.Server
+	  BaseContext), so cancelling it in step 3 reaches every handler.
+	- Track counts in-flight requests and answers Connection: close while draining, so
+	  keep-alive clients reconnect to another instance.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Added DrainStarted for the review event streams.
+
+	Functions:
+		* NewLifecycle: Creates a Lifecycle with the given shutdown settings.
+		* (Lifecycle) Track: Returns the middleware counting in-flight requests.
+		* (Lifecycle) Draining: Reports whether the shutdown has started.
+		* (Lifecycle) DrainStarted: Returns a channel closed when the shutdown starts.
+		* (Lifecycle) Serve: Runs the server until ctx is done, then shuts it down.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type LifecycleConfig struct {
+	Delay     time.Duration // serving time after readiness fails, before closing the listener
+	Timeout   time.Duration // wait for in-flight requests
+	Cancelled time.Duration // wait after cancelling the requests still running
+}
+
+type Lifecycle struct {
+	cfg      LifecycleConfig
+	draining atomic.Bool
+	inFlight atomic.Int64
+	drained  chan struct{} // closed when draining starts
+}
+
+func NewLifecycle(cfg LifecycleConfig) *Lifecycle {
+	return &Lifecycle{cfg: cfg, drained: make(chan struct{})}
+}
+
+func (l *Lifecycle) Track() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		l.inFlight.Add(1)
+		defer l.inFlight.Add(-1)
+		if l.draining.Load() {
+			c.Header("Connection", "close")
+		}
+		c.Next()
+	}
+}
+
+func (l *Lifecycle) Draining() bool {
+	return l.draining.Load()
+}
+
+// DrainStarted is closed when draining starts, for long-lived requests (event streams)
+// that would otherwise hold the shutdown up until Timeout.
+func (l *Lifecycle) DrainStarted() <-chan struct{} {
+	return l.drained
+}
+
+// Serve serves s until ctx is done, then drains it as described above. It returns
+// the error of ListenAndServe, or nil after a shutdown.
+func (l *Lifecycle) Serve(ctx context.Context, s *http.Server) error {
+	baseCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.BaseContext = func(net.Listener) context.Context { return baseCtx }
+
+	served := make(chan error, 1)
+	go func() {
+		served <- s.ListenAndServe()
+	}()
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+
+	l.draining.Store(true)
+	close(l.drained)
+	log.Printf("[SHUTDOWN] draining, %d requests in flight", l.inFlight.Load())
+	time.Sleep(l.cfg.Delay)
+
+	shutdownCtx, stop := context.WithTimeout(context.Background(), l.cfg.Timeout)
+	err := s.Shutdown(shutdownCtx)
+	stop()
+	if errors.Is(err, context.DeadlineExceeded) {
+		log.Printf("[SHUTDOWN] cancelling %d requests still in flight after %v", l.inFlight.Load(), l.cfg.Timeout)
+		cancel()
+		deadline := time.Now().Add(l.cfg.Cancelled)
+		for l.inFlight.Load() > 0 && time.Now().Before(deadline) {
+			time.Sleep(50 * time.Millisecond)
+		}
+		err = s.Close()
+	}
+	if err := <-served; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	log.Printf("[SHUTDOWN] done, %d requests left", l.inFlight.Load())
+	return err
+}
@@ -0,0 +1,41 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/metrics.go
+
+	Module Description:
+		gin middleware recording request metrics (see metrics/metrics.go).
+
+	Details:
+	- Requests not matching any route are counted under endpoint "unmatched".
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* Metrics: Returns the request metrics middleware.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/metrics"
+	"github.com/gin-gonic/gin"
+)
+
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		endpoint := c.FullPath()
+		if endpoint == "" {
+			endpoint = "unmatched"
+		}
+		method := c.Request.Method
+		metrics.HTTPRequests.WithLabelValues(endpoint, method, strconv.Itoa(c.Writer.Status())).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(endpoint, method).Observe(time.Since(start).Seconds())
+	}
+}
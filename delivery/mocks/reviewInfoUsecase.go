@@ -0,0 +1,660 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: reviewInfoUsecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=reviewInfoUsecase.go -destination=mocks/reviewInfoUsecase.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/PolygonPictures/central30-web/front/entity"
+	repository "github.com/PolygonPictures/central30-web/front/repository"
+	usecase "github.com/PolygonPictures/central30-web/front/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewInfoUsecase is a mock of ReviewInfoUsecase interface.
+type MockReviewInfoUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewInfoUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewInfoUsecaseMockRecorder is the mock recorder for MockReviewInfoUsecase.
+type MockReviewInfoUsecaseMockRecorder struct {
+	mock *MockReviewInfoUsecase
+}
+
+// NewMockReviewInfoUsecase creates a new mock instance.
+func NewMockReviewInfoUsecase(ctrl *gomock.Controller) *MockReviewInfoUsecase {
+	mock := &MockReviewInfoUsecase{ctrl: ctrl}
+	mock.recorder = &MockReviewInfoUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewInfoUsecase) EXPECT() *MockReviewInfoUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddTags mocks base method.
+func (m *MockReviewInfoUsecase) AddTags(ctx context.Context, params *entity.AddReviewTagsParams) ([]*entity.ReviewTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTags", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTags indicates an expected call of AddTags.
+func (mr *MockReviewInfoUsecaseMockRecorder) AddTags(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTags", reflect.TypeOf((*MockReviewInfoUsecase)(nil).AddTags), ctx, params)
+}
+
+// ApprovalLatency mocks base method.
+func (m *MockReviewInfoUsecase) ApprovalLatency(ctx context.Context, params *entity.ReviewApprovalLatencyParams) ([]*entity.ReviewApprovalLatency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApprovalLatency", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewApprovalLatency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApprovalLatency indicates an expected call of ApprovalLatency.
+func (mr *MockReviewInfoUsecaseMockRecorder) ApprovalLatency(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApprovalLatency", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ApprovalLatency), ctx, params)
+}
+
+// AssetTimeline mocks base method.
+func (m *MockReviewInfoUsecase) AssetTimeline(ctx context.Context, params *entity.AssetTimelineParams) (*entity.AssetTimeline, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssetTimeline", ctx, params)
+	ret0, _ := ret[0].(*entity.AssetTimeline)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssetTimeline indicates an expected call of AssetTimeline.
+func (mr *MockReviewInfoUsecaseMockRecorder) AssetTimeline(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssetTimeline", reflect.TypeOf((*MockReviewInfoUsecase)(nil).AssetTimeline), ctx, params)
+}
+
+// BatchAssetDetails mocks base method.
+func (m *MockReviewInfoUsecase) BatchAssetDetails(ctx context.Context, params *entity.BatchAssetDetailParams) ([]repository.AssetPivot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchAssetDetails", ctx, params)
+	ret0, _ := ret[0].([]repository.AssetPivot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchAssetDetails indicates an expected call of BatchAssetDetails.
+func (mr *MockReviewInfoUsecaseMockRecorder) BatchAssetDetails(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchAssetDetails", reflect.TypeOf((*MockReviewInfoUsecase)(nil).BatchAssetDetails), ctx, params)
+}
+
+// BulkSetWorkStatus mocks base method.
+func (m *MockReviewInfoUsecase) BulkSetWorkStatus(ctx context.Context, params *entity.BulkSetWorkStatusParams) (*entity.BulkSetWorkStatusResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkSetWorkStatus", ctx, params)
+	ret0, _ := ret[0].(*entity.BulkSetWorkStatusResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkSetWorkStatus indicates an expected call of BulkSetWorkStatus.
+func (mr *MockReviewInfoUsecaseMockRecorder) BulkSetWorkStatus(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkSetWorkStatus", reflect.TypeOf((*MockReviewInfoUsecase)(nil).BulkSetWorkStatus), ctx, params)
+}
+
+// ClearDueDate mocks base method.
+func (m *MockReviewInfoUsecase) ClearDueDate(ctx context.Context, params *entity.ClearReviewDueDateParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearDueDate", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearDueDate indicates an expected call of ClearDueDate.
+func (mr *MockReviewInfoUsecaseMockRecorder) ClearDueDate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDueDate", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ClearDueDate), ctx, params)
+}
+
+// Compare mocks base method.
+func (m *MockReviewInfoUsecase) Compare(ctx context.Context, params *entity.ReviewCompareParams) (*entity.ReviewCompare, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compare", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewCompare)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Compare indicates an expected call of Compare.
+func (mr *MockReviewInfoUsecaseMockRecorder) Compare(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Compare), ctx, params)
+}
+
+// Completion mocks base method.
+func (m *MockReviewInfoUsecase) Completion(ctx context.Context, params *entity.ReviewCompletionParams) (*entity.ReviewCompletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Completion", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewCompletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Completion indicates an expected call of Completion.
+func (mr *MockReviewInfoUsecaseMockRecorder) Completion(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Completion", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Completion), ctx, params)
+}
+
+// Create mocks base method.
+func (m *MockReviewInfoUsecase) Create(ctx context.Context, params *entity.CreateReviewInfoParams) (*entity.ReviewInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockReviewInfoUsecaseMockRecorder) Create(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Create), ctx, params)
+}
+
+// CreateBatch mocks base method.
+func (m *MockReviewInfoUsecase) CreateBatch(ctx context.Context, project string, items []*entity.CreateReviewInfoParams) ([]*entity.ReviewInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, project, items)
+	ret0, _ := ret[0].([]*entity.ReviewInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockReviewInfoUsecaseMockRecorder) CreateBatch(ctx, project, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockReviewInfoUsecase)(nil).CreateBatch), ctx, project, items)
+}
+
+// CreateNote mocks base method.
+func (m *MockReviewInfoUsecase) CreateNote(ctx context.Context, params *entity.CreateAssetNoteParams) (*entity.AssetNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNote", ctx, params)
+	ret0, _ := ret[0].(*entity.AssetNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNote indicates an expected call of CreateNote.
+func (mr *MockReviewInfoUsecaseMockRecorder) CreateNote(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockReviewInfoUsecase)(nil).CreateNote), ctx, params)
+}
+
+// Delete mocks base method.
+func (m *MockReviewInfoUsecase) Delete(ctx context.Context, params *entity.DeleteReviewInfoParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockReviewInfoUsecaseMockRecorder) Delete(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Delete), ctx, params)
+}
+
+// DeleteNote mocks base method.
+func (m *MockReviewInfoUsecase) DeleteNote(ctx context.Context, params *entity.DeleteAssetNoteParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNote", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNote indicates an expected call of DeleteNote.
+func (mr *MockReviewInfoUsecaseMockRecorder) DeleteNote(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockReviewInfoUsecase)(nil).DeleteNote), ctx, params)
+}
+
+// Get mocks base method.
+func (m *MockReviewInfoUsecase) Get(ctx context.Context, params *entity.GetReviewParams) (*entity.ReviewInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReviewInfoUsecaseMockRecorder) Get(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Get), ctx, params)
+}
+
+// List mocks base method.
+func (m *MockReviewInfoUsecase) List(ctx context.Context, params *entity.ListReviewInfoParams, submittedUsers, approvalUpdatedUsers, tags []string) ([]*entity.ReviewInfo, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, params, submittedUsers, approvalUpdatedUsers, tags)
+	ret0, _ := ret[0].([]*entity.ReviewInfo)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// List indicates an expected call of List.
+func (mr *MockReviewInfoUsecaseMockRecorder) List(ctx, params, submittedUsers, approvalUpdatedUsers, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReviewInfoUsecase)(nil).List), ctx, params, submittedUsers, approvalUpdatedUsers, tags)
+}
+
+// ListAssetReviewInfos mocks base method.
+func (m *MockReviewInfoUsecase) ListAssetReviewInfos(ctx context.Context, params *entity.AssetReviewInfoListParams) ([]*entity.ReviewInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssetReviewInfos", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssetReviewInfos indicates an expected call of ListAssetReviewInfos.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListAssetReviewInfos(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssetReviewInfos", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListAssetReviewInfos), ctx, params)
+}
+
+// ListAssets mocks base method.
+func (m *MockReviewInfoUsecase) ListAssets(ctx context.Context, params *entity.AssetListParams, submittedUsers, approvalUpdatedUsers, tags []string) ([]*entity.Asset, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssets", ctx, params, submittedUsers, approvalUpdatedUsers, tags)
+	ret0, _ := ret[0].([]*entity.Asset)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListAssets indicates an expected call of ListAssets.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListAssets(ctx, params, submittedUsers, approvalUpdatedUsers, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssets", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListAssets), ctx, params, submittedUsers, approvalUpdatedUsers, tags)
+}
+
+// ListAssetsPivot mocks base method.
+func (m *MockReviewInfoUsecase) ListAssetsPivot(ctx context.Context, p usecase.ListAssetsPivotParams) (*usecase.ListAssetsPivotResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAssetsPivot", ctx, p)
+	ret0, _ := ret[0].(*usecase.ListAssetsPivotResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAssetsPivot indicates an expected call of ListAssetsPivot.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListAssetsPivot(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAssetsPivot", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListAssetsPivot), ctx, p)
+}
+
+// ListComments mocks base method.
+func (m *MockReviewInfoUsecase) ListComments(ctx context.Context, params *entity.ListReviewCommentsParams) ([]*entity.ReviewComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListComments", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListComments indicates an expected call of ListComments.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListComments(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListComments", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListComments), ctx, params)
+}
+
+// ListDueDates mocks base method.
+func (m *MockReviewInfoUsecase) ListDueDates(ctx context.Context, params *entity.ListReviewDueDatesParams) ([]*entity.ReviewDueDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueDates", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewDueDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueDates indicates an expected call of ListDueDates.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListDueDates(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueDates", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListDueDates), ctx, params)
+}
+
+// ListNotes mocks base method.
+func (m *MockReviewInfoUsecase) ListNotes(ctx context.Context, params *entity.ListAssetNotesParams) ([]*entity.AssetNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNotes", ctx, params)
+	ret0, _ := ret[0].([]*entity.AssetNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNotes indicates an expected call of ListNotes.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListNotes(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNotes", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListNotes), ctx, params)
+}
+
+// ListProjectTags mocks base method.
+func (m *MockReviewInfoUsecase) ListProjectTags(ctx context.Context, params *entity.ListProjectReviewTagsParams) ([]*entity.ReviewTagCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectTags", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewTagCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectTags indicates an expected call of ListProjectTags.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListProjectTags(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectTags", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListProjectTags), ctx, params)
+}
+
+// ListShotReviewInfos mocks base method.
+func (m *MockReviewInfoUsecase) ListShotReviewInfos(ctx context.Context, params *entity.ShotReviewInfoListParams) ([]*entity.ReviewInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShotReviewInfos", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShotReviewInfos indicates an expected call of ListShotReviewInfos.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListShotReviewInfos(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShotReviewInfos", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListShotReviewInfos), ctx, params)
+}
+
+// ListTags mocks base method.
+func (m *MockReviewInfoUsecase) ListTags(ctx context.Context, params *entity.ListReviewTagsParams) ([]*entity.ReviewTag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTags", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTags indicates an expected call of ListTags.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListTags(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListTags), ctx, params)
+}
+
+// ListTakes mocks base method.
+func (m *MockReviewInfoUsecase) ListTakes(ctx context.Context, params *entity.ListReviewTakesParams) (*entity.ReviewTakeList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTakes", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewTakeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTakes indicates an expected call of ListTakes.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListTakes(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTakes", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListTakes), ctx, params)
+}
+
+// ListTrash mocks base method.
+func (m *MockReviewInfoUsecase) ListTrash(ctx context.Context, params *entity.ReviewTrashListParams) ([]*entity.ReviewInfo, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewInfo)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListTrash(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListTrash), ctx, params)
+}
+
+// ListUnassignedAssets mocks base method.
+func (m *MockReviewInfoUsecase) ListUnassignedAssets(ctx context.Context, params *entity.UnassignedAssetListParams) ([]*entity.UnassignedAsset, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnassignedAssets", ctx, params)
+	ret0, _ := ret[0].([]*entity.UnassignedAsset)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListUnassignedAssets indicates an expected call of ListUnassignedAssets.
+func (mr *MockReviewInfoUsecaseMockRecorder) ListUnassignedAssets(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnassignedAssets", reflect.TypeOf((*MockReviewInfoUsecase)(nil).ListUnassignedAssets), ctx, params)
+}
+
+// Overview mocks base method.
+func (m *MockReviewInfoUsecase) Overview(ctx context.Context, params *entity.ReviewOverviewParams) ([]*entity.ReviewProjectOverview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Overview", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewProjectOverview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Overview indicates an expected call of Overview.
+func (mr *MockReviewInfoUsecaseMockRecorder) Overview(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Overview", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Overview), ctx, params)
+}
+
+// PinTake mocks base method.
+func (m *MockReviewInfoUsecase) PinTake(ctx context.Context, params *entity.PinReviewTakeParams) (*entity.ReviewTakePin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinTake", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewTakePin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PinTake indicates an expected call of PinTake.
+func (mr *MockReviewInfoUsecaseMockRecorder) PinTake(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinTake", reflect.TypeOf((*MockReviewInfoUsecase)(nil).PinTake), ctx, params)
+}
+
+// PivotQueryLimits mocks base method.
+func (m *MockReviewInfoUsecase) PivotQueryLimits(ctx context.Context, project string) (entity.PageLimits, time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PivotQueryLimits", ctx, project)
+	ret0, _ := ret[0].(entity.PageLimits)
+	ret1, _ := ret[1].(time.Duration)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// PivotQueryLimits indicates an expected call of PivotQueryLimits.
+func (mr *MockReviewInfoUsecaseMockRecorder) PivotQueryLimits(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PivotQueryLimits", reflect.TypeOf((*MockReviewInfoUsecase)(nil).PivotQueryLimits), ctx, project)
+}
+
+// RemoveTag mocks base method.
+func (m *MockReviewInfoUsecase) RemoveTag(ctx context.Context, params *entity.RemoveReviewTagParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTag", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTag indicates an expected call of RemoveTag.
+func (mr *MockReviewInfoUsecaseMockRecorder) RemoveTag(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTag", reflect.TypeOf((*MockReviewInfoUsecase)(nil).RemoveTag), ctx, params)
+}
+
+// Restore mocks base method.
+func (m *MockReviewInfoUsecase) Restore(ctx context.Context, params *entity.RestoreReviewInfoParams) (*entity.ReviewInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockReviewInfoUsecaseMockRecorder) Restore(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Restore), ctx, params)
+}
+
+// RetakeStats mocks base method.
+func (m *MockReviewInfoUsecase) RetakeStats(ctx context.Context, params *entity.ReviewRetakeStatsParams) (*entity.ReviewRetakeStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetakeStats", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewRetakeStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetakeStats indicates an expected call of RetakeStats.
+func (mr *MockReviewInfoUsecaseMockRecorder) RetakeStats(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetakeStats", reflect.TypeOf((*MockReviewInfoUsecase)(nil).RetakeStats), ctx, params)
+}
+
+// SetAssetPriority mocks base method.
+func (m *MockReviewInfoUsecase) SetAssetPriority(ctx context.Context, params *entity.SetAssetPriorityParams) (*entity.AssetPriority, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAssetPriority", ctx, params)
+	ret0, _ := ret[0].(*entity.AssetPriority)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAssetPriority indicates an expected call of SetAssetPriority.
+func (mr *MockReviewInfoUsecaseMockRecorder) SetAssetPriority(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAssetPriority", reflect.TypeOf((*MockReviewInfoUsecase)(nil).SetAssetPriority), ctx, params)
+}
+
+// SetDueDate mocks base method.
+func (m *MockReviewInfoUsecase) SetDueDate(ctx context.Context, params *entity.SetReviewDueDateParams) (*entity.ReviewDueDate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDueDate", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewDueDate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDueDate indicates an expected call of SetDueDate.
+func (mr *MockReviewInfoUsecaseMockRecorder) SetDueDate(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDueDate", reflect.TypeOf((*MockReviewInfoUsecase)(nil).SetDueDate), ctx, params)
+}
+
+// SubmissionStats mocks base method.
+func (m *MockReviewInfoUsecase) SubmissionStats(ctx context.Context, params *entity.ReviewSubmissionStatsParams) (*entity.ReviewSubmissionStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmissionStats", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewSubmissionStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmissionStats indicates an expected call of SubmissionStats.
+func (mr *MockReviewInfoUsecaseMockRecorder) SubmissionStats(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmissionStats", reflect.TypeOf((*MockReviewInfoUsecase)(nil).SubmissionStats), ctx, params)
+}
+
+// Sync mocks base method.
+func (m *MockReviewInfoUsecase) Sync(ctx context.Context, params *entity.ReviewSyncParams) (*entity.ReviewSyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, params)
+	ret0, _ := ret[0].(*entity.ReviewSyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockReviewInfoUsecaseMockRecorder) Sync(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Sync), ctx, params)
+}
+
+// UnpinTake mocks base method.
+func (m *MockReviewInfoUsecase) UnpinTake(ctx context.Context, params *entity.UnpinReviewTakeParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinTake", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinTake indicates an expected call of UnpinTake.
+func (mr *MockReviewInfoUsecaseMockRecorder) UnpinTake(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinTake", reflect.TypeOf((*MockReviewInfoUsecase)(nil).UnpinTake), ctx, params)
+}
+
+// UpdateNote mocks base method.
+func (m *MockReviewInfoUsecase) UpdateNote(ctx context.Context, params *entity.UpdateAssetNoteParams) (*entity.AssetNote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNote", ctx, params)
+	ret0, _ := ret[0].(*entity.AssetNote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNote indicates an expected call of UpdateNote.
+func (mr *MockReviewInfoUsecaseMockRecorder) UpdateNote(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockReviewInfoUsecase)(nil).UpdateNote), ctx, params)
+}
+
+// UpdateWithMetadata mocks base method.
+func (m *MockReviewInfoUsecase) UpdateWithMetadata(ctx context.Context, params *entity.UpdateReviewInfoParams, meta *entity.ReviewInfoMetadataPatch) (*entity.ReviewInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithMetadata", ctx, params, meta)
+	ret0, _ := ret[0].(*entity.ReviewInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWithMetadata indicates an expected call of UpdateWithMetadata.
+func (mr *MockReviewInfoUsecaseMockRecorder) UpdateWithMetadata(ctx, params, meta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithMetadata", reflect.TypeOf((*MockReviewInfoUsecase)(nil).UpdateWithMetadata), ctx, params, meta)
+}
+
+// Workload mocks base method.
+func (m *MockReviewInfoUsecase) Workload(ctx context.Context, params *entity.ReviewWorkloadParams) ([]*entity.ReviewWorkload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Workload", ctx, params)
+	ret0, _ := ret[0].([]*entity.ReviewWorkload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Workload indicates an expected call of Workload.
+func (mr *MockReviewInfoUsecaseMockRecorder) Workload(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Workload", reflect.TypeOf((*MockReviewInfoUsecase)(nil).Workload), ctx, params)
+}
@@ -0,0 +1,441 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/openapi/openapi.go
+
+	Module Description:
+		OpenAPI 3 document built from the typed request and response structs.
+
+	Details:
+	- An Operation names the request structs the handler binds (Query: form tags,
+	  Body: json tags) and a value of the response; Spec reflects them into
+	  parameters and component schemas, so a field added to a params struct shows up
+	  in the document without anyone editing YAML.
+	- Tags read on struct fields:
+	    form:"name" / json:"name"   parameter and property names ("-" skips the field)
+	    binding:"required"          required parameter or property
+	    doc:"..."                   description
+	- Pointer fields are optional and nullable; embedded structs are flattened like
+	  encoding/json does. Named structs become components/schemas/<Name> (prefixed
+	  with their package on a name clash) and are referenced with $ref.
+	- Fields describes an ad-hoc JSON object (the gin.H responses): each value is a
+	  sample whose type gives the property schema.
+	- Paths use gin syntax (:project); path parameters are strings, except id and index,
+	  which are integers.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - New: Creates an empty Spec.
+	* - (Spec) Add: Adds operations.
+	* - (Spec) Has: Reports whether an operation is declared.
+	* - (Spec) Document: Returns the OpenAPI document.
+	* - SwaggerUI: Returns the Swagger UI page of a document URL.
+	────────────────────────────────────────────────────────────────────────── */
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Document is an OpenAPI 3.0 document.
+type Document struct {
+	OpenAPI    string                        `json:"openapi"`
+	Info       Info                          `json:"info"`
+	Servers    []Server                      `json:"servers,omitempty"`
+	Paths      map[string]map[string]*jsonOp `json:"paths"`
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas,omitempty"`
+	} `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+type Server struct {
+	URL string `json:"url"`
+}
+
+// Schema is the subset of the OpenAPI schema object the reflection produces.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Required    bool    `json:"required,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Operation declares one endpoint.
+type Operation struct {
+	Method      string // GET, POST, ...
+	Path        string // gin syntax, e.g. /projects/:project/reviews/:id
+	Summary     string
+	Description string
+	Tags        []string
+	Query       any // struct bound with ShouldBindQuery (form tags)
+	Body        any // struct bound from the JSON body (json tags)
+	Response    any // sample of the success body; nil for none
+	Status      int // success status; 200 when 0
+	ContentType string
+}
+
+// Fields is an ad-hoc JSON object: property name to a sample value of its type.
+type Fields map[string]any
+
+type jsonOp struct {
+	Summary     string               `json:"summary,omitempty"`
+	Description string               `json:"description,omitempty"`
+	Tags        []string             `json:"tags,omitempty"`
+	OperationID string               `json:"operationId,omitempty"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	RequestBody *jsonBody            `json:"requestBody,omitempty"`
+	Responses   map[string]*jsonBody `json:"responses"`
+}
+
+type jsonBody struct {
+	Description string                `json:"description,omitempty"`
+	Required    bool                  `json:"required,omitempty"`
+	Content     map[string]jsonSchema `json:"content,omitempty"`
+}
+
+type jsonSchema struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Spec builds a Document.
+type Spec struct {
+	doc   Document
+	names map[reflect.Type]string
+}
+
+// New returns an empty Spec served under serverURL (e.g. "/api").
+func New(info Info, serverURL string) *Spec {
+	s := &Spec{names: map[reflect.Type]string{}}
+	s.doc.OpenAPI = "3.0.3"
+	s.doc.Info = info
+	if serverURL != "" {
+		s.doc.Servers = []Server{{URL: serverURL}}
+	}
+	s.doc.Paths = map[string]map[string]*jsonOp{}
+	s.doc.Components.Schemas = map[string]*Schema{}
+	return s
+}
+
+// Add adds ops; a later operation with the same method and path replaces the earlier.
+func (s *Spec) Add(ops ...Operation) {
+	for _, op := range ops {
+		path, params := openAPIPath(op.Path)
+		out := &jsonOp{
+			Summary:     op.Summary,
+			Description: op.Description,
+			Tags:        op.Tags,
+			Parameters:  params,
+			Responses:   map[string]*jsonBody{},
+		}
+		if op.Query != nil {
+			out.Parameters = append(out.Parameters, s.queryParams(reflect.TypeOf(op.Query))...)
+		}
+		if op.Body != nil {
+			out.RequestBody = &jsonBody{
+				Required: true,
+				Content:  map[string]jsonSchema{"application/json": {Schema: s.schema(reflect.TypeOf(op.Body))}},
+			}
+		}
+		code := op.Status
+		if code == 0 {
+			code = http.StatusOK
+		}
+		res := &jsonBody{Description: http.StatusText(code)}
+		if op.Response != nil {
+			ct := op.ContentType
+			if ct == "" {
+				ct = "application/json"
+			}
+			res.Content = map[string]jsonSchema{ct: {Schema: s.sample(op.Response)}}
+		}
+		out.Responses[fmt.Sprint(code)] = res
+		out.Responses["default"] = &jsonBody{
+			Description: "Error envelope",
+			Content: map[string]jsonSchema{"application/json": {Schema: s.sample(Fields{
+				"code": "", "message": "", "details": map[string]any{}, "request_id": "",
+			})}},
+		}
+		method := strings.ToLower(op.Method)
+		out.OperationID = method + strings.NewReplacer("/", "_", "{", "", "}", "", "-", "_", ".", "_").Replace(path)
+		if s.doc.Paths[path] == nil {
+			s.doc.Paths[path] = map[string]*jsonOp{}
+		}
+		s.doc.Paths[path][method] = out
+	}
+}
+
+// Has reports whether method and path (gin syntax) are declared.
+func (s *Spec) Has(method, path string) bool {
+	p, _ := openAPIPath(path)
+	_, ok := s.doc.Paths[p][strings.ToLower(method)]
+	return ok
+}
+
+// Document returns the document built so far.
+func (s *Spec) Document() *Document {
+	return &s.doc
+}
+
+// openAPIPath converts a gin path to OpenAPI syntax and returns its path parameters.
+func openAPIPath(path string) (string, []Parameter) {
+	var params []Parameter
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if part == "" || (part[0] != ':' && part[0] != '*') {
+			continue
+		}
+		name := part[1:]
+		schema := &Schema{Type: "string"}
+		if name == "id" || name == "index" {
+			schema = &Schema{Type: "integer", Format: "int32"}
+		}
+		params = append(params, Parameter{Name: name, In: "path", Required: true, Schema: schema})
+		parts[i] = "{" + name + "}"
+	}
+	return strings.Join(parts, "/"), params
+}
+
+// fieldName returns the name of f under tag and whether it is serialized at all.
+func fieldName(f reflect.StructField, tag string) (string, bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+	name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+	if name == "-" {
+		return "", false
+	}
+	if name == "" {
+		name = f.Name
+	}
+	return name, true
+}
+
+func required(f reflect.StructField) bool {
+	for _, rule := range strings.Split(f.Tag.Get("binding"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+// queryParams returns the query parameters of the form-tagged struct t.
+func (s *Spec) queryParams(t reflect.Type) []Parameter {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var params []Parameter
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Tag.Get("form") == "" {
+			params = append(params, s.queryParams(f.Type)...)
+			continue
+		}
+		name, ok := fieldName(f, "form")
+		if !ok {
+			continue
+		}
+		params = append(params, Parameter{
+			Name:        name,
+			In:          "query",
+			Required:    required(f),
+			Description: f.Tag.Get("doc"),
+			Schema:      s.schema(f.Type),
+		})
+	}
+	return params
+}
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	rawType  = reflect.TypeOf(json.RawMessage{})
+)
+
+// sample returns the schema of the type of v, or of the object v describes.
+func (s *Spec) sample(v any) *Schema {
+	fields, ok := v.(Fields)
+	if !ok {
+		return s.schema(reflect.TypeOf(v))
+	}
+	out := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for name, sample := range fields {
+		if sample == nil {
+			out.Properties[name] = &Schema{}
+			continue
+		}
+		out.Properties[name] = s.sample(sample)
+	}
+	return out
+}
+
+// schema returns the schema of t; named structs are referenced.
+func (s *Spec) schema(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawType:
+		return &Schema{}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		elem := s.schema(t.Elem())
+		if elem.Ref != "" {
+			return elem // $ref siblings are ignored in OpenAPI 3.0
+		}
+		elem.Nullable = true
+		return elem
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int, reflect.Int64:
+		if t == reflect.TypeOf(time.Duration(0)) {
+			return &Schema{Type: "integer", Format: "int64", Description: "nanoseconds"}
+		}
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint, reflect.Uint64:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.schema(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t)
+		}
+		name := s.componentName(t)
+		return &Schema{Ref: "#/components/schemas/" + name}
+	}
+	return &Schema{}
+}
+
+// componentName registers the named struct t as a component and returns its name.
+func (s *Spec) componentName(t reflect.Type) string {
+	if name, ok := s.names[t]; ok {
+		return name
+	}
+	name := t.Name()
+	for other := range s.names {
+		if other.Name() == t.Name() {
+			name = pkgName(t) + name
+			break
+		}
+	}
+	s.names[t] = name
+	s.doc.Components.Schemas[name] = &Schema{} // placeholder for recursive types
+	s.doc.Components.Schemas[name] = s.object(t)
+	return name
+}
+
+func pkgName(t reflect.Type) string {
+	p := t.PkgPath()
+	p = p[strings.LastIndex(p, "/")+1:]
+	if p == "" {
+		return ""
+	}
+	return strings.ToUpper(p[:1]) + p[1:]
+}
+
+// object returns the object schema of the struct t by its json tags.
+func (s *Spec) object(t reflect.Type) *Schema {
+	out := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	var add func(t reflect.Type)
+	add = func(t reflect.Type) {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.Anonymous && f.Tag.Get("json") == "" {
+				ft := f.Type
+				if ft.Kind() == reflect.Pointer {
+					ft = ft.Elem()
+				}
+				if ft.Kind() == reflect.Struct {
+					add(ft)
+					continue
+				}
+			}
+			name, ok := fieldName(f, "json")
+			if !ok {
+				continue
+			}
+			prop := s.schema(f.Type)
+			if doc := f.Tag.Get("doc"); doc != "" && prop.Ref == "" {
+				prop.Description = doc
+			}
+			out.Properties[name] = prop
+			if required(f) {
+				out.Required = append(out.Required, name)
+			}
+		}
+	}
+	add(t)
+	sort.Strings(out.Required)
+	return out
+}
+
+var swaggerUI = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+<head>
+  <meta charset="utf-8">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@5/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@5/swagger-ui-bundle.js"></script>
+  <script>
+    window.ui = SwaggerUIBundle({url: {{.URL}}, dom_id: "#swagger-ui"});
+  </script>
+</body>
+</html>
+`))
+
+// SwaggerUI returns the Swagger UI page showing the document at url.
+func SwaggerUI(title, url string) []byte {
+	var b strings.Builder
+	if err := swaggerUI.Execute(&b, struct{ Title, URL string }{title, url}); err != nil {
+		panic(err) // the template is static
+	}
+	return []byte(b.String())
+}
@@ -0,0 +1,270 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/openapiReview.go
+
+	Module Description:
+		OpenAPI document of the review endpoints, served at /openapi.json and /docs.
+
+	Details:
+	- reviewAPI declares each review endpoint with the params struct its handler binds
+	  and the type it answers; delivery/openapi reflects them, so the document follows
+	  the structs rather than a hand-written YAML file.
+	- ListAssetsPivot reads its query parameter by parameter; listAssetsPivotQuery
+	  lists them for the document and has to be kept in step with the handler.
+	- Every other registered route with "/review" in its path is listed as well, named
+	  after its handler, so a new endpoint appears (undocumented) even before it is
+	  declared here; NewOpenAPI logs those.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewOpenAPI: Builds the OpenAPI document of the review endpoints.
+		* (OpenAPI) JSON: Serves the document.
+		* (OpenAPI) Docs: Serves the Swagger UI.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/delivery/openapi"
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// listAssetsPivotQuery is the query of ListAssetsPivot as documented; comma-separated
+// values may also be repeated parameters.
+type listAssetsPivotQuery struct {
+	Root                string    `form:"root" doc:"assets (default), shots or a custom root"`
+	View                string    `form:"view" doc:"list | grouped (group and category are aliases); default from the project's pivot defaults"`
+	Sort                string    `form:"sort" doc:"sort key, e.g. group_1, mdl_submitted, latest_activity, due_date, attention"`
+	Dir                 string    `form:"dir" doc:"asc | desc"`
+	Nulls               string    `form:"nulls" doc:"first | last: where missing sort values go"`
+	Natural             bool      `form:"natural" doc:"natural name order (asset2 before asset10)"`
+	Phase               string    `form:"phase" doc:"preferred phase"`
+	Name                string    `form:"name" doc:"asset name filter"`
+	Page                int       `form:"page"`
+	PerPage             int       `form:"per_page"`
+	Cursor              string    `form:"cursor" doc:"next_cursor of a previous page; overrides page"`
+	Count               *bool     `form:"count" doc:"false skips the total"`
+	ApprovalStatus      []string  `form:"approval_status"`
+	WorkStatus          []string  `form:"work_status"`
+	SubmittedUser       []string  `form:"submitted_user"`
+	ApprovalUpdatedUser []string  `form:"approval_status_updated_user"`
+	Studio              []string  `form:"studio"`
+	Relation            []string  `form:"relation"`
+	RelationMode        string    `form:"relation_mode" doc:"exact | prefix"`
+	Tags                []string  `form:"tags"`
+	OverallStatus       []string  `form:"overall_status"`
+	Overdue             bool      `form:"overdue"`
+	Fields              []string  `form:"fields" doc:"phases to return, e.g. mdl,rig"`
+	Include             []string  `form:"include" doc:"comment_count, thumbnails, studio, notes"`
+	AsOf                time.Time `form:"as_of" doc:"the pivot as it was at this time"`
+	GroupPage           int       `form:"group_page"`
+	GroupPerPage        int       `form:"group_per_page"`
+	GroupDepth          int       `form:"group_depth"`
+	Tree                bool      `form:"tree"`
+	GroupsOnly          bool      `form:"groups_only"`
+	Group               []string  `form:"group" doc:"top group nodes to keep; Unassigned for assets without one"`
+	Pretty              bool      `form:"pretty"`
+}
+
+var reviewTags = []string{"reviews"}
+
+// reviewAPI declares the review endpoints (paths below /api).
+var reviewAPI = []openapi.Operation{
+	{Method: "GET", Path: "/projects/:project/reviews", Summary: "List review infos",
+		Description: "Accept: application/vnd.api+json answers a JSON:API document.",
+		Query:       listReviewInfoParams{}, Response: openapi.Fields{"reviews": []*entity.ReviewInfo{}}},
+	{Method: "POST", Path: "/projects/:project/reviews", Summary: "Create a review info",
+		Body: createReviewInfoParams{}, Response: &entity.ReviewInfo{}},
+	{Method: "POST", Path: "/projects/:project/reviews/batch", Summary: "Create review infos in one transaction",
+		Body: createReviewInfoBatchParams{}, Response: openapi.Fields{"reviews": []*entity.ReviewInfo{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/:id", Summary: "Get a review info",
+		Response: &entity.ReviewInfo{}},
+	{Method: "PATCH", Path: "/projects/:project/reviews/:id", Summary: "Update a review info",
+		Description: "If-Match (or version) guards against lost updates; 409 with the current row.",
+		Body:        updateReviewInfoParams{}, Response: &entity.ReviewInfo{}},
+	{Method: "DELETE", Path: "/projects/:project/reviews/:id", Summary: "Delete a review info", Status: http.StatusNoContent},
+	{Method: "POST", Path: "/projects/:project/reviews/:id/restore", Summary: "Restore a deleted review info",
+		Response: &entity.ReviewInfo{}},
+	{Method: "GET", Path: "/projects/:project/reviews/trash", Summary: "List deleted review infos",
+		Query: listReviewTrashParams{}, Response: openapi.Fields{"reviews": []*entity.ReviewInfo{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/sync", Summary: "Changes since a sync token",
+		Query: reviewSyncParams{}, Response: &entity.ReviewSyncResult{}},
+	{Method: "GET", Path: "/projects/:project/reviews/compare", Summary: "Compare two review infos",
+		Response: &entity.ReviewCompare{}},
+	{Method: "GET", Path: "/projects/reviews/overview", Summary: "Review overview of several projects",
+		Response: openapi.Fields{"projects": []*entity.ReviewProjectOverview{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/stats/submissions", Summary: "Submission counts over time",
+		Response: &entity.ReviewSubmissionStats{}},
+	{Method: "GET", Path: "/projects/:project/reviews/stats/approval-latency", Summary: "Time from submission to approval",
+		Response: openapi.Fields{"latency": []*entity.ReviewApprovalLatency{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/stats/retakes", Summary: "Retake counts by asset and artist",
+		Response: &entity.ReviewRetakeStats{}},
+	{Method: "GET", Path: "/projects/:project/reviews/workload", Summary: "Open reviews per reviewer",
+		Response: openapi.Fields{"workload": []*entity.ReviewWorkload{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets", Summary: "List assets",
+		Query: assetListParams{}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/pivot", Summary: "Asset pivot (latest status per phase)",
+		Description: "Link header and pagination block keep the request's filters. " +
+			"Accept: application/vnd.api+json answers a JSON:API document; If-None-Match answers 304. " +
+			"The grouped view answers usecase.GroupedPivotResponse: groups, item_count, group_page, " +
+			"group_per_page, group_total and group_page_last with the same paging fields.",
+		Query: listAssetsPivotQuery{}, Response: usecase.PivotResponse{}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/pivot/groups/:topNode", Summary: "One bucket of the grouped asset pivot",
+		Query: listAssetsPivotQuery{}, Response: usecase.PivotResponse{}},
+	{Method: "POST", Path: "/projects/:project/reviews/assets/batch", Summary: "Pivot rows of several assets",
+		Body: batchAssetDetailParams{}, Response: openapi.Fields{"assets": []repository.AssetPivot{}}},
+	{Method: "POST", Path: "/projects/:project/reviews/assets/bulk-set-status", Summary: "Set the work status of several assets",
+		Body: bulkSetWorkStatusParams{}, Response: &entity.BulkSetWorkStatusResult{}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/unassigned", Summary: "Assets without a group category",
+		Query: unassignedAssetListParams{}, Response: openapi.Fields{"assets": []*entity.UnassignedAsset{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/completion", Summary: "Approval completion by asset and phase",
+		Response: &entity.ReviewCompletion{}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/:asset/:relation/timeline", Summary: "Submission timeline of an asset",
+		Response: &entity.AssetTimeline{}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/:asset/:relation/takes", Summary: "Takes of an asset",
+		Response: &entity.ReviewTakeList{}},
+	{Method: "PUT", Path: "/projects/:project/reviews/assets/:asset/:relation/takes/pin", Summary: "Pin the take shown in the pivot",
+		Body: pinReviewTakeParams{}, Response: &entity.ReviewTakePin{}},
+	{Method: "DELETE", Path: "/projects/:project/reviews/assets/:asset/:relation/takes/pin", Summary: "Unpin the take",
+		Status: http.StatusNoContent},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/:asset/:relation/due-dates", Summary: "Due dates of an asset",
+		Response: openapi.Fields{"due_dates": []*entity.ReviewDueDate{}}},
+	{Method: "PUT", Path: "/projects/:project/reviews/assets/:asset/:relation/due-dates/:phase", Summary: "Set the due date of a phase",
+		Body: setReviewDueDateParams{}, Response: &entity.ReviewDueDate{}},
+	{Method: "DELETE", Path: "/projects/:project/reviews/assets/:asset/:relation/due-dates/:phase", Summary: "Clear the due date of a phase",
+		Status: http.StatusNoContent},
+	{Method: "PATCH", Path: "/projects/:project/reviews/assets/:asset/priority", Summary: "Flag an asset as a priority",
+		Body: setAssetPriorityParams{}, Response: &entity.AssetPriority{}},
+	{Method: "GET", Path: "/projects/:project/reviews/tags", Summary: "Tags used in a project",
+		Response: openapi.Fields{"tags": []string{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/:asset/:relation/tags", Summary: "Tags of an asset",
+		Response: openapi.Fields{"tags": []string{}}},
+	{Method: "POST", Path: "/projects/:project/reviews/assets/:asset/:relation/tags", Summary: "Tag an asset",
+		Body: addReviewTagsParams{}, Response: openapi.Fields{"tags": []string{}}},
+	{Method: "DELETE", Path: "/projects/:project/reviews/assets/:asset/:relation/tags/:tag", Summary: "Remove a tag",
+		Status: http.StatusNoContent},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/:asset/:relation/notes", Summary: "Notes of an asset",
+		Response: openapi.Fields{"notes": []*entity.AssetNote{}}},
+	{Method: "POST", Path: "/projects/:project/reviews/assets/:asset/:relation/notes", Summary: "Add a note",
+		Body: assetNoteParams{}, Response: &entity.AssetNote{}, Status: http.StatusCreated},
+	{Method: "PATCH", Path: "/projects/:project/reviews/assets/:asset/:relation/notes/:id", Summary: "Edit a note",
+		Body: assetNoteParams{}, Response: &entity.AssetNote{}},
+	{Method: "DELETE", Path: "/projects/:project/reviews/assets/:asset/:relation/notes/:id", Summary: "Delete a note",
+		Status: http.StatusNoContent},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/:asset/:relation/watch", Summary: "The caller's watch of an asset",
+		Response: openapi.Fields{"watch": &entity.AssetWatch{}}},
+	{Method: "POST", Path: "/projects/:project/reviews/assets/:asset/:relation/watch", Summary: "Watch an asset",
+		Body: watchAssetParams{}, Response: openapi.Fields{"watch": &entity.AssetWatch{}}},
+	{Method: "DELETE", Path: "/projects/:project/reviews/assets/:asset/:relation/watch", Summary: "Stop watching an asset",
+		Status: http.StatusNoContent},
+	{Method: "GET", Path: "/projects/:project/reviews/assets/:asset/:relation/watchers", Summary: "Watchers of an asset",
+		Response: openapi.Fields{"watchers": []*entity.AssetWatch{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/:id/files/:index/url", Summary: "Signed URL of a review file",
+		Response: &entity.ReviewMediaURL{}},
+	{Method: "POST", Path: "/projects/:project/reviews/exports", Summary: "Start an export",
+		Body: createReviewExportParams{}, Response: openapi.Fields{"export": &entity.ReviewExport{}}, Status: http.StatusAccepted},
+	{Method: "GET", Path: "/projects/:project/reviews/exports/:id", Summary: "Status of an export",
+		Response: openapi.Fields{"export": &entity.ReviewExport{}}},
+	{Method: "POST", Path: "/projects/:project/reviews/imports", Summary: "Import review infos from CSV",
+		Response: &entity.ReviewImportResult{}},
+	{Method: "GET", Path: "/projects/:project/reviews/:id/history", Summary: "Status history of a review info",
+		Response: openapi.Fields{"history": []*entity.ReviewStatusHistory{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/:id/lock", Summary: "Live-review lock of a review info",
+		Response: openapi.Fields{"lock": &entity.ReviewLock{}}},
+	{Method: "POST", Path: "/projects/:project/reviews/:id/lock", Summary: "Acquire or renew the lock",
+		Body: acquireReviewLockParams{}, Response: &entity.ReviewLock{}},
+	{Method: "DELETE", Path: "/projects/:project/reviews/:id/lock", Summary: "Release the lock",
+		Query: releaseReviewLockParams{}, Status: http.StatusNoContent},
+	{Method: "GET", Path: "/projects/:project/reviews/pivot-defaults", Summary: "Pivot defaults of a project",
+		Response: openapi.Fields{"pivot_defaults": &entity.PivotDefaults{}}},
+	{Method: "PUT", Path: "/projects/:project/reviews/pivot-defaults", Summary: "Set the pivot defaults",
+		Body: putPivotDefaultsParams{}, Response: openapi.Fields{"pivot_defaults": &entity.PivotDefaults{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/required-phases", Summary: "Required phases of a project",
+		Response: openapi.Fields{"required_phases": &entity.RequiredPhases{}}},
+	{Method: "PUT", Path: "/projects/:project/reviews/required-phases", Summary: "Set the required phases",
+		Body: putRequiredPhasesParams{}, Response: openapi.Fields{"required_phases": &entity.RequiredPhases{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/query-policy", Summary: "Query policy of a project",
+		Response: openapi.Fields{"query_policy": &entity.ProjectQueryPolicy{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/validation-rules", Summary: "Validation rules of a project",
+		Response: openapi.Fields{"validation_rules": &entity.ReviewValidationRules{}}},
+	{Method: "PUT", Path: "/projects/:project/reviews/validation-rules", Summary: "Set the validation rules",
+		Body: putReviewValidationRulesParams{}, Response: openapi.Fields{"validation_rules": &entity.ReviewValidationRules{}}},
+	{Method: "GET", Path: "/projects/:project/reviews/events", Summary: "Stream of review events (server-sent events)",
+		Description: "Each event: id, event (the type) and data (the JSON event). type=review.created,... " +
+			"keeps the given types. No replay of missed events.",
+		Response: &entity.ReviewEvent{}, ContentType: "text/event-stream"},
+	{Method: "GET", Path: "/projects/:project/reviewWebhooks", Summary: "Webhooks of a project",
+		Response: openapi.Fields{"reviewWebhooks": []*entity.ReviewWebhook{}}},
+	{Method: "POST", Path: "/projects/:project/reviewWebhooks", Summary: "Register a webhook",
+		Body: createReviewWebhookParams{}, Response: &entity.ReviewWebhook{}},
+	{Method: "DELETE", Path: "/projects/:project/reviewWebhooks/:id", Summary: "Remove a webhook", Status: http.StatusNoContent},
+}
+
+// OpenAPI serves the OpenAPI document of the review endpoints.
+type OpenAPI struct {
+	doc  []byte
+	docs []byte
+}
+
+// handlerName turns a gin handler name such as
+// ".../delivery.(*ReviewInfo).ListComments-fm" into "ReviewInfo.ListComments".
+var handlerName = regexp.MustCompile(`\(\*?([A-Za-z]+)\)\.([A-Za-z]+)`)
+
+// NewOpenAPI builds the document of reviewAPI and the other routes (below prefix, e.g.
+// "/api") with "/review" in their path.
+func NewOpenAPI(routes gin.RoutesInfo, prefix string) (*OpenAPI, error) {
+	spec := openapi.New(openapi.Info{
+		Title:   "Review API",
+		Version: "1",
+		Description: "Review infos, the asset pivot and their settings. Errors answer the " +
+			"shared envelope {code, message, details, request_id}.",
+	}, prefix)
+	for i := range reviewAPI {
+		reviewAPI[i].Tags = reviewTags
+	}
+	spec.Add(reviewAPI...)
+
+	var undocumented []string
+	for _, r := range routes {
+		path, ok := strings.CutPrefix(r.Path, prefix)
+		if !ok || !strings.Contains(path, "/review") || spec.Has(r.Method, path) {
+			continue
+		}
+		summary := r.Handler
+		if m := handlerName.FindStringSubmatch(r.Handler); m != nil {
+			summary = m[1] + "." + m[2]
+		}
+		spec.Add(openapi.Operation{Method: r.Method, Path: path, Summary: summary, Tags: reviewTags})
+		undocumented = append(undocumented, r.Method+" "+path)
+	}
+	if len(undocumented) > 0 {
+		log.Printf("[OPENAPI] %d review routes without a declaration: %s",
+			len(undocumented), strings.Join(undocumented, ", "))
+	}
+
+	doc, err := json.Marshal(spec.Document())
+	if err != nil {
+		return nil, err
+	}
+	return &OpenAPI{doc: doc, docs: openapi.SwaggerUI("Review API", "/openapi.json")}, nil
+}
+
+func (h *OpenAPI) JSON(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", h.doc)
+}
+
+func (h *OpenAPI) Docs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", h.docs)
+}
@@ -0,0 +1,118 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/pagination/pagination.go
+
+	Module Description:
+		Link headers (RFC 5988) and JSON pagination blocks of paged list responses.
+
+	Details:
+	- The handlers used to build their Link headers by hand, each from the base URL and
+	  page/per_page only, so following a link dropped every filter of the request.
+	  Links copies the request's query and replaces only the page number.
+	- The query is copied pair by pair rather than re-encoded, so sort, dir, phase, name
+	  and the status filters come back exactly as sent (order, repeats, escaping).
+	- The package takes a *url.URL rather than a framework context, so gin and fiber
+	  handlers share it: c.Request.URL in gin, a parsed c.OriginalURL() in fiber.
+	- Parameters that select a page on their own (e.g. cursor, which takes precedence
+	  over page) are named in drop and left out of every link.
+	- When the total was not counted (PageLast 0) there is no last link, and next is
+	  given only when HasNext is set.
+	- Block is the same pagination for the JSON body: the entity.Pagination fields and
+	  the links by relation (entity.PaginationBlock).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Echo the request's query pair by pair; per_page only when absent.
+	* - 15-10-2026 - Block is an alias of entity.PaginationBlock.
+
+	Functions:
+	* - Links: Returns the link URLs of a page by relation.
+	* - Header: Returns the Link header value of a page.
+	* - NewBlock: Returns the JSON pagination block of a page.
+	────────────────────────────────────────────────────────────────────────── */
+
+package pagination
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// Relations of the links, in Link header order.
+var relations = []string{"first", "prev", "next", "last"}
+
+// Block is the pagination block of a JSON list response; it is declared in entity so
+// the usecase responses (e.g. usecase.PivotResponse) can carry it.
+type Block = entity.PaginationBlock
+
+// Links returns the URLs of the first, prev, next and last pages of p by relation: u
+// with its query as sent, only the page number replaced and the drop parameters left
+// out. A per_page the request did not send is added, so the pages keep their size.
+func Links(u *url.URL, p entity.Pagination, drop ...string) map[string]string {
+	if u == nil || p.PerPage <= 0 {
+		return nil
+	}
+	pageParam, perPageParam := p.Params()
+	skip := map[string]bool{pageParam: true}
+	for _, name := range drop {
+		skip[name] = true
+	}
+	// The raw pairs keep the order, repeats and escaping of the request.
+	var kept []string
+	hasPerPage := false
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		key, _, _ := strings.Cut(pair, "=")
+		if k, err := url.QueryUnescape(key); err == nil {
+			key = k
+		}
+		if skip[key] {
+			continue
+		}
+		hasPerPage = hasPerPage || key == perPageParam
+		kept = append(kept, pair)
+	}
+	if !hasPerPage {
+		kept = append(kept, perPageParam+"="+strconv.Itoa(p.PerPage))
+	}
+	link := func(page int) string {
+		l := *u
+		l.RawQuery = strings.Join(append(kept[:len(kept):len(kept)], pageParam+"="+strconv.Itoa(page)), "&")
+		return l.String()
+	}
+
+	links := map[string]string{"first": link(1)}
+	if p.HasPrev {
+		links["prev"] = link(p.Page - 1)
+	}
+	if p.HasNext || (p.PageLast > 0 && p.Page < p.PageLast) {
+		links["next"] = link(p.Page + 1)
+	}
+	if p.PageLast > 0 {
+		links["last"] = link(p.PageLast)
+	}
+	return links
+}
+
+// Header returns the Link header value of p (see Links), or "" without links.
+func Header(u *url.URL, p entity.Pagination, drop ...string) string {
+	links := Links(u, p, drop...)
+	var parts []string
+	for _, rel := range relations {
+		if l, ok := links[rel]; ok {
+			parts = append(parts, fmt.Sprintf(`<%s>; rel="%s"`, l, rel))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// NewBlock returns the JSON pagination block of p (see Links).
+func NewBlock(u *url.URL, p entity.Pagination, drop ...string) Block {
+	return Block{Pagination: p, Links: Links(u, p, drop...)}
+}
@@ -0,0 +1,108 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/pivotDefaults.go
+
+	Module Description:
+		HTTP delivery handlers for per-project asset pivot defaults.
+
+	Details:
+	- GET /projects/:project/reviews/pivot-defaults
+	- PUT /projects/:project/reviews/pivot-defaults
+	  {"sort": "mdl_submitted", "dir": "desc", "view": "grouped", "per_page": 30, "updated_by": "..."}
+	- Any field may be omitted; omitted fields use the built-in default.
+	- "attention_weights": {"retakes": 2, "stale_downstream": 3, "time_in_status": 0.5}
+	  tunes the attention_score behind sort=attention.
+	- "overall_status_rules": {"required_phases": ["mdl", "rig"],
+	  "approved_statuses": ["approved"], "retake_statuses": ["retake"]} sets how
+	  overall_status rolls the phases of a pivot row up.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Accept attention_weights.
+		* - 15-10-2026 - Accept overall_status_rules.
+		* - 15-10-2026 - Writes answer 403 to callers below supervisor.
+
+	Functions:
+		* NewPivotDefaults: Creates a new PivotDefaults handler.
+		* (PivotDefaults) Get: Returns the defaults of a project.
+		* (PivotDefaults) Put: Replaces the defaults of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewPivotDefaults(
+	uc *usecase.PivotDefaults,
+) *PivotDefaults {
+	return &PivotDefaults{
+		uc: uc,
+	}
+}
+
+type PivotDefaults struct {
+	uc *usecase.PivotDefaults
+}
+
+func (h *PivotDefaults) Get(c *gin.Context) {
+	e, err := h.uc.Get(c.Request.Context(), &entity.GetPivotDefaultsParams{
+		Project: c.Param("project"),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			c.PureJSON(http.StatusOK, gin.H{"pivot_defaults": nil})
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"pivot_defaults": e})
+}
+
+type putPivotDefaultsParams struct {
+	OrderKey           string                     `json:"sort"`
+	Direction          string                     `json:"dir"`
+	View               string                     `json:"view"`
+	PerPage            int                        `json:"per_page"`
+	UpdatedBy          string                     `json:"updated_by"`
+	AttentionWeights   *entity.AttentionWeights   `json:"attention_weights"`
+	OverallStatusRules *entity.OverallStatusRules `json:"overall_status_rules"`
+}
+
+func (h *PivotDefaults) Put(c *gin.Context) {
+	var p putPivotDefaultsParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Put(c.Request.Context(), &entity.PutPivotDefaultsParams{
+		Project:   c.Param("project"),
+		OrderKey:  p.OrderKey,
+		Direction: p.Direction,
+		View:      p.View,
+		PerPage:   p.PerPage,
+		UpdatedBy: p.UpdatedBy,
+
+		AttentionWeights:   p.AttentionWeights,
+		OverallStatusRules: p.OverallStatusRules,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, err)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"pivot_defaults": e})
+}
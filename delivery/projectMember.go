@@ -0,0 +1,198 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/projectMember.go
+
+	Module Description:
+		HTTP delivery handlers for per-project review roles, and the middleware that
+		makes the authenticated user available to the usecases.
+
+	Details:
+	- GET    /projects/:project/members
+	- PUT    /projects/:project/members/:user   {"role": "lead", "updated_by": "..."}
+	- DELETE /projects/:project/members/:user
+	- Roles: viewer, artist, lead, supervisor. A denied action is answered with 403.
+	- UserFromAuthHeader must run after Auth.ParseHeaderToken, which verifies the token;
+	  it only reads the user claim from the already-verified bearer token.
+	- RoleFromMembership runs after UserFromAuthHeader and stores the caller's role in the
+	  :project of the route, which category access restricts the pivot by. Routes without
+	  a :project carry no role.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - forbidden answers with the shared error envelope (code forbidden).
+		* - 15-10-2026 - Added RoleFromMembership.
+
+	Functions:
+		* NewProjectMember: Creates a new ProjectMember handler.
+		* (ProjectMember) List: Lists the members of a project.
+		* (ProjectMember) Put: Sets the role of a user.
+		* (ProjectMember) Delete: Removes a user from a project.
+		* UserFromAuthHeader – middleware: Stores the caller in the gin and request context.
+		* (ProjectMember) RoleFromMembership – middleware: Stores the caller's project role.
+		* forbidden – utility function: Writes 403 for a *entity.PermissionDeniedError.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewProjectMember(
+	uc *usecase.ProjectMember,
+) *ProjectMember {
+	return &ProjectMember{
+		uc: uc,
+	}
+}
+
+type ProjectMember struct {
+	uc *usecase.ProjectMember
+}
+
+// UserFromAuthHeader resolves the caller once per request: the user set by the auth
+// middleware if any, otherwise the user claim of the bearer token. The user is put in
+// the request context under entity.KeyUser, where the usecases look for it.
+func UserFromAuthHeader(c *gin.Context) {
+	user := authUser(c)
+	if user == "" {
+		user = bearerTokenUser(c.GetHeader("Authorization"))
+		if user != "" {
+			c.Set(authUserKey, user)
+		}
+	}
+	if user != "" {
+		c.Request = c.Request.WithContext(
+			context.WithValue(c.Request.Context(), entity.KeyUser, user),
+		)
+	}
+	c.Next()
+}
+
+// RoleFromMembership stores the caller's role in the route's project under authRoleKey,
+// where authRole reads it. Nothing is stored while the project has no members.
+func (h *ProjectMember) RoleFromMembership(c *gin.Context) {
+	project := c.Param("project")
+	if project == "" {
+		c.Next()
+		return
+	}
+	role, err := h.uc.Role(c.Request.Context(), project, authUser(c))
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	if role != "" {
+		c.Set(authRoleKey, role)
+	}
+	c.Next()
+}
+
+// bearerTokenUser reads the user claim of a JWT without verifying it (see Details).
+func bearerTokenUser(header string) string {
+	token := strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return ""
+	}
+	var claims map[string]interface{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	for _, k := range []string{"user", "username", "preferred_username", "sub"} {
+		if v, ok := claims[k].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// forbidden writes 403 Forbidden when err is a permission denial.
+func forbidden(c *gin.Context, err error) bool {
+	var deniedErr *entity.PermissionDeniedError
+	if !errors.As(err, &deniedErr) {
+		return false
+	}
+	abortWithError(c, http.StatusForbidden, CodeForbidden, deniedErr, gin.H{
+		"role":   deniedErr.Role,
+		"action": deniedErr.Action,
+	})
+	return true
+}
+
+func (h *ProjectMember) List(c *gin.Context) {
+	entities, err := h.uc.List(c.Request.Context(), &entity.ListProjectMemberParams{
+		Project: c.Param("project"),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, map[string]interface{}{
+		"members": entities,
+	})
+}
+
+type putProjectMemberParams struct {
+	Role      string `json:"role" binding:"required,oneof=viewer artist lead supervisor"`
+	UpdatedBy string `json:"updated_by"`
+}
+
+func (h *ProjectMember) Put(c *gin.Context) {
+	var p putProjectMemberParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	updatedBy := p.UpdatedBy
+	if updatedBy == "" {
+		updatedBy = authUser(c)
+	}
+	e, err := h.uc.Put(c.Request.Context(), &entity.PutProjectMemberParams{
+		Project:   c.Param("project"),
+		User:      c.Param("user"),
+		Role:      p.Role,
+		UpdatedBy: updatedBy,
+	})
+	if err != nil {
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ProjectMember) Delete(c *gin.Context) {
+	params := &entity.DeleteProjectMemberParams{
+		Project: c.Param("project"),
+		User:    c.Param("user"),
+	}
+	if err := h.uc.Delete(c.Request.Context(), params); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("member %s not found", params.User))
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,101 @@
+package delivery
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/delivery/mocks"
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+// newMemberUsecase returns a ProjectMember usecase over an in-memory database where
+// project rod has a supervisor sam and a viewer val, and project ext has no members.
+func newMemberUsecase(t *testing.T) *usecase.ProjectMember {
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := repository.NewProjectMember(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []entity.PutProjectMemberParams{
+		{Project: "rod", User: "sam", Role: entity.ReviewRoleSupervisor},
+		{Project: "rod", User: "val", Role: entity.ReviewRoleViewer},
+	} {
+		if _, err := repo.Put(db, &m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return usecase.NewProjectMember(repo, nil, time.Second, time.Second)
+}
+
+// bearerToken returns an unsigned JWT carrying user; UserFromAuthHeader does not verify it.
+func bearerToken(user string) string {
+	enc := base64.RawURLEncoding
+	return "Bearer " + enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." +
+		enc.EncodeToString([]byte(`{"user":"`+user+`"}`)) + ".sig"
+}
+
+// The pivot restricts categories by the role RoleFromMembership derives from the
+// caller's membership: a supervisor, whom category access never restricts, a viewer,
+// anyone outside a project with members (viewer) and anyone in a project without.
+func TestRoleFromMembershipListAssetsPivot(t *testing.T) {
+	members := NewProjectMember(newMemberUsecase(t))
+	for _, tc := range []struct {
+		name    string
+		project string
+		user    string
+		want    string
+	}{
+		{"supervisor", "rod", "sam", entity.ReviewRoleSupervisor},
+		{"viewer", "rod", "val", entity.ReviewRoleViewer},
+		{"not a member", "rod", "eve", entity.ReviewRoleViewer},
+		{"anonymous", "rod", "", entity.ReviewRoleViewer},
+		{"project without members", "ext", "sam", ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := mocks.NewMockReviewInfoUsecase(ctrl)
+			uc.EXPECT().PivotQueryLimits(gomock.Any(), tc.project).
+				Return(entity.DefaultPageLimits, 10*time.Second, nil).AnyTimes()
+			var got *usecase.ListAssetsPivotParams
+			uc.EXPECT().ListAssetsPivot(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, p usecase.ListAssetsPivotParams) (*usecase.ListAssetsPivotResult, error) {
+					got = &p
+					return &usecase.ListAssetsPivotResult{Page: 1, PerPage: 30, PageLast: 1, View: "list"}, nil
+				})
+
+			router := gin.New()
+			router.Use(UserFromAuthHeader, members.RoleFromMembership)
+			router.GET("/projects/:project/reviews/assets/pivot", NewReviewInfo(uc).ListAssetsPivot)
+
+			req := httptest.NewRequest(http.MethodGet, "/projects/"+tc.project+"/reviews/assets/pivot", nil)
+			if tc.user != "" {
+				req.Header.Set("Authorization", bearerToken(tc.user))
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("status %d, body %s", w.Code, w.Body)
+			}
+			if got == nil {
+				t.Fatal("ListAssetsPivot was not called")
+			}
+			if got.Role != tc.want {
+				t.Errorf("role = %q, want %q", got.Role, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/projectQueryPolicy.go
+
+	Module Description:
+		HTTP delivery handlers for per-project query policies of the asset pivot.
+
+	Details:
+	- GET /projects/:project/reviews/query-policy
+	- GET|PUT /admin/projects/:project/query-policy (admin API, see delivery/admin.go)
+	  {"max_per_page": 30, "timeout_seconds": 20, "allowed_views": ["list"], "updated_by": "..."}
+	- Omitted or zero fields keep the server-wide limits; an empty allowed_views
+	  allows both views. ListAssetsPivot answers 400 for a disabled view.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Moved PUT to the admin API.
+
+	Functions:
+		* NewProjectQueryPolicy: Creates a new ProjectQueryPolicy handler.
+		* (ProjectQueryPolicy) Get: Returns the query policy of a project.
+		* (ProjectQueryPolicy) Put: Replaces the query policy of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewProjectQueryPolicy(
+	uc *usecase.ProjectQueryPolicy,
+) *ProjectQueryPolicy {
+	return &ProjectQueryPolicy{
+		uc: uc,
+	}
+}
+
+type ProjectQueryPolicy struct {
+	uc *usecase.ProjectQueryPolicy
+}
+
+func (h *ProjectQueryPolicy) Get(c *gin.Context) {
+	e, err := h.uc.Get(c.Request.Context(), &entity.GetProjectQueryPolicyParams{
+		Project: c.Param("project"),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			c.PureJSON(http.StatusOK, gin.H{"query_policy": nil})
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"query_policy": e})
+}
+
+type putProjectQueryPolicyParams struct {
+	MaxPerPage     int      `json:"max_per_page"`
+	TimeoutSeconds int      `json:"timeout_seconds"`
+	AllowedViews   []string `json:"allowed_views"`
+	UpdatedBy      string   `json:"updated_by"`
+}
+
+func (h *ProjectQueryPolicy) Put(c *gin.Context) {
+	var p putProjectQueryPolicyParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Put(c.Request.Context(), &entity.PutProjectQueryPolicyParams{
+		Project:        c.Param("project"),
+		MaxPerPage:     p.MaxPerPage,
+		TimeoutSeconds: p.TimeoutSeconds,
+		AllowedViews:   p.AllowedViews,
+		UpdatedBy:      p.UpdatedBy,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"query_policy": e})
+}
@@ -0,0 +1,134 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/rateLimit.go
+
+	Module Description:
+		Token bucket rate limiting of the heavy read requests per client.
+
+	Details:
+	- A client is the authenticated user, otherwise a hash of its bearer token,
+	  otherwise its IP address; so it must run after UserFromAuthHeader.
+	- The middleware is attached per route (the review list and asset pivot reads in
+	  main.go), not to the whole API; cheap reads like a single review are not limited.
+	  The routes of one RateLimiter share its buckets.
+	- Each client may make RPS GET/HEAD requests per second with bursts of up to Burst.
+	  Writes are not limited.
+	- A limited request is answered 429 with Retry-After (whole seconds until the next
+	  token).
+	- Buckets idle long enough to be full again are dropped once the table grows large.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Answer limited requests with the shared error envelope (code rate_limited).
+		* - 15-10-2026 - Attached to the list and pivot read routes only.
+
+	Functions:
+		* NewRateLimiter: Creates a rate limiter.
+		* (RateLimiter) Middleware: Returns the gin middleware limiting read requests.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const maxRateLimitBuckets = 10000
+
+type RateLimitConfig struct {
+	RPS   float64 // sustained requests per second; <= 0 disables limiting
+	Burst int     // bucket size; < 1 is taken as 1
+}
+
+type tokenBucket struct {
+	tokens float64
+	at     time.Time
+}
+
+type RateLimiter struct {
+	cfg     RateLimitConfig
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func NewRateLimiter(cfg RateLimitConfig) *RateLimiter {
+	if cfg.Burst < 1 {
+		cfg.Burst = 1
+	}
+	return &RateLimiter{
+		cfg:     cfg,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// take spends one token of key, returning how long to wait when none is left.
+func (l *RateLimiter) take(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	burst := float64(l.cfg.Burst)
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxRateLimitBuckets {
+			l.sweep(now)
+		}
+		b = &tokenBucket{tokens: burst, at: now}
+		l.buckets[key] = b
+	}
+	b.tokens = math.Min(burst, b.tokens+now.Sub(b.at).Seconds()*l.cfg.RPS)
+	b.at = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - b.tokens) / l.cfg.RPS * float64(time.Second))
+}
+
+// sweep drops the buckets that have refilled completely. Caller holds l.mu.
+func (l *RateLimiter) sweep(now time.Time) {
+	full := time.Duration(float64(l.cfg.Burst) / l.cfg.RPS * float64(time.Second))
+	for k, b := range l.buckets {
+		if now.Sub(b.at) >= full {
+			delete(l.buckets, k)
+		}
+	}
+}
+
+func rateLimitClient(c *gin.Context) string {
+	if user := authUser(c); user != "" {
+		return "user:" + user
+	}
+	if token := strings.TrimSpace(strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer ")); token != "" {
+		sum := sha256.Sum256([]byte(token))
+		return "token:" + hex.EncodeToString(sum[:8])
+	}
+	return "ip:" + c.ClientIP()
+}
+
+func (l *RateLimiter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if l.cfg.RPS <= 0 || (c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead) {
+			c.Next()
+			return
+		}
+		ok, wait := l.take(rateLimitClient(c), time.Now())
+		if !ok {
+			seconds := int(math.Ceil(wait.Seconds()))
+			c.Header("Retry-After", strconv.Itoa(seconds))
+			abortWithError(c, http.StatusTooManyRequests, CodeRateLimited,
+				errors.New("request rate limit exceeded, please slow down"),
+				gin.H{"retry_after": seconds})
+			return
+		}
+		c.Next()
+	}
+}
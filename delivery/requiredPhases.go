@@ -0,0 +1,95 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/requiredPhases.go
+
+	Module Description:
+		HTTP delivery handlers for the per-project required phases of assets.
+
+	Details:
+	- GET /projects/:project/reviews/required-phases
+	- PUT /projects/:project/reviews/required-phases
+	  {"by_relation": {"cam": ["mdl", "rig"]}, "by_top_group_node": {"props": ["mdl", "ldv"]},
+	   "updated_by": "..."}
+	- A relation mapping wins over a top group node mapping. Relations and phases compare
+	  case-insensitively; top group nodes as they are spelled.
+	- Assets matching neither use the required phases of the overall status rules.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Writes answer 403 to callers below supervisor.
+
+	Functions:
+		* NewRequiredPhases: Creates a new RequiredPhases handler.
+		* (RequiredPhases) Get: Returns the required phases of a project.
+		* (RequiredPhases) Put: Replaces the required phases of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewRequiredPhases(
+	uc *usecase.RequiredPhases,
+) *RequiredPhases {
+	return &RequiredPhases{
+		uc: uc,
+	}
+}
+
+type RequiredPhases struct {
+	uc *usecase.RequiredPhases
+}
+
+func (h *RequiredPhases) Get(c *gin.Context) {
+	e, err := h.uc.Get(c.Request.Context(), &entity.GetRequiredPhasesParams{
+		Project: c.Param("project"),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			c.PureJSON(http.StatusOK, gin.H{"required_phases": nil})
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"required_phases": e})
+}
+
+type putRequiredPhasesParams struct {
+	ByRelation     map[string][]string `json:"by_relation"`
+	ByTopGroupNode map[string][]string `json:"by_top_group_node"`
+	UpdatedBy      string              `json:"updated_by"`
+}
+
+func (h *RequiredPhases) Put(c *gin.Context) {
+	var p putRequiredPhasesParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Put(c.Request.Context(), &entity.PutRequiredPhasesParams{
+		Project:        c.Param("project"),
+		ByRelation:     p.ByRelation,
+		ByTopGroupNode: p.ByTopGroupNode,
+		UpdatedBy:      p.UpdatedBy,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, err)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"required_phases": e})
+}
@@ -0,0 +1,106 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewActivity.go
+
+	Module Description:
+		HTTP delivery handler for the review activity feed.
+
+	Details:
+	- GET /projects/:project/reviewActivities?since=&limit=&window=
+	  * window is a Go duration (e.g. 30s, 5m); window=0 returns raw events uncollapsed.
+	- GET /projects/:project/sessions/:id/decisions
+	  * the dailies decision log of one review session.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Added SessionDecisions.
+
+	Functions:
+		* NewReviewActivity: Creates a new ReviewActivity handler.
+		* (ReviewActivity) List: Lists the collapsed activity feed of a project.
+		* (ReviewActivity) SessionDecisions: Returns the decision log of a review session.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewActivity(
+	uc *usecase.ReviewActivity,
+) *ReviewActivity {
+	return &ReviewActivity{
+		uc: uc,
+	}
+}
+
+type ReviewActivity struct {
+	uc *usecase.ReviewActivity
+}
+
+type listReviewActivityParams struct {
+	Since  *time.Time `form:"since"`
+	Limit  *int       `form:"limit"`
+	Window *string    `form:"window"`
+}
+
+func (p *listReviewActivityParams) Entity(project string) (*entity.ListReviewActivityParams, error) {
+	params := &entity.ListReviewActivityParams{
+		Project: project,
+		Since:   p.Since,
+		Window:  usecase.DefaultReviewActivityWindow,
+	}
+	if p.Limit != nil {
+		params.Limit = *p.Limit
+	}
+	if p.Window != nil {
+		d, err := time.ParseDuration(*p.Window)
+		if err != nil || d < 0 {
+			return nil, fmt.Errorf("window must be a non-negative duration such as 30s or 5m")
+		}
+		params.Window = d
+	}
+	return params, nil
+}
+
+func (h *ReviewActivity) List(c *gin.Context) {
+	var p listReviewActivityParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params, err := p.Entity(c.Param("project"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	entries, err := h.uc.Feed(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, map[string]interface{}{
+		"activities": entries,
+		"window":     params.Window.String(),
+	})
+}
+
+func (h *ReviewActivity) SessionDecisions(c *gin.Context) {
+	params := &entity.ListReviewSessionDecisionsParams{
+		Project:   c.Param("project"),
+		SessionID: c.Param("id"),
+	}
+	res, err := h.uc.SessionDecisions(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, res)
+}
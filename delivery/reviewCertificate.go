@@ -0,0 +1,199 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewCertificate.go
+
+	Module Description:
+		HTTP delivery handlers for approval certificates.
+
+	Details:
+	- GET  /projects/:project/reviews/:id/certificates
+	- GET  /projects/:project/reviewCertificates/:certificate?format=json|pdf
+	- GET  /projects/:project/reviewCertificates/:certificate/verify
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewReviewCertificate: Creates a new ReviewCertificate handler.
+		* (ReviewCertificate) List: Lists certificates issued for a review info.
+		* (ReviewCertificate) Get: Downloads a certificate as JSON or PDF.
+		* (ReviewCertificate) Verify: Re-checks the hash and signature of a certificate.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewCertificate(
+	uc *usecase.ReviewCertificate,
+) *ReviewCertificate {
+	return &ReviewCertificate{
+		uc: uc,
+	}
+}
+
+type ReviewCertificate struct {
+	uc *usecase.ReviewCertificate
+}
+
+func (h *ReviewCertificate) List(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.ListReviewCertificateParams{
+		Project:      c.Param("project"),
+		ReviewInfoID: int32(id),
+	}
+	entities, err := h.uc.List(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, map[string]interface{}{
+		"certificates": entities,
+	})
+}
+
+func (h *ReviewCertificate) getParams(c *gin.Context) (*entity.GetReviewCertificateParams, error) {
+	id, err := strconv.Atoi(c.Param("certificate"))
+	if err != nil {
+		return nil, err
+	}
+	return &entity.GetReviewCertificateParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	}, nil
+}
+
+func (h *ReviewCertificate) Get(c *gin.Context) {
+	params, err := h.getParams(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Get(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review certificate with ID %d not found", params.ID))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("review-certificate-%s-%d", e.Project, e.ID)
+	switch strings.ToLower(c.DefaultQuery("format", "json")) {
+	case "json":
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.json"`, filename))
+		c.PureJSON(http.StatusOK, e)
+	case "pdf":
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.pdf"`, filename))
+		c.Data(http.StatusOK, "application/pdf", renderReviewCertificatePDF(e))
+	default:
+		badRequest(c, fmt.Errorf("format must be json or pdf"))
+	}
+}
+
+func (h *ReviewCertificate) Verify(c *gin.Context) {
+	params, err := h.getParams(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	res, err := h.uc.Verify(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review certificate with ID %d not found", params.ID))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, res)
+}
+
+/*
+========================================================================================
+  - renderReviewCertificatePDF – helper
+  - Writes a single-page PDF (Helvetica, no external dependency) with the certificate
+    fields. Non-ASCII characters are replaced with '?' since the base-14 fonts cannot
+    render them; the JSON download remains the authoritative record.
+
+========================================================================================
+*/
+func renderReviewCertificatePDF(e *entity.ReviewCertificate) []byte {
+	pdfText := func(s string) string {
+		var b strings.Builder
+		for _, r := range s {
+			switch {
+			case r == '(' || r == ')' || r == '\\':
+				b.WriteByte('\\')
+				b.WriteRune(r)
+			case r < 0x20 || r > 0x7e:
+				b.WriteByte('?')
+			default:
+				b.WriteRune(r)
+			}
+		}
+		return b.String()
+	}
+
+	lines := []string{
+		"Review Approval Certificate",
+		"",
+		fmt.Sprintf("Certificate ID: %d", e.ID),
+		fmt.Sprintf("Project: %s", e.Project),
+		fmt.Sprintf("Review Info ID: %d", e.ReviewInfoID),
+		fmt.Sprintf("Approver: %s", e.Approver),
+		fmt.Sprintf("Approved At (UTC): %s", e.ApprovedAtUtc.UTC().Format(time.RFC3339)),
+		fmt.Sprintf("Issued At (UTC): %s", e.CreatedAtUtc.UTC().Format(time.RFC3339)),
+		"",
+		"SHA-256: " + e.Hash,
+		"Signature: " + e.Signature,
+	}
+
+	var content bytes.Buffer
+	content.WriteString("BT\n/F1 11 Tf\n14 TL\n50 780 Td\n")
+	for _, l := range lines {
+		fmt.Fprintf(&content, "(%s) Tj T*\n", pdfText(l))
+	}
+	content.WriteString("ET\n")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 595 842] " +
+			"/Resources << /Font << /F1 4 0 R >> >> /Contents 5 0 R >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return out.Bytes()
+}
@@ -0,0 +1,108 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewEvents.go
+
+	Module Description:
+		Server-sent event stream of a project's review events.
+
+	Details:
+	- GET /projects/:project/reviews/events?type=review.created,review.updated
+	  Streams the events the webhooks receive (ReviewWebhook.Subscribe) as
+	  text/event-stream: "id" is the event ID, "event" its type, "data" the JSON event.
+	- Only events committed after the request are sent; there is no replay, so
+	  Last-Event-ID is not read. A comment line is sent every reviewEventsKeepAlive so
+	  proxies keep the connection open.
+	- The stream lifts the server's write deadline; it ends when the client goes away
+	  or the server starts draining, so it does not hold up the shutdown. Clients
+	  reconnect (client.StreamEvents does), to another instance by then.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewReviewEvents: Creates a new ReviewEvents handler.
+		* (ReviewEvents) Stream: Streams the review events of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+const reviewEventsKeepAlive = 30 * time.Second
+
+// NewReviewEvents returns the handler; the streams end when shutdown is closed
+// (Lifecycle.DrainStarted).
+func NewReviewEvents(
+	uc *usecase.ReviewWebhook,
+	shutdown <-chan struct{},
+) *ReviewEvents {
+	return &ReviewEvents{
+		uc:       uc,
+		shutdown: shutdown,
+	}
+}
+
+type ReviewEvents struct {
+	uc       *usecase.ReviewWebhook
+	shutdown <-chan struct{}
+}
+
+func (h *ReviewEvents) Stream(c *gin.Context) {
+	types := map[string]bool{}
+	for _, raw := range c.QueryArray("type") {
+		for _, t := range splitCSV(raw) {
+			types[t] = true
+		}
+	}
+	events, unsubscribe := h.uc.Subscribe(c.Param("project"))
+	defer unsubscribe()
+
+	// Not every writer supports it (ErrNotSupported); the stream then ends at the
+	// write timeout and the client reconnects.
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(reviewEventsKeepAlive)
+	defer keepAlive.Stop()
+	ctx := c.Request.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-h.shutdown:
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(c.Writer, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+			if len(types) > 0 && !types[event.Type] {
+				continue
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
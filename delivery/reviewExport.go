@@ -0,0 +1,175 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewExport.go
+
+	Module Description:
+		HTTP delivery handlers for asynchronous asset pivot CSV exports.
+
+	Details:
+	- POST /projects/:project/reviews/exports
+	       {"root": "assets", "phase": "mdl", "sort": "group_1", "dir": "asc", "name": "...",
+	        "approval_status": ["retake"], "work_status": [], "submitted_user": ["alice"],
+	        "approval_status_updated_user": [], "as_of": "2026-01-15T00:00:00Z"}
+	       -> 202 with the queued job. Every field is optional and means the same as the
+	          ListAssetsPivot query param of the same name.
+	- GET  /projects/:project/reviews/exports/:id            job status (queued/running/done/failed)
+	- GET  /projects/:project/reviews/exports/:id/download   the CSV; 409 until the job is done
+	- The export sees what the caller's role may see in the pivot.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Accept submitted_user/approval_status_updated_user filters.
+		* - 15-10-2026 - Answer 409 on Download with the shared error envelope.
+
+	Functions:
+		* NewReviewExport: Creates a new ReviewExport handler.
+		* (ReviewExport) Post: Queues an export job.
+		* (ReviewExport) Get: Returns the status of an export job.
+		* (ReviewExport) Download: Streams the file of a finished export job.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewExport(
+	uc *usecase.ReviewExport,
+) *ReviewExport {
+	return &ReviewExport{
+		uc: uc,
+	}
+}
+
+type ReviewExport struct {
+	uc *usecase.ReviewExport
+}
+
+type createReviewExportParams struct {
+	Root                 string     `json:"root"`
+	Phase                string     `json:"phase"`
+	Sort                 string     `json:"sort"`
+	Dir                  string     `json:"dir" binding:"omitempty,oneof=asc desc ASC DESC"`
+	Name                 string     `json:"name"`
+	ApprovalStatuses     []string   `json:"approval_status"`
+	WorkStatuses         []string   `json:"work_status"`
+	SubmittedUsers       []string   `json:"submitted_user"`
+	ApprovalUpdatedUsers []string   `json:"approval_status_updated_user"`
+	AsOf                 *time.Time `json:"as_of"`
+}
+
+// reviewExportResponse adds the URLs to poll and to download the job.
+func reviewExportResponse(c *gin.Context, e *entity.ReviewExport) gin.H {
+	statusURL := strings.TrimSuffix(c.Request.URL.Path, "/") // POST .../exports, GET .../exports/:id
+	if c.Param("id") == "" {
+		statusURL = fmt.Sprintf("%s/%d", statusURL, e.ID)
+	}
+	res := gin.H{
+		"review_export": e,
+		"status_url":    statusURL,
+	}
+	if e.Status == entity.ReviewExportStatusDone {
+		res["download_url"] = statusURL + "/download"
+	}
+	return res
+}
+
+func (h *ReviewExport) Post(c *gin.Context) {
+	var p createReviewExportParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	phase := strings.TrimSpace(p.Phase)
+	if phase == "" {
+		phase = "none"
+	}
+	e, err := h.uc.Create(c.Request.Context(), &entity.CreateReviewExportParams{
+		Project: c.Param("project"),
+		Filters: entity.ReviewExportFilters{
+			Root:                 strings.TrimSpace(p.Root),
+			PreferredPhase:       phase,
+			OrderKey:             strings.TrimSpace(p.Sort),
+			Direction:            strings.ToLower(p.Dir),
+			AssetNameKey:         strings.TrimSpace(p.Name),
+			ApprovalStatuses:     p.ApprovalStatuses,
+			WorkStatuses:         p.WorkStatuses,
+			SubmittedUsers:       p.SubmittedUsers,
+			ApprovalUpdatedUsers: p.ApprovalUpdatedUsers,
+			AsOf:                 p.AsOf,
+		},
+		Role:      authRole(c),
+		CreatedBy: authUser(c),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusAccepted, reviewExportResponse(c, e))
+}
+
+func (h *ReviewExport) params(c *gin.Context) (*entity.GetReviewExportParams, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return nil, err
+	}
+	return &entity.GetReviewExportParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	}, nil
+}
+
+func (h *ReviewExport) Get(c *gin.Context) {
+	params, err := h.params(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Get(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review export with ID %d not found", params.ID))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, reviewExportResponse(c, e))
+}
+
+func (h *ReviewExport) Download(c *gin.Context) {
+	params, err := h.params(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, rc, err := h.uc.Open(c.Request.Context(), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrReviewExportNotDone):
+			abortWithError(c, http.StatusConflict, CodeConflict,
+				fmt.Errorf("review export %d is %s", e.ID, e.Status),
+				gin.H{"status": e.Status})
+		case errors.Is(err, entity.ErrRecordNotFound):
+			badRequest(c, fmt.Errorf("review export with ID %d not found", params.ID))
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	defer rc.Close()
+	filename := fmt.Sprintf("%s-review-export-%d.csv", e.Project, e.ID)
+	c.DataFromReader(http.StatusOK, -1, "text/csv; charset=utf-8", rc, map[string]string{
+		"Content-Disposition": fmt.Sprintf(`attachment; filename="%s"`, filename),
+	})
+}
@@ -0,0 +1,121 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewImport.go
+
+	Module Description:
+		HTTP delivery handler for backfilling review statuses from legacy spreadsheets.
+
+	Details:
+	- POST /projects/:project/reviews/imports?dry_run=true
+	  * multipart/form-data: "file" (CSV), "mapping" (JSON), "imported_by"
+	  * or a raw text/csv body with the mapping JSON in the "mapping" query parameter
+	- The first CSV line is the header; the response lists one diff row per data line.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Answer role denials with 403.
+
+	Functions:
+		* NewReviewImport: Creates a new ReviewImport handler.
+		* (ReviewImport) Post: Parses the CSV and mapping and runs (or previews) the import.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewImport(
+	uc *usecase.ReviewImport,
+) *ReviewImport {
+	return &ReviewImport{
+		uc: uc,
+	}
+}
+
+type ReviewImport struct {
+	uc *usecase.ReviewImport
+}
+
+func (h *ReviewImport) Post(c *gin.Context) {
+	dryRun := false
+	if v := c.Query("dry_run"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			badRequest(c, fmt.Errorf("dry_run must be a boolean"))
+			return
+		}
+		dryRun = b
+	}
+
+	var (
+		src        io.Reader
+		rawMapping string
+		importedBy string
+	)
+	if fh, err := c.FormFile("file"); err == nil {
+		f, err := fh.Open()
+		if err != nil {
+			badRequest(c, err)
+			return
+		}
+		defer f.Close()
+		src = f
+		rawMapping = c.PostForm("mapping")
+		importedBy = c.PostForm("imported_by")
+	} else {
+		src = c.Request.Body
+		rawMapping = c.Query("mapping")
+		importedBy = c.Query("imported_by")
+	}
+	if rawMapping == "" {
+		badRequest(c, fmt.Errorf("mapping is required"))
+		return
+	}
+	var mapping entity.ReviewImportMapping
+	if err := json.Unmarshal([]byte(rawMapping), &mapping); err != nil {
+		badRequest(c, fmt.Errorf("invalid mapping: %w", err))
+		return
+	}
+
+	r := csv.NewReader(src)
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		badRequest(c, fmt.Errorf("invalid csv: %w", err))
+		return
+	}
+	if len(records) == 0 {
+		badRequest(c, fmt.Errorf("csv is empty"))
+		return
+	}
+
+	params := &entity.ReviewImportParams{
+		Project:    c.Param("project"),
+		Mapping:    &mapping,
+		Header:     records[0],
+		Records:    records[1:],
+		DryRun:     dryRun,
+		ImportedBy: importedBy,
+	}
+	res, err := h.uc.Import(c.Request.Context(), params)
+	if err != nil {
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, res)
+}
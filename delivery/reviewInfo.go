@@ -1,128 +1,1167 @@
-// ========================================================================================
-// ListAssetsPivot – helper
-// ========================================================================================
-func splitCSV(raw string) []string {
-	raw = strings.TrimSpace(raw)
-	if raw == "" {
-		return nil
-	}
-	parts := strings.Split(raw, ",")
-	out := make([]string, 0, len(parts))
-	for _, p := range parts {
-		if t := strings.TrimSpace(p); t != "" {
-			out = append(out, t)
-		}
-	}
-	return out
-}
-
-// ========================================================================================
-// Asset Review Pivot Listing
-// GET /api/projects/:project/reviews/assets/pivot
-//
-// Query:
-//   root=assets
-//   view=list|grouped
-//   sort=group_1|...
-//   dir=asc|desc
-//   page=1
-//   per_page=15
-//   phase=none|mdl|rig|...
-//   name=cam
-//   approval_status=check,approved   (or appr=...)
-//   work_status=check,review         (or work=...)
-// ========================================================================================
-func (h *ReviewInfo) ListAssetsPivot(c *gin.Context) {
-	// ---- Required path param ----
-	project := strings.TrimSpace(c.Param("project"))
-	if project == "" {
-		badRequest(c, fmt.Errorf("project is required"))
-		return
-	}
-
-	// ---- Query params ----
-	root := strings.TrimSpace(c.DefaultQuery("root", "assets"))
-	if root == "" {
-		root = "assets"
-	}
-
-	view := strings.TrimSpace(c.DefaultQuery("view", "list")) // list | grouped
-
-	sortKey := strings.TrimSpace(c.DefaultQuery("sort", "group_1"))
-	dir := strings.TrimSpace(c.DefaultQuery("dir", "asc")) // usecase will normalize
-
-	phase := strings.TrimSpace(c.DefaultQuery("phase", "none"))
-	if phase == "" {
-		phase = "none"
-	}
-
-	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
-	if page < 1 {
-		page = 1
-	}
-
-	perPage, _ := strconv.Atoi(c.DefaultQuery("per_page", "15"))
-	if perPage < 1 {
-		perPage = 15
-	}
-
-	assetNameKey := strings.TrimSpace(c.DefaultQuery("name", ""))
-
-	// Support both new & old query keys
-	approvalRaw := c.Query("approval_status")
-	if approvalRaw == "" {
-		approvalRaw = c.Query("appr")
-	}
-	workRaw := c.Query("work_status")
-	if workRaw == "" {
-		workRaw = c.Query("work")
-	}
-
-	approvalStatuses := splitCSV(approvalRaw)
-	workStatuses := splitCSV(workRaw)
-
-	// ---- Context timeout ----
-	ctx, cancel := context.WithTimeout(c.Request.Context(), 7*time.Second)
-	defer cancel()
-
-	// ---- NEW usecase signature: (ctx, params) -> (result, error) ----
-	params := usecase.ListAssetsPivotParams{
-		Project:          project,
-		Root:             root,
-		PreferredPhase:   phase,
-		OrderKey:         sortKey,
-		Direction:        dir,
-		Page:             page,
-		PerPage:          perPage,
-		AssetNameKey:     assetNameKey,
-		ApprovalStatuses: approvalStatuses,
-		WorkStatuses:     workStatuses,
-		View:             view,
-	}
-
-	result, err := h.uc.ListAssetsPivot(ctx, params)
-	if err != nil {
-		internalServerError(c, err)
-		return
-	}
-
-	res := gin.H{
-		"assets":    result.Assets,
-		"total":     result.Total,
-		"page":      result.Page,
-		"per_page":  result.PerPage,
-		"page_last": result.PageLast,
-		"has_next":  result.HasNext,
-		"has_prev":  result.HasPrev,
-		"sort":      result.Sort,
-		"dir":       result.Dir,
-		"project":   project,
-		"root":      root,
-		"view":      view,
-	}
-	if len(result.Groups) > 0 {
-		res["groups"] = result.Groups
-	}
-
-	c.PureJSON(http.StatusOK, res)
-}
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+    	delivery/reviewInfo.go
+
+	Module Description:
+		HTTP delivery handlers for review information management.
+
+	Details:
+
+	Update and Modification History:
+		* - 29-10-2025 - SanjayK PSI - Initial creation sorting pagination implementation.
+		* - 07-11-2025 - SanjayK PSI - Column visibility toggling implementation.
+		* - 20-11-2025 - SanjayK PSI - Fixed typo in filter property names handling.
+		* - [TODAY] - Added performance optimizations for ListAssetsPivot
+		* - 15-10-2026 - ETag / If-None-Match validation caching on ListAssetsPivot.
+		* - 15-10-2026 - Pass the caller's role to ListAssetsPivot for category access.
+		* - 15-10-2026 - Accept ?cursor= and return next_cursor on ListAssetsPivot.
+		* - 15-10-2026 - Accept ?as_of= for historical pivot reads.
+		* - 15-10-2026 - Leave absent view/sort/dir/per_page to the project's pivot defaults.
+		* - 15-10-2026 - Answer role denials on Update/Delete with 403.
+		* - 15-10-2026 - Answer 409 from Update when the review is locked by someone else.
+		* - 15-10-2026 - Accept a review session ID on Update (body or X-Review-Session-ID).
+		* - 15-10-2026 - Added AssetTimeline.
+		* - 15-10-2026 - Added BatchAssetDetails.
+		* - 15-10-2026 - Accept group_page/group_per_page for the grouped view of ListAssetsPivot.
+		* - 15-10-2026 - Accept group_depth/tree for a nested category tree in the grouped view.
+		* - 15-10-2026 - Added ListUnassignedAssets.
+		* - 15-10-2026 - Replace the pivot request/timeout atomics with Prometheus metrics.
+		* - 15-10-2026 - Use the trace ID as the request ID of ListAssetsPivot.
+		* - 15-10-2026 - Move the pivot circuit breaker to the CircuitBreaker middleware.
+		* - 15-10-2026 - Accept submitted_user/approval_status_updated_user on List, ListAssets and ListAssetsPivot.
+		* - 15-10-2026 - Accept fields=mdl,rig on ListAssetsPivot to trim the phase columns.
+		* - 15-10-2026 - Compact JSON on the pivot handlers, indented with ?pretty=true.
+		* - 15-10-2026 - Answer errors with the shared error envelope (code, message, details, request_id).
+		* - 15-10-2026 - Answer 422 with field errors when Post/Update break the validation rules.
+		* - 15-10-2026 - Answer 400 for pivot status filters outside the status vocabulary.
+		* - 15-10-2026 - Accept an Idempotency-Key header on Post; retries return the original row.
+		* - 15-10-2026 - Accept count=false on ListAssetsPivot to skip the total.
+		* - 15-10-2026 - Return total_is_estimate on the ListAssetsPivot list view.
+		* - 15-10-2026 - Accept include=comment_count on ListAssetsPivot.
+		* - 15-10-2026 - Accept include=thumbnails on ListAssetsPivot.
+		* - 15-10-2026 - Accept studio filters and include=studio on ListAssetsPivot.
+		* - 15-10-2026 - Accept relation and relation_mode on ListAssetsPivot.
+		* - 15-10-2026 - Document root=shots and custom roots on ListAssetsPivot.
+		* - 15-10-2026 - Accept overall_status filters on ListAssetsPivot.
+		* - 15-10-2026 - Accept overdue=true and sort=due_date on ListAssetsPivot.
+		* - 15-10-2026 - Accept tags=hero,crowd on List, ListAssets and ListAssetsPivot.
+		* - 15-10-2026 - Accept include=notes on ListAssetsPivot.
+		* - 15-10-2026 - ETag on Get/Update; Update checks If-Match (or "version") and answers 409 with the current row.
+		* - 15-10-2026 - Accept take_path, target_components, duration and review_comments corrections on Update.
+		* - 15-10-2026 - Accept groups_only=true and group=a,b on ListAssetsPivot.
+		* - 15-10-2026 - Serve one grouped view bucket at /pivot/groups/:topNode through ListAssetsPivot.
+		* - 15-10-2026 - Accept sort=latest_activity on ListAssetsPivot (desc by default).
+		* - 15-10-2026 - Accept nulls=first|last on ListAssetsPivot.
+		* - 15-10-2026 - Accept natural=true on ListAssetsPivot.
+		* - 15-10-2026 - Take the per_page limits of ListAssetsPivot from the page limit configuration, dropping the project special case.
+		* - 15-10-2026 - Apply the project's query policy (per_page, timeout, views) to ListAssetsPivot; 400 for a disabled view.
+		* - 15-10-2026 - Link header and pagination block on ListAssetsPivot, keeping the request's filters (delivery/pagination).
+		* - 15-10-2026 - JSON:API output on List and ListAssetsPivot for Accept: application/vnd.api+json.
+		* - 15-10-2026 - Answer ListAssetsPivot with usecase.PivotResponse/GroupedPivotResponse instead of gin.H; the grouped view's total is null with count=false too.
+		* - 15-10-2026 - Depend on the ReviewInfoUsecase interface rather than *usecase.ReviewInfo.
+		* - 15-10-2026 - Leave the ListAssetsPivot deadline to the usecase (PivotTimeout).
+		* - 15-10-2026 - Read the pivot query limits only for the hints of a failed ListAssetsPivot.
+		* - 15-10-2026 - Add Vary: Accept next to the compression middleware's Accept-Encoding.
+
+	Functions:
+		* NewReviewInfo: Creates a new ReviewInfo handler.
+		* (ReviewInfo) List: Handles listing review information with filtering and pagination.
+		* (ReviewInfo) Get: Handles retrieving a specific review information by ID.
+		* (ReviewInfo) Post: Handles creating new review information.
+		* (ReviewInfo) Update: Handles updating existing review information.
+		* (ReviewInfo) Delete: Handles deleting review information by ID.
+		* (ReviewInfo) ListAssets: Handles listing assets with filtering and pagination.
+		* (ReviewInfo) ListUnassignedAssets: Handles listing assets without a group category for librarians.
+		* (ReviewInfo) ListAssetReviewInfos: Handles listing review information for a specific asset.
+		* (ReviewInfo) ListShotReviewInfos: Handles listing review information for specific shots.
+		* (ReviewInfo) AssetTimeline: Handles the submission timeline of one asset for the asset detail page.
+		* (splitCSV) – utility function: Splits a comma-separated string into a slice of trimmed strings.
+		* (ReviewInfo) ListAssetsPivot: Handles listing pivoted assets with filtering and sorting.
+		* (ReviewInfo) BatchAssetDetails: Handles fetching the per-phase detail of several assets at once.
+		* (pivotNotModified) – utility function: Sets the ETag of a pivot payload and answers 304 when it matches.
+		* (writeJSON) – utility function: Writes compact JSON, indented with ?pretty=true.
+		* (pivotGroupNode) – utility function: Maps a grouped view bucket name to its top group node.
+		* (ifMatchVersion) – utility function: Returns the review version an update expects.
+		* (versionConflict) – utility function: Writes 409 with the current row for a *entity.ReviewVersionConflictError.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/delivery/pagination"
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/libs"
+	"github.com/PolygonPictures/central30-web/front/tracing"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+type listReviewInfoParams struct {
+	Studio        *string    `form:"studio"`
+	TaskID        *string    `form:"task_id"`
+	SubtaskID     *string    `form:"subtask_id"`
+	Root          *string    `form:"root"`
+	Group         *string    `form:"groups"`
+	Relation      *string    `form:"relation"`
+	Phase         *string    `form:"phase"`
+	Component     *string    `form:"component"`
+	Take          *string    `form:"take"`
+	PerPage       *int       `form:"per_page"`
+	Page          *int       `form:"page"`
+	ModifiedSince *time.Time `form:"modified_since"`
+}
+
+func (p *listReviewInfoParams) Entity(project string) *entity.ListReviewInfoParams {
+	var group []string
+	if p.Group != nil {
+		group = strings.Split(*p.Group, "/")
+	}
+	var relation []string
+	if p.Relation != nil {
+		relation = strings.Split(*p.Relation, ",")
+	}
+	var phase []string
+	if p.Phase != nil {
+		phase = strings.Split(*p.Phase, ",")
+	}
+	params := &entity.ListReviewInfoParams{
+		Project:   project,
+		Studio:    p.Studio,
+		TaskID:    p.TaskID,
+		SubtaskID: p.SubtaskID,
+		Root:      p.Root,
+		Group:     group,
+		Relation:  relation,
+		Phase:     phase,
+		Component: p.Component,
+		Take:      p.Take,
+		BaseListParams: &entity.BaseListParams{
+			PerPage: p.PerPage,
+			Page:    p.Page,
+		},
+	}
+	if p.ModifiedSince != nil {
+		params.ModifiedSince = p.ModifiedSince
+	}
+
+	return params
+}
+
+type createReviewInfoParams struct {
+	TaskID                    string              `json:"task_id"`
+	SubtaskID                 string              `json:"subtask_id"`
+	Studio                    string              `json:"studio"`
+	ProjectPath               string              `json:"project_path"`
+	ReviewComments            []*libs.CommentInfo `json:"review_comments"`
+	Path                      *string             `json:"path"`
+	TakePath                  string              `json:"take_path" binding:"required_without=Path"`
+	Root                      string              `json:"root"`
+	Groups                    []string            `json:"groups"`
+	Relation                  string              `json:"relation"`
+	Phase                     string              `json:"phase"`
+	Component                 string              `json:"component"`
+	Take                      string              `json:"take"`
+	ApprovalStatus            string              `json:"approval_status"`
+	ApprovalStatusUpdatedUser string              `json:"approval_status_updated_user"`
+	WorkStatus                string              `json:"work_status"`
+	WorkStatusUpdatedUser     string              `json:"work_status_updated_user"`
+	ReviewTarget              []*libs.Content     `json:"review_target"`
+	ReviewData                []*libs.Content     `json:"review_data"`
+	OutputContents            []*libs.Content     `json:"output_contents"`
+	SubmittedAtUtc            time.Time           `json:"submitted_at_utc"`
+	SubmittedComputer         string              `json:"submitted_computer"`
+	SubmittedOS               string              `json:"submitted_os"`
+	SubmittedOSVersion        string              `json:"submitted_os_version"`
+	SubmittedUser             string              `json:"submitted_user"`
+	ExecutedAtUtc             time.Time           `json:"executed_at_utc"`
+	ExecutedComputer          string              `json:"executed_computer"`
+	ExecutedOS                string              `json:"executed_os"`
+	ExecutedOSVersion         string              `json:"executed_os_version"`
+	ExecutedUser              string              `json:"executed_user"`
+	AllFiles                  []*libs.File        `json:"all_files"`
+	NumAllFiles               uint32              `json:"num_all_files"`
+	SizeAllFiles              uint64              `json:"size_all_files"`
+	TargetComponents          []string            `json:"target_components"`
+
+	Duration                    *int32  `json:"duration,omitempty"`
+	DurationTimeline            *string `json:"duration_timeline,omitempty"`
+	ExportShotsVersions         *bool   `json:"export_shotsVersions,omitempty"`
+	ExportShotsVersionsRevision *string `json:"export_shotsVersions_revision,omitempty"`
+	ExportShotsVersionsPath     *string `json:"export_shotsVersions_path,omitempty"`
+}
+
+func (p *createReviewInfoParams) Entity(
+	project string,
+	createdBy *string,
+) *entity.CreateReviewInfoParams {
+	takePath := p.TakePath
+	if takePath == "" && p.Path != nil {
+		takePath = *p.Path
+	}
+	return &entity.CreateReviewInfoParams{
+		Project:   project,
+		CreatedBy: createdBy,
+
+		TaskID:                    p.TaskID,
+		SubtaskID:                 p.SubtaskID,
+		Studio:                    p.Studio,
+		ProjectPath:               p.ProjectPath,
+		ReviewComments:            p.ReviewComments,
+		TakePath:                  takePath,
+		Root:                      p.Root,
+		Groups:                    p.Groups,
+		Relation:                  p.Relation,
+		Phase:                     p.Phase,
+		Component:                 p.Component,
+		Take:                      p.Take,
+		ApprovalStatus:            p.ApprovalStatus,
+		ApprovalStatusUpdatedUser: p.ApprovalStatusUpdatedUser,
+		WorkStatus:                p.WorkStatus,
+		WorkStatusUpdatedUser:     p.WorkStatusUpdatedUser,
+		ReviewTarget:              p.ReviewTarget,
+		ReviewData:                p.ReviewData,
+		OutputContents:            p.OutputContents,
+		SubmittedAtUtc:            p.SubmittedAtUtc,
+		SubmittedComputer:         p.SubmittedComputer,
+		SubmittedOS:               p.SubmittedOS,
+		SubmittedOSVersion:        p.SubmittedOSVersion,
+		SubmittedUser:             p.SubmittedUser,
+		ExecutedAtUtc:             p.ExecutedAtUtc,
+		ExecutedComputer:          p.ExecutedComputer,
+		ExecutedOS:                p.ExecutedOS,
+		ExecutedOSVersion:         p.ExecutedOSVersion,
+		ExecutedUser:              p.ExecutedUser,
+		AllFiles:                  p.AllFiles,
+		NumAllFiles:               p.NumAllFiles,
+		SizeAllFiles:              p.SizeAllFiles,
+		TargetComponents:          p.TargetComponents,
+
+		Duration:                    p.Duration,
+		DurationTimeline:            p.DurationTimeline,
+		ExportShotsVersions:         p.ExportShotsVersions,
+		ExportShotsVersionsRevision: p.ExportShotsVersionsRevision,
+		ExportShotsVersionsPath:     p.ExportShotsVersionsPath,
+	}
+}
+
+type updateReviewInfoParams struct {
+	ApprovalStatus            *string `json:"approval_status,omitempty"`
+	ApprovalStatusUpdatedUser *string `json:"approval_status_updated_user,omitempty"`
+	WorkStatus                *string `json:"work_status,omitempty"`
+	WorkStatusUpdatedUser     *string `json:"work_status_updated_user,omitempty"`
+	// SessionID tags the change with the live review session it was made in.
+	SessionID *string `json:"session_id,omitempty"`
+	// Version is the ETag of the row the change is based on, for clients that cannot
+	// send If-Match.
+	Version *string `json:"version,omitempty"`
+
+	// Submission metadata corrections (leads and above); absent fields are kept.
+	TakePath         *string              `json:"take_path,omitempty"`
+	TargetComponents *[]string            `json:"target_components,omitempty"`
+	Duration         *int32               `json:"duration,omitempty"`
+	ReviewComments   *[]*libs.CommentInfo `json:"review_comments,omitempty"`
+}
+
+// Metadata returns the submission metadata corrections of p, nil without any.
+func (p *updateReviewInfoParams) Metadata() *entity.ReviewInfoMetadataPatch {
+	meta := &entity.ReviewInfoMetadataPatch{
+		TakePath:         p.TakePath,
+		TargetComponents: p.TargetComponents,
+		Duration:         p.Duration,
+		ReviewComments:   p.ReviewComments,
+	}
+	if meta.Empty() {
+		return nil
+	}
+	return meta
+}
+
+func (p *updateReviewInfoParams) Entity(
+	project string,
+	id int32,
+	modifiedBy *string,
+) *entity.UpdateReviewInfoParams {
+	return &entity.UpdateReviewInfoParams{
+		ApprovalStatus:            p.ApprovalStatus,
+		ApprovalStatusUpdatedUser: p.ApprovalStatusUpdatedUser,
+		WorkStatus:                p.WorkStatus,
+		WorkStatusUpdatedUser:     p.WorkStatusUpdatedUser,
+		Project:                   project,
+		ID:                        id,
+		ModifiedBy:                modifiedBy,
+	}
+}
+
+func NewReviewInfo(
+	uc ReviewInfoUsecase,
+) *ReviewInfo {
+	return &ReviewInfo{
+		uc: uc,
+	}
+}
+
+type ReviewInfo struct {
+	uc ReviewInfoUsecase
+}
+
+func (h *ReviewInfo) List(c *gin.Context) {
+	var p listReviewInfoParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"))
+	submittedUsers := splitCSV(c.Query("submitted_user"))
+	approvalUpdatedUsers := splitCSV(c.Query("approval_status_updated_user"))
+	tags := splitCSV(c.Query("tags"))
+	entities, total, err := h.uc.List(c.Request.Context(), params, submittedUsers, approvalUpdatedUsers, tags)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	c.Writer.Header().Add("Vary", "Accept")
+	if wantsJSONAPI(c) {
+		doc, err := reviewListDocument(c.Request.URL, entities, params, total)
+		if err != nil {
+			internalServerError(c, err)
+			return
+		}
+		writeJSONAPI(c, http.StatusOK, doc)
+		return
+	}
+
+	res := libs.CreateListResponse("reviews", entities, c.Request, params, total)
+	c.PureJSON(http.StatusOK, res)
+}
+
+func (h *ReviewInfo) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.GetReviewParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	}
+	e, err := h.uc.Get(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review info with ID %d not found", params.ID))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Header("ETag", `"`+entity.ReviewInfoVersion(e)+`"`)
+	c.PureJSON(http.StatusOK, e)
+}
+
+// maxIdempotencyKeyLength bounds the Idempotency-Key header of Post.
+const maxIdempotencyKeyLength = 255
+
+func (h *ReviewInfo) Post(c *gin.Context) {
+	var p createReviewInfoParams
+	if err := c.ShouldBind(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"), nil)
+	idemKey := strings.TrimSpace(c.GetHeader("Idempotency-Key"))
+	if len(idemKey) > maxIdempotencyKeyLength {
+		badRequest(c, fmt.Errorf("Idempotency-Key must be at most %d characters", maxIdempotencyKeyLength))
+		return
+	}
+	ctx := entity.WithIdempotencyKey(c.Request.Context(), idemKey)
+	e, err := h.uc.Create(ctx, params)
+	if err != nil {
+		if validationFailed(c, err) {
+			return
+		}
+		if errors.Is(err, entity.ErrIdempotencyConflict) {
+			abortWithError(c, http.StatusConflict, CodeConflict, err, nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ReviewInfo) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	var p updateReviewInfoParams
+	if err := c.ShouldBind(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"), int32(id), nil)
+	sessionID := c.GetHeader("X-Review-Session-ID")
+	if p.SessionID != nil {
+		sessionID = *p.SessionID
+	}
+	ctx := entity.WithReviewSessionID(c.Request.Context(), sessionID)
+	ctx = entity.WithExpectedReviewVersion(ctx, ifMatchVersion(c, p.Version))
+	e, err := h.uc.UpdateWithMetadata(ctx, params, p.Metadata())
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review info with ID %d not found", params.ID))
+			return
+		}
+		if reviewLocked(c, err) || versionConflict(c, err) {
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		if validationFailed(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Header("ETag", `"`+entity.ReviewInfoVersion(e)+`"`)
+	c.PureJSON(http.StatusOK, e)
+}
+
+// ifMatchVersion returns the review version an update expects: the If-Match header, or
+// else the version of the body. "" (absent, or If-Match: *) skips the check.
+func ifMatchVersion(c *gin.Context, bodyVersion *string) string {
+	v := strings.TrimSpace(c.GetHeader("If-Match"))
+	if v == "" && bodyVersion != nil {
+		v = strings.TrimSpace(*bodyVersion)
+	}
+	if v == "*" {
+		return ""
+	}
+	return strings.Trim(strings.TrimPrefix(v, "W/"), `"`)
+}
+
+// versionConflict writes 409 with the current row and its version for a
+// *entity.ReviewVersionConflictError.
+func versionConflict(c *gin.Context, err error) bool {
+	var conflictErr *entity.ReviewVersionConflictError
+	if !errors.As(err, &conflictErr) {
+		return false
+	}
+	version := entity.ReviewInfoVersion(conflictErr.Current)
+	c.Header("ETag", `"`+version+`"`)
+	abortWithError(c, http.StatusConflict, CodeConflict, conflictErr, gin.H{
+		"current": conflictErr.Current,
+		"version": version,
+	})
+	return true
+}
+
+func (h *ReviewInfo) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.DeleteReviewInfoParams{
+		Project:    c.Param("project"),
+		ID:         int32(id),
+		ModifiedBy: nil,
+	}
+	if err := h.uc.Delete(c.Request.Context(), params); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review info with ID %d not found", params.ID))
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+type assetListParams struct {
+	Studio  *string `form:"studio"`
+	PerPage *int    `form:"per_page"`
+	Page    *int    `form:"page"`
+}
+
+func (p *assetListParams) Entity(project string) *entity.AssetListParams {
+	params := &entity.AssetListParams{
+		Project: project,
+		Studio:  p.Studio,
+		BaseListParams: &entity.BaseListParams{
+			PerPage: p.PerPage,
+			Page:    p.Page,
+		},
+	}
+
+	return params
+}
+
+func (h *ReviewInfo) ListAssets(c *gin.Context) {
+	var p assetListParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"))
+	submittedUsers := splitCSV(c.Query("submitted_user"))
+	approvalUpdatedUsers := splitCSV(c.Query("approval_status_updated_user"))
+	tags := splitCSV(c.Query("tags"))
+	entities, total, err := h.uc.ListAssets(c.Request.Context(), params, submittedUsers, approvalUpdatedUsers, tags)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	res := libs.CreateListResponse("assets", entities, c.Request, params, total)
+	c.PureJSON(http.StatusOK, res)
+}
+
+type unassignedAssetListParams struct {
+	PerPage *int `form:"per_page"`
+	Page    *int `form:"page"`
+}
+
+func (p *unassignedAssetListParams) Entity(project string) *entity.UnassignedAssetListParams {
+	return &entity.UnassignedAssetListParams{
+		Project: project,
+		BaseListParams: &entity.BaseListParams{
+			PerPage: p.PerPage,
+			Page:    p.Page,
+		},
+	}
+}
+
+func (h *ReviewInfo) ListUnassignedAssets(c *gin.Context) {
+	var p unassignedAssetListParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"))
+	entities, total, err := h.uc.ListUnassignedAssets(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	res := libs.CreateListResponse("assets", entities, c.Request, params, total)
+	c.PureJSON(http.StatusOK, res)
+}
+
+func (p *listReviewInfoParams) assetReviewInfoEntity(
+	project string,
+	asset string,
+	relation string,
+) *entity.AssetReviewInfoListParams {
+	params := &entity.AssetReviewInfoListParams{
+		Project:  project,
+		Asset:    asset,
+		Relation: relation,
+	}
+
+	return params
+}
+
+func (h *ReviewInfo) ListAssetReviewInfos(c *gin.Context) {
+	var p listReviewInfoParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	params := p.assetReviewInfoEntity(
+		c.Param("project"),
+		c.Param("asset"),
+		c.Param("relation"),
+	)
+	entities, err := h.uc.ListAssetReviewInfos(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	res := map[string]interface{}{
+		"reviews": entities,
+	}
+	c.PureJSON(http.StatusOK, res)
+}
+
+func (h *ReviewInfo) AssetTimeline(c *gin.Context) {
+	params := &entity.AssetTimelineParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+	}
+	timeline, err := h.uc.AssetTimeline(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, timeline)
+}
+
+func (p *listReviewInfoParams) shotReviewInfoEntity(
+	project string,
+	group string,
+	relation string,
+) *entity.ShotReviewInfoListParams {
+	var groups []string
+	if group != "" {
+		groups = strings.Split(group, "/")
+	}
+	params := &entity.ShotReviewInfoListParams{
+		Project:  project,
+		Groups:   groups,
+		Relation: relation,
+	}
+
+	return params
+}
+
+func (h *ReviewInfo) ListShotReviewInfos(c *gin.Context) {
+	var p listReviewInfoParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+
+	params := p.shotReviewInfoEntity(
+		c.Param("project"),
+		c.Query("groups"),
+		c.Query("relation"),
+	)
+	entities, err := h.uc.ListShotReviewInfos(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	res := map[string]interface{}{
+		"reviews": entities,
+	}
+	c.PureJSON(http.StatusOK, res)
+}
+
+/*
+* ========================================================================================
+  - splitCSV – utility function
+  - Splits a comma-separated string into a slice of trimmed strings.
+  - Ignores empty entries.
+
+==========================================================================================
+*/
+func splitCSV(raw string) []string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil
+	}
+	parts := strings.Split(raw, ",")
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if t := strings.TrimSpace(p); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+/*
+========================================================================================
+  - ListAssetsPivot – handler function
+  - Handles HTTP requests to list pivoted assets with filtering, sorting, and pagination.
+  - Extracts parameters from the request, invokes the usecase, and returns the results as JSON.
+
+========================================================================================
+*/
+func (h *ReviewInfo) ListAssetsPivot(c *gin.Context) {
+	// ---- DEBUG: Log the exact request ----
+	startTime := time.Now()
+	requestID := fmt.Sprintf("%d", startTime.UnixNano())
+	// Prefer the trace ID so logs and responses can be matched with the trace.
+	if traceID := tracing.TraceID(c.Request.Context()); traceID != "" {
+		requestID = traceID
+	}
+
+	log.Printf("[API] 🚀 ListAssetsPivot START - ID: %s, Path: %s, Query: %s",
+		requestID,
+		c.Request.URL.Path,
+		c.Request.URL.RawQuery)
+
+	defer func() {
+		elapsed := time.Since(startTime)
+		log.Printf("[API] ✅ ListAssetsPivot END - ID: %s, Time: %v", requestID, elapsed)
+	}()
+
+	// ---- Required path param ----
+	project := strings.TrimSpace(c.Param("project"))
+	if project == "" {
+		badRequest(c, fmt.Errorf("project is required"))
+		return
+	}
+
+	// ---- Query params ----
+	// Any root works (assets, shots, custom ones); outside assets the rows carry
+	// group_2/group_3.
+	root := strings.TrimSpace(c.DefaultQuery("root", "assets"))
+	if root == "" {
+		root = "assets"
+	}
+
+	// view, sort, dir and per_page are left empty when absent so the usecase can apply
+	// the project's pivot defaults.
+	view := strings.TrimSpace(c.Query("view")) // list | grouped
+
+	sortKey := strings.TrimSpace(c.Query("sort"))
+	dir := strings.TrimSpace(c.Query("dir")) // usecase will normalize
+
+	// nulls=first|last places missing sort values; absent keeps the sort's default
+	nulls := strings.ToLower(strings.TrimSpace(c.Query("nulls")))
+	if nulls != "" && nulls != entity.PivotNullsFirst && nulls != entity.PivotNullsLast {
+		badRequest(c, fmt.Errorf("nulls must be first or last"))
+		return
+	}
+
+	// natural=true orders names with their numbers by value (asset2 before asset10)
+	natural := false
+	if raw := strings.TrimSpace(c.Query("natural")); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			badRequest(c, fmt.Errorf("natural must be true or false"))
+			return
+		}
+		natural = v
+	}
+
+	phase := strings.TrimSpace(c.DefaultQuery("phase", "none"))
+	if phase == "" {
+		phase = "none"
+	}
+
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(c.Query("per_page"))
+	if perPage < 1 {
+		perPage = 0
+	}
+
+	// Deep pagination is slow
+	if page > 10 {
+		log.Printf("[WARN] Deep pagination detected: page=%d", page)
+	}
+
+	// Grouped view pages over whole top group node buckets (usecase defaults apply).
+	groupPage, _ := strconv.Atoi(c.Query("group_page"))
+	if groupPage < 1 {
+		groupPage = 1
+	}
+	groupPerPage, _ := strconv.Atoi(c.Query("group_per_page"))
+	if groupPerPage < 1 {
+		groupPerPage = 0
+	}
+
+	// Nested category tree: group_depth=N levels, or tree=true for the full path.
+	groupDepth, _ := strconv.Atoi(c.Query("group_depth"))
+	if groupDepth < 0 {
+		groupDepth = 0
+	}
+	if tree, _ := strconv.ParseBool(c.Query("tree")); tree {
+		groupDepth = -1
+	}
+
+	// groups_only=true (grouped view) answers every bucket with its total_count and no
+	// items; group=character,prop (or /pivot/groups/:topNode) then loads the items of
+	// single buckets. group=Unassigned is the bucket of assets without a category.
+	groupsOnly := false
+	if raw := strings.TrimSpace(c.Query("groups_only")); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			badRequest(c, fmt.Errorf("groups_only must be true or false"))
+			return
+		}
+		groupsOnly = v
+	}
+	if groupsOnly {
+		view = "grouped"
+	}
+	var groups []string
+	for _, raw := range c.QueryArray("group") {
+		for _, node := range splitCSV(raw) {
+			groups = append(groups, pivotGroupNode(node))
+		}
+	}
+	// /pivot/groups/:topNode lists one bucket as a list view with its own sort and paging.
+	topNode := strings.TrimSpace(c.Param("topNode"))
+	if topNode != "" {
+		view = "list"
+		groupsOnly = false
+		groups = []string{pivotGroupNode(topNode)}
+	}
+
+	assetNameKey := strings.TrimSpace(c.DefaultQuery("name", ""))
+
+	// Keyset cursor from a previous response's next_cursor; takes precedence over page.
+	cursor := strings.TrimSpace(c.Query("cursor"))
+
+	// count=false skips the total (infinite scroll); total and page_last come back null.
+	skipCount := false
+	if raw := strings.TrimSpace(c.Query("count")); raw != "" {
+		count, err := strconv.ParseBool(raw)
+		if err != nil {
+			badRequest(c, fmt.Errorf("count must be true or false"))
+			return
+		}
+		skipCount = !count
+	}
+
+	// Optional historical view, e.g. as_of=2026-01-15T00:00:00Z
+	var asOf *time.Time
+	if raw := strings.TrimSpace(c.Query("as_of")); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			badRequest(c, fmt.Errorf("as_of must be an RFC 3339 timestamp such as 2026-01-15T00:00:00Z"))
+			return
+		}
+		asOf = &t
+	}
+
+	// Support both new & old query keys
+	approvalRaw := c.Query("approval_status")
+	if approvalRaw == "" {
+		approvalRaw = c.Query("appr")
+	}
+	workRaw := c.Query("work_status")
+	if workRaw == "" {
+		workRaw = c.Query("work")
+	}
+
+	approvalStatuses := splitCSV(approvalRaw)
+	workStatuses := splitCSV(workRaw)
+	submittedUsers := splitCSV(c.Query("submitted_user"))
+	approvalUpdatedUsers := splitCSV(c.Query("approval_status_updated_user"))
+	// studio=a,b or studio=a&studio=b
+	var studios []string
+	for _, raw := range c.QueryArray("studio") {
+		studios = append(studios, splitCSV(raw)...)
+	}
+	// relation=charA:costumeB,charB (multi-value); relation_mode=prefix matches prefixes
+	var relations []string
+	for _, raw := range c.QueryArray("relation") {
+		relations = append(relations, splitCSV(raw)...)
+	}
+	relationMode := c.DefaultQuery("relation_mode", entity.PivotRelationModeExact)
+	if relationMode != entity.PivotRelationModeExact && relationMode != entity.PivotRelationModePrefix {
+		badRequest(c, fmt.Errorf("relation_mode must be exact or prefix"))
+		return
+	}
+	// tags=hero,crowd (multi-value): the asset carries any of them
+	var tags []string
+	for _, raw := range c.QueryArray("tags") {
+		tags = append(tags, splitCSV(raw)...)
+	}
+	// overall_status=retake,in_progress (multi-value), see pivot-defaults overall_status_rules
+	var overallStatuses []string
+	for _, raw := range c.QueryArray("overall_status") {
+		overallStatuses = append(overallStatuses, splitCSV(raw)...)
+	}
+	for _, v := range overallStatuses {
+		if v != entity.OverallStatusApproved && v != entity.OverallStatusRetake && v != entity.OverallStatusInProgress {
+			badRequest(c, fmt.Errorf("overall_status must be a comma-separated list of: %s",
+				strings.Join(entity.OverallStatuses, ", ")))
+			return
+		}
+	}
+	// overdue=true keeps assets with a phase past its due date and not approved yet
+	overdue := false
+	if raw := strings.TrimSpace(c.Query("overdue")); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			badRequest(c, fmt.Errorf("overdue must be true or false"))
+			return
+		}
+		overdue = v
+	}
+	fields := splitCSV(c.Query("fields"))
+
+	// include=comment_count,thumbnails,studio adds per-cell comment counts
+	// (comment_counts), image/video URLs (thumbnails) and the studio of the latest
+	// submission (studios), all keyed by phase; include=notes adds the note count
+	// (note_count) and newest note (latest_note) of each row.
+	commentCounts, thumbnails, studio, notes := false, false, false, false
+	for _, include := range splitCSV(c.Query("include")) {
+		switch include {
+		case "comment_count":
+			commentCounts = true
+		case "thumbnails":
+			thumbnails = true
+		case "studio":
+			studio = true
+		case "notes":
+			notes = true
+		default:
+			badRequest(c, fmt.Errorf("include must be a comma-separated list of: comment_count, thumbnails, studio, notes"))
+			return
+		}
+	}
+
+	// The usecase puts the one deadline on the request.
+	ctx := c.Request.Context()
+
+	// ---- ADD PERFORMANCE TRACKING ----
+	queryStart := time.Now()
+
+	params := usecase.ListAssetsPivotParams{
+		Project:              project,
+		Root:                 root,
+		PreferredPhase:       phase,
+		OrderKey:             sortKey,
+		Direction:            dir,
+		Nulls:                nulls,
+		Natural:              natural,
+		Page:                 page,
+		PerPage:              perPage,
+		Cursor:               cursor,
+		AssetNameKey:         assetNameKey,
+		ApprovalStatuses:     approvalStatuses,
+		WorkStatuses:         workStatuses,
+		SubmittedUsers:       submittedUsers,
+		ApprovalUpdatedUsers: approvalUpdatedUsers,
+		Studios:              studios,
+		Relations:            relations,
+		RelationMode:         relationMode,
+		Tags:                 tags,
+		OverallStatuses:      overallStatuses,
+		Overdue:              overdue,
+		View:                 view,
+		Role:                 authRole(c),
+		AsOf:                 asOf,
+		GroupPage:            groupPage,
+		GroupPerPage:         groupPerPage,
+		GroupDepth:           groupDepth,
+		GroupsOnly:           groupsOnly,
+		Groups:               groups,
+		Fields:               fields,
+		SkipCount:            skipCount,
+		CommentCounts:        commentCounts,
+		Thumbnails:           thumbnails,
+		Studio:               studio,
+		Notes:                notes,
+	}
+
+	result, err := h.uc.ListAssetsPivot(ctx, params)
+
+	queryTime := time.Since(queryStart)
+	log.Printf("[PERF] Usecase call took: %v", queryTime)
+
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidPivotCursor) || errors.Is(err, entity.ErrInvalidPivotFields) ||
+			errors.Is(err, entity.ErrUnknownReviewStatus) || errors.Is(err, entity.ErrPivotViewNotAllowed) {
+			badRequest(c, err)
+			return
+		}
+
+		// Page sizes are bounded per project by the server's page limits and the
+		// project's query policy, which may also replace the query timeout (the usecase
+		// clamps per_page and group_per_page and applies the timeout; see
+		// entity.PageLimits, entity.ProjectQueryPolicy). Only the hints of the errors
+		// below need them.
+		limits, queryTimeout, limitsErr := h.uc.PivotQueryLimits(c.Request.Context(), project)
+		if limitsErr != nil {
+			internalServerError(c, err)
+			return
+		}
+
+		// ---- SPECIFIC TIMEOUT HANDLING ----
+		if errors.Is(err, context.DeadlineExceeded) {
+			// The 408 counts as a failure for the route's CircuitBreaker.
+			log.Printf("[ERROR] ⏱️ TIMEOUT for project %s - Query took >%v", project, queryTimeout)
+
+			// Return user-friendly timeout error
+			abortWithError(c, http.StatusRequestTimeout, CodeTimeout,
+				fmt.Errorf("the query took too long (>%v). Project: %s", queryTimeout, project),
+				gin.H{
+					"suggestions": []string{
+						fmt.Sprintf("Reduce 'per_page' from %d to %d or less", perPage, limits.PerPage),
+						"Add asset name filter with 'name=...'",
+						"Use 'view=list' instead of 'view=grouped'",
+						fmt.Sprintf("Try 'page=1' (current: %d)", page),
+					},
+					"query_time": queryTime.Seconds(),
+				})
+			return
+		}
+
+		// Check for specific error messages from usecase
+		if strings.Contains(err.Error(), "reduce page") || strings.Contains(err.Error(), "too complex") {
+			abortWithError(c, http.StatusBadRequest, CodeQueryTooComplex,
+				errors.New("please reduce the page size or use filters"),
+				gin.H{"max_per_page": limits.MaxPerPage})
+			return
+		}
+
+		// Any other error
+		log.Printf("[ERROR] ListAssetsPivot failed: %v", err)
+		internalServerError(c, err)
+		return
+	}
+
+	// ---- SUCCESS RESPONSE ----
+
+	// Add performance headers
+	c.Header("X-Request-ID", requestID)
+	c.Header("X-Query-Time", fmt.Sprintf("%.3f", queryTime.Seconds()))
+	if !result.CountSkipped {
+		c.Header("X-Total-Count", strconv.FormatInt(result.Total, 10))
+		c.Header("X-Page-Last", strconv.Itoa(result.PageLast))
+	}
+	// The links keep every filter of the request; cursor would override their page.
+	pg := result.Pagination()
+	if links := pagination.Header(c.Request.URL, pg, "cursor"); links != "" {
+		c.Header("Link", links)
+	}
+	c.Writer.Header().Add("Vary", "Accept")
+
+	// Accept: application/vnd.api+json (see reviewInfoJSONAPI.go)
+	if wantsJSONAPI(c) {
+		meta := map[string]any{"project": project, "root": root}
+		if topNode != "" {
+			meta["top_group_node"] = topNode
+		}
+		if asOf != nil {
+			meta["as_of"] = asOf.UTC()
+		}
+		writePivotJSONAPI(c, result, meta, requestID)
+		return
+	}
+
+	// Return minimal response for grouped view (less data)
+	if result.View == "grouped" {
+		res := result.GroupedResponse()
+		res.Pagination = pagination.NewBlock(c.Request.URL, pg, "cursor")
+		if pivotNotModified(c, res) {
+			return
+		}
+		res.RequestID = requestID
+		writeJSON(c, http.StatusOK, res)
+		return
+	}
+
+	// Normal response for list view
+	res := result.PivotResponse(project, root, topNode, asOf)
+	res.Pagination = pagination.NewBlock(c.Request.URL, pg, "cursor")
+	// The ETag is taken before the per-request fields (request_id, query_time) are set,
+	// so polling clients that send a matching If-None-Match get 304 with no body.
+	if pivotNotModified(c, res) {
+		return
+	}
+	seconds := queryTime.Seconds()
+	res.RequestID, res.QueryTime = requestID, &seconds
+	writeJSON(c, http.StatusOK, res)
+}
+
+// pivotNotModified sets the ETag of the pivot payload v and answers 304 when
+// If-None-Match matches it (or 500 when v cannot be encoded); false means the caller
+// writes the response.
+func pivotNotModified(c *gin.Context, v any) bool {
+	body, err := json.Marshal(v)
+	if err != nil {
+		internalServerError(c, err)
+		return true
+	}
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", "private, max-age=0, must-revalidate")
+
+	if etagMatches(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+// writeJSON writes compact JSON, or indented JSON when debugging with ?pretty=true.
+func writeJSON(c *gin.Context, code int, obj any) {
+	if pretty, _ := strconv.ParseBool(c.Query("pretty")); pretty {
+		c.IndentedJSON(code, obj)
+		return
+	}
+	c.PureJSON(code, obj)
+}
+
+// etagMatches reports whether an If-None-Match header value matches etag.
+// Weak validators (W/"...") compare equal to their strong form.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, v := range strings.Split(ifNoneMatch, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// pivotGroupNode maps a bucket name of the grouped view to its top group node; the
+// Unassigned bucket holds the assets without one ("").
+func pivotGroupNode(name string) string {
+	if strings.EqualFold(name, "Unassigned") {
+		return ""
+	}
+	return name
+}
+
+// Helper functions answering with the shared error envelope (see apiError.go)
+func badRequest(c *gin.Context, err error) {
+	abortWithError(c, http.StatusBadRequest, CodeBadRequest, err, nil)
+}
+
+func internalServerError(c *gin.Context, err error) {
+	abortWithError(c, http.StatusInternalServerError, CodeInternal, err, nil)
+}
+
+type batchAssetDetailParams struct {
+	Root   string                 `json:"root"`
+	Assets []entity.PivotAssetKey `json:"assets" binding:"required,min=1,max=200,dive"`
+}
+
+func (h *ReviewInfo) BatchAssetDetails(c *gin.Context) {
+	var p batchAssetDetailParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	assets, err := h.uc.BatchAssetDetails(c.Request.Context(), &entity.BatchAssetDetailParams{
+		Project: c.Param("project"),
+		Root:    p.Root,
+		Assets:  p.Assets,
+		Role:    authRole(c),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	writeJSON(c, http.StatusOK, gin.H{
+		"assets": assets,
+	})
+}
@@ -0,0 +1,75 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoBatch.go
+
+	Module Description:
+		HTTP delivery handler for batch review info creates.
+
+	Details:
+	- POST /projects/:project/reviews/batch
+	       {"items": [{...same body as POST /reviews...}, ...]}   (1 to 500 items)
+	       → {"ids": [101, 102, ...], "reviews": [...]} in request order.
+	- The batch is all or nothing. A failing item answers 400, or 422 when it breaks the
+	  validation rules, with its position in details.index.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) PostBatch: Creates several review infos in one transaction.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+type createReviewInfoBatchParams struct {
+	Items []*createReviewInfoParams `json:"items" binding:"required,min=1,max=500,dive"`
+}
+
+func (h *ReviewInfo) PostBatch(c *gin.Context) {
+	var p createReviewInfoBatchParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	project := c.Param("project")
+	items := make([]*entity.CreateReviewInfoParams, len(p.Items))
+	for i, item := range p.Items {
+		items[i] = item.Entity(project, nil)
+	}
+	entities, err := h.uc.CreateBatch(c.Request.Context(), project, items)
+	if err != nil {
+		var itemErr *entity.BatchItemError
+		if errors.As(err, &itemErr) {
+			var validationErr *entity.ValidationError
+			if errors.As(itemErr.Err, &validationErr) {
+				abortWithError(c, http.StatusUnprocessableEntity, CodeValidationFailed, err, gin.H{
+					"index":  itemErr.Index,
+					"fields": validationErr.Fields,
+				})
+				return
+			}
+			abortWithError(c, http.StatusBadRequest, CodeBadRequest, err, gin.H{
+				"index": itemErr.Index,
+			})
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	ids := make([]int32, len(entities))
+	for i, e := range entities {
+		ids[i] = e.ID
+	}
+	c.PureJSON(http.StatusOK, gin.H{
+		"ids":     ids,
+		"reviews": entities,
+	})
+}
@@ -0,0 +1,107 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoBulkStatus.go
+
+	Module Description:
+		HTTP delivery handler for setting the work status of every asset matching the
+		pivot filters.
+
+	Details:
+	- POST /projects/:project/reviews/assets/bulk-set-status?phase=mdl&name=chr&work_status=wip
+	       {"work_status": "review"}
+	- The filters are the pivot's query params: root, name, phase (none or absent for
+	  every phase), approval_status / appr, work_status / work, plus group=a,b for
+	  top group nodes.
+	- Answers {"matched", "updated", "locked"}; submissions already at the target
+	  status are not matched. A failure after some batches committed answers 500 with
+	  the counts so far in details.
+	- dry_run=true changes nothing and adds "changes": every matching submission with
+	  its current_status, new_status and whether it is locked.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Added dry_run.
+
+	Functions:
+		* (ReviewInfo) BulkSetWorkStatus: Sets the work status of every matching asset.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+type bulkSetWorkStatusParams struct {
+	WorkStatus string `json:"work_status" binding:"required"`
+}
+
+func (h *ReviewInfo) BulkSetWorkStatus(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	dryRun := false
+	if v := c.Query("dry_run"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			badRequest(c, fmt.Errorf("dry_run must be a boolean"))
+			return
+		}
+		dryRun = b
+	}
+	var p bulkSetWorkStatusParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	approvalRaw := c.Query("approval_status")
+	if approvalRaw == "" {
+		approvalRaw = c.Query("appr")
+	}
+	workRaw := c.Query("work_status")
+	if workRaw == "" {
+		workRaw = c.Query("work")
+	}
+	var groups []string
+	for _, raw := range c.QueryArray("group") {
+		groups = append(groups, splitCSV(raw)...)
+	}
+	res, err := h.uc.BulkSetWorkStatus(c.Request.Context(), &entity.BulkSetWorkStatusParams{
+		Project:          c.Param("project"),
+		Root:             strings.TrimSpace(c.Query("root")),
+		Phase:            strings.TrimSpace(c.Query("phase")),
+		AssetNameKey:     strings.TrimSpace(c.Query("name")),
+		ApprovalStatuses: splitCSV(approvalRaw),
+		WorkStatuses:     splitCSV(workRaw),
+		Groups:           groups,
+		WorkStatus:       p.WorkStatus,
+		User:             user,
+		Role:             authRole(c),
+		DryRun:           dryRun,
+	})
+	if err != nil {
+		if forbidden(c, err) || validationFailed(c, err) {
+			return
+		}
+		if errors.Is(err, entity.ErrRecordNotFound) || errors.Is(err, entity.ErrUnknownReviewStatus) ||
+			errors.Is(err, entity.ErrBulkStatusTooMany) {
+			badRequest(c, err)
+			return
+		}
+		var details gin.H
+		if res != nil {
+			details = gin.H{"matched": res.Matched, "updated": res.Updated, "locked": res.Locked}
+		}
+		abortWithError(c, http.StatusInternalServerError, CodeInternal, err, details)
+		return
+	}
+	c.PureJSON(http.StatusOK, res)
+}
@@ -0,0 +1,58 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoComments.go
+
+	Module Description:
+		HTTP delivery handler listing the comments of a review info.
+
+	Details:
+	- GET /projects/:project/reviews/:id/comments
+	      {"comments": [{"id": "...", "take": "t012", "submitted_user": "...",
+	                     "comment_data": [{"language": "ja", "text": "..."}]}]}
+	- Comments are listed oldest first. The pivot's include=comment_count adds the
+	  per-cell counts, and review_info_ids gives the ID to call this with.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) ListComments: Lists the comments of a review info.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) ListComments(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	comments, err := h.uc.ListComments(c.Request.Context(), &entity.ListReviewCommentsParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrRecordNotFound):
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("review info with ID %d not found", id), nil)
+		case errors.Is(err, entity.ErrReviewCommentsUnavailable):
+			abortWithError(c, http.StatusServiceUnavailable, CodeUnavailable, err, nil)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{
+		"comments": comments,
+	})
+}
@@ -0,0 +1,59 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoCompare.go
+
+	Module Description:
+		HTTP delivery handler comparing two review infos for the side-by-side view.
+
+	Details:
+	- GET /projects/:project/reviews/compare?id_a=123&id_b=456
+	      {"a": {...}, "b": {...}, "files_added": [...], "files_removed": [...],
+	       "files_changed": [...], "num_files_delta": 3, "size_delta": 1048576,
+	       "changes": [{"field": "approval_status", "a": "check", "b": "approved"}]}
+	- Deltas are B minus A; comment_count of a side is null when it could not be counted.
+	- A missing or deleted review info answers 404.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) Compare: Returns the difference between two review infos.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) Compare(c *gin.Context) {
+	var ids [2]int32
+	for i, name := range []string{"id_a", "id_b"} {
+		id, err := strconv.ParseInt(c.Query(name), 10, 32)
+		if err != nil || id <= 0 {
+			badRequest(c, fmt.Errorf("%s must be a review info ID", name))
+			return
+		}
+		ids[i] = int32(id)
+	}
+	res, err := h.uc.Compare(c.Request.Context(), &entity.ReviewCompareParams{
+		Project: c.Param("project"),
+		IDA:     ids[0],
+		IDB:     ids[1],
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, res)
+}
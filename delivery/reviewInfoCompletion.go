@@ -0,0 +1,56 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoCompletion.go
+
+	Module Description:
+		HTTP delivery handler for the phase completion matrix of a project.
+
+	Details:
+	- GET /projects/:project/reviews/assets/completion?root=assets
+	      {"phases": ["mdl", "rig", "bld", "dsn", "ldv"],
+	       "assets": [{"group_1": "hero", "relation": "main", "phases": 7, "approved": 3}],
+	       "by_phase": [{"phase": "mdl", "assets": 120, "approved": 96, "percent": 80}],
+	       "cells": 410, "approved": 250, "percent": 60.97}
+	- Bit i of "phases" / "approved" stands for phases[i]: the asset has a live take of
+	  the phase / at least one approved take of it.
+	- For assets mapped by the project's required phases, "phases" holds exactly the
+	  required phases (see /projects/:project/reviews/required-phases).
+	- Assets respect the category access of the caller's role, like the pivot.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Document the required phases of mapped assets.
+
+	Functions:
+		* (ReviewInfo) Completion: Returns the phase completion matrix of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) Completion(c *gin.Context) {
+	project := c.Param("project")
+	completion, err := h.uc.Completion(c.Request.Context(), &entity.ReviewCompletionParams{
+		Project: project,
+		Root:    strings.TrimSpace(c.Query("root")),
+		Role:    authRole(c),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("project %s not found", project), nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, completion)
+}
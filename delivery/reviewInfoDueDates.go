@@ -0,0 +1,96 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoDueDates.go
+
+	Module Description:
+		HTTP delivery handlers for the due dates of asset phases.
+
+	Details:
+	- GET    /projects/:project/reviews/assets/:asset/:relation/due-dates
+	- PUT    /projects/:project/reviews/assets/:asset/:relation/due-dates/:phase
+	         {"due_date": "2026-11-02"}
+	- DELETE /projects/:project/reviews/assets/:asset/:relation/due-dates/:phase
+	- Pivot rows carry due_dates, next_due_date and overdue; ListAssetsPivot accepts
+	  overdue=true and sort=due_date.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) ListDueDates: Lists the due dates of an asset relation.
+		* (ReviewInfo) SetDueDate: Sets the due date of an asset phase.
+		* (ReviewInfo) ClearDueDate: Removes the due date of an asset phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) ListDueDates(c *gin.Context) {
+	dueDates, err := h.uc.ListDueDates(c.Request.Context(), &entity.ListReviewDueDatesParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"due_dates": dueDates})
+}
+
+type setReviewDueDateParams struct {
+	DueDate string `json:"due_date" binding:"required,datetime=2006-01-02"`
+}
+
+func (h *ReviewInfo) SetDueDate(c *gin.Context) {
+	var p setReviewDueDateParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.SetDueDate(c.Request.Context(), &entity.SetReviewDueDateParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		Phase:    c.Param("phase"),
+		DueDate:  p.DueDate,
+		SetBy:    authUser(c),
+	})
+	if err != nil {
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ReviewInfo) ClearDueDate(c *gin.Context) {
+	phase := c.Param("phase")
+	if err := h.uc.ClearDueDate(c.Request.Context(), &entity.ClearReviewDueDateParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		Phase:    phase,
+	}); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("no due date in phase %s", phase), nil)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,273 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoJSONAPI.go
+
+	Module Description:
+		JSON:API serializers of the review list and the asset pivot.
+
+	Details:
+	- Requests with "Accept: application/vnd.api+json" on GET /projects/:project/reviews
+	  and GET /projects/:project/reviews/assets/pivot are answered with a JSON:API
+	  document (see delivery/jsonapi); any other Accept keeps the plain JSON.
+	- Resources:
+	      reviews  id = review info ID;  relationships: asset
+	      assets   id = root/group_1[/group_2/group_3]/relation;
+	               relationships: reviews (the review info of each phase cell), group
+	      groups   id = top group node (or category path in the nested tree);
+	               relationships: assets, children
+	  The grouped view answers the groups as data and their assets as included.
+	- links.self is the request; first/prev/next/last are the pagination links
+	  (delivery/pagination). The counts, sort and cursors go into meta.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* wantsJSONAPI: Reports whether the request asks for JSON:API.
+		* writeJSONAPI: Writes a JSON:API document.
+		* reviewListDocument: Builds the document of a review list page.
+		* pivotDocument: Builds the document of an asset pivot page.
+		* writePivotJSONAPI: Writes an asset pivot page as JSON:API with its ETag.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/delivery/jsonapi"
+	"github.com/PolygonPictures/central30-web/front/delivery/pagination"
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// wantsJSONAPI reports whether the request's Accept header asks for JSON:API.
+func wantsJSONAPI(c *gin.Context) bool {
+	return jsonapi.Accepts(c.GetHeader("Accept"))
+}
+
+// writeJSONAPI writes doc as JSON:API, indented with ?pretty=true.
+func writeJSONAPI(c *gin.Context, code int, doc jsonapi.Document) {
+	var body []byte
+	var err error
+	if pretty, _ := strconv.ParseBool(c.Query("pretty")); pretty {
+		body, err = json.MarshalIndent(doc, "", "    ")
+	} else {
+		body, err = json.Marshal(doc)
+	}
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.Data(code, jsonapi.MediaType, body)
+}
+
+// documentLinks returns the self and pagination links of the page pg requested by u.
+func documentLinks(u *url.URL, pg entity.Pagination) map[string]string {
+	links := pagination.Links(u, pg, "cursor")
+	if links == nil {
+		links = map[string]string{}
+	}
+	links["self"] = u.String()
+	return links
+}
+
+// reviewsPath returns the path of the reviews collection of the request path
+// (/.../projects/:project/reviews).
+func reviewsPath(u *url.URL) string {
+	if i := strings.Index(u.Path, "/reviews"); i >= 0 {
+		return u.Path[:i+len("/reviews")]
+	}
+	return u.Path
+}
+
+// assetResourceID is the id of the asset resource of an asset pivot row or review.
+func assetResourceID(root string, groups []string, relation string) string {
+	parts := []string{root}
+	for _, g := range groups {
+		if g != "" {
+			parts = append(parts, g)
+		}
+	}
+	return strings.Join(append(parts, relation), "/")
+}
+
+// reviewListDocument builds the JSON:API document of a page of the review list.
+func reviewListDocument(
+	u *url.URL,
+	entities []*entity.ReviewInfo,
+	params *entity.ListReviewInfoParams,
+	total int,
+) (jsonapi.Document, error) {
+	reviews := reviewsPath(u)
+	data := make([]jsonapi.Resource, 0, len(entities))
+	for _, e := range entities {
+		attrs, err := jsonapi.Attributes(e, "id")
+		if err != nil {
+			return jsonapi.Document{}, err
+		}
+		id := strconv.Itoa(int(e.ID))
+		asset := &jsonapi.Identifier{
+			Type: "assets",
+			ID:   assetResourceID(e.Root, e.Groups, e.Relation),
+		}
+		data = append(data, jsonapi.Resource{
+			Type:          "reviews",
+			ID:            id,
+			Attributes:    attrs,
+			Relationships: map[string]jsonapi.Relationship{"asset": jsonapi.One(asset)},
+			Links:         map[string]string{"self": reviews + "/" + id},
+		})
+	}
+
+	pg := entity.NewPagination(params.GetPage(), params.GetPerPage(), int64(total))
+	return jsonapi.Document{
+		Data:  data,
+		Meta:  map[string]any{"pagination": pg},
+		Links: documentLinks(u, pg),
+	}, nil
+}
+
+// pivotAssetResource builds the asset resource of a pivot row; the review info IDs
+// of its phase cells become the reviews relationship.
+func pivotAssetResource(a repository.AssetPivot) (jsonapi.Resource, error) {
+	attrs, err := jsonapi.Attributes(a, "review_info_ids")
+	if err != nil {
+		return jsonapi.Resource{}, err
+	}
+	phases := make([]string, 0, len(a.ReviewInfoIDs))
+	for phase := range a.ReviewInfoIDs {
+		phases = append(phases, phase)
+	}
+	sort.Strings(phases)
+	ids := make([]jsonapi.Identifier, 0, len(phases))
+	for _, phase := range phases {
+		ids = append(ids, jsonapi.Identifier{
+			Type: "reviews",
+			ID:   strconv.Itoa(int(a.ReviewInfoIDs[phase])),
+		})
+	}
+	group := &jsonapi.Identifier{Type: "groups", ID: a.TopGroupNode}
+	if a.TopGroupNode == "" {
+		group.ID = "Unassigned"
+	}
+	return jsonapi.Resource{
+		Type:       "assets",
+		ID:         assetResourceID(a.Root, []string{a.Group1, a.Group2, a.Group3}, a.Relation),
+		Attributes: attrs,
+		Relationships: map[string]jsonapi.Relationship{
+			"reviews": jsonapi.Many(ids),
+			"group":   jsonapi.One(group),
+		},
+	}, nil
+}
+
+// pivotGroupResources appends the group resources of buckets (and their nested
+// children) to groups and their assets to included.
+func pivotGroupResources(
+	buckets []repository.GroupedAssetBucket,
+	groups, included []jsonapi.Resource,
+) ([]jsonapi.Resource, []jsonapi.Resource, error) {
+	for _, b := range buckets {
+		attrs, err := jsonapi.Attributes(b, "items", "children")
+		if err != nil {
+			return nil, nil, err
+		}
+		var assets, children []jsonapi.Identifier
+		for _, item := range b.Items {
+			r, err := pivotAssetResource(item)
+			if err != nil {
+				return nil, nil, err
+			}
+			included = append(included, r)
+			assets = append(assets, jsonapi.Identifier{Type: r.Type, ID: r.ID})
+		}
+		for _, child := range b.Children {
+			children = append(children, jsonapi.Identifier{Type: "groups", ID: bucketResourceID(child)})
+		}
+		groups = append(groups, jsonapi.Resource{
+			Type:       "groups",
+			ID:         bucketResourceID(b),
+			Attributes: attrs,
+			Relationships: map[string]jsonapi.Relationship{
+				"assets":   jsonapi.Many(assets),
+				"children": jsonapi.Many(children),
+			},
+		})
+		if groups, included, err = pivotGroupResources(b.Children, groups, included); err != nil {
+			return nil, nil, err
+		}
+	}
+	return groups, included, nil
+}
+
+// bucketResourceID is the id of the group resource of a bucket: its category path in
+// the nested tree, else its top group node.
+func bucketResourceID(b repository.GroupedAssetBucket) string {
+	if b.Path != "" {
+		return b.Path
+	}
+	return b.TopGroupNode
+}
+
+// pivotDocument builds the JSON:API document of an asset pivot page: the assets in
+// the list view, the groups with their assets included in the grouped view.
+func pivotDocument(
+	u *url.URL,
+	result *usecase.ListAssetsPivotResult,
+	meta map[string]any,
+) (jsonapi.Document, error) {
+	pg := result.Pagination()
+	meta["pagination"] = pg
+	meta["sort"] = result.Sort
+	meta["dir"] = result.Dir
+	meta["view"] = result.View
+	if result.NextCursor != "" {
+		meta["next_cursor"] = result.NextCursor
+	}
+	doc := jsonapi.Document{Meta: meta, Links: documentLinks(u, pg)}
+
+	if result.View == "grouped" {
+		meta["total"] = result.Total
+		groups, included, err := pivotGroupResources(result.Groups, []jsonapi.Resource{}, nil)
+		if err != nil {
+			return jsonapi.Document{}, err
+		}
+		doc.Data, doc.Included = groups, included
+		return doc, nil
+	}
+
+	meta["total_is_estimate"] = result.TotalIsEstimate
+	data := make([]jsonapi.Resource, 0, len(result.Assets))
+	for _, a := range result.Assets {
+		r, err := pivotAssetResource(a)
+		if err != nil {
+			return jsonapi.Document{}, err
+		}
+		data = append(data, r)
+	}
+	doc.Data = data
+	return doc, nil
+}
+
+// writePivotJSONAPI answers an asset pivot page as JSON:API, with the ETag validation
+// of the JSON responses (pivotNotModified); requestID goes into meta after the ETag is taken.
+func writePivotJSONAPI(c *gin.Context, result *usecase.ListAssetsPivotResult, meta map[string]any, requestID string) {
+	doc, err := pivotDocument(c.Request.URL, result, meta)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	if pivotNotModified(c, doc) {
+		return
+	}
+	doc.Meta["request_id"] = requestID
+	writeJSONAPI(c, http.StatusOK, doc)
+}
@@ -0,0 +1,51 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoOverview.go
+
+	Module Description:
+		HTTP delivery handler for the review overview of the landing dashboard.
+
+	Details:
+	- GET /projects/reviews/overview?project=a,b
+	      {"projects": [{"project": "a", "asset_count": 1200,
+	                     "last_submitted_at_utc": "...", "pending_approval_count": 37}]}
+	- Without project, every project with reviews is listed. Counts respect the
+	  category access of the caller's role, like the pivot.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) Overview: Returns the review overview of projects.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+const maxOverviewProjects = 200
+
+func (h *ReviewInfo) Overview(c *gin.Context) {
+	projects := splitCSV(c.Query("project"))
+	if len(projects) > maxOverviewProjects {
+		badRequest(c, fmt.Errorf("at most %d projects can be requested", maxOverviewProjects))
+		return
+	}
+	overviews, err := h.uc.Overview(c.Request.Context(), &entity.ReviewOverviewParams{
+		Projects: projects,
+		Role:     authRole(c),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{
+		"projects": overviews,
+	})
+}
@@ -0,0 +1,59 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoPriority.go
+
+	Module Description:
+		HTTP delivery handler for the priority flag of an asset.
+
+	Details:
+	- PATCH /projects/:project/reviews/assets/:asset/priority
+	        {"priority": true}
+	- The flag covers every relation of the asset. Pivot rows carry priority;
+	  ListAssetsPivot accepts sort=priority_first.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) SetAssetPriority: Flags or unflags an asset.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+type setAssetPriorityParams struct {
+	Priority *bool `json:"priority"`
+}
+
+func (h *ReviewInfo) SetAssetPriority(c *gin.Context) {
+	var p setAssetPriorityParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	if p.Priority == nil {
+		badRequest(c, fmt.Errorf("priority is required"))
+		return
+	}
+	e, err := h.uc.SetAssetPriority(c.Request.Context(), &entity.SetAssetPriorityParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Priority: *p.Priority,
+		SetBy:    authUser(c),
+	})
+	if err != nil {
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
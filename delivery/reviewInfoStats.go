@@ -0,0 +1,197 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoStats.go
+
+	Module Description:
+		HTTP delivery handler for the submission statistics of a project.
+
+	Details:
+	- GET /projects/:project/reviews/stats/submissions?interval=day&from=..&to=..
+	      {"project": "...", "interval": "day", "from": "...", "to": "...",
+	       "buckets": [{"start": "...", "total": 12, "phases": {"mdl": 7, "rig": 5}}]}
+	- interval is day (default), week or month. from and to are RFC 3339 timestamps or
+	  dates (YYYY-MM-DD); to defaults to now and from to defaultStatsDays days before.
+	- GET /projects/:project/reviews/stats/approval-latency?status=approved&from=..&to=..
+	      {"latencies": [{"phase": "mdl", "relation": "main", "count": 40,
+	                      "avg_seconds": 93211.5, "p50_seconds": 86400,
+	                      "p90_seconds": 259200, "p95_seconds": 345600}]}
+	  status defaults to approved; from and to bound the approval time and are optional.
+	- GET /projects/:project/reviews/stats/retakes?root=assets&status=retake&from=..&to=..&limit=100
+	      {"project": "...", "status": "retake",
+	       "assets": [{"root": "assets", "group_1": "chr", "relation": "main",
+	                   "phase": "mdl", "takes": 9, "retakes": 6}],
+	       "artists": [{"submitted_user": "...", "takes": 40, "retaken_takes": 10,
+	                    "retakes": 12, "retake_rate": 0.25}]}
+	  from and to bound the submission time; limit caps the asset list (max 1000).
+	- Counts respect the category access of the caller's role, like the pivot.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Added ApprovalLatency.
+		* - 15-10-2026 - Added RetakeStats.
+
+	Functions:
+		* (ReviewInfo) SubmissionStats: Returns the submissions of a project over time.
+		* (ReviewInfo) ApprovalLatency: Returns the approval latencies of a project.
+		* (ReviewInfo) RetakeStats: Returns the retakes of a project per asset and per artist.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+const defaultStatsDays = 30
+
+// parseStatsTime accepts an RFC 3339 timestamp or a date, read as UTC midnight.
+func parseStatsTime(raw string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, raw)
+}
+
+func (h *ReviewInfo) SubmissionStats(c *gin.Context) {
+	interval := c.DefaultQuery("interval", entity.ReviewStatsIntervalDay)
+	switch interval {
+	case entity.ReviewStatsIntervalDay, entity.ReviewStatsIntervalWeek, entity.ReviewStatsIntervalMonth:
+	default:
+		badRequest(c, fmt.Errorf("interval must be one of: day, week, month"))
+		return
+	}
+	to := time.Now().UTC()
+	if raw := strings.TrimSpace(c.Query("to")); raw != "" {
+		t, err := parseStatsTime(raw)
+		if err != nil {
+			badRequest(c, fmt.Errorf("to must be an RFC 3339 timestamp or a date such as 2026-01-15"))
+			return
+		}
+		to = t
+	}
+	from := to.AddDate(0, 0, -defaultStatsDays)
+	if raw := strings.TrimSpace(c.Query("from")); raw != "" {
+		t, err := parseStatsTime(raw)
+		if err != nil {
+			badRequest(c, fmt.Errorf("from must be an RFC 3339 timestamp or a date such as 2026-01-15"))
+			return
+		}
+		from = t
+	}
+
+	stats, err := h.uc.SubmissionStats(c.Request.Context(), &entity.ReviewSubmissionStatsParams{
+		Project:  c.Param("project"),
+		Interval: interval,
+		From:     from,
+		To:       to,
+		Role:     authRole(c),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrRecordNotFound):
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("project %s not found", c.Param("project")), nil)
+		case errors.Is(err, entity.ErrInvalidStatsRange):
+			badRequest(c, err)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	c.PureJSON(http.StatusOK, stats)
+}
+
+// parseStatsRange reads the optional from and to query parameters.
+func parseStatsRange(c *gin.Context) (from, to *time.Time, err error) {
+	for _, bound := range []struct {
+		name string
+		dst  **time.Time
+	}{{"from", &from}, {"to", &to}} {
+		raw := strings.TrimSpace(c.Query(bound.name))
+		if raw == "" {
+			continue
+		}
+		t, err := parseStatsTime(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s must be an RFC 3339 timestamp or a date such as 2026-01-15", bound.name)
+		}
+		*bound.dst = &t
+	}
+	return from, to, nil
+}
+
+func (h *ReviewInfo) ApprovalLatency(c *gin.Context) {
+	from, to, err := parseStatsRange(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.ReviewApprovalLatencyParams{
+		Project: c.Param("project"),
+		Status:  strings.TrimSpace(c.Query("status")),
+		From:    from,
+		To:      to,
+		Role:    authRole(c),
+	}
+
+	latencies, err := h.uc.ApprovalLatency(c.Request.Context(), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrRecordNotFound):
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("project %s not found", params.Project), nil)
+		case errors.Is(err, entity.ErrInvalidStatsRange):
+			badRequest(c, err)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{
+		"latencies": latencies,
+	})
+}
+
+func (h *ReviewInfo) RetakeStats(c *gin.Context) {
+	from, to, err := parseStatsRange(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	limit := 0
+	if raw := strings.TrimSpace(c.Query("limit")); raw != "" {
+		limit, err = strconv.Atoi(raw)
+		if err != nil || limit < 1 || limit > 1000 {
+			badRequest(c, fmt.Errorf("limit must be an integer between 1 and 1000"))
+			return
+		}
+	}
+	params := &entity.ReviewRetakeStatsParams{
+		Project: c.Param("project"),
+		Root:    strings.TrimSpace(c.Query("root")),
+		Status:  strings.TrimSpace(c.Query("status")),
+		From:    from,
+		To:      to,
+		Limit:   limit,
+		Role:    authRole(c),
+	}
+	stats, err := h.uc.RetakeStats(c.Request.Context(), params)
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrRecordNotFound):
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("project %s not found", params.Project), nil)
+		case errors.Is(err, entity.ErrInvalidStatsRange):
+			badRequest(c, err)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	c.PureJSON(http.StatusOK, stats)
+}
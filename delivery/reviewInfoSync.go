@@ -0,0 +1,62 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoSync.go
+
+	Module Description:
+		HTTP delivery handler for the review info delta sync.
+
+	Details:
+	- GET /projects/:project/reviews/sync?token=&limit=
+	      → {"changes": [{"op": "upsert", "id": 1, "modified_at_utc": "...", "review": {...}},
+	                     {"op": "delete", "id": 2, "modified_at_utc": "..."}],
+	         "next_token": "...", "has_more": false}
+	- Start without a token, then always send the last next_token; call again right away
+	  while has_more is true.
+	- 410 sync_token_expired: the token predates the trash retention, start over.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) Sync: Returns the changes of a project since a sync token.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+type reviewSyncParams struct {
+	Token string `form:"token"`
+	Limit int    `form:"limit" binding:"omitempty,min=1,max=1000"`
+}
+
+func (h *ReviewInfo) Sync(c *gin.Context) {
+	var p reviewSyncParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	res, err := h.uc.Sync(c.Request.Context(), &entity.ReviewSyncParams{
+		Project: c.Param("project"),
+		Token:   p.Token,
+		Limit:   p.Limit,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrSyncTokenExpired):
+			abortWithError(c, http.StatusGone, CodeSyncTokenExpired, err, nil)
+		case errors.Is(err, entity.ErrInvalidSyncToken):
+			badRequest(c, err)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	c.PureJSON(http.StatusOK, res)
+}
@@ -0,0 +1,110 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoTakes.go
+
+	Module Description:
+		HTTP delivery handlers for the takes of an asset phase and take pinning.
+
+	Details:
+	- GET    /projects/:project/reviews/assets/:asset/:relation/takes?phase=mdl
+	- PUT    /projects/:project/reviews/assets/:asset/:relation/takes/pin
+	         {"phase": "mdl", "take": "t012"}
+	- DELETE /projects/:project/reviews/assets/:asset/:relation/takes/pin?phase=mdl
+	- The pinned take is what the pivot shows for the phase until it is unpinned.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) ListTakes: Lists the takes of an asset phase.
+		* (ReviewInfo) PinTake: Pins a take of an asset phase.
+		* (ReviewInfo) UnpinTake: Removes the pin of an asset phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) ListTakes(c *gin.Context) {
+	phase := c.Query("phase")
+	if phase == "" {
+		badRequest(c, fmt.Errorf("phase is required"))
+		return
+	}
+	list, err := h.uc.ListTakes(c.Request.Context(), &entity.ListReviewTakesParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		Phase:    phase,
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, list)
+}
+
+type pinReviewTakeParams struct {
+	Phase string `json:"phase" binding:"required"`
+	Take  string `json:"take" binding:"required"`
+}
+
+func (h *ReviewInfo) PinTake(c *gin.Context) {
+	var p pinReviewTakeParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	pin, err := h.uc.PinTake(c.Request.Context(), &entity.PinReviewTakeParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		Phase:    p.Phase,
+		Take:     p.Take,
+		PinnedBy: authUser(c),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrReviewTakeNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, pin)
+}
+
+func (h *ReviewInfo) UnpinTake(c *gin.Context) {
+	phase := c.Query("phase")
+	if phase == "" {
+		badRequest(c, fmt.Errorf("phase is required"))
+		return
+	}
+	if err := h.uc.UnpinTake(c.Request.Context(), &entity.UnpinReviewTakeParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		Phase:    phase,
+	}); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("no pinned take in phase %s", phase), nil)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
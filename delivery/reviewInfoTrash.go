@@ -0,0 +1,94 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoTrash.go
+
+	Module Description:
+		HTTP delivery handlers for the trash of deleted review infos.
+
+	Details:
+	- GET  /projects/:project/reviews/trash?page=&per_page=
+	       Deleted review infos, most recently deleted first.
+	- POST /projects/:project/reviews/:id/restore
+	       Returns the restored review info; needs the role allowed to delete.
+	- Deleted review infos are purged for good after PPI_TRASH_RETENTION (see main).
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) ListTrash: Lists the deleted review infos of a project.
+		* (ReviewInfo) Restore: Restores a deleted review info.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/libs"
+	"github.com/gin-gonic/gin"
+)
+
+type listReviewTrashParams struct {
+	PerPage *int `form:"per_page"`
+	Page    *int `form:"page"`
+}
+
+func (p *listReviewTrashParams) Entity(project string) *entity.ReviewTrashListParams {
+	return &entity.ReviewTrashListParams{
+		Project: project,
+		BaseListParams: &entity.BaseListParams{
+			PerPage: p.PerPage,
+			Page:    p.Page,
+		},
+	}
+}
+
+func (h *ReviewInfo) ListTrash(c *gin.Context) {
+	var p listReviewTrashParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"))
+	entities, total, err := h.uc.ListTrash(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	res := libs.CreateListResponse("reviews", entities, c.Request, params, total)
+	c.PureJSON(http.StatusOK, res)
+}
+
+func (h *ReviewInfo) Restore(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.RestoreReviewInfoParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	}
+	if user := authUser(c); user != "" {
+		params.ModifiedBy = &user
+	}
+	e, err := h.uc.Restore(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("deleted review info with ID %d not found", params.ID))
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
@@ -0,0 +1,118 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoUsecase.go
+
+	Module Description:
+		The usecase interface of the ReviewInfo handlers.
+
+	Details:
+	- The ReviewInfo handlers depend on ReviewInfoUsecase, the usecase methods they
+	  call, instead of *usecase.ReviewInfo, so handler tests can replace the usecase
+	  with mocks.ReviewInfoUsecase (delivery/mocks, go.uber.org/mock) and run without
+	  a database. *usecase.ReviewInfo implements it and main wires it as before.
+	- A handler calling a new usecase method needs it added here and the mock
+	  regenerated: go generate ./delivery/...
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* ReviewInfoUsecase: The usecase methods the ReviewInfo handlers use.
+	────────────────────────────────────────────────────────────────────────── */
+
+//go:generate mockgen -source=reviewInfoUsecase.go -destination=mocks/reviewInfoUsecase.go -package=mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+)
+
+// ReviewInfoUsecase is the review info usecase as seen by the handlers.
+type ReviewInfoUsecase interface {
+	// Review infos
+	List(
+		ctx context.Context,
+		params *entity.ListReviewInfoParams,
+		submittedUsers []string,
+		approvalUpdatedUsers []string,
+		tags []string,
+	) ([]*entity.ReviewInfo, int, error)
+	Get(ctx context.Context, params *entity.GetReviewParams) (*entity.ReviewInfo, error)
+	Create(ctx context.Context, params *entity.CreateReviewInfoParams) (*entity.ReviewInfo, error)
+	CreateBatch(ctx context.Context, project string, items []*entity.CreateReviewInfoParams) ([]*entity.ReviewInfo, error)
+	UpdateWithMetadata(
+		ctx context.Context,
+		params *entity.UpdateReviewInfoParams,
+		meta *entity.ReviewInfoMetadataPatch,
+	) (*entity.ReviewInfo, error)
+	Delete(ctx context.Context, params *entity.DeleteReviewInfoParams) error
+	ListTrash(ctx context.Context, params *entity.ReviewTrashListParams) ([]*entity.ReviewInfo, int, error)
+	Restore(ctx context.Context, params *entity.RestoreReviewInfoParams) (*entity.ReviewInfo, error)
+	Sync(ctx context.Context, params *entity.ReviewSyncParams) (*entity.ReviewSyncResult, error)
+	Compare(ctx context.Context, params *entity.ReviewCompareParams) (*entity.ReviewCompare, error)
+	ListComments(ctx context.Context, params *entity.ListReviewCommentsParams) ([]*entity.ReviewComment, error)
+
+	// Assets and shots
+	ListAssets(
+		ctx context.Context,
+		params *entity.AssetListParams,
+		submittedUsers []string,
+		approvalUpdatedUsers []string,
+		tags []string,
+	) ([]*entity.Asset, int, error)
+	ListUnassignedAssets(
+		ctx context.Context,
+		params *entity.UnassignedAssetListParams,
+	) ([]*entity.UnassignedAsset, int, error)
+	ListAssetReviewInfos(ctx context.Context, params *entity.AssetReviewInfoListParams) ([]*entity.ReviewInfo, error)
+	ListShotReviewInfos(ctx context.Context, params *entity.ShotReviewInfoListParams) ([]*entity.ReviewInfo, error)
+	AssetTimeline(ctx context.Context, params *entity.AssetTimelineParams) (*entity.AssetTimeline, error)
+	BulkSetWorkStatus(
+		ctx context.Context,
+		params *entity.BulkSetWorkStatusParams,
+	) (*entity.BulkSetWorkStatusResult, error)
+
+	// Asset pivot
+	ListAssetsPivot(ctx context.Context, p usecase.ListAssetsPivotParams) (*usecase.ListAssetsPivotResult, error)
+	PivotQueryLimits(ctx context.Context, project string) (entity.PageLimits, time.Duration, error)
+	BatchAssetDetails(ctx context.Context, params *entity.BatchAssetDetailParams) ([]repository.AssetPivot, error)
+
+	// Takes, due dates, priorities, tags and notes
+	ListTakes(ctx context.Context, params *entity.ListReviewTakesParams) (*entity.ReviewTakeList, error)
+	PinTake(ctx context.Context, params *entity.PinReviewTakeParams) (*entity.ReviewTakePin, error)
+	UnpinTake(ctx context.Context, params *entity.UnpinReviewTakeParams) error
+	ListDueDates(ctx context.Context, params *entity.ListReviewDueDatesParams) ([]*entity.ReviewDueDate, error)
+	SetDueDate(ctx context.Context, params *entity.SetReviewDueDateParams) (*entity.ReviewDueDate, error)
+	ClearDueDate(ctx context.Context, params *entity.ClearReviewDueDateParams) error
+	SetAssetPriority(ctx context.Context, params *entity.SetAssetPriorityParams) (*entity.AssetPriority, error)
+	ListTags(ctx context.Context, params *entity.ListReviewTagsParams) ([]*entity.ReviewTag, error)
+	ListProjectTags(ctx context.Context, params *entity.ListProjectReviewTagsParams) ([]*entity.ReviewTagCount, error)
+	AddTags(ctx context.Context, params *entity.AddReviewTagsParams) ([]*entity.ReviewTag, error)
+	RemoveTag(ctx context.Context, params *entity.RemoveReviewTagParams) error
+	ListNotes(ctx context.Context, params *entity.ListAssetNotesParams) ([]*entity.AssetNote, error)
+	CreateNote(ctx context.Context, params *entity.CreateAssetNoteParams) (*entity.AssetNote, error)
+	UpdateNote(ctx context.Context, params *entity.UpdateAssetNoteParams) (*entity.AssetNote, error)
+	DeleteNote(ctx context.Context, params *entity.DeleteAssetNoteParams) error
+
+	// Statistics and overviews
+	Overview(ctx context.Context, params *entity.ReviewOverviewParams) ([]*entity.ReviewProjectOverview, error)
+	Completion(ctx context.Context, params *entity.ReviewCompletionParams) (*entity.ReviewCompletion, error)
+	SubmissionStats(
+		ctx context.Context,
+		params *entity.ReviewSubmissionStatsParams,
+	) (*entity.ReviewSubmissionStats, error)
+	ApprovalLatency(
+		ctx context.Context,
+		params *entity.ReviewApprovalLatencyParams,
+	) ([]*entity.ReviewApprovalLatency, error)
+	RetakeStats(ctx context.Context, params *entity.ReviewRetakeStatsParams) (*entity.ReviewRetakeStats, error)
+	Workload(ctx context.Context, params *entity.ReviewWorkloadParams) ([]*entity.ReviewWorkload, error)
+}
+
+var _ ReviewInfoUsecase = (*usecase.ReviewInfo)(nil)
@@ -0,0 +1,52 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewInfoWorkload.go
+
+	Module Description:
+		HTTP delivery handler for the per-studio workload of a project.
+
+	Details:
+	- GET /projects/:project/reviews/workload?root=assets
+	      {"workload": [{"studio": "ppi", "phase": "mdl", "wip": 12, "pending": 3,
+	                     "approved": 40, "total": 55}]}
+	- Counts follow the latest take of every asset phase and respect the category
+	  access of the caller's role, like the pivot.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) Workload: Returns the workload of a project per studio and phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) Workload(c *gin.Context) {
+	project := c.Param("project")
+	workload, err := h.uc.Workload(c.Request.Context(), &entity.ReviewWorkloadParams{
+		Project: project,
+		Root:    strings.TrimSpace(c.Query("root")),
+		Role:    authRole(c),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("project %s not found", project), nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{
+		"workload": workload,
+	})
+}
@@ -0,0 +1,176 @@
+package delivery
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/delivery/mocks"
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/mock/gomock"
+)
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func TestEtagMatches(t *testing.T) {
+	const etag = `"0123abcd"`
+	for _, tc := range []struct {
+		ifNoneMatch string
+		want        bool
+	}{
+		{"", false},
+		{`"0123abcd"`, true},
+		{`W/"0123abcd"`, true},
+		{`"ffff", "0123abcd"`, true},
+		{`"ffff"`, false},
+		{`0123abcd`, false},
+		{`*`, true},
+	} {
+		if got := etagMatches(tc.ifNoneMatch, etag); got != tc.want {
+			t.Errorf("etagMatches(%q) = %v, want %v", tc.ifNoneMatch, got, tc.want)
+		}
+	}
+}
+
+// newPivotRouter serves ListAssetsPivot over a mocked usecase answering result.
+func newPivotRouter(t *testing.T, result *usecase.ListAssetsPivotResult) *gin.Engine {
+	ctrl := gomock.NewController(t)
+	uc := mocks.NewMockReviewInfoUsecase(ctrl)
+	uc.EXPECT().PivotQueryLimits(gomock.Any(), "rod").
+		Return(entity.DefaultPageLimits, 10*time.Second, nil).AnyTimes()
+	uc.EXPECT().ListAssetsPivot(gomock.Any(), gomock.Any()).
+		Return(result, nil).AnyTimes()
+
+	router := gin.New()
+	router.GET("/projects/:project/reviews/assets/pivot", NewReviewInfo(uc).ListAssetsPivot)
+	return router
+}
+
+func getPivot(router *gin.Engine, query, ifNoneMatch string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/projects/rod/reviews/assets/pivot"+query, nil)
+	if ifNoneMatch != "" {
+		req.Header.Set("If-None-Match", ifNoneMatch)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestListAssetsPivotNotModified(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		query  string
+		result *usecase.ListAssetsPivotResult
+	}{
+		{
+			name:  "list",
+			query: "",
+			result: &usecase.ListAssetsPivotResult{
+				Assets:   []repository.AssetPivot{{Group1: "ast001", Relation: "main"}},
+				Total:    1,
+				Page:     1,
+				PerPage:  30,
+				PageLast: 1,
+				View:     "list",
+			},
+		},
+		{
+			name:  "grouped",
+			query: "?view=grouped",
+			result: &usecase.ListAssetsPivotResult{
+				Groups: []repository.GroupedAssetBucket{{
+					TopGroupNode: "character",
+					Items:        []repository.AssetPivot{{Group1: "ast001", Relation: "main"}},
+				}},
+				Total:         1,
+				View:          "grouped",
+				GroupPage:     1,
+				GroupPerPage:  10,
+				GroupTotal:    1,
+				GroupPageLast: 1,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			router := newPivotRouter(t, tc.result)
+
+			first := getPivot(router, tc.query, "")
+			if first.Code != http.StatusOK {
+				t.Fatalf("first request: status %d, body %s", first.Code, first.Body)
+			}
+			etag := first.Header().Get("ETag")
+			if etag == "" {
+				t.Fatal("first request: no ETag")
+			}
+
+			// request_id and query_time differ per request and stay out of the tag.
+			again := getPivot(router, tc.query, "")
+			if got := again.Header().Get("ETag"); got != etag {
+				t.Errorf("ETag changed between identical pages: %s, then %s", etag, got)
+			}
+
+			for _, inm := range []string{etag, "W/" + etag, `"other", ` + etag} {
+				w := getPivot(router, tc.query, inm)
+				if w.Code != http.StatusNotModified {
+					t.Errorf("If-None-Match %s: status %d, want 304", inm, w.Code)
+				}
+				if w.Body.Len() != 0 {
+					t.Errorf("If-None-Match %s: 304 with a body: %s", inm, w.Body)
+				}
+				if got := w.Header().Get("ETag"); got != etag {
+					t.Errorf("If-None-Match %s: ETag %s, want %s", inm, got, etag)
+				}
+			}
+
+			if w := getPivot(router, tc.query, `"stale"`); w.Code != http.StatusOK || w.Body.Len() == 0 {
+				t.Errorf("stale If-None-Match: status %d with %d bytes, want 200 with the page", w.Code, w.Body.Len())
+			}
+		})
+	}
+}
+
+// The pivot varies on Accept next to the Accept-Encoding of the compression middleware,
+// so a cache keys its copies on both.
+func TestListAssetsPivotVary(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	uc := mocks.NewMockReviewInfoUsecase(ctrl)
+	uc.EXPECT().ListAssetsPivot(gomock.Any(), gomock.Any()).Return(&usecase.ListAssetsPivotResult{
+		Assets:   []repository.AssetPivot{{Group1: "ast001", Relation: "main"}},
+		Total:    1,
+		Page:     1,
+		PerPage:  30,
+		PageLast: 1,
+		View:     "list",
+	}, nil).AnyTimes()
+	router := gin.New()
+	router.Use(Compression(DefaultCompressionConfig()))
+	router.GET("/projects/:project/reviews/assets/pivot", NewReviewInfo(uc).ListAssetsPivot)
+
+	for _, encoding := range []string{"", "gzip", "br"} {
+		req := httptest.NewRequest(http.MethodGet, "/projects/rod/reviews/assets/pivot", nil)
+		if encoding != "" {
+			req.Header.Set("Accept-Encoding", encoding)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		if w.Code != http.StatusOK {
+			t.Fatalf("Accept-Encoding %q: status %d, body %s", encoding, w.Code, w.Body)
+		}
+		vary := map[string]bool{}
+		for _, v := range w.Header().Values("Vary") {
+			for _, name := range strings.Split(v, ",") {
+				vary[strings.TrimSpace(name)] = true
+			}
+		}
+		if !vary["Accept"] || !vary["Accept-Encoding"] {
+			t.Errorf("Accept-Encoding %q: Vary %v, want Accept and Accept-Encoding", encoding, w.Header().Values("Vary"))
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewLock.go
+
+	Module Description:
+		HTTP delivery handlers for live review session locks.
+
+	Details:
+	- GET    /projects/:project/reviews/:id/lock
+	- POST   /projects/:project/reviews/:id/lock   {"ttl_seconds": 300, "reason": "..."}
+	- DELETE /projects/:project/reviews/:id/lock?force=true
+	- The holder is the authenticated user (401 without one); force=true releases
+	  someone else's lock and needs the lead role (403).
+	- A lock held by someone else is answered with 409 and the current lock.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - reviewLocked answers with the shared error envelope (code review_locked).
+		* - 15-10-2026 - The holder is the authenticated user instead of a body/query field.
+
+	Functions:
+		* NewReviewLock: Creates a new ReviewLock handler.
+		* (ReviewLock) Get: Returns the active lock of a review info.
+		* (ReviewLock) Post: Takes or renews a lock.
+		* (ReviewLock) Delete: Releases a lock.
+		* reviewLocked – utility function: Writes 409 for a *entity.ReviewLockedError.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewLock(
+	uc *usecase.ReviewLock,
+) *ReviewLock {
+	return &ReviewLock{
+		uc: uc,
+	}
+}
+
+type ReviewLock struct {
+	uc *usecase.ReviewLock
+}
+
+// reviewLocked writes 409 Conflict with the blocking lock when err is a lock conflict.
+func reviewLocked(c *gin.Context, err error) bool {
+	var lockedErr *entity.ReviewLockedError
+	if !errors.As(err, &lockedErr) {
+		return false
+	}
+	abortWithError(c, http.StatusConflict, CodeReviewLocked, lockedErr, gin.H{
+		"lock": lockedErr.Lock,
+	})
+	return true
+}
+
+func (h *ReviewLock) Get(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.GetReviewLockParams{
+		Project:      c.Param("project"),
+		ReviewInfoID: int32(id),
+	}
+	e, err := h.uc.Get(c.Request.Context(), params)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			c.PureJSON(http.StatusOK, gin.H{"lock": nil})
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"lock": e})
+}
+
+type acquireReviewLockParams struct {
+	Reason     string `json:"reason"`
+	TTLSeconds *int   `json:"ttl_seconds"`
+}
+
+func (h *ReviewLock) Post(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	var p acquireReviewLockParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	params := &entity.AcquireReviewLockParams{
+		Project:      c.Param("project"),
+		ReviewInfoID: int32(id),
+		Holder:       user,
+		Reason:       p.Reason,
+	}
+	if p.TTLSeconds != nil {
+		params.TTL = time.Duration(*p.TTLSeconds) * time.Second
+	}
+	e, err := h.uc.Acquire(c.Request.Context(), params)
+	if err != nil {
+		if reviewLocked(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+type releaseReviewLockParams struct {
+	Force bool `form:"force"`
+}
+
+func (h *ReviewLock) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	var p releaseReviewLockParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	params := &entity.ReleaseReviewLockParams{
+		Project:      c.Param("project"),
+		ReviewInfoID: int32(id),
+		Holder:       user,
+		Force:        p.Force,
+	}
+	if err := h.uc.Release(c.Request.Context(), params); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review lock for review info with ID %d not found", params.ReviewInfoID))
+			return
+		}
+		if reviewLocked(c, err) || forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
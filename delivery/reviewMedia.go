@@ -0,0 +1,97 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewMedia.go
+
+	Module Description:
+		HTTP delivery handlers for signed review media URLs.
+
+	Details:
+	- GET /projects/:project/reviews/:id/files/:index/url?kind=all_files|review_target
+	      {"review_info_id": 123, "kind": "all_files", "index": 0, "path": "...",
+	       "url": "https://...", "expires_at_utc": "..."}
+	  kind defaults to all_files; index is 0-based.
+	- GET /media?key=&expires=&signature= serves a file of the local media store. It
+	  is registered outside the authenticated API: the signature is the credential.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewReviewMedia: Creates a new ReviewMedia handler.
+		* (ReviewMedia) FileURL: Issues a signed URL for a file of a review info.
+		* (ReviewMedia) Serve: Serves a file of the local media store.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewMedia(
+	uc *usecase.ReviewMedia,
+) *ReviewMedia {
+	return &ReviewMedia{
+		uc: uc,
+	}
+}
+
+type ReviewMedia struct {
+	uc *usecase.ReviewMedia
+}
+
+func (h *ReviewMedia) FileURL(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil || index < 0 {
+		badRequest(c, fmt.Errorf("index must be a non-negative integer"))
+		return
+	}
+	res, err := h.uc.FileURL(c.Request.Context(), &entity.GetReviewMediaURLParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+		Kind:    c.DefaultQuery("kind", entity.ReviewMediaKindAllFiles),
+		Index:   index,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrRecordNotFound):
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("review info with ID %d not found", id), nil)
+		case errors.Is(err, entity.ErrReviewMediaNotFound):
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+		case errors.Is(err, entity.ErrReviewMediaNotServable):
+			abortWithError(c, http.StatusUnprocessableEntity, CodeValidationFailed, err, nil)
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	c.Header("Cache-Control", "no-store")
+	c.PureJSON(http.StatusOK, res)
+}
+
+func (h *ReviewMedia) Serve(c *gin.Context) {
+	expires, err := strconv.ParseInt(c.Query("expires"), 10, 64)
+	if err != nil {
+		abortWithError(c, http.StatusForbidden, CodeForbidden, entity.ErrInvalidMediaSignature, nil)
+		return
+	}
+	file, err := h.uc.ResolveLocal(c.Query("key"), time.Unix(expires, 0), c.Query("signature"))
+	if err != nil {
+		abortWithError(c, http.StatusForbidden, CodeForbidden, err, nil)
+		return
+	}
+	c.File(file)
+}
@@ -0,0 +1,196 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewPlaylist.go
+
+	Module Description:
+		HTTP delivery handlers for review playlists.
+
+	Details:
+	- GET    /projects/:project/playlists?page=&per_page=
+	- POST   /projects/:project/playlists
+	         {"name": "Dailies 10/15", "description": "...",
+	          "items": [{"asset": "chrA", "relation": "main", "phase": "mdl", "take": "t012",
+	                     "review_info_id": 123}]}
+	- GET    /projects/:project/playlists/:id
+	- PUT    /projects/:project/playlists/:id/order   {"item_ids": [3, 1, 2]}
+	- DELETE /projects/:project/playlists/:id
+	- Items keep the order they are posted in; the order body must list every item ID.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewReviewPlaylist: Creates a new ReviewPlaylist handler.
+		* (ReviewPlaylist) List: Lists the playlists of a project.
+		* (ReviewPlaylist) Get: Returns a playlist.
+		* (ReviewPlaylist) Post: Creates a playlist from a pivot selection.
+		* (ReviewPlaylist) Reorder: Reorders the items of a playlist.
+		* (ReviewPlaylist) Delete: Removes a playlist.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/libs"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewPlaylist(
+	uc *usecase.ReviewPlaylist,
+) *ReviewPlaylist {
+	return &ReviewPlaylist{
+		uc: uc,
+	}
+}
+
+type ReviewPlaylist struct {
+	uc *usecase.ReviewPlaylist
+}
+
+type listReviewPlaylistParams struct {
+	PerPage *int `form:"per_page"`
+	Page    *int `form:"page"`
+}
+
+func (p *listReviewPlaylistParams) Entity(project string) *entity.ReviewPlaylistListParams {
+	return &entity.ReviewPlaylistListParams{
+		Project: project,
+		BaseListParams: &entity.BaseListParams{
+			PerPage: p.PerPage,
+			Page:    p.Page,
+		},
+	}
+}
+
+func (h *ReviewPlaylist) List(c *gin.Context) {
+	var p listReviewPlaylistParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"))
+	entities, total, err := h.uc.List(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	res := libs.CreateListResponse("playlists", entities, c.Request, params, total)
+	c.PureJSON(http.StatusOK, res)
+}
+
+func playlistID(c *gin.Context) (int32, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return 0, err
+	}
+	return int32(id), nil
+}
+
+func (h *ReviewPlaylist) Get(c *gin.Context) {
+	id, err := playlistID(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Get(c.Request.Context(), &entity.GetReviewPlaylistParams{
+		Project: c.Param("project"),
+		ID:      id,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("playlist with ID %d not found", id))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+type createReviewPlaylistParams struct {
+	Name        string                             `json:"name" binding:"required,max=255"`
+	Description string                             `json:"description" binding:"max=1024"`
+	Items       []*entity.ReviewPlaylistItemParams `json:"items" binding:"required,min=1,max=500,dive"`
+}
+
+func (h *ReviewPlaylist) Post(c *gin.Context) {
+	var p createReviewPlaylistParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Create(c.Request.Context(), &entity.CreateReviewPlaylistParams{
+		Project:     c.Param("project"),
+		Name:        p.Name,
+		Description: p.Description,
+		Items:       p.Items,
+		CreatedBy:   authUser(c),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+type reorderReviewPlaylistParams struct {
+	ItemIDs []int32 `json:"item_ids" binding:"required,min=1"`
+}
+
+func (h *ReviewPlaylist) Reorder(c *gin.Context) {
+	id, err := playlistID(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	var p reorderReviewPlaylistParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Reorder(c.Request.Context(), &entity.ReorderReviewPlaylistParams{
+		Project: c.Param("project"),
+		ID:      id,
+		ItemIDs: p.ItemIDs,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, entity.ErrInvalidPlaylistOrder):
+			badRequest(c, err)
+		case errors.Is(err, entity.ErrRecordNotFound):
+			badRequest(c, fmt.Errorf("playlist with ID %d not found", id))
+		default:
+			internalServerError(c, err)
+		}
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ReviewPlaylist) Delete(c *gin.Context) {
+	id, err := playlistID(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := h.uc.Delete(c.Request.Context(), &entity.DeleteReviewPlaylistParams{
+		Project: c.Param("project"),
+		ID:      id,
+	}); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("playlist with ID %d not found", id))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,248 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewSession.go
+
+	Module Description:
+		HTTP delivery handlers for scheduled review sessions and their calendar feed.
+
+	Details:
+	- GET    /projects/:project/review-sessions?from=&to=&page=&per_page=
+	         (from defaults to now, so the list shows upcoming sessions)
+	- POST   /projects/:project/review-sessions
+	         {"title": "Dailies", "location": "Room 3",
+	          "starts_at_utc": "2026-10-16T09:00:00Z", "ends_at_utc": "2026-10-16T10:00:00Z",
+	          "playlist_id": 12, "assets": [{"asset": "chrA", "relation": "main"}]}
+	- GET    /projects/:project/review-sessions/:id
+	- DELETE /projects/:project/review-sessions/:id
+	- GET    /projects/:project/review-sessions.ics        (authenticated feed)
+	- GET    /projects/:project/review-sessions/feed       (subscription URL of the feed)
+	- GET    /calendar/:project/review-sessions.ics?token= (unauthenticated, for calendar
+	         apps; 404 when the feed secret is not configured)
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewReviewSession: Creates a new ReviewSession handler.
+		* (ReviewSession) List: Lists the sessions of a project.
+		* (ReviewSession) Get: Returns a session.
+		* (ReviewSession) Post: Schedules a session.
+		* (ReviewSession) Delete: Removes a session.
+		* (ReviewSession) Calendar: Returns the iCalendar feed of a project.
+		* (ReviewSession) Feed: Returns the subscription URL of the feed of a project.
+		* (ReviewSession) PublicCalendar: Returns the feed of a project for a feed token.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/libs"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+func NewReviewSession(
+	uc *usecase.ReviewSession,
+) *ReviewSession {
+	return &ReviewSession{
+		uc: uc,
+	}
+}
+
+type ReviewSession struct {
+	uc *usecase.ReviewSession
+}
+
+type listReviewSessionParams struct {
+	From    *time.Time `form:"from"`
+	To      *time.Time `form:"to"`
+	PerPage *int       `form:"per_page"`
+	Page    *int       `form:"page"`
+}
+
+func (p *listReviewSessionParams) Entity(project string) *entity.ReviewSessionListParams {
+	return &entity.ReviewSessionListParams{
+		Project: project,
+		From:    p.From,
+		To:      p.To,
+		BaseListParams: &entity.BaseListParams{
+			PerPage: p.PerPage,
+			Page:    p.Page,
+		},
+	}
+}
+
+func (h *ReviewSession) List(c *gin.Context) {
+	var p listReviewSessionParams
+	if err := c.ShouldBindQuery(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := p.Entity(c.Param("project"))
+	entities, total, err := h.uc.List(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+
+	res := libs.CreateListResponse("review_sessions", entities, c.Request, params, total)
+	c.PureJSON(http.StatusOK, res)
+}
+
+func reviewSessionID(c *gin.Context) (int32, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		return 0, err
+	}
+	return int32(id), nil
+}
+
+func (h *ReviewSession) Get(c *gin.Context) {
+	id, err := reviewSessionID(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Get(c.Request.Context(), &entity.GetReviewSessionParams{
+		Project: c.Param("project"),
+		ID:      id,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review session with ID %d not found", id))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+type createReviewSessionParams struct {
+	Title       string                       `json:"title" binding:"required,max=255"`
+	Description string                       `json:"description" binding:"max=2048"`
+	Location    string                       `json:"location" binding:"max=255"`
+	StartsAtUtc time.Time                    `json:"starts_at_utc" binding:"required"`
+	EndsAtUtc   time.Time                    `json:"ends_at_utc" binding:"required"`
+	PlaylistID  *int32                       `json:"playlist_id"`
+	Assets      []*entity.ReviewSessionAsset `json:"assets" binding:"max=500,dive"`
+}
+
+func (h *ReviewSession) Post(c *gin.Context) {
+	var p createReviewSessionParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Create(c.Request.Context(), &entity.CreateReviewSessionParams{
+		Project:     c.Param("project"),
+		Title:       p.Title,
+		Description: p.Description,
+		Location:    p.Location,
+		StartsAtUtc: p.StartsAtUtc,
+		EndsAtUtc:   p.EndsAtUtc,
+		PlaylistID:  p.PlaylistID,
+		Assets:      p.Assets,
+		CreatedBy:   authUser(c),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, err)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ReviewSession) Delete(c *gin.Context) {
+	id, err := reviewSessionID(c)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	if err := h.uc.Delete(c.Request.Context(), &entity.DeleteReviewSessionParams{
+		Project: c.Param("project"),
+		ID:      id,
+	}); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review session with ID %d not found", id))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ReviewSession) writeCalendar(c *gin.Context, project string) {
+	b, err := h.uc.Calendar(c.Request.Context(), project)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="%s-review-sessions.ics"`, project))
+	c.Data(http.StatusOK, calendarContentType, b)
+}
+
+func (h *ReviewSession) Calendar(c *gin.Context) {
+	h.writeCalendar(c, c.Param("project"))
+}
+
+// Feed returns the URL calendar apps subscribe to, built from the request's host.
+func (h *ReviewSession) Feed(c *gin.Context) {
+	project := c.Param("project")
+	token, err := h.uc.FeedToken(project)
+	if err != nil {
+		if errors.Is(err, entity.ErrReviewSessionFeedDisabled) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	scheme := "https"
+	if proto := c.GetHeader("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	} else if c.Request.TLS == nil {
+		scheme = "http"
+	}
+	u := url.URL{
+		Scheme:   scheme,
+		Host:     c.Request.Host,
+		Path:     "/calendar/" + project + "/review-sessions.ics",
+		RawQuery: url.Values{"token": {token}}.Encode(),
+	}
+	c.PureJSON(http.StatusOK, gin.H{"feed": gin.H{
+		"url":        u.String(),
+		"webcal_url": "webcal://" + u.Host + u.RequestURI(),
+	}})
+}
+
+func (h *ReviewSession) PublicCalendar(c *gin.Context) {
+	project := c.Param("project")
+	if err := h.uc.CheckFeedToken(project, c.Query("token")); err != nil {
+		if errors.Is(err, entity.ErrReviewSessionFeedDisabled) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, err, nil)
+			return
+		}
+		abortWithError(c, http.StatusForbidden, CodeForbidden, err, nil)
+		return
+	}
+	h.writeCalendar(c, project)
+}
@@ -0,0 +1,181 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewStatus.go
+
+	Module Description:
+		HTTP delivery handlers for the project status vocabulary and its summary.
+
+	Details:
+	- GET    /projects/:project/review-statuses?type=approval|work
+	- POST   /projects/:project/review-statuses
+	         {"type": "approval", "key": "retake", "label": "Retake", "color": "#e5534b",
+	          "order": 30}
+	- PATCH  /projects/:project/review-statuses/:id   {"label": "...", "color": "...", "order": 40}
+	- DELETE /projects/:project/review-statuses/:id
+	- GET    /projects/:project/review-statuses/summary?root=assets
+	         Latest review info per asset phase counted by status, grouped by the
+	         vocabulary: {"approval": [{"key", "label", "color", "total", "by_phase"}], "work": [...]}
+	- type and key cannot be changed; a duplicate key answers 409.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Writes answer 403 to callers below supervisor.
+
+	Functions:
+		* NewReviewStatus: Creates a new ReviewStatus handler.
+		* (ReviewStatus) List: Lists the statuses of a project.
+		* (ReviewStatus) Post: Adds a status.
+		* (ReviewStatus) Update: Changes the label, color or order of a status.
+		* (ReviewStatus) Delete: Removes a status.
+		* (ReviewStatus) Summary: Counts latest review infos per status.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewStatus(
+	uc *usecase.ReviewStatus,
+) *ReviewStatus {
+	return &ReviewStatus{
+		uc: uc,
+	}
+}
+
+type ReviewStatus struct {
+	uc *usecase.ReviewStatus
+}
+
+func (h *ReviewStatus) List(c *gin.Context) {
+	entities, err := h.uc.List(c.Request.Context(), &entity.ListReviewStatusParams{
+		Project: c.Param("project"),
+		Type:    c.Query("type"),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, map[string]interface{}{
+		"review_statuses": entities,
+	})
+}
+
+type createReviewStatusParams struct {
+	Type  string `json:"type" binding:"required,oneof=approval work"`
+	Key   string `json:"key" binding:"required,max=64"`
+	Label string `json:"label" binding:"required,max=255"`
+	Color string `json:"color" binding:"omitempty,hexcolor"`
+	Order int    `json:"order"`
+}
+
+func (h *ReviewStatus) Post(c *gin.Context) {
+	var p createReviewStatusParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Create(c.Request.Context(), &entity.CreateReviewStatusParams{
+		Project: c.Param("project"),
+		Type:    p.Type,
+		Key:     p.Key,
+		Label:   p.Label,
+		Color:   p.Color,
+		Order:   p.Order,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrDuplicateReviewStatus) {
+			abortWithError(c, http.StatusConflict, CodeConflict, err, nil)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+type updateReviewStatusParams struct {
+	Label *string `json:"label" binding:"omitempty,min=1,max=255"`
+	Color *string `json:"color" binding:"omitempty,hexcolor"`
+	Order *int    `json:"order"`
+}
+
+func (h *ReviewStatus) Update(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	var p updateReviewStatusParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Update(c.Request.Context(), &entity.UpdateReviewStatusParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+		Label:   p.Label,
+		Color:   p.Color,
+		Order:   p.Order,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review status with ID %d not found", id))
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ReviewStatus) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.DeleteReviewStatusParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	}
+	if err := h.uc.Delete(c.Request.Context(), params); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review status with ID %d not found", params.ID))
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *ReviewStatus) Summary(c *gin.Context) {
+	e, err := h.uc.Summary(c.Request.Context(), &entity.ReviewStatusSummaryParams{
+		Project: c.Param("project"),
+		Root:    c.Query("root"),
+		Role:    authRole(c),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
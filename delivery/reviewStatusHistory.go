@@ -0,0 +1,70 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewStatusHistory.go
+
+	Module Description:
+		HTTP delivery handler for the status change timeline of a review info.
+
+	Details:
+	- GET /projects/:project/reviews/:id/history?field=
+	  * field narrows the timeline to approval_status or work_status.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* NewReviewStatusHistory: Creates a new ReviewStatusHistory handler.
+		* (ReviewStatusHistory) List: Returns the status changes of a review info, oldest first.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewStatusHistory(
+	uc *usecase.ReviewStatusHistory,
+) *ReviewStatusHistory {
+	return &ReviewStatusHistory{
+		uc: uc,
+	}
+}
+
+type ReviewStatusHistory struct {
+	uc *usecase.ReviewStatusHistory
+}
+
+func (h *ReviewStatusHistory) List(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.ListReviewStatusHistoryParams{
+		Project:      c.Param("project"),
+		ReviewInfoID: int32(id),
+	}
+	if field, ok := c.GetQuery("field"); ok {
+		if field != entity.ReviewActivityFieldApprovalStatus &&
+			field != entity.ReviewActivityFieldWorkStatus {
+			badRequest(c, fmt.Errorf("field must be approval_status or work_status"))
+			return
+		}
+		params.Field = &field
+	}
+	entities, err := h.uc.History(c.Request.Context(), params)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, map[string]interface{}{
+		"history": entities,
+	})
+}
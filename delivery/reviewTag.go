@@ -0,0 +1,107 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewTag.go
+
+	Module Description:
+		HTTP delivery handlers for the tags of asset relations.
+
+	Details:
+	- GET    /projects/:project/reviews/tags                              (tags with asset counts)
+	- GET    /projects/:project/reviews/assets/:asset/:relation/tags
+	- POST   /projects/:project/reviews/assets/:asset/:relation/tags
+	         {"tags": ["hero", "crowd"]}
+	- DELETE /projects/:project/reviews/assets/:asset/:relation/tags/:tag
+	- Pivot rows carry tags; List, ListAssets and ListAssetsPivot accept tags=hero,crowd.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* (ReviewInfo) ListProjectTags: Lists the tags of a project with their asset counts.
+		* (ReviewInfo) ListTags: Lists the tags of an asset relation.
+		* (ReviewInfo) AddTags: Adds tags to an asset relation.
+		* (ReviewInfo) RemoveTag: Removes a tag from an asset relation.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin"
+)
+
+func (h *ReviewInfo) ListProjectTags(c *gin.Context) {
+	tags, err := h.uc.ListProjectTags(c.Request.Context(), &entity.ListProjectReviewTagsParams{
+		Project: c.Param("project"),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+func (h *ReviewInfo) ListTags(c *gin.Context) {
+	tags, err := h.uc.ListTags(c.Request.Context(), &entity.ListReviewTagsParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+type addReviewTagsParams struct {
+	Tags []string `json:"tags" binding:"required"`
+}
+
+func (h *ReviewInfo) AddTags(c *gin.Context) {
+	var p addReviewTagsParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	tags, err := h.uc.AddTags(c.Request.Context(), &entity.AddReviewTagsParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		Tags:     p.Tags,
+		TaggedBy: authUser(c),
+	})
+	if err != nil {
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"tags": tags})
+}
+
+func (h *ReviewInfo) RemoveTag(c *gin.Context) {
+	tag := c.Param("tag")
+	if err := h.uc.RemoveTag(c.Request.Context(), &entity.RemoveReviewTagParams{
+		Project:  c.Param("project"),
+		Asset:    c.Param("asset"),
+		Relation: c.Param("relation"),
+		Tag:      tag,
+	}); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			abortWithError(c, http.StatusNotFound, CodeNotFound, fmt.Errorf("no tag %s", tag), nil)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,111 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewValidation.go
+
+	Module Description:
+		HTTP delivery handlers for per-project review info validation rules.
+
+	Details:
+	- GET /projects/:project/reviews/validation-rules
+	- PUT /projects/:project/reviews/validation-rules
+	  {"phases": ["mdl", "rig"], "approval_statuses": ["check", "retake", "approved"],
+	   "work_statuses": [], "updated_by": "..."}
+	- An empty or omitted list allows any value. Values compare case-insensitively.
+	- Create/Update of a review info breaking the rules is answered with 422 and the
+	  rejected fields in details.fields.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Writes answer 403 to callers below supervisor.
+
+	Functions:
+		* NewReviewValidation: Creates a new ReviewValidation handler.
+		* (ReviewValidation) Get: Returns the rules of a project.
+		* (ReviewValidation) Put: Replaces the rules of a project.
+		* validationFailed – utility function: Writes 422 for a *entity.ValidationError.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewValidation(
+	uc *usecase.ReviewValidation,
+) *ReviewValidation {
+	return &ReviewValidation{
+		uc: uc,
+	}
+}
+
+type ReviewValidation struct {
+	uc *usecase.ReviewValidation
+}
+
+// validationFailed writes 422 Unprocessable Entity with the rejected fields when err is
+// a validation failure.
+func validationFailed(c *gin.Context, err error) bool {
+	var validationErr *entity.ValidationError
+	if !errors.As(err, &validationErr) {
+		return false
+	}
+	abortWithError(c, http.StatusUnprocessableEntity, CodeValidationFailed, validationErr, gin.H{
+		"fields": validationErr.Fields,
+	})
+	return true
+}
+
+func (h *ReviewValidation) Get(c *gin.Context) {
+	e, err := h.uc.Get(c.Request.Context(), &entity.GetReviewValidationRulesParams{
+		Project: c.Param("project"),
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			c.PureJSON(http.StatusOK, gin.H{"validation_rules": nil})
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"validation_rules": e})
+}
+
+type putReviewValidationRulesParams struct {
+	Phases           []string `json:"phases"`
+	ApprovalStatuses []string `json:"approval_statuses"`
+	WorkStatuses     []string `json:"work_statuses"`
+	UpdatedBy        string   `json:"updated_by"`
+}
+
+func (h *ReviewValidation) Put(c *gin.Context) {
+	var p putReviewValidationRulesParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Put(c.Request.Context(), &entity.PutReviewValidationRulesParams{
+		Project:          c.Param("project"),
+		Phases:           p.Phases,
+		ApprovalStatuses: p.ApprovalStatuses,
+		WorkStatuses:     p.WorkStatuses,
+		UpdatedBy:        p.UpdatedBy,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, err)
+			return
+		}
+		if forbidden(c, err) {
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"validation_rules": e})
+}
@@ -0,0 +1,112 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/reviewWebhook.go
+
+	Module Description:
+		HTTP delivery handlers for review event webhook registrations.
+
+	Details:
+	- GET    /projects/:project/reviewWebhooks
+	- POST   /projects/:project/reviewWebhooks
+	         {"url": "https://...", "event_types": ["review.approval_status_changed"],
+	          "secret": "...", "created_by": "..."}
+	- DELETE /projects/:project/reviewWebhooks/:id
+	- Event types: review.created, review.updated, review.deleted, review.restored,
+	  review.approval_status_changed. The secret is write-only.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - Accept review.restored subscriptions.
+
+	Functions:
+		* NewReviewWebhook: Creates a new ReviewWebhook handler.
+		* (ReviewWebhook) List: Lists the webhooks of a project.
+		* (ReviewWebhook) Post: Registers a webhook.
+		* (ReviewWebhook) Delete: Removes a webhook.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+func NewReviewWebhook(
+	uc *usecase.ReviewWebhook,
+) *ReviewWebhook {
+	return &ReviewWebhook{
+		uc: uc,
+	}
+}
+
+type ReviewWebhook struct {
+	uc *usecase.ReviewWebhook
+}
+
+func (h *ReviewWebhook) List(c *gin.Context) {
+	entities, err := h.uc.List(c.Request.Context(), &entity.ListReviewWebhookParams{
+		Project: c.Param("project"),
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, map[string]interface{}{
+		"review_webhooks": entities,
+	})
+}
+
+type createReviewWebhookParams struct {
+	URL        string   `json:"url" binding:"required,url,max=1024"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,dive,oneof=review.created review.updated review.deleted review.restored review.approval_status_changed"`
+	Secret     string   `json:"secret" binding:"required,min=16,max=255"`
+	CreatedBy  string   `json:"created_by"`
+}
+
+func (h *ReviewWebhook) Post(c *gin.Context) {
+	var p createReviewWebhookParams
+	if err := c.ShouldBindJSON(&p); err != nil {
+		badRequest(c, err)
+		return
+	}
+	e, err := h.uc.Create(c.Request.Context(), &entity.CreateReviewWebhookParams{
+		Project:    c.Param("project"),
+		URL:        p.URL,
+		EventTypes: p.EventTypes,
+		Secret:     p.Secret,
+		CreatedBy:  p.CreatedBy,
+	})
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, e)
+}
+
+func (h *ReviewWebhook) Delete(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	params := &entity.DeleteReviewWebhookParams{
+		Project: c.Param("project"),
+		ID:      int32(id),
+	}
+	if err := h.uc.Delete(c.Request.Context(), params); err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			badRequest(c, fmt.Errorf("review webhook with ID %d not found", params.ID))
+			return
+		}
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
@@ -0,0 +1,385 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/rpc/review.go
+
+	Module Description:
+		gRPC ReviewService: the asset pivot, reviews, status updates and review events.
+
+	Details:
+	- ListAssetsPivot takes the filters of the HTTP pivot under the same names and
+	  applies the project's query limits the same way; the usecase applies the pivot
+	  timeout, or the client's deadline when it sent one. The caller's role comes from
+	  the project membership of the call's user, as RoleFromMembership resolves it for
+	  HTTP, so category access restricts both APIs alike.
+	- UpdateStatus is the status part of PATCH /reviews/:id: the status fields, the
+	  expected version (as the ETag) and the caller's user; the usecase checks roles,
+	  locks and validation rules.
+	- WatchReviews sends the project's review events (ReviewWebhook.Subscribe) until the
+	  client cancels or the server stops.
+	- Usecase errors map to status codes: not found → NotFound, bad filters →
+	  InvalidArgument, roles → PermissionDenied, locks and validation →
+	  FailedPrecondition, version conflicts → Aborted, timeouts → DeadlineExceeded.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Leave the pivot deadline to the usecase.
+	* - 15-10-2026 - Resolve the caller's role for category access in ListAssetsPivot.
+
+	Functions:
+	* - NewReview: Creates the ReviewService server.
+	* - (Review) ListAssetsPivot / GetReview / UpdateStatus / WatchReviews: The RPCs.
+	────────────────────────────────────────────────────────────────────────── */
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/delivery/rpc/reviewpb"
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type Review struct {
+	reviewpb.UnimplementedReviewServiceServer
+	uc      *usecase.ReviewInfo
+	members *usecase.ProjectMember
+	events  *usecase.ReviewWebhook
+}
+
+func NewReview(
+	uc *usecase.ReviewInfo,
+	members *usecase.ProjectMember,
+	events *usecase.ReviewWebhook,
+) *Review {
+	return &Review{uc: uc, members: members, events: events}
+}
+
+func (s *Review) ListAssetsPivot(
+	ctx context.Context,
+	req *reviewpb.ListAssetsPivotRequest,
+) (*reviewpb.ListAssetsPivotResponse, error) {
+	project := strings.TrimSpace(req.GetProject())
+	if project == "" {
+		return nil, status.Error(codes.InvalidArgument, "project is required")
+	}
+	root := req.GetRoot()
+	if root == "" {
+		root = "assets"
+	}
+	phase := req.GetPhase()
+	if phase == "" {
+		phase = "none"
+	}
+	page := int(req.GetPage())
+	if page < 1 {
+		page = 1
+	}
+	groupPage := int(req.GetGroupPage())
+	if groupPage < 1 {
+		groupPage = 1
+	}
+	var asOf *time.Time
+	if req.GetAsOf() != nil {
+		t := req.GetAsOf().AsTime()
+		asOf = &t
+	}
+	var groups []string
+	for _, g := range req.GetGroups() {
+		if strings.EqualFold(g, "Unassigned") {
+			g = ""
+		}
+		groups = append(groups, g)
+	}
+	role, err := s.members.Role(ctx, project, entity.UserFromContext(ctx))
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	result, err := s.uc.ListAssetsPivot(ctx, usecase.ListAssetsPivotParams{
+		Project:              project,
+		Root:                 root,
+		PreferredPhase:       phase,
+		OrderKey:             req.GetSort(),
+		Direction:            req.GetDir(),
+		Page:                 page,
+		PerPage:              int(req.GetPerPage()),
+		Cursor:               req.GetCursor(),
+		AssetNameKey:         req.GetName(),
+		ApprovalStatuses:     req.GetApprovalStatuses(),
+		WorkStatuses:         req.GetWorkStatuses(),
+		SubmittedUsers:       req.GetSubmittedUsers(),
+		ApprovalUpdatedUsers: req.GetApprovalUpdatedUsers(),
+		Studios:              req.GetStudios(),
+		Relations:            req.GetRelations(),
+		RelationMode:         req.GetRelationMode(),
+		Tags:                 req.GetTags(),
+		OverallStatuses:      req.GetOverallStatuses(),
+		Overdue:              req.GetOverdue(),
+		View:                 req.GetView(),
+		AsOf:                 asOf,
+		GroupPage:            groupPage,
+		GroupPerPage:         int(req.GetGroupPerPage()),
+		Groups:               groups,
+		Fields:               req.GetFields(),
+		SkipCount:            req.GetSkipCount(),
+		Studio:               true,
+		Role:                 role,
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	pg := result.Pagination()
+	res := &reviewpb.ListAssetsPivotResponse{
+		Pagination: &reviewpb.Pagination{
+			Page:     int32(pg.Page),
+			PerPage:  int32(pg.PerPage),
+			Total:    pg.Total,
+			PageLast: int32(pg.PageLast),
+			HasNext:  pg.HasNext,
+			HasPrev:  pg.HasPrev,
+		},
+		TotalIsEstimate: result.TotalIsEstimate,
+		NextCursor:      result.NextCursor,
+		Sort:            result.Sort,
+		Dir:             result.Dir,
+		View:            result.View,
+	}
+	for i := range result.Assets {
+		res.Assets = append(res.Assets, assetMessage(&result.Assets[i]))
+	}
+	for _, b := range result.Groups {
+		res.Groups = append(res.Groups, groupMessage(b))
+	}
+	return res, nil
+}
+
+func (s *Review) GetReview(ctx context.Context, req *reviewpb.GetReviewRequest) (*reviewpb.Review, error) {
+	e, err := s.uc.Get(ctx, &entity.GetReviewParams{
+		Project: req.GetProject(),
+		ID:      req.GetId(),
+	})
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return reviewMessage(e), nil
+}
+
+func (s *Review) UpdateStatus(ctx context.Context, req *reviewpb.UpdateStatusRequest) (*reviewpb.Review, error) {
+	if req.ApprovalStatus == nil && req.WorkStatus == nil {
+		return nil, status.Error(codes.InvalidArgument, "approval_status or work_status is required")
+	}
+	params := &entity.UpdateReviewInfoParams{
+		ApprovalStatus: req.ApprovalStatus,
+		WorkStatus:     req.WorkStatus,
+		Project:        req.GetProject(),
+		ID:             req.GetId(),
+	}
+	if user := entity.UserFromContext(ctx); user != "" {
+		if params.ApprovalStatus != nil {
+			params.ApprovalStatusUpdatedUser = &user
+		}
+		if params.WorkStatus != nil {
+			params.WorkStatusUpdatedUser = &user
+		}
+		params.ModifiedBy = &user
+	}
+	ctx = entity.WithExpectedReviewVersion(ctx, req.GetVersion())
+	e, err := s.uc.Update(ctx, params)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return reviewMessage(e), nil
+}
+
+func (s *Review) WatchReviews(
+	req *reviewpb.WatchReviewsRequest,
+	stream reviewpb.ReviewService_WatchReviewsServer,
+) error {
+	if req.GetProject() == "" {
+		return status.Error(codes.InvalidArgument, "project is required")
+	}
+	types := map[string]bool{}
+	for _, t := range req.GetEventTypes() {
+		types[t] = true
+	}
+	events, unsubscribe := s.events.Subscribe(req.GetProject())
+	defer unsubscribe()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if len(types) > 0 && !types[event.Type] {
+				continue
+			}
+			if err := stream.Send(&reviewpb.ReviewEvent{
+				Id:                     event.ID,
+				Type:                   event.Type,
+				Project:                event.Project,
+				OccurredAt:             timestamppb.New(event.OccurredAtUtc),
+				Review:                 reviewMessage(event.Review),
+				PreviousApprovalStatus: event.PreviousApprovalStatus,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// statusError maps a usecase error to its gRPC status.
+func statusError(err error) error {
+	var (
+		deniedErr   *entity.PermissionDeniedError
+		lockedErr   *entity.ReviewLockedError
+		invalidErr  *entity.ValidationError
+		conflictErr *entity.ReviewVersionConflictError
+	)
+	switch {
+	case errors.Is(err, entity.ErrRecordNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, entity.ErrInvalidPivotCursor), errors.Is(err, entity.ErrInvalidPivotFields),
+		errors.Is(err, entity.ErrUnknownReviewStatus), errors.Is(err, entity.ErrPivotViewNotAllowed):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &deniedErr):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.As(err, &lockedErr), errors.As(err, &invalidErr):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.As(err, &conflictErr):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+func timestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func reviewMessage(e *entity.ReviewInfo) *reviewpb.Review {
+	if e == nil {
+		return nil
+	}
+	return &reviewpb.Review{
+		Id:                        e.ID,
+		Project:                   e.Project,
+		Studio:                    e.Studio,
+		Root:                      e.Root,
+		Groups:                    e.Groups,
+		Relation:                  e.Relation,
+		Phase:                     e.Phase,
+		Component:                 e.Component,
+		Take:                      e.Take,
+		TakePath:                  e.TakePath,
+		ApprovalStatus:            e.ApprovalStatus,
+		ApprovalStatusUpdatedUser: e.ApprovalStatusUpdatedUser,
+		ApprovalStatusUpdatedAt:   timestamp(&e.ApprovalStatusUpdatedAtUtc),
+		WorkStatus:                e.WorkStatus,
+		WorkStatusUpdatedUser:     e.WorkStatusUpdatedUser,
+		WorkStatusUpdatedAt:       timestamp(&e.WorkStatusUpdatedAtUtc),
+		SubmittedUser:             e.SubmittedUser,
+		SubmittedAt:               timestamp(&e.SubmittedAtUtc),
+		ModifiedAt:                timestamp(&e.ModifiedAtUTC),
+		Version:                   entity.ReviewInfoVersion(e),
+	}
+}
+
+func groupMessage(b repository.GroupedAssetBucket) *reviewpb.AssetGroup {
+	g := &reviewpb.AssetGroup{
+		TopGroupNode: b.TopGroupNode,
+		Path:         b.Path,
+		ItemCount:    int32(b.ItemCount),
+	}
+	if b.TotalCount != nil {
+		g.TotalCount = int64(*b.TotalCount)
+	}
+	for i := range b.Items {
+		g.Items = append(g.Items, assetMessage(&b.Items[i]))
+	}
+	for _, child := range b.Children {
+		g.Children = append(g.Children, groupMessage(child))
+	}
+	return g
+}
+
+func assetMessage(a *repository.AssetPivot) *reviewpb.Asset {
+	m := &reviewpb.Asset{
+		Root:              a.Root,
+		Project:           a.Project,
+		Group_1:           a.Group1,
+		Group_2:           a.Group2,
+		Group_3:           a.Group3,
+		Relation:          a.Relation,
+		Component:         a.Component,
+		LeafGroupName:     a.LeafGroupName,
+		GroupCategoryPath: a.GroupCategoryPath,
+		TopGroupNode:      a.TopGroupNode,
+		Phases:            map[string]*reviewpb.PhaseCell{},
+		OverallStatus:     a.OverallStatus,
+		AttentionScore:    a.AttentionScore,
+		Priority:          a.Priority,
+		Tags:              a.Tags,
+		RequiredPhases:    a.RequiredPhases,
+		Overdue:           a.Overdue,
+	}
+	if a.NextDueDate != nil {
+		m.NextDueDate = *a.NextDueDate
+	}
+	cells := []struct {
+		phase                string
+		work, approval, take *string
+		submitted            *time.Time
+	}{
+		{"mdl", a.MDLWorkStatus, a.MDLApprovalStatus, a.MDLTake, a.MDLSubmittedAtUTC},
+		{"rig", a.RIGWorkStatus, a.RIGApprovalStatus, a.RIGTake, a.RIGSubmittedAtUTC},
+		{"bld", a.BLDWorkStatus, a.BLDApprovalStatus, a.BLDTake, a.BLDSubmittedAtUTC},
+		{"dsn", a.DSNWorkStatus, a.DSNApprovalStatus, a.DSNTake, a.DSNSubmittedAtUTC},
+		{"ldv", a.LDVWorkStatus, a.LDVApprovalStatus, a.LDVTake, a.LDVSubmittedAtUTC},
+	}
+	for _, c := range cells {
+		id, submitted := a.ReviewInfoIDs[c.phase]
+		if !submitted && c.work == nil && c.approval == nil {
+			continue
+		}
+		cell := &reviewpb.PhaseCell{
+			WorkStatus:     deref(c.work),
+			ApprovalStatus: deref(c.approval),
+			SubmittedAt:    timestamp(c.submitted),
+			Take:           deref(c.take),
+			ReviewInfoId:   id,
+			Studio:         a.Studios[c.phase],
+			DueDate:        a.DueDates[c.phase],
+		}
+		if lock := a.Locks[c.phase]; lock != nil {
+			cell.LockedBy = lock.Holder
+		}
+		m.Phases[c.phase] = cell
+	}
+	return m
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,1523 @@
+// Review service for internal consumers (render farm integration).
+//
+// The service shares the usecase layer with the HTTP API: ListAssetsPivot answers
+// what GET /projects/:project/reviews/assets/pivot does (list and grouped views),
+// GetReview and UpdateStatus what GET and PATCH /projects/:project/reviews/:id do
+// for the status fields, and WatchReviews streams the events the webhooks receive.
+//
+// Generated code lives in delivery/rpc/reviewpb; regenerate it with
+//   protoc --go_out=. --go_opt=module=github.com/PolygonPictures/central30-web/front \
+//          --go-grpc_out=. --go-grpc_opt=module=github.com/PolygonPictures/central30-web/front \
+//          proto/review/v1/review.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.28.3
+// source: proto/review/v1/review.proto
+
+package reviewpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListAssetsPivotRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Project              string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Root                 string                 `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"` // default "assets"
+	View                 string                 `protobuf:"bytes,3,opt,name=view,proto3" json:"view,omitempty"` // list | grouped; default from the project's pivot defaults
+	Sort                 string                 `protobuf:"bytes,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	Dir                  string                 `protobuf:"bytes,5,opt,name=dir,proto3" json:"dir,omitempty"` // asc | desc
+	Page                 int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              int32                  `protobuf:"varint,7,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Cursor               string                 `protobuf:"bytes,8,opt,name=cursor,proto3" json:"cursor,omitempty"` // next_cursor of a previous response; overrides page
+	Name                 string                 `protobuf:"bytes,9,opt,name=name,proto3" json:"name,omitempty"`     // asset name filter
+	Phase                string                 `protobuf:"bytes,10,opt,name=phase,proto3" json:"phase,omitempty"`  // preferred phase
+	ApprovalStatuses     []string               `protobuf:"bytes,11,rep,name=approval_statuses,json=approvalStatuses,proto3" json:"approval_statuses,omitempty"`
+	WorkStatuses         []string               `protobuf:"bytes,12,rep,name=work_statuses,json=workStatuses,proto3" json:"work_statuses,omitempty"`
+	SubmittedUsers       []string               `protobuf:"bytes,13,rep,name=submitted_users,json=submittedUsers,proto3" json:"submitted_users,omitempty"`
+	ApprovalUpdatedUsers []string               `protobuf:"bytes,14,rep,name=approval_updated_users,json=approvalUpdatedUsers,proto3" json:"approval_updated_users,omitempty"`
+	Studios              []string               `protobuf:"bytes,15,rep,name=studios,proto3" json:"studios,omitempty"`
+	Relations            []string               `protobuf:"bytes,16,rep,name=relations,proto3" json:"relations,omitempty"`
+	RelationMode         string                 `protobuf:"bytes,17,opt,name=relation_mode,json=relationMode,proto3" json:"relation_mode,omitempty"` // exact | prefix
+	Tags                 []string               `protobuf:"bytes,18,rep,name=tags,proto3" json:"tags,omitempty"`
+	OverallStatuses      []string               `protobuf:"bytes,19,rep,name=overall_statuses,json=overallStatuses,proto3" json:"overall_statuses,omitempty"`
+	Overdue              bool                   `protobuf:"varint,20,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	Fields               []string               `protobuf:"bytes,21,rep,name=fields,proto3" json:"fields,omitempty"` // phases to return; empty = all
+	SkipCount            bool                   `protobuf:"varint,22,opt,name=skip_count,json=skipCount,proto3" json:"skip_count,omitempty"`
+	AsOf                 *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	GroupPage            int32                  `protobuf:"varint,24,opt,name=group_page,json=groupPage,proto3" json:"group_page,omitempty"`
+	GroupPerPage         int32                  `protobuf:"varint,25,opt,name=group_per_page,json=groupPerPage,proto3" json:"group_per_page,omitempty"`
+	Groups               []string               `protobuf:"bytes,26,rep,name=groups,proto3" json:"groups,omitempty"` // top group nodes to keep
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ListAssetsPivotRequest) Reset() {
+	*x = ListAssetsPivotRequest{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetsPivotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetsPivotRequest) ProtoMessage() {}
+
+func (x *ListAssetsPivotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetsPivotRequest.ProtoReflect.Descriptor instead.
+func (*ListAssetsPivotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListAssetsPivotRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAssetsPivotRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListAssetsPivotRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetApprovalStatuses() []string {
+	if x != nil {
+		return x.ApprovalStatuses
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetWorkStatuses() []string {
+	if x != nil {
+		return x.WorkStatuses
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetSubmittedUsers() []string {
+	if x != nil {
+		return x.SubmittedUsers
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetApprovalUpdatedUsers() []string {
+	if x != nil {
+		return x.ApprovalUpdatedUsers
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetStudios() []string {
+	if x != nil {
+		return x.Studios
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetRelations() []string {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetRelationMode() string {
+	if x != nil {
+		return x.RelationMode
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetOverallStatuses() []string {
+	if x != nil {
+		return x.OverallStatuses
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *ListAssetsPivotRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetSkipCount() bool {
+	if x != nil {
+		return x.SkipCount
+	}
+	return false
+}
+
+func (x *ListAssetsPivotRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotRequest) GetGroupPage() int32 {
+	if x != nil {
+		return x.GroupPage
+	}
+	return 0
+}
+
+func (x *ListAssetsPivotRequest) GetGroupPerPage() int32 {
+	if x != nil {
+		return x.GroupPerPage
+	}
+	return 0
+}
+
+func (x *ListAssetsPivotRequest) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type ListAssetsPivotResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Assets          []*Asset               `protobuf:"bytes,1,rep,name=assets,proto3" json:"assets,omitempty"` // list view; grouped view: the assets of groups
+	Groups          []*AssetGroup          `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	Pagination      *Pagination            `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	TotalIsEstimate bool                   `protobuf:"varint,4,opt,name=total_is_estimate,json=totalIsEstimate,proto3" json:"total_is_estimate,omitempty"`
+	NextCursor      string                 `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	Sort            string                 `protobuf:"bytes,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	Dir             string                 `protobuf:"bytes,7,opt,name=dir,proto3" json:"dir,omitempty"`
+	View            string                 `protobuf:"bytes,8,opt,name=view,proto3" json:"view,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListAssetsPivotResponse) Reset() {
+	*x = ListAssetsPivotResponse{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAssetsPivotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAssetsPivotResponse) ProtoMessage() {}
+
+func (x *ListAssetsPivotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAssetsPivotResponse.ProtoReflect.Descriptor instead.
+func (*ListAssetsPivotResponse) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAssetsPivotResponse) GetAssets() []*Asset {
+	if x != nil {
+		return x.Assets
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotResponse) GetGroups() []*AssetGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListAssetsPivotResponse) GetTotalIsEstimate() bool {
+	if x != nil {
+		return x.TotalIsEstimate
+	}
+	return false
+}
+
+func (x *ListAssetsPivotResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotResponse) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotResponse) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *ListAssetsPivotResponse) GetView() string {
+	if x != nil {
+		return x.View
+	}
+	return ""
+}
+
+type Pagination struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	PageLast      int32                  `protobuf:"varint,4,opt,name=page_last,json=pageLast,proto3" json:"page_last,omitempty"` // 0 when the total was not counted
+	HasNext       bool                   `protobuf:"varint,5,opt,name=has_next,json=hasNext,proto3" json:"has_next,omitempty"`
+	HasPrev       bool                   `protobuf:"varint,6,opt,name=has_prev,json=hasPrev,proto3" json:"has_prev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pagination) Reset() {
+	*x = Pagination{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pagination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pagination) ProtoMessage() {}
+
+func (x *Pagination) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Pagination) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *Pagination) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *Pagination) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Pagination) GetPageLast() int32 {
+	if x != nil {
+		return x.PageLast
+	}
+	return 0
+}
+
+func (x *Pagination) GetHasNext() bool {
+	if x != nil {
+		return x.HasNext
+	}
+	return false
+}
+
+func (x *Pagination) GetHasPrev() bool {
+	if x != nil {
+		return x.HasPrev
+	}
+	return false
+}
+
+type AssetGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TopGroupNode  string                 `protobuf:"bytes,1,opt,name=top_group_node,json=topGroupNode,proto3" json:"top_group_node,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"` // category path in the nested tree
+	ItemCount     int32                  `protobuf:"varint,3,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,4,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Items         []*Asset               `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	Children      []*AssetGroup          `protobuf:"bytes,6,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssetGroup) Reset() {
+	*x = AssetGroup{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssetGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssetGroup) ProtoMessage() {}
+
+func (x *AssetGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssetGroup.ProtoReflect.Descriptor instead.
+func (*AssetGroup) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AssetGroup) GetTopGroupNode() string {
+	if x != nil {
+		return x.TopGroupNode
+	}
+	return ""
+}
+
+func (x *AssetGroup) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AssetGroup) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *AssetGroup) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *AssetGroup) GetItems() []*Asset {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AssetGroup) GetChildren() []*AssetGroup {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type Asset struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Root              string                 `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Project           string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Group_1           string                 `protobuf:"bytes,3,opt,name=group_1,json=group1,proto3" json:"group_1,omitempty"`
+	Group_2           string                 `protobuf:"bytes,4,opt,name=group_2,json=group2,proto3" json:"group_2,omitempty"`
+	Group_3           string                 `protobuf:"bytes,5,opt,name=group_3,json=group3,proto3" json:"group_3,omitempty"`
+	Relation          string                 `protobuf:"bytes,6,opt,name=relation,proto3" json:"relation,omitempty"`
+	Component         string                 `protobuf:"bytes,7,opt,name=component,proto3" json:"component,omitempty"`
+	LeafGroupName     string                 `protobuf:"bytes,8,opt,name=leaf_group_name,json=leafGroupName,proto3" json:"leaf_group_name,omitempty"`
+	GroupCategoryPath string                 `protobuf:"bytes,9,opt,name=group_category_path,json=groupCategoryPath,proto3" json:"group_category_path,omitempty"`
+	TopGroupNode      string                 `protobuf:"bytes,10,opt,name=top_group_node,json=topGroupNode,proto3" json:"top_group_node,omitempty"`
+	Phases            map[string]*PhaseCell  `protobuf:"bytes,11,rep,name=phases,proto3" json:"phases,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // keyed by phase (mdl, rig, bld, dsn, ldv)
+	OverallStatus     string                 `protobuf:"bytes,12,opt,name=overall_status,json=overallStatus,proto3" json:"overall_status,omitempty"`
+	AttentionScore    float64                `protobuf:"fixed64,13,opt,name=attention_score,json=attentionScore,proto3" json:"attention_score,omitempty"`
+	Priority          bool                   `protobuf:"varint,14,opt,name=priority,proto3" json:"priority,omitempty"`
+	Tags              []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"`
+	RequiredPhases    []string               `protobuf:"bytes,16,rep,name=required_phases,json=requiredPhases,proto3" json:"required_phases,omitempty"`
+	NextDueDate       string                 `protobuf:"bytes,17,opt,name=next_due_date,json=nextDueDate,proto3" json:"next_due_date,omitempty"` // YYYY-MM-DD
+	Overdue           bool                   `protobuf:"varint,18,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Asset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Asset) ProtoMessage() {}
+
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Asset) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *Asset) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Asset) GetGroup_1() string {
+	if x != nil {
+		return x.Group_1
+	}
+	return ""
+}
+
+func (x *Asset) GetGroup_2() string {
+	if x != nil {
+		return x.Group_2
+	}
+	return ""
+}
+
+func (x *Asset) GetGroup_3() string {
+	if x != nil {
+		return x.Group_3
+	}
+	return ""
+}
+
+func (x *Asset) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *Asset) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *Asset) GetLeafGroupName() string {
+	if x != nil {
+		return x.LeafGroupName
+	}
+	return ""
+}
+
+func (x *Asset) GetGroupCategoryPath() string {
+	if x != nil {
+		return x.GroupCategoryPath
+	}
+	return ""
+}
+
+func (x *Asset) GetTopGroupNode() string {
+	if x != nil {
+		return x.TopGroupNode
+	}
+	return ""
+}
+
+func (x *Asset) GetPhases() map[string]*PhaseCell {
+	if x != nil {
+		return x.Phases
+	}
+	return nil
+}
+
+func (x *Asset) GetOverallStatus() string {
+	if x != nil {
+		return x.OverallStatus
+	}
+	return ""
+}
+
+func (x *Asset) GetAttentionScore() float64 {
+	if x != nil {
+		return x.AttentionScore
+	}
+	return 0
+}
+
+func (x *Asset) GetPriority() bool {
+	if x != nil {
+		return x.Priority
+	}
+	return false
+}
+
+func (x *Asset) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Asset) GetRequiredPhases() []string {
+	if x != nil {
+		return x.RequiredPhases
+	}
+	return nil
+}
+
+func (x *Asset) GetNextDueDate() string {
+	if x != nil {
+		return x.NextDueDate
+	}
+	return ""
+}
+
+func (x *Asset) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+type PhaseCell struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WorkStatus     string                 `protobuf:"bytes,1,opt,name=work_status,json=workStatus,proto3" json:"work_status,omitempty"`
+	ApprovalStatus string                 `protobuf:"bytes,2,opt,name=approval_status,json=approvalStatus,proto3" json:"approval_status,omitempty"`
+	SubmittedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	Take           string                 `protobuf:"bytes,4,opt,name=take,proto3" json:"take,omitempty"`
+	ReviewInfoId   int32                  `protobuf:"varint,5,opt,name=review_info_id,json=reviewInfoId,proto3" json:"review_info_id,omitempty"`
+	Studio         string                 `protobuf:"bytes,6,opt,name=studio,proto3" json:"studio,omitempty"`
+	DueDate        string                 `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`    // YYYY-MM-DD
+	LockedBy       string                 `protobuf:"bytes,8,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"` // holder of an active live-review lock
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PhaseCell) Reset() {
+	*x = PhaseCell{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PhaseCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PhaseCell) ProtoMessage() {}
+
+func (x *PhaseCell) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PhaseCell.ProtoReflect.Descriptor instead.
+func (*PhaseCell) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PhaseCell) GetWorkStatus() string {
+	if x != nil {
+		return x.WorkStatus
+	}
+	return ""
+}
+
+func (x *PhaseCell) GetApprovalStatus() string {
+	if x != nil {
+		return x.ApprovalStatus
+	}
+	return ""
+}
+
+func (x *PhaseCell) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *PhaseCell) GetTake() string {
+	if x != nil {
+		return x.Take
+	}
+	return ""
+}
+
+func (x *PhaseCell) GetReviewInfoId() int32 {
+	if x != nil {
+		return x.ReviewInfoId
+	}
+	return 0
+}
+
+func (x *PhaseCell) GetStudio() string {
+	if x != nil {
+		return x.Studio
+	}
+	return ""
+}
+
+func (x *PhaseCell) GetDueDate() string {
+	if x != nil {
+		return x.DueDate
+	}
+	return ""
+}
+
+func (x *PhaseCell) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
+	}
+	return ""
+}
+
+type GetReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetReviewRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *GetReviewRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateStatusRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Project        string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	Id             int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	ApprovalStatus *string                `protobuf:"bytes,3,opt,name=approval_status,json=approvalStatus,proto3,oneof" json:"approval_status,omitempty"`
+	WorkStatus     *string                `protobuf:"bytes,4,opt,name=work_status,json=workStatus,proto3,oneof" json:"work_status,omitempty"`
+	Version        string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"` // expected review version (as the HTTP ETag); empty skips the check
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateStatusRequest) Reset() {
+	*x = UpdateStatusRequest{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStatusRequest) ProtoMessage() {}
+
+func (x *UpdateStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateStatusRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *UpdateStatusRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateStatusRequest) GetApprovalStatus() string {
+	if x != nil && x.ApprovalStatus != nil {
+		return *x.ApprovalStatus
+	}
+	return ""
+}
+
+func (x *UpdateStatusRequest) GetWorkStatus() string {
+	if x != nil && x.WorkStatus != nil {
+		return *x.WorkStatus
+	}
+	return ""
+}
+
+func (x *UpdateStatusRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type Review struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Id                        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Project                   string                 `protobuf:"bytes,2,opt,name=project,proto3" json:"project,omitempty"`
+	Studio                    string                 `protobuf:"bytes,3,opt,name=studio,proto3" json:"studio,omitempty"`
+	Root                      string                 `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	Groups                    []string               `protobuf:"bytes,5,rep,name=groups,proto3" json:"groups,omitempty"`
+	Relation                  string                 `protobuf:"bytes,6,opt,name=relation,proto3" json:"relation,omitempty"`
+	Phase                     string                 `protobuf:"bytes,7,opt,name=phase,proto3" json:"phase,omitempty"`
+	Component                 string                 `protobuf:"bytes,8,opt,name=component,proto3" json:"component,omitempty"`
+	Take                      string                 `protobuf:"bytes,9,opt,name=take,proto3" json:"take,omitempty"`
+	TakePath                  string                 `protobuf:"bytes,10,opt,name=take_path,json=takePath,proto3" json:"take_path,omitempty"`
+	ApprovalStatus            string                 `protobuf:"bytes,11,opt,name=approval_status,json=approvalStatus,proto3" json:"approval_status,omitempty"`
+	ApprovalStatusUpdatedUser string                 `protobuf:"bytes,12,opt,name=approval_status_updated_user,json=approvalStatusUpdatedUser,proto3" json:"approval_status_updated_user,omitempty"`
+	ApprovalStatusUpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=approval_status_updated_at,json=approvalStatusUpdatedAt,proto3" json:"approval_status_updated_at,omitempty"`
+	WorkStatus                string                 `protobuf:"bytes,14,opt,name=work_status,json=workStatus,proto3" json:"work_status,omitempty"`
+	WorkStatusUpdatedUser     string                 `protobuf:"bytes,15,opt,name=work_status_updated_user,json=workStatusUpdatedUser,proto3" json:"work_status_updated_user,omitempty"`
+	WorkStatusUpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=work_status_updated_at,json=workStatusUpdatedAt,proto3" json:"work_status_updated_at,omitempty"`
+	SubmittedUser             string                 `protobuf:"bytes,17,opt,name=submitted_user,json=submittedUser,proto3" json:"submitted_user,omitempty"`
+	SubmittedAt               *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	ModifiedAt                *timestamppb.Timestamp `protobuf:"bytes,19,opt,name=modified_at,json=modifiedAt,proto3" json:"modified_at,omitempty"`
+	Version                   string                 `protobuf:"bytes,20,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Review) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Review) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *Review) GetStudio() string {
+	if x != nil {
+		return x.Studio
+	}
+	return ""
+}
+
+func (x *Review) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *Review) GetGroups() []string {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *Review) GetRelation() string {
+	if x != nil {
+		return x.Relation
+	}
+	return ""
+}
+
+func (x *Review) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *Review) GetComponent() string {
+	if x != nil {
+		return x.Component
+	}
+	return ""
+}
+
+func (x *Review) GetTake() string {
+	if x != nil {
+		return x.Take
+	}
+	return ""
+}
+
+func (x *Review) GetTakePath() string {
+	if x != nil {
+		return x.TakePath
+	}
+	return ""
+}
+
+func (x *Review) GetApprovalStatus() string {
+	if x != nil {
+		return x.ApprovalStatus
+	}
+	return ""
+}
+
+func (x *Review) GetApprovalStatusUpdatedUser() string {
+	if x != nil {
+		return x.ApprovalStatusUpdatedUser
+	}
+	return ""
+}
+
+func (x *Review) GetApprovalStatusUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ApprovalStatusUpdatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetWorkStatus() string {
+	if x != nil {
+		return x.WorkStatus
+	}
+	return ""
+}
+
+func (x *Review) GetWorkStatusUpdatedUser() string {
+	if x != nil {
+		return x.WorkStatusUpdatedUser
+	}
+	return ""
+}
+
+func (x *Review) GetWorkStatusUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WorkStatusUpdatedAt
+	}
+	return nil
+}
+
+func (x *Review) GetSubmittedUser() string {
+	if x != nil {
+		return x.SubmittedUser
+	}
+	return ""
+}
+
+func (x *Review) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *Review) GetModifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ModifiedAt
+	}
+	return nil
+}
+
+func (x *Review) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type WatchReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       string                 `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // e.g. review.approval_status_changed; empty = all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchReviewsRequest) Reset() {
+	*x = WatchReviewsRequest{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchReviewsRequest) ProtoMessage() {}
+
+func (x *WatchReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchReviewsRequest.ProtoReflect.Descriptor instead.
+func (*WatchReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WatchReviewsRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *WatchReviewsRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type ReviewEvent struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Id                     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                   string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Project                string                 `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	OccurredAt             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Review                 *Review                `protobuf:"bytes,5,opt,name=review,proto3" json:"review,omitempty"`
+	PreviousApprovalStatus string                 `protobuf:"bytes,6,opt,name=previous_approval_status,json=previousApprovalStatus,proto3" json:"previous_approval_status,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ReviewEvent) Reset() {
+	*x = ReviewEvent{}
+	mi := &file_proto_review_v1_review_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewEvent) ProtoMessage() {}
+
+func (x *ReviewEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_review_v1_review_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewEvent.ProtoReflect.Descriptor instead.
+func (*ReviewEvent) Descriptor() ([]byte, []int) {
+	return file_proto_review_v1_review_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReviewEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ReviewEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *ReviewEvent) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *ReviewEvent) GetPreviousApprovalStatus() string {
+	if x != nil {
+		return x.PreviousApprovalStatus
+	}
+	return ""
+}
+
+var File_proto_review_v1_review_proto protoreflect.FileDescriptor
+
+const file_proto_review_v1_review_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/review/v1/review.proto\x12\x11central.review.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x9d\x06\n" +
+	"\x16ListAssetsPivotRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x12\n" +
+	"\x04root\x18\x02 \x01(\tR\x04root\x12\x12\n" +
+	"\x04view\x18\x03 \x01(\tR\x04view\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\tR\x04sort\x12\x10\n" +
+	"\x03dir\x18\x05 \x01(\tR\x03dir\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\a \x01(\x05R\aperPage\x12\x16\n" +
+	"\x06cursor\x18\b \x01(\tR\x06cursor\x12\x12\n" +
+	"\x04name\x18\t \x01(\tR\x04name\x12\x14\n" +
+	"\x05phase\x18\n" +
+	" \x01(\tR\x05phase\x12+\n" +
+	"\x11approval_statuses\x18\v \x03(\tR\x10approvalStatuses\x12#\n" +
+	"\rwork_statuses\x18\f \x03(\tR\fworkStatuses\x12'\n" +
+	"\x0fsubmitted_users\x18\r \x03(\tR\x0esubmittedUsers\x124\n" +
+	"\x16approval_updated_users\x18\x0e \x03(\tR\x14approvalUpdatedUsers\x12\x18\n" +
+	"\astudios\x18\x0f \x03(\tR\astudios\x12\x1c\n" +
+	"\trelations\x18\x10 \x03(\tR\trelations\x12#\n" +
+	"\rrelation_mode\x18\x11 \x01(\tR\frelationMode\x12\x12\n" +
+	"\x04tags\x18\x12 \x03(\tR\x04tags\x12)\n" +
+	"\x10overall_statuses\x18\x13 \x03(\tR\x0foverallStatuses\x12\x18\n" +
+	"\aoverdue\x18\x14 \x01(\bR\aoverdue\x12\x16\n" +
+	"\x06fields\x18\x15 \x03(\tR\x06fields\x12\x1d\n" +
+	"\n" +
+	"skip_count\x18\x16 \x01(\bR\tskipCount\x12/\n" +
+	"\x05as_of\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\x12\x1d\n" +
+	"\n" +
+	"group_page\x18\x18 \x01(\x05R\tgroupPage\x12$\n" +
+	"\x0egroup_per_page\x18\x19 \x01(\x05R\fgroupPerPage\x12\x16\n" +
+	"\x06groups\x18\x1a \x03(\tR\x06groups\"\xc8\x02\n" +
+	"\x17ListAssetsPivotResponse\x120\n" +
+	"\x06assets\x18\x01 \x03(\v2\x18.central.review.v1.AssetR\x06assets\x125\n" +
+	"\x06groups\x18\x02 \x03(\v2\x1d.central.review.v1.AssetGroupR\x06groups\x12=\n" +
+	"\n" +
+	"pagination\x18\x03 \x01(\v2\x1d.central.review.v1.PaginationR\n" +
+	"pagination\x12*\n" +
+	"\x11total_is_estimate\x18\x04 \x01(\bR\x0ftotalIsEstimate\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursor\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\tR\x04sort\x12\x10\n" +
+	"\x03dir\x18\a \x01(\tR\x03dir\x12\x12\n" +
+	"\x04view\x18\b \x01(\tR\x04view\"\xa4\x01\n" +
+	"\n" +
+	"Pagination\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12\x1b\n" +
+	"\tpage_last\x18\x04 \x01(\x05R\bpageLast\x12\x19\n" +
+	"\bhas_next\x18\x05 \x01(\bR\ahasNext\x12\x19\n" +
+	"\bhas_prev\x18\x06 \x01(\bR\ahasPrev\"\xf1\x01\n" +
+	"\n" +
+	"AssetGroup\x12$\n" +
+	"\x0etop_group_node\x18\x01 \x01(\tR\ftopGroupNode\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x03 \x01(\x05R\titemCount\x12\x1f\n" +
+	"\vtotal_count\x18\x04 \x01(\x03R\n" +
+	"totalCount\x12.\n" +
+	"\x05items\x18\x05 \x03(\v2\x18.central.review.v1.AssetR\x05items\x129\n" +
+	"\bchildren\x18\x06 \x03(\v2\x1d.central.review.v1.AssetGroupR\bchildren\"\xb6\x05\n" +
+	"\x05Asset\x12\x12\n" +
+	"\x04root\x18\x01 \x01(\tR\x04root\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12\x17\n" +
+	"\agroup_1\x18\x03 \x01(\tR\x06group1\x12\x17\n" +
+	"\agroup_2\x18\x04 \x01(\tR\x06group2\x12\x17\n" +
+	"\agroup_3\x18\x05 \x01(\tR\x06group3\x12\x1a\n" +
+	"\brelation\x18\x06 \x01(\tR\brelation\x12\x1c\n" +
+	"\tcomponent\x18\a \x01(\tR\tcomponent\x12&\n" +
+	"\x0fleaf_group_name\x18\b \x01(\tR\rleafGroupName\x12.\n" +
+	"\x13group_category_path\x18\t \x01(\tR\x11groupCategoryPath\x12$\n" +
+	"\x0etop_group_node\x18\n" +
+	" \x01(\tR\ftopGroupNode\x12<\n" +
+	"\x06phases\x18\v \x03(\v2$.central.review.v1.Asset.PhasesEntryR\x06phases\x12%\n" +
+	"\x0eoverall_status\x18\f \x01(\tR\roverallStatus\x12'\n" +
+	"\x0fattention_score\x18\r \x01(\x01R\x0eattentionScore\x12\x1a\n" +
+	"\bpriority\x18\x0e \x01(\bR\bpriority\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x12'\n" +
+	"\x0frequired_phases\x18\x10 \x03(\tR\x0erequiredPhases\x12\"\n" +
+	"\rnext_due_date\x18\x11 \x01(\tR\vnextDueDate\x12\x18\n" +
+	"\aoverdue\x18\x12 \x01(\bR\aoverdue\x1aW\n" +
+	"\vPhasesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x122\n" +
+	"\x05value\x18\x02 \x01(\v2\x1c.central.review.v1.PhaseCellR\x05value:\x028\x01\"\x9e\x02\n" +
+	"\tPhaseCell\x12\x1f\n" +
+	"\vwork_status\x18\x01 \x01(\tR\n" +
+	"workStatus\x12'\n" +
+	"\x0fapproval_status\x18\x02 \x01(\tR\x0eapprovalStatus\x12=\n" +
+	"\fsubmitted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12\x12\n" +
+	"\x04take\x18\x04 \x01(\tR\x04take\x12$\n" +
+	"\x0ereview_info_id\x18\x05 \x01(\x05R\freviewInfoId\x12\x16\n" +
+	"\x06studio\x18\x06 \x01(\tR\x06studio\x12\x19\n" +
+	"\bdue_date\x18\a \x01(\tR\adueDate\x12\x1b\n" +
+	"\tlocked_by\x18\b \x01(\tR\blockedBy\"<\n" +
+	"\x10GetReviewRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\"\xd1\x01\n" +
+	"\x13UpdateStatusRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12,\n" +
+	"\x0fapproval_status\x18\x03 \x01(\tH\x00R\x0eapprovalStatus\x88\x01\x01\x12$\n" +
+	"\vwork_status\x18\x04 \x01(\tH\x01R\n" +
+	"workStatus\x88\x01\x01\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversionB\x12\n" +
+	"\x10_approval_statusB\x0e\n" +
+	"\f_work_status\"\xa2\x06\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x18\n" +
+	"\aproject\x18\x02 \x01(\tR\aproject\x12\x16\n" +
+	"\x06studio\x18\x03 \x01(\tR\x06studio\x12\x12\n" +
+	"\x04root\x18\x04 \x01(\tR\x04root\x12\x16\n" +
+	"\x06groups\x18\x05 \x03(\tR\x06groups\x12\x1a\n" +
+	"\brelation\x18\x06 \x01(\tR\brelation\x12\x14\n" +
+	"\x05phase\x18\a \x01(\tR\x05phase\x12\x1c\n" +
+	"\tcomponent\x18\b \x01(\tR\tcomponent\x12\x12\n" +
+	"\x04take\x18\t \x01(\tR\x04take\x12\x1b\n" +
+	"\ttake_path\x18\n" +
+	" \x01(\tR\btakePath\x12'\n" +
+	"\x0fapproval_status\x18\v \x01(\tR\x0eapprovalStatus\x12?\n" +
+	"\x1capproval_status_updated_user\x18\f \x01(\tR\x19approvalStatusUpdatedUser\x12W\n" +
+	"\x1aapproval_status_updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x17approvalStatusUpdatedAt\x12\x1f\n" +
+	"\vwork_status\x18\x0e \x01(\tR\n" +
+	"workStatus\x127\n" +
+	"\x18work_status_updated_user\x18\x0f \x01(\tR\x15workStatusUpdatedUser\x12O\n" +
+	"\x16work_status_updated_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x13workStatusUpdatedAt\x12%\n" +
+	"\x0esubmitted_user\x18\x11 \x01(\tR\rsubmittedUser\x12=\n" +
+	"\fsubmitted_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12;\n" +
+	"\vmodified_at\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"modifiedAt\x12\x18\n" +
+	"\aversion\x18\x14 \x01(\tR\aversion\"P\n" +
+	"\x13WatchReviewsRequest\x12\x18\n" +
+	"\aproject\x18\x01 \x01(\tR\aproject\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\"\xf5\x01\n" +
+	"\vReviewEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x18\n" +
+	"\aproject\x18\x03 \x01(\tR\aproject\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x121\n" +
+	"\x06review\x18\x05 \x01(\v2\x19.central.review.v1.ReviewR\x06review\x128\n" +
+	"\x18previous_approval_status\x18\x06 \x01(\tR\x16previousApprovalStatus2\xf3\x02\n" +
+	"\rReviewService\x12h\n" +
+	"\x0fListAssetsPivot\x12).central.review.v1.ListAssetsPivotRequest\x1a*.central.review.v1.ListAssetsPivotResponse\x12K\n" +
+	"\tGetReview\x12#.central.review.v1.GetReviewRequest\x1a\x19.central.review.v1.Review\x12Q\n" +
+	"\fUpdateStatus\x12&.central.review.v1.UpdateStatusRequest\x1a\x19.central.review.v1.Review\x12X\n" +
+	"\fWatchReviews\x12&.central.review.v1.WatchReviewsRequest\x1a\x1e.central.review.v1.ReviewEvent0\x01BFZDgithub.com/PolygonPictures/central30-web/front/delivery/rpc/reviewpbb\x06proto3"
+
+var (
+	file_proto_review_v1_review_proto_rawDescOnce sync.Once
+	file_proto_review_v1_review_proto_rawDescData []byte
+)
+
+func file_proto_review_v1_review_proto_rawDescGZIP() []byte {
+	file_proto_review_v1_review_proto_rawDescOnce.Do(func() {
+		file_proto_review_v1_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_review_v1_review_proto_rawDesc), len(file_proto_review_v1_review_proto_rawDesc)))
+	})
+	return file_proto_review_v1_review_proto_rawDescData
+}
+
+var file_proto_review_v1_review_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_proto_review_v1_review_proto_goTypes = []any{
+	(*ListAssetsPivotRequest)(nil),  // 0: central.review.v1.ListAssetsPivotRequest
+	(*ListAssetsPivotResponse)(nil), // 1: central.review.v1.ListAssetsPivotResponse
+	(*Pagination)(nil),              // 2: central.review.v1.Pagination
+	(*AssetGroup)(nil),              // 3: central.review.v1.AssetGroup
+	(*Asset)(nil),                   // 4: central.review.v1.Asset
+	(*PhaseCell)(nil),               // 5: central.review.v1.PhaseCell
+	(*GetReviewRequest)(nil),        // 6: central.review.v1.GetReviewRequest
+	(*UpdateStatusRequest)(nil),     // 7: central.review.v1.UpdateStatusRequest
+	(*Review)(nil),                  // 8: central.review.v1.Review
+	(*WatchReviewsRequest)(nil),     // 9: central.review.v1.WatchReviewsRequest
+	(*ReviewEvent)(nil),             // 10: central.review.v1.ReviewEvent
+	nil,                             // 11: central.review.v1.Asset.PhasesEntry
+	(*timestamppb.Timestamp)(nil),   // 12: google.protobuf.Timestamp
+}
+var file_proto_review_v1_review_proto_depIdxs = []int32{
+	12, // 0: central.review.v1.ListAssetsPivotRequest.as_of:type_name -> google.protobuf.Timestamp
+	4,  // 1: central.review.v1.ListAssetsPivotResponse.assets:type_name -> central.review.v1.Asset
+	3,  // 2: central.review.v1.ListAssetsPivotResponse.groups:type_name -> central.review.v1.AssetGroup
+	2,  // 3: central.review.v1.ListAssetsPivotResponse.pagination:type_name -> central.review.v1.Pagination
+	4,  // 4: central.review.v1.AssetGroup.items:type_name -> central.review.v1.Asset
+	3,  // 5: central.review.v1.AssetGroup.children:type_name -> central.review.v1.AssetGroup
+	11, // 6: central.review.v1.Asset.phases:type_name -> central.review.v1.Asset.PhasesEntry
+	12, // 7: central.review.v1.PhaseCell.submitted_at:type_name -> google.protobuf.Timestamp
+	12, // 8: central.review.v1.Review.approval_status_updated_at:type_name -> google.protobuf.Timestamp
+	12, // 9: central.review.v1.Review.work_status_updated_at:type_name -> google.protobuf.Timestamp
+	12, // 10: central.review.v1.Review.submitted_at:type_name -> google.protobuf.Timestamp
+	12, // 11: central.review.v1.Review.modified_at:type_name -> google.protobuf.Timestamp
+	12, // 12: central.review.v1.ReviewEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	8,  // 13: central.review.v1.ReviewEvent.review:type_name -> central.review.v1.Review
+	5,  // 14: central.review.v1.Asset.PhasesEntry.value:type_name -> central.review.v1.PhaseCell
+	0,  // 15: central.review.v1.ReviewService.ListAssetsPivot:input_type -> central.review.v1.ListAssetsPivotRequest
+	6,  // 16: central.review.v1.ReviewService.GetReview:input_type -> central.review.v1.GetReviewRequest
+	7,  // 17: central.review.v1.ReviewService.UpdateStatus:input_type -> central.review.v1.UpdateStatusRequest
+	9,  // 18: central.review.v1.ReviewService.WatchReviews:input_type -> central.review.v1.WatchReviewsRequest
+	1,  // 19: central.review.v1.ReviewService.ListAssetsPivot:output_type -> central.review.v1.ListAssetsPivotResponse
+	8,  // 20: central.review.v1.ReviewService.GetReview:output_type -> central.review.v1.Review
+	8,  // 21: central.review.v1.ReviewService.UpdateStatus:output_type -> central.review.v1.Review
+	10, // 22: central.review.v1.ReviewService.WatchReviews:output_type -> central.review.v1.ReviewEvent
+	19, // [19:23] is the sub-list for method output_type
+	15, // [15:19] is the sub-list for method input_type
+	15, // [15:15] is the sub-list for extension type_name
+	15, // [15:15] is the sub-list for extension extendee
+	0,  // [0:15] is the sub-list for field type_name
+}
+
+func init() { file_proto_review_v1_review_proto_init() }
+func file_proto_review_v1_review_proto_init() {
+	if File_proto_review_v1_review_proto != nil {
+		return
+	}
+	file_proto_review_v1_review_proto_msgTypes[7].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_review_v1_review_proto_rawDesc), len(file_proto_review_v1_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_review_v1_review_proto_goTypes,
+		DependencyIndexes: file_proto_review_v1_review_proto_depIdxs,
+		MessageInfos:      file_proto_review_v1_review_proto_msgTypes,
+	}.Build()
+	File_proto_review_v1_review_proto = out.File
+	file_proto_review_v1_review_proto_goTypes = nil
+	file_proto_review_v1_review_proto_depIdxs = nil
+}
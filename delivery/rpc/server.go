@@ -0,0 +1,124 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/rpc/server.go
+
+	Module Description:
+		gRPC server of the internal consumers (render farm integration).
+
+	Details:
+	- The service is defined in proto/review/v1/review.proto; its generated code is in
+	  delivery/rpc/reviewpb. It runs next to the HTTP server on PPI_GRPC_ADDR (see main)
+	  and calls the same usecases.
+	- Callers authenticate with "authorization: Bearer <PPI_GRPC_TOKEN>" metadata. The
+	  user a call acts for is the "x-user" metadata, trusted only from a caller holding
+	  the token, and put in the context under entity.KeyUser like the HTTP auth does, so
+	  permission checks and audit fields apply alike. Without a token the server is not
+	  started (see main), and a server created with none rejects every call.
+	- Serve stops the server gracefully once ctx is done; open WatchReviews streams are
+	  ended then.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Reject every call when no token is configured.
+
+	Functions:
+	* - NewServer: Creates a gRPC server with the auth interceptors.
+	* - Serve: Serves on addr until ctx is done.
+	────────────────────────────────────────────────────────────────────────── */
+
+package rpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+	"net"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// NewServer returns a gRPC server accepting the calls that carry token (no call when
+// token is empty).
+func NewServer(token string) *grpc.Server {
+	auth := authenticator{token: token}
+	return grpc.NewServer(
+		grpc.UnaryInterceptor(auth.unary),
+		grpc.StreamInterceptor(auth.stream),
+	)
+}
+
+// Serve serves s on addr until ctx is done, then stops it gracefully.
+func Serve(ctx context.Context, s *grpc.Server, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	go func() {
+		<-ctx.Done()
+		s.GracefulStop()
+	}()
+	log.Printf("[GRPC] serving on %s", addr)
+	return s.Serve(lis)
+}
+
+type authenticator struct {
+	token string
+}
+
+// authenticate checks the bearer token of ctx and returns ctx with the caller's user.
+func (a authenticator) authenticate(ctx context.Context) (context.Context, error) {
+	if a.token == "" {
+		return nil, status.Error(codes.Unauthenticated, "no bearer token is configured")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	got := ""
+	if v := md.Get("authorization"); len(v) > 0 {
+		got = strings.TrimSpace(strings.TrimPrefix(v[0], "Bearer "))
+	}
+	if subtle.ConstantTimeCompare([]byte(got), []byte(a.token)) != 1 {
+		return nil, status.Error(codes.Unauthenticated, "invalid or missing bearer token")
+	}
+	if v := md.Get("x-user"); len(v) > 0 && v[0] != "" {
+		ctx = context.WithValue(ctx, entity.KeyUser, v[0])
+	}
+	return ctx, nil
+}
+
+func (a authenticator) unary(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a authenticator) stream(
+	srv any,
+	ss grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := a.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// contextStream is a ServerStream with the authenticated context.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context { return s.ctx }
@@ -0,0 +1,47 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// A call is accepted only with the configured token, and only then acts for its x-user;
+// with no token configured, no call is.
+func TestAuthenticate(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		token    string
+		md       metadata.MD
+		wantCode codes.Code
+		wantUser string
+	}{
+		{"token and user", "s3cret", metadata.Pairs("authorization", "Bearer s3cret", "x-user", "sam"), codes.OK, "sam"},
+		{"token without user", "s3cret", metadata.Pairs("authorization", "Bearer s3cret"), codes.OK, ""},
+		{"wrong token", "s3cret", metadata.Pairs("authorization", "Bearer guess", "x-user", "sam"), codes.Unauthenticated, ""},
+		{"no metadata", "s3cret", nil, codes.Unauthenticated, ""},
+		{"no token configured", "", metadata.Pairs("x-user", "sam"), codes.Unauthenticated, ""},
+		{"no token configured, empty bearer", "", metadata.Pairs("authorization", "Bearer ", "x-user", "sam"), codes.Unauthenticated, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+			got, err := authenticator{token: tc.token}.authenticate(ctx)
+			if code := status.Code(err); code != tc.wantCode {
+				t.Fatalf("code = %v, want %v (%v)", code, tc.wantCode, err)
+			}
+			if err != nil {
+				return
+			}
+			if user := entity.UserFromContext(got); user != tc.wantUser {
+				t.Errorf("user = %q, want %q", user, tc.wantUser)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/tracing.go
+
+	Module Description:
+		gin middleware starting the request span (see tracing/tracing.go).
+
+	Details:
+	- The trace ID is returned in the X-Trace-Id response header, so a slow response
+	  can be looked up in the trace backend.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+
+	Functions:
+		* Tracing: Returns the request tracing middleware.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/tracing"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func Tracing() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		endpoint := c.FullPath()
+		if endpoint == "" {
+			endpoint = "unmatched"
+		}
+		ctx := otel.GetTextMapPropagator().Extract(
+			c.Request.Context(), propagation.HeaderCarrier(c.Request.Header),
+		)
+		ctx, span := tracing.Start(ctx, c.Request.Method+" "+endpoint,
+			attribute.String("http.method", c.Request.Method),
+			attribute.String("http.route", endpoint),
+		)
+		defer span.End()
+
+		if id := tracing.TraceID(ctx); id != "" {
+			c.Header("X-Trace-Id", id)
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		status := c.Writer.Status()
+		span.SetAttributes(attribute.Int("http.status_code", status))
+		if status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(status))
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package delivery
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		delivery/userPreference.go
+
+	Module Description:
+		HTTP delivery handlers for the caller's own preferences.
+
+	Details:
+	- GET    /users/me/preferences/review-columns
+	- PUT    /users/me/preferences/review-columns   {"columns": [{"key": "mdl_work", "visible": false, "width": 120}]}
+	- DELETE /users/me/preferences/review-columns   (back to the client defaults)
+	- "me" is the user stored in the gin context by the auth middleware.
+
+	Update and Modification History:
+		* - 15-10-2026 - Initial creation.
+		* - 15-10-2026 - requireUser answers with the shared error envelope (code unauthorized).
+
+	Functions:
+		* NewUserPreference: Creates a new UserPreference handler.
+		* (UserPreference) GetReviewColumns: Returns the caller's review column layout.
+		* (UserPreference) PutReviewColumns: Stores the caller's review column layout.
+		* (UserPreference) DeleteReviewColumns: Forgets the caller's review column layout.
+		* authUser – utility function: Returns the authenticated user name.
+		* requireUser – utility function: Writes 401 when no user is authenticated.
+	────────────────────────────────────────────────────────────────────────── */
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/usecase"
+	"github.com/gin-gonic/gin"
+)
+
+// authUserKey is the gin context key under which the auth middleware stores the user;
+// the same name the usecases use for entity.KeyUser.
+const authUserKey = string(entity.KeyUser)
+
+func authUser(c *gin.Context) string {
+	return c.GetString(authUserKey)
+}
+
+func NewUserPreference(
+	uc *usecase.UserPreference,
+) *UserPreference {
+	return &UserPreference{
+		uc: uc,
+	}
+}
+
+type UserPreference struct {
+	uc *usecase.UserPreference
+}
+
+// requireUser writes 401 when the request carries no authenticated user.
+func requireUser(c *gin.Context) (string, bool) {
+	user := authUser(c)
+	if user == "" {
+		abortWithError(c, http.StatusUnauthorized, CodeUnauthorized, errors.New("no authenticated user"), nil)
+		return "", false
+	}
+	return user, true
+}
+
+func (h *UserPreference) GetReviewColumns(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	pref, err := h.uc.GetReviewColumns(c.Request.Context(), user)
+	if err != nil {
+		internalServerError(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"review_columns": pref})
+}
+
+func (h *UserPreference) PutReviewColumns(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	var pref entity.ReviewColumnsPreference
+	if err := c.ShouldBindJSON(&pref); err != nil {
+		badRequest(c, err)
+		return
+	}
+	res, err := h.uc.PutReviewColumns(c.Request.Context(), user, &pref)
+	if err != nil {
+		badRequest(c, err)
+		return
+	}
+	c.PureJSON(http.StatusOK, gin.H{"review_columns": res})
+}
+
+func (h *UserPreference) DeleteReviewColumns(c *gin.Context) {
+	user, ok := requireUser(c)
+	if !ok {
+		return
+	}
+	err := h.uc.Delete(c.Request.Context(), &entity.DeleteUserPreferenceParams{
+		User: user,
+		Key:  entity.UserPreferenceKeyReviewColumns,
+	})
+	if err != nil && !errors.Is(err, entity.ErrRecordNotFound) {
+		internalServerError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
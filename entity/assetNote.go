@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrAssetNoteNotAuthor is returned when someone else than its author edits a note.
+var ErrAssetNoteNotAuthor = errors.New("only the author may edit a note")
+
+// AssetNote is a free-form note of an asset relation, kept apart from its review infos.
+type AssetNote struct {
+	ID            int32     `json:"id"`
+	Project       string    `json:"project"`
+	Asset         string    `json:"asset"`
+	Relation      string    `json:"relation"`
+	Body          string    `json:"body"`
+	CreatedBy     string    `json:"created_by"`
+	CreatedAtUtc  time.Time `json:"created_at_utc"`
+	ModifiedAtUtc time.Time `json:"modified_at_utc"`
+}
+
+type ListAssetNotesParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+}
+
+type CreateAssetNoteParams struct {
+	Project   string `binding:"required"`
+	Asset     string `binding:"required,max=255"`
+	Relation  string `binding:"required,max=255"`
+	Body      string `binding:"required,max=4096"`
+	CreatedBy string `binding:"required"`
+}
+
+// UpdateAssetNoteParams replaces the body of a note; User must be its author.
+type UpdateAssetNoteParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	ID       int32  `binding:"required"`
+	Body     string `binding:"required,max=4096"`
+	User     string `binding:"required"`
+}
+
+// DeleteAssetNoteParams deletes a note; User must be its author.
+type DeleteAssetNoteParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	ID       int32  `binding:"required"`
+	User     string `binding:"required"`
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// AssetTimeline is every submission of one asset/relation, grouped by phase.
+type AssetTimeline struct {
+	Project  string                `json:"project"`
+	Asset    string                `json:"asset"`
+	Relation string                `json:"relation"`
+	Phases   []*AssetTimelinePhase `json:"phases"`
+}
+
+// AssetTimelinePhase lists the submissions of one phase, oldest first. Phases are
+// ordered by their first submission.
+type AssetTimelinePhase struct {
+	Phase       string                     `json:"phase"`
+	Submissions []*AssetTimelineSubmission `json:"submissions"`
+}
+
+type AssetTimelineSubmission struct {
+	ReviewInfoID   int32     `json:"review_info_id"`
+	Component      string    `json:"component"`
+	Take           string    `json:"take"`
+	SubmittedUser  string    `json:"submitted_user"`
+	SubmittedAtUtc time.Time `json:"submitted_at_utc"`
+	ApprovalStatus string    `json:"approval_status"`
+	WorkStatus     string    `json:"work_status"`
+
+	// Transitions are the recorded status changes of this submission, oldest first.
+	Transitions []*ReviewStatusHistory `json:"transitions"`
+}
+
+type AssetTimelineParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrAssetWatchNoChannel is returned when a watch names neither an email address nor a
+// Slack ID to notify.
+var ErrAssetWatchNoChannel = errors.New("email or slack_id is required")
+
+// AssetWatch subscribes User to the status changes of an asset; they are sent to
+// Email and / or SlackID (a Slack member or channel ID).
+type AssetWatch struct {
+	ID           int32     `json:"id"`
+	Project      string    `json:"project"`
+	Asset        string    `json:"asset"`
+	Relation     string    `json:"relation"`
+	User         string    `json:"user"`
+	Email        string    `json:"email,omitempty"`
+	SlackID      string    `json:"slack_id,omitempty"`
+	CreatedAtUtc time.Time `json:"created_at_utc"`
+}
+
+// WatchAssetParams creates or replaces the watch of User on an asset.
+type WatchAssetParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required,max=255"`
+	Relation string `binding:"required,max=255"`
+	User     string `binding:"required,max=128"`
+	Email    string `binding:"omitempty,email,max=255"`
+	SlackID  string `binding:"omitempty,max=64"`
+}
+
+type GetAssetWatchParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	User     string `binding:"required"`
+}
+
+type UnwatchAssetParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	User     string `binding:"required"`
+}
+
+type ListAssetWatchersParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+}
+
+// AssetNotification is the message sent to a watcher about a status change.
+type AssetNotification struct {
+	Watch   *AssetWatch
+	Subject string
+	Text    string
+	Review  *ReviewInfo
+}
+
+// AssetNotifier delivers notifications over one channel. Notify does nothing for a
+// watch without an address on that channel.
+type AssetNotifier interface {
+	Notify(ctx context.Context, n *AssetNotification) error
+}
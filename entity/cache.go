@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Cache is a byte-oriented key/value cache shared by usecases. Implementations must be
+// safe for concurrent use. A ttl of 0 means the entry does not expire on its own.
+type Cache interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// CacheTTLs are the lifetimes of the asset pivot caches, adjustable at run time.
+type CacheTTLs struct {
+	PivotPage  time.Duration // how long a pivot page is served from cache
+	CountFresh time.Duration // cached totals younger than this are not recounted
+	CountStale time.Duration // cached totals older than this are recounted before use
+}
+
+// ErrInvalidCacheTTLs is returned when the cached totals would turn stale before they
+// stop being fresh.
+var ErrInvalidCacheTTLs = errors.New("count_stale must not be shorter than count_fresh")
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// CategoryAccess allows a role to see one top group node (e.g. "prop") of a project's
+// asset categories. A role without any rule in a project is unrestricted.
+type CategoryAccess struct {
+	ID           int32     `json:"id"`
+	Project      string    `json:"project"`
+	Role         string    `json:"role"`
+	TopGroupNode string    `json:"top_group_node"`
+	CreatedBy    string    `json:"created_by"`
+	CreatedAtUtc time.Time `json:"created_at_utc"`
+}
+
+type CreateCategoryAccessParams struct {
+	Project      string `binding:"required"`
+	Role         string `binding:"required"`
+	TopGroupNode string `binding:"required"`
+	CreatedBy    string
+}
+
+type ListCategoryAccessParams struct {
+	Project string `binding:"required"`
+	Role    *string
+}
+
+type DeleteCategoryAccessParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
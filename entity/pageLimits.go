@@ -0,0 +1,72 @@
+package entity
+
+// PageLimits are the page sizes of the asset pivot. In a project override a zero field
+// inherits the server-wide value.
+type PageLimits struct {
+	PerPage           int `json:"per_page"`             // list view page size when neither the request nor the project defaults set one
+	MaxPerPage        int `json:"max_per_page"`         // larger list view pages are cut down to it
+	MaxGroupedPerPage int `json:"max_grouped_per_page"` // larger grouped view pages are cut down to it
+	GroupPerPage      int `json:"group_per_page"`       // buckets per grouped view page when the request sets none
+	MaxGroupPerPage   int `json:"max_group_per_page"`   // larger group_per_page values are cut down to it
+}
+
+// DefaultPageLimits are the page sizes of a server without page limit configuration.
+var DefaultPageLimits = PageLimits{
+	PerPage:           30,
+	MaxPerPage:        100,
+	MaxGroupedPerPage: 50,
+	GroupPerPage:      10,
+	MaxGroupPerPage:   50,
+}
+
+// PageLimitsConfig are the server-wide page limits and their per-project overrides,
+// keyed by project key name.
+type PageLimitsConfig struct {
+	PageLimits
+	Projects map[string]PageLimits `json:"projects"`
+}
+
+// For returns the page limits of project: its override over the server-wide limits over
+// DefaultPageLimits. A nil config gives DefaultPageLimits.
+func (c *PageLimitsConfig) For(project string) PageLimits {
+	l := DefaultPageLimits
+	if c == nil {
+		return l
+	}
+	l = l.merge(c.PageLimits)
+	if o, ok := c.Projects[project]; ok {
+		l = l.merge(o)
+	}
+	return l
+}
+
+func (l PageLimits) merge(o PageLimits) PageLimits {
+	if o.PerPage > 0 {
+		l.PerPage = o.PerPage
+	}
+	if o.MaxPerPage > 0 {
+		l.MaxPerPage = o.MaxPerPage
+	}
+	if o.MaxGroupedPerPage > 0 {
+		l.MaxGroupedPerPage = o.MaxGroupedPerPage
+	}
+	if o.GroupPerPage > 0 {
+		l.GroupPerPage = o.GroupPerPage
+	}
+	if o.MaxGroupPerPage > 0 {
+		l.MaxGroupPerPage = o.MaxGroupPerPage
+	}
+	return l
+}
+
+// ClampPerPage cuts perPage down to the maximum of the list or grouped view.
+func (l PageLimits) ClampPerPage(perPage int, grouped bool) int {
+	limit := l.MaxPerPage
+	if grouped {
+		limit = l.MaxGroupedPerPage
+	}
+	if perPage > limit {
+		return limit
+	}
+	return perPage
+}
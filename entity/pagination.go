@@ -0,0 +1,55 @@
+package entity
+
+// Pagination is the position of a page in a paged list. PageParam and PerPageParam are
+// the query parameters selecting the page ("page" and "per_page" when empty); the
+// grouped asset pivot pages with group_page and group_per_page.
+type Pagination struct {
+	Page     int   `json:"page"`
+	PerPage  int   `json:"per_page"`
+	Total    int64 `json:"total"`
+	PageLast int   `json:"page_last"` // 0 when the total was not counted
+	HasNext  bool  `json:"has_next"`
+	HasPrev  bool  `json:"has_prev"`
+
+	PageParam    string `json:"-"`
+	PerPageParam string `json:"-"`
+}
+
+// NewPagination returns the pagination of page of a list of total items in pages of
+// perPage; a list without items has one empty page.
+func NewPagination(page, perPage int, total int64) Pagination {
+	if page < 1 {
+		page = 1
+	}
+	last := 1
+	if perPage > 0 && total > 0 {
+		last = int((total + int64(perPage) - 1) / int64(perPage))
+	}
+	return Pagination{
+		Page:     page,
+		PerPage:  perPage,
+		Total:    total,
+		PageLast: last,
+		HasNext:  page < last,
+		HasPrev:  page > 1,
+	}
+}
+
+// Params returns the query parameters of the page number and page size.
+func (p Pagination) Params() (page, perPage string) {
+	page, perPage = p.PageParam, p.PerPageParam
+	if page == "" {
+		page = "page"
+	}
+	if perPage == "" {
+		perPage = "per_page"
+	}
+	return page, perPage
+}
+
+// PaginationBlock is the pagination block of a JSON list response: the page and the
+// URLs of the first, prev, next and last pages by relation.
+type PaginationBlock struct {
+	Pagination
+	Links map[string]string `json:"links,omitempty"`
+}
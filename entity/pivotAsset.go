@@ -0,0 +1,32 @@
+package entity
+
+// Relation filter modes of the asset pivot.
+const (
+	PivotRelationModeExact  = "exact"
+	PivotRelationModePrefix = "prefix"
+)
+
+// Placements of missing sort values of the asset pivot (nulls=first|last); empty keeps
+// the sort key's default, which is mostly last.
+const (
+	PivotNullsFirst = "first"
+	PivotNullsLast  = "last"
+)
+
+// PivotAssetKey selects one asset relation of the asset pivot. A nil Component selects
+// every component of it. Group2 and Group3 are only set outside the assets root (e.g.
+// the sequence and shot of a shot).
+type PivotAssetKey struct {
+	Group1    string  `json:"group_1"   binding:"required"`
+	Group2    string  `json:"group_2"`
+	Group3    string  `json:"group_3"`
+	Relation  string  `json:"relation"  binding:"required"`
+	Component *string `json:"component"`
+}
+
+type BatchAssetDetailParams struct {
+	Project string          `binding:"required"`
+	Root    string          // defaults to "assets"
+	Assets  []PivotAssetKey `binding:"required,min=1,max=200,dive"`
+	Role    string          // caller's role; drives category access
+}
@@ -0,0 +1,7 @@
+package entity
+
+import "errors"
+
+// ErrInvalidPivotCursor is returned when a pivot page cursor cannot be decoded or was
+// issued for a different sort than the one requested.
+var ErrInvalidPivotCursor = errors.New("invalid or mismatched page cursor")
@@ -0,0 +1,74 @@
+package entity
+
+import "time"
+
+// PivotDefaults are the asset pivot settings a project applies when the client sends
+// none. Empty fields fall back to the built-in defaults.
+type PivotDefaults struct {
+	Project      string    `json:"project"`
+	OrderKey     string    `json:"sort"`
+	Direction    string    `json:"dir"`
+	View         string    `json:"view"`
+	PerPage      int       `json:"per_page"`
+	UpdatedBy    string    `json:"updated_by"`
+	UpdatedAtUtc time.Time `json:"updated_at_utc"`
+
+	// AttentionWeights is nil when the project uses DefaultAttentionWeights.
+	AttentionWeights *AttentionWeights `json:"attention_weights"`
+	// OverallStatusRules is nil when the project uses DefaultOverallStatusRules.
+	OverallStatusRules *OverallStatusRules `json:"overall_status_rules"`
+}
+
+// AttentionWeights weigh the signals summed into the attention_score of a pivot row.
+type AttentionWeights struct {
+	Retakes         float64 `json:"retakes"          binding:"min=0"` // per retake ever given to the asset
+	StaleDownstream float64 `json:"stale_downstream" binding:"min=0"` // per phase older than its upstream
+	TimeInStatus    float64 `json:"time_in_status"   binding:"min=0"` // per day an unapproved phase sat unchanged
+}
+
+var DefaultAttentionWeights = AttentionWeights{
+	Retakes:         2,
+	StaleDownstream: 3,
+	TimeInStatus:    0.5,
+}
+
+// Values of the overall_status roll-up of a pivot row.
+const (
+	OverallStatusApproved   = "approved"
+	OverallStatusRetake     = "retake"
+	OverallStatusInProgress = "in_progress"
+)
+
+// OverallStatuses are the overall_status values in workflow order.
+var OverallStatuses = []string{OverallStatusRetake, OverallStatusInProgress, OverallStatusApproved}
+
+// OverallStatusRules roll the latest approval status of every phase of an asset up
+// into its overall_status: "retake" if any phase is in one of RetakeStatuses, else
+// "approved" if every required phase is in one of ApprovedStatuses, else "in_progress".
+// Empty RequiredPhases requires every phase the asset has.
+type OverallStatusRules struct {
+	RequiredPhases   []string `json:"required_phases"   binding:"max=32,dive,min=1,max=64"`
+	ApprovedStatuses []string `json:"approved_statuses" binding:"min=1,max=32,dive,min=1,max=255"`
+	RetakeStatuses   []string `json:"retake_statuses"   binding:"max=32,dive,min=1,max=255"`
+}
+
+var DefaultOverallStatusRules = OverallStatusRules{
+	ApprovedStatuses: []string{"approved"},
+	RetakeStatuses:   []string{"retake"},
+}
+
+type GetPivotDefaultsParams struct {
+	Project string `binding:"required"`
+}
+
+type PutPivotDefaultsParams struct {
+	Project   string `binding:"required"`
+	OrderKey  string
+	Direction string `binding:"omitempty,oneof=asc desc"`
+	View      string `binding:"omitempty,oneof=list grouped"`
+	PerPage   int    `binding:"omitempty,min=1,max=100"`
+	UpdatedBy string
+
+	AttentionWeights   *AttentionWeights
+	OverallStatusRules *OverallStatusRules
+}
@@ -0,0 +1,9 @@
+package entity
+
+import "errors"
+
+// PivotPhases are the phases with their own column group in the asset pivot.
+var PivotPhases = []string{"mdl", "rig", "bld", "dsn", "ldv"}
+
+// ErrInvalidPivotFields is returned when a pivot fields selection names an unknown phase.
+var ErrInvalidPivotFields = errors.New("fields must be a comma-separated list of pivot phases (mdl, rig, bld, dsn, ldv)")
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// Views of the asset pivot a query policy may allow.
+const (
+	PivotViewList    = "list"
+	PivotViewGrouped = "grouped"
+)
+
+// ProjectQueryPolicy tunes the asset pivot queries of a heavy project at run time.
+// Zero fields keep the server-wide page limits and timeout; empty AllowedViews allows
+// every view.
+type ProjectQueryPolicy struct {
+	Project        string    `json:"project"`
+	MaxPerPage     int       `json:"max_per_page"`
+	TimeoutSeconds int       `json:"timeout_seconds"`
+	AllowedViews   []string  `json:"allowed_views"`
+	UpdatedBy      string    `json:"updated_by"`
+	UpdatedAtUtc   time.Time `json:"updated_at_utc"`
+}
+
+type GetProjectQueryPolicyParams struct {
+	Project string `binding:"required"`
+}
+
+type PutProjectQueryPolicyParams struct {
+	Project        string   `binding:"required"`
+	MaxPerPage     int      `binding:"omitempty,min=1,max=1000"`
+	TimeoutSeconds int      `binding:"omitempty,min=1,max=300"`
+	AllowedViews   []string `binding:"max=2,dive,oneof=list grouped"`
+	UpdatedBy      string
+}
+
+// ErrPivotViewNotAllowed is returned when a project's query policy does not allow the
+// requested pivot view.
+var ErrPivotViewNotAllowed = errors.New("this view of the asset pivot is disabled for the project")
+
+// Limits narrows the page limits l by the policy: MaxPerPage caps the list and grouped
+// views and the default page size. A nil policy returns l.
+func (p *ProjectQueryPolicy) Limits(l PageLimits) PageLimits {
+	if p == nil || p.MaxPerPage <= 0 {
+		return l
+	}
+	l.MaxPerPage = p.MaxPerPage
+	if l.MaxGroupedPerPage > p.MaxPerPage {
+		l.MaxGroupedPerPage = p.MaxPerPage
+	}
+	if l.PerPage > p.MaxPerPage {
+		l.PerPage = p.MaxPerPage
+	}
+	return l
+}
+
+// Timeout returns the query timeout of the policy, or def when it sets none.
+func (p *ProjectQueryPolicy) Timeout(def time.Duration) time.Duration {
+	if p == nil || p.TimeoutSeconds <= 0 {
+		return def
+	}
+	return time.Duration(p.TimeoutSeconds) * time.Second
+}
+
+// AllowsView reports whether the policy allows view (list or grouped).
+func (p *ProjectQueryPolicy) AllowsView(view string) bool {
+	if p == nil || len(p.AllowedViews) == 0 {
+		return true
+	}
+	for _, v := range p.AllowedViews {
+		if strings.EqualFold(v, view) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"strings"
+	"time"
+)
+
+// RequiredPhases map the assets of a project to the phases they need, so an asset
+// that never gets a phase (a camera without ldv) is not held back by it.
+// ByRelation keys are lower-case relations; ByTopGroupNode keys are top group nodes.
+// A relation mapping takes precedence over a top group node mapping; assets matching
+// neither fall back to OverallStatusRules.RequiredPhases.
+type RequiredPhases struct {
+	Project        string              `json:"project"`
+	ByRelation     map[string][]string `json:"by_relation"`
+	ByTopGroupNode map[string][]string `json:"by_top_group_node"`
+	UpdatedBy      string              `json:"updated_by"`
+	UpdatedAtUtc   time.Time           `json:"updated_at_utc"`
+}
+
+type GetRequiredPhasesParams struct {
+	Project string `binding:"required"`
+}
+
+type PutRequiredPhasesParams struct {
+	Project        string              `binding:"required"`
+	ByRelation     map[string][]string `binding:"max=500,dive,keys,required,max=255,endkeys,min=1,max=32,dive,required,max=64"`
+	ByTopGroupNode map[string][]string `binding:"max=500,dive,keys,required,max=255,endkeys,min=1,max=32,dive,required,max=64"`
+	UpdatedBy      string
+}
+
+// Empty reports whether r maps no asset.
+func (r *RequiredPhases) Empty() bool {
+	return r == nil || (len(r.ByRelation) == 0 && len(r.ByTopGroupNode) == 0)
+}
+
+// Phases returns the lower-case phases required of an asset with the given relation
+// under topGroupNode, and false when neither is mapped.
+func (r *RequiredPhases) Phases(relation, topGroupNode string) ([]string, bool) {
+	if r.Empty() {
+		return nil, false
+	}
+	if phases, ok := r.ByRelation[strings.ToLower(strings.TrimSpace(relation))]; ok {
+		return phases, true
+	}
+	if topGroupNode == "" {
+		return nil, false
+	}
+	phases, ok := r.ByTopGroupNode[topGroupNode]
+	return phases, ok
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	ReviewActivityFieldSubmission     = "submission"
+	ReviewActivityFieldApprovalStatus = "approval_status"
+	ReviewActivityFieldWorkStatus     = "work_status"
+)
+
+// ReviewActivity is a single raw event on a review info (a submission or a status change).
+type ReviewActivity struct {
+	ID            int32     `json:"id"`
+	Project       string    `json:"project"`
+	ReviewInfoID  int32     `json:"review_info_id"`
+	Root          string    `json:"root"`
+	Groups        []string  `json:"groups"`
+	Relation      string    `json:"relation"`
+	Phase         string    `json:"phase"`
+	Field         string    `json:"field"`
+	Value         string    `json:"value"`
+	Actor         string    `json:"actor"`
+	SessionID     string    `json:"session_id,omitempty"`
+	OccurredAtUtc time.Time `json:"occurred_at_utc"`
+}
+
+type CreateReviewActivityParams struct {
+	Project       string `binding:"required"`
+	ReviewInfoID  int32  `binding:"required"`
+	Root          string
+	Groups        []string
+	Relation      string
+	Phase         string
+	Field         string `binding:"required"`
+	Value         string
+	Actor         string
+	SessionID     string
+	OccurredAtUtc time.Time
+}
+
+type ListReviewActivityParams struct {
+	Project string `binding:"required"`
+	Since   *time.Time
+	Limit   int
+	// Window collapses repeated events of the same actor on the same review info and
+	// field when they are at most Window apart. Zero disables collapsing.
+	Window time.Duration
+}
+
+// ReviewActivityFeedEntry is one line of the activity feed. Count is 1 for a plain event;
+// for a collapsed run, FirstValue/Value are the values at the start and end of the run.
+type ReviewActivityFeedEntry struct {
+	ReviewInfoID int32     `json:"review_info_id"`
+	Root         string    `json:"root"`
+	Groups       []string  `json:"groups"`
+	Relation     string    `json:"relation"`
+	Phase        string    `json:"phase"`
+	Field        string    `json:"field"`
+	Actor        string    `json:"actor"`
+	FirstValue   string    `json:"first_value"`
+	Value        string    `json:"value"`
+	Count        int       `json:"count"`
+	FirstAtUtc   time.Time `json:"first_at_utc"`
+	LastAtUtc    time.Time `json:"last_at_utc"`
+	Summary      string    `json:"summary"`
+	LastEventID  int32     `json:"last_event_id"`
+	FirstEventID int32     `json:"first_event_id"`
+}
+
+type reviewSessionKey struct{}
+
+// WithReviewSessionID tags ctx with the review session a change is made in, so the
+// activity recorded for it can be grouped into that session's decision log.
+func WithReviewSessionID(ctx context.Context, sessionID string) context.Context {
+	if sessionID == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, reviewSessionKey{}, sessionID)
+}
+
+// ReviewSessionIDFrom returns the review session of ctx, or "" outside a session.
+func ReviewSessionIDFrom(ctx context.Context) string {
+	id, _ := ctx.Value(reviewSessionKey{}).(string)
+	return id
+}
+
+type ListReviewSessionDecisionsParams struct {
+	Project   string `binding:"required"`
+	SessionID string `binding:"required"`
+}
+
+// ReviewSessionDecision is the outcome of one review info within a session: the last
+// approval/work status set during the session and every change that led to it.
+type ReviewSessionDecision struct {
+	ReviewInfoID   int32             `json:"review_info_id"`
+	Root           string            `json:"root"`
+	Groups         []string          `json:"groups"`
+	Relation       string            `json:"relation"`
+	Phase          string            `json:"phase"`
+	ApprovalStatus *string           `json:"approval_status"`
+	WorkStatus     *string           `json:"work_status"`
+	DecidedBy      string            `json:"decided_by"`
+	DecidedAtUtc   time.Time         `json:"decided_at_utc"`
+	History        []*ReviewActivity `json:"history"`
+}
+
+// ReviewSessionDecisionLog is the dailies decision log of one session, in the order the
+// review infos were first touched.
+type ReviewSessionDecisionLog struct {
+	SessionID    string                   `json:"session_id"`
+	StartedAtUtc *time.Time               `json:"started_at_utc"`
+	EndedAtUtc   *time.Time               `json:"ended_at_utc"`
+	Decisions    []*ReviewSessionDecision `json:"decisions"`
+}
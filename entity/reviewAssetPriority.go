@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// AssetPriority flags an asset of a project as a priority; flagged assets come first
+// in the asset pivot sorted by priority_first. Unflagged assets have no record.
+type AssetPriority struct {
+	Project  string     `json:"project"`
+	Asset    string     `json:"asset"`
+	Priority bool       `json:"priority"`
+	SetBy    string     `json:"set_by,omitempty"`
+	SetAtUtc *time.Time `json:"set_at_utc,omitempty"`
+}
+
+// SetAssetPriorityParams flags (Priority true) or unflags an asset.
+type SetAssetPriorityParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required,max=255"`
+	Priority bool
+	SetBy    string
+}
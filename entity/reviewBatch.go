@@ -0,0 +1,20 @@
+package entity
+
+import "fmt"
+
+// MaxReviewBatchSize bounds the number of review infos of one batch create.
+const MaxReviewBatchSize = 500
+
+// BatchItemError is the error of one item of a batch, by its index in the request.
+type BatchItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchItemError) Error() string {
+	return fmt.Sprintf("item %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchItemError) Unwrap() error {
+	return e.Err
+}
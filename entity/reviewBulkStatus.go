@@ -0,0 +1,49 @@
+package entity
+
+import "errors"
+
+// BulkSetWorkStatusParams sets the work status of every latest submission matching the
+// pivot filters. An empty Phase (or "none") matches every phase; Groups are top group
+// nodes and are intersected with the categories Role may see. DryRun lists the changes
+// without writing them.
+type BulkSetWorkStatusParams struct {
+	Project          string `binding:"required"`
+	Root             string
+	Phase            string
+	AssetNameKey     string
+	ApprovalStatuses []string
+	WorkStatuses     []string
+	Groups           []string
+	WorkStatus       string `binding:"required,max=255"`
+	User             string `binding:"required"`
+	Role             string
+	DryRun           bool
+}
+
+// BulkWorkStatusChange is one submission a bulk status change matches, with its current
+// and new work status. Locked is set when another user's review lock skips it.
+type BulkWorkStatusChange struct {
+	ReviewInfoID  int32   `json:"review_info_id" gorm:"column:review_info_id"`
+	Asset         string  `json:"asset"          gorm:"column:group_1"`
+	Relation      string  `json:"relation"`
+	Phase         string  `json:"phase"`
+	CurrentStatus *string `json:"current_status" gorm:"column:work_status"`
+	NewStatus     string  `json:"new_status"     gorm:"-"`
+	Locked        bool    `json:"locked"         gorm:"-"`
+}
+
+// BulkSetWorkStatusResult counts the latest submissions of a bulk status change.
+// Matched excludes submissions already at the target status; Locked ones were skipped
+// because another user holds their review lock. A dry run updates nothing and lists
+// the Changes it would make.
+type BulkSetWorkStatusResult struct {
+	DryRun  bool                    `json:"dry_run"`
+	Matched int                     `json:"matched"`
+	Updated int                     `json:"updated"`
+	Locked  int                     `json:"locked"`
+	Changes []*BulkWorkStatusChange `json:"changes,omitempty"`
+}
+
+// ErrBulkStatusTooMany is returned when the filters of a bulk status change match more
+// submissions than one request may change.
+var ErrBulkStatusTooMany = errors.New("too many matching submissions, narrow the filters")
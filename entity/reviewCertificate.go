@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/libs"
+)
+
+// ReviewCertificate is the immutable record issued when a review info reaches final approval.
+// Hash covers the take manifest, the approver and the approval timestamp; Signature is the
+// server-side HMAC of Hash so a record cannot be forged by recomputing the hash alone.
+type ReviewCertificate struct {
+	ID            int32           `json:"id"`
+	Project       string          `json:"project"`
+	ReviewInfoID  int32           `json:"review_info_id"`
+	Approver      string          `json:"approver"`
+	ApprovedAtUtc time.Time       `json:"approved_at_utc"`
+	Manifest      json.RawMessage `json:"manifest"`
+	Hash          string          `json:"hash"`
+	Signature     string          `json:"signature"`
+	CreatedAtUtc  time.Time       `json:"created_at_utc"`
+}
+
+// ReviewCertificateManifest is the part of a review info that is frozen into a certificate.
+type ReviewCertificateManifest struct {
+	ReviewInfoID   int32        `json:"review_info_id"`
+	Project        string       `json:"project"`
+	Root           string       `json:"root"`
+	Groups         []string     `json:"groups"`
+	Relation       string       `json:"relation"`
+	Phase          string       `json:"phase"`
+	Component      string       `json:"component"`
+	Take           string       `json:"take"`
+	TakePath       string       `json:"take_path"`
+	SubmittedUser  string       `json:"submitted_user"`
+	SubmittedAtUtc time.Time    `json:"submitted_at_utc"`
+	AllFiles       []*libs.File `json:"all_files"`
+	NumAllFiles    uint32       `json:"num_all_files"`
+	SizeAllFiles   uint64       `json:"size_all_files"`
+}
+
+type CreateReviewCertificateParams struct {
+	Project       string          `binding:"required"`
+	ReviewInfoID  int32           `binding:"required"`
+	Approver      string          `binding:"required"`
+	ApprovedAtUtc time.Time       `binding:"required"`
+	Manifest      json.RawMessage `binding:"required"`
+	Hash          string          `binding:"required"`
+	Signature     string          `binding:"required"`
+}
+
+type GetReviewCertificateParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+type ListReviewCertificateParams struct {
+	Project      string `binding:"required"`
+	ReviewInfoID int32  `binding:"required"`
+}
+
+// ReviewCertificateVerification is the result of re-checking a stored certificate.
+// HashValid and SignatureValid describe the stored record itself; ManifestMatchesCurrent
+// reports whether the live review info still has the same take manifest.
+type ReviewCertificateVerification struct {
+	Certificate            *ReviewCertificate `json:"certificate"`
+	HashValid              bool               `json:"hash_valid"`
+	SignatureValid         bool               `json:"signature_valid"`
+	ManifestMatchesCurrent bool               `json:"manifest_matches_current"`
+	Valid                  bool               `json:"valid"`
+}
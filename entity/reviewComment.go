@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"context"
+	"errors"
+)
+
+// ReviewComment is a comment document of a review, as created with the review info.
+// SubmittedAtUtc is kept as stored (RFC 3339).
+type ReviewComment struct {
+	ID                string                `json:"id"`
+	Take              string                `json:"take"`
+	Type              string                `json:"type"`
+	Tool              string                `json:"tool"`
+	OriginalCommentID *string               `json:"original_comment_id"`
+	SubmittedUser     string                `json:"submitted_user"`
+	SubmittedAtUtc    string                `json:"submitted_at_utc"`
+	CommentData       []*ReviewCommentEntry `json:"comment_data"`
+}
+
+type ReviewCommentEntry struct {
+	Language              string `json:"language"`
+	Text                  string `json:"text"`
+	Attachments           any    `json:"attachments"`
+	NeedTranslation       bool   `json:"need_translation"`
+	IsTranslated          bool   `json:"is_translated"`
+	ResponsiblePersonRole string `json:"responsible_person_role"`
+}
+
+type ListReviewCommentsParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+// ReviewCommentRepository reads the review comment documents of takes by take path.
+type ReviewCommentRepository interface {
+	ListByTakePath(ctx context.Context, project, takePath string, limit int) ([]*ReviewComment, error)
+	CountByTakePath(ctx context.Context, project string, takePaths []string) (map[string]int64, error)
+}
+
+// ErrReviewCommentsUnavailable is returned when no comment store is configured.
+var ErrReviewCommentsUnavailable = errors.New("review comments are not available")
@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/libs"
+)
+
+// ReviewCompare is the difference between two review infos of a project, B relative to A,
+// for the side-by-side compare view.
+type ReviewCompare struct {
+	Project       string                 `json:"project"`
+	A             *ReviewCompareSide     `json:"a"`
+	B             *ReviewCompareSide     `json:"b"`
+	FilesAdded    []*libs.File           `json:"files_added"`
+	FilesRemoved  []*libs.File           `json:"files_removed"`
+	FilesChanged  []*libs.File           `json:"files_changed"`
+	NumFilesDelta int64                  `json:"num_files_delta"`
+	SizeDelta     int64                  `json:"size_delta"`
+	Changes       []*ReviewCompareChange `json:"changes"`
+}
+
+type ReviewCompareSide struct {
+	ReviewInfoID   int32     `json:"review_info_id"`
+	Root           string    `json:"root"`
+	Groups         []string  `json:"groups"`
+	Relation       string    `json:"relation"`
+	Phase          string    `json:"phase"`
+	Component      string    `json:"component"`
+	Take           string    `json:"take"`
+	TakePath       string    `json:"take_path"`
+	SubmittedUser  string    `json:"submitted_user"`
+	SubmittedAtUtc time.Time `json:"submitted_at_utc"`
+	ApprovalStatus string    `json:"approval_status"`
+	WorkStatus     string    `json:"work_status"`
+	NumAllFiles    uint32    `json:"num_all_files"`
+	SizeAllFiles   uint64    `json:"size_all_files"`
+	// CommentCount is nil when the comments could not be counted.
+	CommentCount *int64 `json:"comment_count"`
+}
+
+// ReviewCompareChange is a field whose value differs between A and B.
+type ReviewCompareChange struct {
+	Field string `json:"field"`
+	A     string `json:"a"`
+	B     string `json:"b"`
+}
+
+type ReviewCompareParams struct {
+	Project string `binding:"required"`
+	IDA     int32  `binding:"required"`
+	IDB     int32  `binding:"required"`
+}
@@ -0,0 +1,54 @@
+package entity
+
+// ReviewCompletion is the phase completion matrix of a project: for every asset, which
+// of PivotPhases have at least one approved take. Bit i of a mask stands for Phases[i].
+type ReviewCompletion struct {
+	Phases  []string                 `json:"phases"`
+	Assets  []*ReviewAssetCompletion `json:"assets"`
+	ByPhase []*ReviewPhaseCompletion `json:"by_phase"`
+	// Cells counts the asset phases that count (see ReviewAssetCompletion), Approved
+	// those of them with an approved take; Percent is Approved / Cells in percent.
+	Cells    int64   `json:"cells"`
+	Approved int64   `json:"approved"`
+	Percent  float64 `json:"percent"`
+}
+
+// ReviewAssetCompletion is one asset of the matrix. Phases has the bits of the phases
+// the asset requires (RequiredPhases) or, when unmapped, of those it has a live take
+// of; Approved those with an approved take.
+type ReviewAssetCompletion struct {
+	Group1   string `json:"group_1"`
+	Group2   string `json:"group_2,omitempty"`
+	Group3   string `json:"group_3,omitempty"`
+	Relation string `json:"relation"`
+	Phases   uint32 `json:"phases"`
+	Approved uint32 `json:"approved"`
+}
+
+// ReviewPhaseCompletion counts the assets Phase counts for and those with an approved
+// take of it; Percent is Approved / Assets in percent.
+type ReviewPhaseCompletion struct {
+	Phase    string  `json:"phase"`
+	Assets   int64   `json:"assets"`
+	Approved int64   `json:"approved"`
+	Percent  float64 `json:"percent"`
+}
+
+// ReviewPhaseApproval is one asset phase read for the matrix.
+type ReviewPhaseApproval struct {
+	Group1       string `gorm:"column:group_1"`
+	Group2       string `gorm:"column:group_2"`
+	Group3       string `gorm:"column:group_3"`
+	Relation     string `gorm:"column:relation"`
+	TopGroupNode string `gorm:"column:top_group_node"`
+	Phase        string `gorm:"column:phase"`
+	Approved     bool   `gorm:"column:approved"`
+}
+
+// ReviewCompletionParams asks for the completion matrix of Project under Root (assets
+// when empty). Role limits the assets like the pivot's category access.
+type ReviewCompletionParams struct {
+	Project string `binding:"required,max=255"`
+	Root    string `binding:"max=255"`
+	Role    string
+}
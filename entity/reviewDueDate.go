@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// ReviewDueDateLayout is the format of due dates (a calendar day, no time zone).
+const ReviewDueDateLayout = "2006-01-02"
+
+// ReviewDueDate is the day an asset phase is due. The phase is overdue from the next
+// day on until its latest take is approved.
+type ReviewDueDate struct {
+	Project  string    `json:"project"`
+	Asset    string    `json:"asset"`
+	Relation string    `json:"relation"`
+	Phase    string    `json:"phase"`
+	DueDate  string    `json:"due_date"`
+	SetBy    string    `json:"set_by"`
+	SetAtUtc time.Time `json:"set_at_utc"`
+}
+
+type ListReviewDueDatesParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+}
+
+// SetReviewDueDateParams sets or moves the due date of an asset phase.
+type SetReviewDueDateParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	Phase    string `binding:"required,max=64"`
+	DueDate  string `binding:"required,datetime=2006-01-02"`
+	SetBy    string
+}
+
+type ClearReviewDueDateParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	Phase    string `binding:"required,max=64"`
+}
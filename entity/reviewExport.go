@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// Review export job statuses, in the order a job goes through them.
+const (
+	ReviewExportStatusQueued  = "queued"
+	ReviewExportStatusRunning = "running"
+	ReviewExportStatusDone    = "done"
+	ReviewExportStatusFailed  = "failed"
+)
+
+// ReviewExportFilters selects the assets of an export; the fields mean the same as the
+// ListAssetsPivot query params.
+type ReviewExportFilters struct {
+	Root                 string     `json:"root,omitempty"`
+	PreferredPhase       string     `json:"phase,omitempty"`
+	OrderKey             string     `json:"sort,omitempty"`
+	Direction            string     `json:"dir,omitempty"`
+	AssetNameKey         string     `json:"name,omitempty"`
+	ApprovalStatuses     []string   `json:"approval_status,omitempty"`
+	WorkStatuses         []string   `json:"work_status,omitempty"`
+	SubmittedUsers       []string   `json:"submitted_user,omitempty"`
+	ApprovalUpdatedUsers []string   `json:"approval_status_updated_user,omitempty"`
+	AsOf                 *time.Time `json:"as_of,omitempty"`
+}
+
+// ReviewExport is a background job writing the asset pivot of a project to a CSV file in
+// the export storage.
+type ReviewExport struct {
+	ID            int32               `json:"id"`
+	Project       string              `json:"project"`
+	Status        string              `json:"status"`
+	Filters       ReviewExportFilters `json:"filters"`
+	Role          string              `json:"-"`
+	Rows          int                 `json:"rows"`
+	ObjectName    string              `json:"-"`
+	Error         string              `json:"error,omitempty"`
+	CreatedBy     string              `json:"created_by"`
+	CreatedAtUtc  time.Time           `json:"created_at_utc"`
+	StartedAtUtc  *time.Time          `json:"started_at_utc"`
+	FinishedAtUtc *time.Time          `json:"finished_at_utc"`
+}
+
+type CreateReviewExportParams struct {
+	Project   string `binding:"required"`
+	Filters   ReviewExportFilters
+	Role      string
+	CreatedBy string
+}
+
+type GetReviewExportParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+type FinishReviewExportParams struct {
+	ID         int32  `binding:"required"`
+	Status     string `binding:"required,oneof=done failed"`
+	Rows       int
+	ObjectName string
+	Error      string
+}
+
+// ErrReviewExportNotDone is returned when downloading a job that has not finished
+// successfully.
+var ErrReviewExportNotDone = errors.New("review export is not done")
+
+// ExportStorage keeps export files. Implementations must be safe for concurrent use.
+type ExportStorage interface {
+	Put(ctx context.Context, name, contentType string, r io.Reader) error
+	Open(ctx context.Context, name string) (io.ReadCloser, error)
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ReviewIdempotencyKey remembers which review info a client-supplied Idempotency-Key
+// created, so a retried create returns that row instead of inserting again.
+type ReviewIdempotencyKey struct {
+	Project      string
+	Key          string
+	Fingerprint  string // hash of the create payload the key was first used with
+	ReviewInfoID int32
+	CreatedAtUtc time.Time
+}
+
+// ErrIdempotencyConflict is returned when an idempotency key is reused with a different
+// payload, or the review info it created has been deleted since.
+var ErrIdempotencyConflict = errors.New("idempotency key conflict")
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey tags ctx with the client's idempotency key of a create.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// IdempotencyKeyFrom returns the idempotency key of ctx, or "" without one.
+func IdempotencyKeyFrom(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKey{}).(string)
+	return key
+}
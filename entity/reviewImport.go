@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+// ReviewImportMapping describes how the columns of a legacy tracking spreadsheet map onto
+// review info fields. Column names are matched case-insensitively against the CSV header.
+type ReviewImportMapping struct {
+	AssetColumn     string `json:"asset_column"`
+	RelationColumn  string `json:"relation_column"`
+	PhaseColumn     string `json:"phase_column"`
+	StatusColumn    string `json:"status_column"`
+	DateColumn      string `json:"date_column"`
+	DefaultRelation string `json:"default_relation"`
+	// StatusField is "approval" (default) or "work".
+	StatusField string `json:"status_field"`
+	// DateLayout is a Go time layout; defaults to "2006-01-02".
+	DateLayout string `json:"date_layout"`
+	// PhaseValues / StatusValues translate spreadsheet values (e.g. "Model" -> "mdl").
+	// Keys are matched case-insensitively; unmapped values are lower-cased as-is.
+	PhaseValues  map[string]string `json:"phase_values"`
+	StatusValues map[string]string `json:"status_values"`
+}
+
+type ReviewImportParams struct {
+	Project    string               `binding:"required"`
+	Mapping    *ReviewImportMapping `binding:"required"`
+	Header     []string             `binding:"required"`
+	Records    [][]string
+	DryRun     bool
+	ImportedBy string
+}
+
+const (
+	ReviewImportActionCreate = "create"
+	ReviewImportActionSkip   = "skip"
+	ReviewImportActionError  = "error"
+)
+
+// ReviewImportRow is one line of the import diff: what the latest status is today and
+// what the import would write.
+type ReviewImportRow struct {
+	Line          int        `json:"line"`
+	Asset         string     `json:"asset"`
+	Relation      string     `json:"relation"`
+	Phase         string     `json:"phase"`
+	Date          *time.Time `json:"date"`
+	CurrentStatus *string    `json:"current_status"`
+	NewStatus     string     `json:"new_status"`
+	Action        string     `json:"action"`
+	Error         string     `json:"error,omitempty"`
+	ReviewInfoID  *int32     `json:"review_info_id,omitempty"`
+}
+
+type ReviewImportResult struct {
+	BatchID string             `json:"batch_id"`
+	DryRun  bool               `json:"dry_run"`
+	Created int                `json:"created"`
+	Skipped int                `json:"skipped"`
+	Failed  int                `json:"failed"`
+	Rows    []*ReviewImportRow `json:"rows"`
+}
+
+type CreateReviewImportParams struct {
+	Project      string `binding:"required"`
+	BatchID      string `binding:"required"`
+	ReviewInfoID int32  `binding:"required"`
+	SourceLine   int
+	ImportedBy   string
+	// BackdatedAtUtc is written to modified_at_utc of the imported row so that the
+	// historical status never shadows a newer real submission in latest-row queries.
+	BackdatedAtUtc time.Time
+}
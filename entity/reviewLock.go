@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReviewLock is a short-lived pessimistic lock on one review info, taken for the length
+// of a live review session. It expires on its own at ExpiresAtUtc.
+type ReviewLock struct {
+	Project       string    `json:"project"`
+	ReviewInfoID  int32     `json:"review_info_id"`
+	Holder        string    `json:"holder"`
+	Reason        string    `json:"reason,omitempty"`
+	AcquiredAtUtc time.Time `json:"acquired_at_utc"`
+	ExpiresAtUtc  time.Time `json:"expires_at_utc"`
+}
+
+type AcquireReviewLockParams struct {
+	Project      string `binding:"required"`
+	ReviewInfoID int32  `binding:"required"`
+	Holder       string `binding:"required"`
+	Reason       string
+	TTL          time.Duration
+}
+
+type ReleaseReviewLockParams struct {
+	Project      string `binding:"required"`
+	ReviewInfoID int32  `binding:"required"`
+	Holder       string `binding:"required"`
+	// Force releases a lock held by someone else.
+	Force bool
+}
+
+type GetReviewLockParams struct {
+	Project      string `binding:"required"`
+	ReviewInfoID int32  `binding:"required"`
+}
+
+// ReviewLockedError is returned when a review info is locked by another holder.
+type ReviewLockedError struct {
+	Lock *ReviewLock
+}
+
+func (e *ReviewLockedError) Error() string {
+	return fmt.Sprintf(
+		"review info with ID %d is locked by %s until %s",
+		e.Lock.ReviewInfoID, e.Lock.Holder, e.Lock.ExpiresAtUtc.Format(time.RFC3339),
+	)
+}
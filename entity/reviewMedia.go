@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Kinds of review media a URL can be issued for.
+const (
+	ReviewMediaKindAllFiles     = "all_files"
+	ReviewMediaKindReviewTarget = "review_target"
+)
+
+// ReviewMediaURL is a short-lived URL of one file of a review info.
+type ReviewMediaURL struct {
+	ReviewInfoID int32     `json:"review_info_id"`
+	Kind         string    `json:"kind"`
+	Index        int       `json:"index"`
+	Path         string    `json:"path"`
+	URL          string    `json:"url"`
+	ExpiresAtUtc time.Time `json:"expires_at_utc"`
+}
+
+type GetReviewMediaURLParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+	Kind    string `binding:"required,oneof=all_files review_target"`
+	Index   int    `binding:"min=0"`
+}
+
+// MediaSigner issues URLs a client can fetch a media object from without further
+// credentials until expires. key is the object's path below the media root, with
+// forward slashes. Implementations must be safe for concurrent use.
+type MediaSigner interface {
+	SignURL(ctx context.Context, key string, expires time.Time) (string, error)
+}
+
+// LocalMediaStore is a MediaSigner whose URLs are served by this app; Resolve checks a
+// signed request and returns the local file to send.
+type LocalMediaStore interface {
+	MediaSigner
+	Resolve(key string, expires time.Time, signature string) (string, error)
+}
+
+var (
+	// ErrReviewMediaNotFound is returned when the review info has no file at the index.
+	ErrReviewMediaNotFound = errors.New("review info has no file at this index")
+	// ErrReviewMediaNotServable is returned for files outside the media root.
+	ErrReviewMediaNotServable = errors.New("file is outside the media root")
+	// ErrInvalidMediaSignature is returned for expired or tampered local media URLs.
+	ErrInvalidMediaSignature = errors.New("invalid or expired media signature")
+)
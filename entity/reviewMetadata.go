@@ -0,0 +1,27 @@
+package entity
+
+import "github.com/PolygonPictures/central30-web/front/libs"
+
+// Submission metadata fields a review info update may correct, as recorded in its
+// status history.
+const (
+	ReviewFieldTakePath         = "take_path"
+	ReviewFieldTargetComponents = "target_components"
+	ReviewFieldDuration         = "duration"
+	ReviewFieldReviewComments   = "review_comments"
+)
+
+// ReviewInfoMetadataPatch corrects the submission metadata of a review info along with
+// an update; nil fields are kept.
+type ReviewInfoMetadataPatch struct {
+	TakePath         *string `binding:"omitempty,min=1,max=1024"`
+	TargetComponents *[]string
+	Duration         *int32 `binding:"omitempty,min=0"`
+	ReviewComments   *[]*libs.CommentInfo
+}
+
+// Empty reports whether p changes nothing.
+func (p *ReviewInfoMetadataPatch) Empty() bool {
+	return p == nil ||
+		p.TakePath == nil && p.TargetComponents == nil && p.Duration == nil && p.ReviewComments == nil
+}
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+// ReviewProjectOverview summarises the review state of a project for the landing
+// dashboard. Counts follow the latest row of every asset phase, like the pivot.
+type ReviewProjectOverview struct {
+	Project              string     `json:"project"`
+	AssetCount           int64      `json:"asset_count"`
+	LastSubmittedAtUtc   *time.Time `json:"last_submitted_at_utc"`
+	PendingApprovalCount int64      `json:"pending_approval_count"` // phases waiting in the review queue
+}
+
+// ReviewOverviewParams lists the overviews of Projects, or of every project with
+// reviews when empty. Role limits the assets counted like the pivot's category access.
+type ReviewOverviewParams struct {
+	Projects []string `binding:"max=200,dive,max=255"`
+	Role     string
+}
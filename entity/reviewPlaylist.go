@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// ReviewPlaylist is an ordered list of takes to go through in a review session.
+type ReviewPlaylist struct {
+	ID            int32                 `json:"id"`
+	Project       string                `json:"project"`
+	Name          string                `json:"name"`
+	Description   string                `json:"description"`
+	CreatedBy     string                `json:"created_by"`
+	CreatedAtUtc  time.Time             `json:"created_at_utc"`
+	ModifiedAtUtc time.Time             `json:"modified_at_utc"`
+	Items         []*ReviewPlaylistItem `json:"items"`
+}
+
+// ReviewPlaylistItem references one take of an asset phase, as selected in the pivot.
+// Position is 0-based and contiguous within a playlist.
+type ReviewPlaylistItem struct {
+	ID           int32  `json:"id"`
+	Position     int    `json:"position"`
+	Root         string `json:"root"`
+	Asset        string `json:"asset"`
+	Relation     string `json:"relation"`
+	Phase        string `json:"phase"`
+	Take         string `json:"take"`
+	ReviewInfoID *int32 `json:"review_info_id"`
+}
+
+type ReviewPlaylistItemParams struct {
+	Root         string `json:"root"`
+	Asset        string `json:"asset" binding:"required"`
+	Relation     string `json:"relation" binding:"required"`
+	Phase        string `json:"phase" binding:"required"`
+	Take         string `json:"take" binding:"required"`
+	ReviewInfoID *int32 `json:"review_info_id"`
+}
+
+type CreateReviewPlaylistParams struct {
+	Project     string                      `binding:"required"`
+	Name        string                      `binding:"required,max=255"`
+	Description string                      `binding:"max=1024"`
+	Items       []*ReviewPlaylistItemParams `binding:"required,min=1,max=500,dive"`
+	CreatedBy   string
+}
+
+type ReviewPlaylistListParams struct {
+	Project string `binding:"required"`
+	*BaseListParams
+}
+
+type GetReviewPlaylistParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+// ReorderReviewPlaylistParams lists every item ID of the playlist in the new order.
+type ReorderReviewPlaylistParams struct {
+	Project string  `binding:"required"`
+	ID      int32   `binding:"required"`
+	ItemIDs []int32 `binding:"required,min=1"`
+}
+
+type DeleteReviewPlaylistParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+// ErrInvalidPlaylistOrder is returned when a reorder does not list each item exactly once.
+var ErrInvalidPlaylistOrder = errors.New("item_ids must list every item of the playlist exactly once")
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// ReviewSession is a scheduled review (dailies) of a project, going through a playlist
+// and / or a list of assets.
+type ReviewSession struct {
+	ID            int32                 `json:"id"`
+	Project       string                `json:"project"`
+	Title         string                `json:"title"`
+	Description   string                `json:"description"`
+	Location      string                `json:"location"`
+	StartsAtUtc   time.Time             `json:"starts_at_utc"`
+	EndsAtUtc     time.Time             `json:"ends_at_utc"`
+	PlaylistID    *int32                `json:"playlist_id"`
+	Assets        []*ReviewSessionAsset `json:"assets"`
+	CreatedBy     string                `json:"created_by"`
+	CreatedAtUtc  time.Time             `json:"created_at_utc"`
+	ModifiedAtUtc time.Time             `json:"modified_at_utc"`
+}
+
+// ReviewSessionAsset is an asset to be looked at in a session; Root is assets when empty.
+type ReviewSessionAsset struct {
+	Root     string `json:"root,omitempty"`
+	Asset    string `json:"asset"    binding:"required,max=255"`
+	Relation string `json:"relation" binding:"required,max=255"`
+}
+
+type CreateReviewSessionParams struct {
+	Project     string                `binding:"required"`
+	Title       string                `binding:"required,max=255"`
+	Description string                `binding:"max=2048"`
+	Location    string                `binding:"max=255"`
+	StartsAtUtc time.Time             `binding:"required"`
+	EndsAtUtc   time.Time             `binding:"required,gtfield=StartsAtUtc"`
+	PlaylistID  *int32                `binding:"omitempty,min=1"`
+	Assets      []*ReviewSessionAsset `binding:"max=500,dive"`
+	CreatedBy   string
+}
+
+// ReviewSessionListParams lists the sessions of Project overlapping [From, To), ordered
+// by start. A nil From means now, so the list shows upcoming sessions; a nil To is open.
+type ReviewSessionListParams struct {
+	Project string `binding:"required"`
+	From    *time.Time
+	To      *time.Time
+	*BaseListParams
+}
+
+type GetReviewSessionParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+type DeleteReviewSessionParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+var (
+	// ErrReviewSessionFeedDisabled is returned when no calendar feed secret is configured.
+	ErrReviewSessionFeedDisabled = errors.New("calendar feed is disabled")
+	// ErrReviewSessionFeedToken is returned for a calendar feed request with a wrong token.
+	ErrReviewSessionFeedToken = errors.New("invalid calendar feed token")
+)
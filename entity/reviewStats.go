@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Intervals of the submission statistics.
+const (
+	ReviewStatsIntervalDay   = "day"
+	ReviewStatsIntervalWeek  = "week"
+	ReviewStatsIntervalMonth = "month"
+)
+
+// ReviewSubmissionStats counts the submissions of a project per interval, for burn-up
+// charts. Every interval between From and To has a bucket, empty ones included.
+type ReviewSubmissionStats struct {
+	Project  string                    `json:"project"`
+	Interval string                    `json:"interval"`
+	From     time.Time                 `json:"from"`
+	To       time.Time                 `json:"to"`
+	Buckets  []*ReviewSubmissionBucket `json:"buckets"`
+}
+
+// ReviewSubmissionBucket holds the submissions of the interval starting at Start, per
+// phase.
+type ReviewSubmissionBucket struct {
+	Start  time.Time        `json:"start"`
+	Total  int64            `json:"total"`
+	Phases map[string]int64 `json:"phases"`
+}
+
+// ReviewSubmissionCount is one row of the grouped query: the submissions of Phase in the
+// interval starting on Bucket (YYYY-MM-DD, UTC).
+type ReviewSubmissionCount struct {
+	Bucket string
+	Phase  string
+	Count  int64
+}
+
+// ReviewSubmissionStatsParams asks for the submissions in [From, To). Role limits the
+// assets counted like the pivot's category access.
+type ReviewSubmissionStatsParams struct {
+	Project  string `binding:"required,max=255"`
+	Interval string `binding:"required,oneof=day week month"`
+	From     time.Time
+	To       time.Time
+	Role     string
+}
+
+// ErrInvalidStatsRange is returned when from is not before to or the range holds too
+// many intervals.
+var ErrInvalidStatsRange = errors.New("invalid statistics range")
+
+// ReviewApprovalLatency summarises the time from submission to approval of the approved
+// review infos of one phase and relation. Percentiles are nearest-rank, in seconds.
+type ReviewApprovalLatency struct {
+	Phase      string  `json:"phase"`
+	Relation   string  `json:"relation"`
+	Count      int64   `json:"count"`
+	AvgSeconds float64 `json:"avg_seconds"`
+	P50Seconds int64   `json:"p50_seconds"`
+	P90Seconds int64   `json:"p90_seconds"`
+	P95Seconds int64   `json:"p95_seconds"`
+}
+
+// ReviewApprovalLatencyParams asks for the latencies of the review infos reaching Status
+// (the final approval status when empty), approved in [From, To) when set. Role limits
+// the assets counted like the pivot's category access.
+type ReviewApprovalLatencyParams struct {
+	Project string `binding:"required,max=255"`
+	Status  string `binding:"max=255"`
+	From    *time.Time
+	To      *time.Time
+	Role    string
+}
+
+// ReviewRetakeStats lists the asset phases sent to retake most often and the retake
+// rate of every artist.
+type ReviewRetakeStats struct {
+	Project string                 `json:"project"`
+	Status  string                 `json:"status"`
+	Assets  []*ReviewAssetRetakes  `json:"assets"`
+	Artists []*ReviewArtistRetakes `json:"artists"`
+}
+
+// ReviewAssetRetakes counts how many times the takes of one asset phase entered the
+// retake status.
+type ReviewAssetRetakes struct {
+	Root     string `json:"root"`
+	Group1   string `json:"group_1"`
+	Relation string `json:"relation"`
+	Phase    string `json:"phase"`
+	Takes    int64  `json:"takes"`
+	Retakes  int64  `json:"retakes"`
+}
+
+// ReviewArtistRetakes is the retake record of the takes submitted by one user;
+// RetakeRate is RetakenTakes / Takes.
+type ReviewArtistRetakes struct {
+	SubmittedUser string  `json:"submitted_user"`
+	Takes         int64   `json:"takes"`
+	RetakenTakes  int64   `json:"retaken_takes"`
+	Retakes       int64   `json:"retakes"`
+	RetakeRate    float64 `json:"retake_rate"`
+}
+
+// ReviewRetakeStatsParams asks for the retakes of the takes submitted in [From, To)
+// when set. Status is the retake approval status ("retake" when empty); Limit caps the
+// asset list. Role limits the assets counted like the pivot's category access.
+type ReviewRetakeStatsParams struct {
+	Project string `binding:"required,max=255"`
+	Root    string `binding:"max=255"`
+	Status  string `binding:"max=255"`
+	From    *time.Time
+	To      *time.Time
+	Limit   int `binding:"min=0,max=1000"`
+	Role    string
+}
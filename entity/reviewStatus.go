@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Review status types: which review info field a status is a value of.
+const (
+	ReviewStatusTypeApproval = "approval"
+	ReviewStatusTypeWork     = "work"
+)
+
+// ReviewStatus is one entry of a project's status vocabulary, e.g. approval "retake".
+// Key is the value stored in review infos; Label and Color are for display.
+type ReviewStatus struct {
+	ID            int32     `json:"id"`
+	Project       string    `json:"project"`
+	Type          string    `json:"type"`
+	Key           string    `json:"key"`
+	Label         string    `json:"label"`
+	Color         string    `json:"color"`
+	Order         int       `json:"order"`
+	CreatedAtUtc  time.Time `json:"created_at_utc"`
+	ModifiedAtUtc time.Time `json:"modified_at_utc"`
+}
+
+type ListReviewStatusParams struct {
+	Project string `binding:"required"`
+	Type    string `binding:"omitempty,oneof=approval work"`
+}
+
+type GetReviewStatusParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+type CreateReviewStatusParams struct {
+	Project string `binding:"required"`
+	Type    string `binding:"required,oneof=approval work"`
+	Key     string `binding:"required,max=64"`
+	Label   string `binding:"required,max=255"`
+	Color   string `binding:"omitempty,hexcolor"`
+	Order   int
+}
+
+// UpdateReviewStatusParams changes the display of a status; its type and key are fixed.
+type UpdateReviewStatusParams struct {
+	Project string  `binding:"required"`
+	ID      int32   `binding:"required"`
+	Label   *string `binding:"omitempty,min=1,max=255"`
+	Color   *string `binding:"omitempty,hexcolor"`
+	Order   *int
+}
+
+type DeleteReviewStatusParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+// ErrDuplicateReviewStatus is returned when a project already has a status of the same
+// type and key.
+var ErrDuplicateReviewStatus = errors.New("a review status with this type and key already exists")
+
+// ErrUnknownReviewStatus is returned when a status filter names a value outside the
+// project's vocabulary.
+var ErrUnknownReviewStatus = errors.New("unknown review status")
+
+type ReviewStatusSummaryParams struct {
+	Project string `binding:"required"`
+	Root    string `binding:"omitempty,oneof=assets shots"`
+	Role    string // counts only cover the categories the role may see
+}
+
+// ReviewStatusSummary counts the latest review info of every asset phase by status,
+// grouped by the project's vocabulary.
+type ReviewStatusSummary struct {
+	Project  string               `json:"project"`
+	Root     string               `json:"root"`
+	Approval []*ReviewStatusCount `json:"approval"`
+	Work     []*ReviewStatusCount `json:"work"`
+}
+
+// ReviewStatusCount is one vocabulary entry of a summary. Values outside the vocabulary
+// are counted under Key "other"; an empty status is counted under Key "".
+type ReviewStatusCount struct {
+	Key     string         `json:"key"`
+	Label   string         `json:"label"`
+	Color   string         `json:"color"`
+	Total   int            `json:"total"`
+	ByPhase map[string]int `json:"by_phase"`
+}
@@ -0,0 +1,35 @@
+package entity
+
+import "time"
+
+// ReviewStatusHistory is one change of approval_status, work_status or corrected
+// submission metadata of a review info, written in the same transaction as the change
+// itself.
+type ReviewStatusHistory struct {
+	ID           int32     `json:"id"`
+	Project      string    `json:"project"`
+	ReviewInfoID int32     `json:"review_info_id"`
+	Field        string    `json:"field"` // ReviewActivityField* | ReviewField*
+	FromValue    string    `json:"from"`
+	ToValue      string    `json:"to"`
+	ChangedBy    string    `json:"changed_by"`
+	SessionID    string    `json:"session_id,omitempty"`
+	ChangedAtUtc time.Time `json:"changed_at_utc"`
+}
+
+type CreateReviewStatusHistoryParams struct {
+	Project      string `binding:"required"`
+	ReviewInfoID int32  `binding:"required"`
+	Field        string `binding:"required"`
+	FromValue    string
+	ToValue      string
+	ChangedBy    string
+	SessionID    string
+	ChangedAtUtc time.Time
+}
+
+type ListReviewStatusHistoryParams struct {
+	Project      string `binding:"required"`
+	ReviewInfoID int32  `binding:"required"`
+	Field        *string
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// Operations of a ReviewChange.
+const (
+	ReviewChangeUpsert = "upsert"
+	ReviewChangeDelete = "delete"
+)
+
+type ReviewSyncParams struct {
+	Project string `binding:"required"`
+	Token   string // server-issued sync token; empty syncs from the beginning
+	Limit   int    `binding:"omitempty,min=1,max=1000"`
+}
+
+// ReviewChange is one changed review info: the current row for an upsert, only its ID
+// (a tombstone) for a delete.
+type ReviewChange struct {
+	Op            string      `json:"op"`
+	ID            int32       `json:"id"`
+	ModifiedAtUtc time.Time   `json:"modified_at_utc"`
+	Review        *ReviewInfo `json:"review,omitempty"`
+}
+
+type ReviewSyncResult struct {
+	Changes   []*ReviewChange `json:"changes"`
+	NextToken string          `json:"next_token"`
+	HasMore   bool            `json:"has_more"`
+}
+
+// ErrInvalidSyncToken is returned when a sync token cannot be decoded or belongs to
+// another project.
+var ErrInvalidSyncToken = errors.New("invalid sync token")
+
+// ErrSyncTokenExpired is returned when tombstones newer than a sync token may have been
+// purged already; the client has to sync from the beginning.
+var ErrSyncTokenExpired = errors.New("sync token expired, sync again without a token")
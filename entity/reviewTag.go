@@ -0,0 +1,47 @@
+package entity
+
+import "time"
+
+// ReviewTag tags an asset relation, e.g. hero or crowd. An asset relation has any number
+// of tags and a tag any number of asset relations; the review infos of an asset share
+// its tags. Tags are lowercase.
+type ReviewTag struct {
+	Project     string    `json:"project"`
+	Asset       string    `json:"asset"`
+	Relation    string    `json:"relation"`
+	Tag         string    `json:"tag"`
+	TaggedBy    string    `json:"tagged_by"`
+	TaggedAtUtc time.Time `json:"tagged_at_utc"`
+}
+
+// ReviewTagCount is the number of asset relations of a project carrying Tag.
+type ReviewTagCount struct {
+	Tag   string `json:"tag"   gorm:"column:tag"`
+	Count int    `json:"count" gorm:"column:asset_count"`
+}
+
+type ListProjectReviewTagsParams struct {
+	Project string `binding:"required"`
+}
+
+type ListReviewTagsParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+}
+
+// AddReviewTagsParams adds Tags to an asset relation; tags it already has are kept.
+type AddReviewTagsParams struct {
+	Project  string   `binding:"required"`
+	Asset    string   `binding:"required,max=255"`
+	Relation string   `binding:"required,max=255"`
+	Tags     []string `binding:"required,min=1,max=20,dive,required,max=64"`
+	TaggedBy string
+}
+
+type RemoveReviewTagParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	Tag      string `binding:"required,max=64"`
+}
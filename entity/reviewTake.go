@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// ReviewTakeList is every live take of one asset phase, newest first. The pinned take,
+// when there is one, is what the pivot shows for the phase instead of the newest.
+type ReviewTakeList struct {
+	Project            string        `json:"project"`
+	Asset              string        `json:"asset"`
+	Relation           string        `json:"relation"`
+	Phase              string        `json:"phase"`
+	PinnedReviewInfoID *int32        `json:"pinned_review_info_id"`
+	Takes              []*ReviewTake `json:"takes"`
+}
+
+type ReviewTake struct {
+	ReviewInfoID   int32     `json:"review_info_id"`
+	Take           string    `json:"take"`
+	Component      string    `json:"component"`
+	SubmittedUser  string    `json:"submitted_user"`
+	SubmittedAtUtc time.Time `json:"submitted_at_utc"`
+	ApprovalStatus string    `json:"approval_status"`
+	WorkStatus     string    `json:"work_status"`
+	Pinned         bool      `json:"pinned"`
+}
+
+// ReviewTakePin makes a take the one the pivot surfaces for its asset phase.
+type ReviewTakePin struct {
+	Project      string    `json:"project"`
+	Asset        string    `json:"asset"`
+	Relation     string    `json:"relation"`
+	Phase        string    `json:"phase"`
+	ReviewInfoID int32     `json:"review_info_id"`
+	Take         string    `json:"take"`
+	PinnedBy     string    `json:"pinned_by"`
+	PinnedAtUtc  time.Time `json:"pinned_at_utc"`
+}
+
+type ListReviewTakesParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	Phase    string `binding:"required,max=64"`
+}
+
+// PinReviewTakeParams pins the latest live review info of Take.
+type PinReviewTakeParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	Phase    string `binding:"required,max=64"`
+	Take     string `binding:"required,max=255"`
+	PinnedBy string
+}
+
+type UnpinReviewTakeParams struct {
+	Project  string `binding:"required"`
+	Asset    string `binding:"required"`
+	Relation string `binding:"required"`
+	Phase    string `binding:"required,max=64"`
+}
+
+// ErrReviewTakeNotFound is returned when the take to pin has no live review info.
+var ErrReviewTakeNotFound = errors.New("take not found in this asset phase")
@@ -0,0 +1,14 @@
+package entity
+
+// ReviewTrashListParams lists the soft-deleted review infos of a project, most recently
+// deleted first.
+type ReviewTrashListParams struct {
+	Project string `binding:"required"`
+	*BaseListParams
+}
+
+type RestoreReviewInfoParams struct {
+	Project    string `binding:"required"`
+	ID         int32  `binding:"required"`
+	ModifiedBy *string
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReviewValidationRules restrict the values the review infos of a project may carry.
+// Values compare case-insensitively; an empty list allows any value.
+type ReviewValidationRules struct {
+	Project          string    `json:"project"`
+	Phases           []string  `json:"phases"`
+	ApprovalStatuses []string  `json:"approval_statuses"`
+	WorkStatuses     []string  `json:"work_statuses"`
+	UpdatedBy        string    `json:"updated_by"`
+	UpdatedAtUtc     time.Time `json:"updated_at_utc"`
+}
+
+type GetReviewValidationRulesParams struct {
+	Project string `binding:"required"`
+}
+
+type PutReviewValidationRulesParams struct {
+	Project          string   `binding:"required"`
+	Phases           []string `binding:"max=100,dive,required,max=64"`
+	ApprovalStatuses []string `binding:"max=100,dive,required,max=64"`
+	WorkStatuses     []string `binding:"max=100,dive,required,max=64"`
+	UpdatedBy        string
+}
+
+// Validate checks the given review info values against r; nil and empty values are
+// not checked. It returns a *ValidationError listing every rejected field, or nil.
+func (r *ReviewValidationRules) Validate(phase, approvalStatus, workStatus *string) error {
+	var fields []FieldError
+	check := func(field string, value *string, allowed []string) {
+		if value == nil || *value == "" || len(allowed) == 0 {
+			return
+		}
+		for _, a := range allowed {
+			if strings.EqualFold(strings.TrimSpace(*value), a) {
+				return
+			}
+		}
+		fields = append(fields, FieldError{
+			Field:   field,
+			Value:   *value,
+			Message: fmt.Sprintf("must be one of %s", strings.Join(allowed, ", ")),
+		})
+	}
+	check("phase", phase, r.Phases)
+	check("approval_status", approvalStatus, r.ApprovalStatuses)
+	check("work_status", workStatus, r.WorkStatuses)
+	if len(fields) == 0 {
+		return nil
+	}
+	return &ValidationError{Fields: fields}
+}
+
+// FieldError is one rejected field of a payload.
+type FieldError struct {
+	Field   string `json:"field"`
+	Value   string `json:"value"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when a payload breaks the project's validation rules.
+type ValidationError struct {
+	Fields []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		parts[i] = fmt.Sprintf("%s %q %s", f.Field, f.Value, f.Message)
+	}
+	return "invalid review info: " + strings.Join(parts, "; ")
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/PolygonPictures/central30-web/front/libs"
+)
+
+// ReviewInfoVersion is the opaque version of the state of a review info, sent as its
+// ETag and checked against If-Match on update. It changes with the statuses, their
+// users, the submission metadata an update may correct and the modification time (to
+// the second).
+func ReviewInfoVersion(e *ReviewInfo) string {
+	meta, _ := json.Marshal(struct {
+		TakePath         string
+		TargetComponents []string
+		Duration         *int32
+		ReviewComments   []*libs.CommentInfo
+	}{e.TakePath, e.TargetComponents, e.Duration, e.ReviewComments})
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%d|%d|%s|%s|%s|%s|%s",
+		e.ID, e.ModifiedAtUTC.Unix(),
+		e.ApprovalStatus, e.ApprovalStatusUpdatedUser,
+		e.WorkStatus, e.WorkStatusUpdatedUser,
+		meta,
+	)))
+	return hex.EncodeToString(sum[:12])
+}
+
+// ReviewVersionConflictError is returned when a review info changed since the version
+// an update was based on. Current is its state now.
+type ReviewVersionConflictError struct {
+	Current *ReviewInfo
+}
+
+func (e *ReviewVersionConflictError) Error() string {
+	return fmt.Sprintf("review info with ID %d was changed by someone else", e.Current.ID)
+}
+
+type reviewVersionKey struct{}
+
+// WithExpectedReviewVersion tags ctx with the version an update expects the review
+// info to be at.
+func WithExpectedReviewVersion(ctx context.Context, version string) context.Context {
+	if version == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, reviewVersionKey{}, version)
+}
+
+// ExpectedReviewVersionFrom returns the expected version of ctx, or "" when any
+// version may be overwritten.
+func ExpectedReviewVersionFrom(ctx context.Context) string {
+	v, _ := ctx.Value(reviewVersionKey{}).(string)
+	return v
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/libs"
+)
+
+// A metadata correction within the second of the last change still moves the version.
+func TestReviewInfoVersion(t *testing.T) {
+	base := func() *ReviewInfo {
+		duration := int32(48)
+		return &ReviewInfo{
+			ID:                        42,
+			TakePath:                  "/show/rod/assets/chr/take0003",
+			TargetComponents:          []string{"body"},
+			Duration:                  &duration,
+			ReviewComments:            []*libs.CommentInfo{{Text: "tighten the silhouette"}},
+			ApprovalStatus:            "check",
+			ApprovalStatusUpdatedUser: "sam",
+			WorkStatus:                "wip",
+			WorkStatusUpdatedUser:     "val",
+			ModifiedAtUTC:             time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+		}
+	}
+	want := ReviewInfoVersion(base())
+	if got := ReviewInfoVersion(base()); got != want {
+		t.Fatalf("version of the same state differs: %s, %s", got, want)
+	}
+	for name, change := range map[string]func(e *ReviewInfo){
+		"approval status":   func(e *ReviewInfo) { e.ApprovalStatus = "approved" },
+		"work status user":  func(e *ReviewInfo) { e.WorkStatusUpdatedUser = "sam" },
+		"modified":          func(e *ReviewInfo) { e.ModifiedAtUTC = e.ModifiedAtUTC.Add(time.Second) },
+		"take path":         func(e *ReviewInfo) { e.TakePath += "_fix" },
+		"target components": func(e *ReviewInfo) { e.TargetComponents = append(e.TargetComponents, "head") },
+		"duration":          func(e *ReviewInfo) { *e.Duration = 50 },
+		"no duration":       func(e *ReviewInfo) { e.Duration = nil },
+		"review comments":   func(e *ReviewInfo) { e.ReviewComments[0].Text = "approved as is" },
+	} {
+		e := base()
+		change(e)
+		if ReviewInfoVersion(e) == want {
+			t.Errorf("%s: version unchanged", name)
+		}
+	}
+}
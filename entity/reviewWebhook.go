@@ -0,0 +1,64 @@
+package entity
+
+import "time"
+
+// Review event types a webhook can subscribe to.
+const (
+	ReviewEventCreated               = "review.created"
+	ReviewEventUpdated               = "review.updated"
+	ReviewEventDeleted               = "review.deleted"
+	ReviewEventRestored              = "review.restored"
+	ReviewEventApprovalStatusChanged = "review.approval_status_changed"
+)
+
+// ReviewWebhook is a per-project subscription to review events. Secret signs every
+// delivery and is never returned by the API.
+type ReviewWebhook struct {
+	ID           int32     `json:"id"`
+	Project      string    `json:"project"`
+	URL          string    `json:"url"`
+	EventTypes   []string  `json:"event_types"`
+	Secret       string    `json:"-"`
+	CreatedBy    string    `json:"created_by"`
+	CreatedAtUtc time.Time `json:"created_at_utc"`
+}
+
+// Subscribes reports whether the webhook wants events of eventType.
+func (w *ReviewWebhook) Subscribes(eventType string) bool {
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+type CreateReviewWebhookParams struct {
+	Project    string   `binding:"required"`
+	URL        string   `binding:"required,url,max=1024"`
+	EventTypes []string `binding:"required,min=1,dive,oneof=review.created review.updated review.deleted review.restored review.approval_status_changed"`
+	Secret     string   `binding:"required,min=16,max=255"`
+	CreatedBy  string
+}
+
+type ListReviewWebhookParams struct {
+	Project string `binding:"required"`
+}
+
+type DeleteReviewWebhookParams struct {
+	Project string `binding:"required"`
+	ID      int32  `binding:"required"`
+}
+
+// ReviewEvent is the JSON body POSTed to webhooks. Retries of a delivery carry the
+// same ID so receivers can drop duplicates.
+type ReviewEvent struct {
+	ID            string      `json:"id"`
+	Type          string      `json:"type"`
+	Project       string      `json:"project"`
+	OccurredAtUtc time.Time   `json:"occurred_at_utc"`
+	Review        *ReviewInfo `json:"review"`
+
+	// Set on review.approval_status_changed.
+	PreviousApprovalStatus string `json:"previous_approval_status,omitempty"`
+}
@@ -0,0 +1,21 @@
+package entity
+
+// ReviewWorkload counts the asset phases of one studio and phase by state, following the
+// latest row of every asset phase like the pivot: Approved ones carry the final approval
+// status, Pending ones wait in the review queue, WIP is everything else.
+type ReviewWorkload struct {
+	Studio   string `json:"studio"`
+	Phase    string `json:"phase"`
+	WIP      int64  `json:"wip"`
+	Pending  int64  `json:"pending"`
+	Approved int64  `json:"approved"`
+	Total    int64  `json:"total"`
+}
+
+// ReviewWorkloadParams asks for the workload of Project, limited to Root when set. Role
+// limits the assets counted like the pivot's category access.
+type ReviewWorkloadParams struct {
+	Project string `binding:"required,max=255"`
+	Root    string `binding:"max=255"`
+	Role    string
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// UnassignedAsset is an asset whose leaf group (groups[0] of its latest review) has no
+// group category, so the grouped pivot files it under "Unassigned".
+type UnassignedAsset struct {
+	Name              string    `json:"name"`
+	Relation          string    `json:"relation"`
+	LeafGroupName     string    `json:"leaf_group_name"` // "" when the review has no groups
+	LastModifiedAtUtc time.Time `json:"last_modified_at_utc"`
+}
+
+type UnassignedAssetListParams struct {
+	Project string `binding:"required"`
+	*BaseListParams
+}
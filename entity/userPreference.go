@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// UserPreferenceKeyReviewColumns stores the review pivot column layout of a user.
+const UserPreferenceKeyReviewColumns = "review-columns"
+
+// UserPreference is one named JSON document kept per user, so UI layouts follow the
+// user across machines instead of living in one browser's local storage.
+type UserPreference struct {
+	User         string          `json:"user"`
+	Key          string          `json:"key"`
+	Value        json.RawMessage `json:"value"`
+	UpdatedAtUtc time.Time       `json:"updated_at_utc"`
+}
+
+type GetUserPreferenceParams struct {
+	User string `binding:"required"`
+	Key  string `binding:"required"`
+}
+
+type PutUserPreferenceParams struct {
+	User  string          `binding:"required"`
+	Key   string          `binding:"required"`
+	Value json.RawMessage `binding:"required"`
+}
+
+type DeleteUserPreferenceParams struct {
+	User string `binding:"required"`
+	Key  string `binding:"required"`
+}
+
+// ReviewColumnsPreference is the value stored under UserPreferenceKeyReviewColumns.
+// Columns are listed in display order; columns the client knows but that are missing
+// here keep their default visibility.
+type ReviewColumnsPreference struct {
+	Columns []ReviewColumnPreference `json:"columns"`
+}
+
+type ReviewColumnPreference struct {
+	Key     string `json:"key"`
+	Visible bool   `json:"visible"`
+	Width   *int   `json:"width,omitempty"`
+}
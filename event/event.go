@@ -0,0 +1,213 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		event/event.go
+
+	Module Description:
+		Versioned domain event payloads published to external integrators
+		(webhooks, streams).
+
+	Details:
+	- Every event travels in an Envelope; Data holds the payload of Type at Version.
+	- Each (type, version) has a JSON Schema in schemas/<type>.v<version>.json, served
+	  by Schema so integrators can validate what they receive.
+	- Compatibility rules for a published version:
+	  * fields may be added (optional, or with a documented default);
+	  * fields are never removed, renamed, retyped or given a new meaning.
+	  Anything else is a new version. Producers keep emitting the previous version
+	  alongside the new one until integrators have moved.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation (review.created, status.changed, asset.renamed v1).
+
+	Functions:
+	* - New: Wraps a payload in an Envelope.
+	* - Marshal: Encodes a payload as an Envelope in one step.
+	* - Unmarshal: Decodes an Envelope and its payload.
+	* - (Envelope) Payload: Decodes Data into the registered payload type.
+	* - Schema: Returns the JSON Schema of a (type, version).
+	* - Versions: Lists the published versions of a type.
+	────────────────────────────────────────────────────────────────────────── */
+
+package event
+
+import (
+	"crypto/rand"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	TypeReviewCreated = "review.created"
+	TypeStatusChanged = "status.changed"
+	TypeAssetRenamed  = "asset.renamed"
+)
+
+// Envelope is the stable outer shape of every event. Its own fields follow the same
+// compatibility rules as the payloads.
+type Envelope struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	Version       int             `json:"version"`
+	Project       string          `json:"project"`
+	OccurredAtUtc time.Time       `json:"occurred_at_utc"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Payload is implemented by every versioned event body.
+type Payload interface {
+	EventType() string
+	EventVersion() int
+}
+
+// ReviewCreatedV1 is emitted when a take is submitted for review.
+type ReviewCreatedV1 struct {
+	ReviewInfoID   int32     `json:"review_info_id"`
+	Root           string    `json:"root"`
+	Groups         []string  `json:"groups"`
+	Relation       string    `json:"relation"`
+	Phase          string    `json:"phase"`
+	Component      string    `json:"component,omitempty"`
+	Take           string    `json:"take,omitempty"`
+	SubmittedBy    string    `json:"submitted_by,omitempty"`
+	SubmittedAtUtc time.Time `json:"submitted_at_utc"`
+}
+
+func (ReviewCreatedV1) EventType() string { return TypeReviewCreated }
+func (ReviewCreatedV1) EventVersion() int { return 1 }
+
+// StatusChangedV1 is emitted when approval_status or work_status of a review changes.
+type StatusChangedV1 struct {
+	ReviewInfoID int32    `json:"review_info_id"`
+	Root         string   `json:"root"`
+	Groups       []string `json:"groups"`
+	Relation     string   `json:"relation"`
+	Phase        string   `json:"phase"`
+	Field        string   `json:"field"` // "approval_status" | "work_status"
+	From         *string  `json:"from"`  // null when unknown or previously unset
+	To           string   `json:"to"`
+	ChangedBy    string   `json:"changed_by,omitempty"`
+	SessionID    string   `json:"session_id,omitempty"`
+}
+
+func (StatusChangedV1) EventType() string { return TypeStatusChanged }
+func (StatusChangedV1) EventVersion() int { return 1 }
+
+// AssetRenamedV1 is emitted when an asset moves to a new group path or relation.
+type AssetRenamedV1 struct {
+	Root         string   `json:"root"`
+	FromGroups   []string `json:"from_groups"`
+	ToGroups     []string `json:"to_groups"`
+	FromRelation string   `json:"from_relation"`
+	ToRelation   string   `json:"to_relation"`
+	RenamedBy    string   `json:"renamed_by,omitempty"`
+}
+
+func (AssetRenamedV1) EventType() string { return TypeAssetRenamed }
+func (AssetRenamedV1) EventVersion() int { return 1 }
+
+type typeVersion struct {
+	typ     string
+	version int
+}
+
+// registry maps every published (type, version) to a constructor of its payload.
+var registry = map[typeVersion]func() Payload{
+	{TypeReviewCreated, 1}: func() Payload { return &ReviewCreatedV1{} },
+	{TypeStatusChanged, 1}: func() Payload { return &StatusChangedV1{} },
+	{TypeAssetRenamed, 1}:  func() Payload { return &AssetRenamedV1{} },
+}
+
+//go:embed schemas/*.json
+var schemaFS embed.FS
+
+func New(project string, p Payload, at time.Time) (*Envelope, error) {
+	if _, ok := registry[typeVersion{p.EventType(), p.EventVersion()}]; !ok {
+		return nil, fmt.Errorf("event %s v%d is not registered", p.EventType(), p.EventVersion())
+	}
+	data, err := json.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	id, err := newID()
+	if err != nil {
+		return nil, err
+	}
+	return &Envelope{
+		ID:            id,
+		Type:          p.EventType(),
+		Version:       p.EventVersion(),
+		Project:       project,
+		OccurredAtUtc: at.UTC(),
+		Data:          data,
+	}, nil
+}
+
+func Marshal(project string, p Payload, at time.Time) ([]byte, error) {
+	e, err := New(project, p, at)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(e)
+}
+
+// Unmarshal decodes an envelope and its payload. Unknown payload fields are ignored,
+// so a consumer built against an older schema of the same version keeps working.
+func Unmarshal(b []byte) (*Envelope, Payload, error) {
+	var e Envelope
+	if err := json.Unmarshal(b, &e); err != nil {
+		return nil, nil, err
+	}
+	p, err := e.Payload()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &e, p, nil
+}
+
+func (e *Envelope) Payload() (Payload, error) {
+	ctor, ok := registry[typeVersion{e.Type, e.Version}]
+	if !ok {
+		return nil, fmt.Errorf("unknown event %s v%d", e.Type, e.Version)
+	}
+	p := ctor()
+	if err := json.Unmarshal(e.Data, p); err != nil {
+		return nil, fmt.Errorf("event %s v%d: %w", e.Type, e.Version, err)
+	}
+	return p, nil
+}
+
+// Schema returns the JSON Schema document of typ at version.
+func Schema(typ string, version int) ([]byte, bool) {
+	if _, ok := registry[typeVersion{typ, version}]; !ok {
+		return nil, false
+	}
+	b, err := schemaFS.ReadFile(fmt.Sprintf("schemas/%s.v%d.json", typ, version))
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+// Versions lists the published versions of typ, oldest first.
+func Versions(typ string) []int {
+	var out []int
+	for tv := range registry {
+		if tv.typ == typ {
+			out = append(out, tv.version)
+		}
+	}
+	sort.Ints(out)
+	return out
+}
+
+func newID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,224 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// samples holds every registered payload with all of its fields set, so that each
+// field, omitempty ones included, is checked against the schema.
+var samples = map[typeVersion]Payload{
+	{TypeReviewCreated, 1}: &ReviewCreatedV1{
+		ReviewInfoID:   42,
+		Root:           "assets",
+		Groups:         []string{"chr_main"},
+		Relation:       "main",
+		Phase:          "MDL",
+		Component:      "body",
+		Take:           "take0003",
+		SubmittedBy:    "sam",
+		SubmittedAtUtc: time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC),
+	},
+	{TypeStatusChanged, 1}: &StatusChangedV1{
+		ReviewInfoID: 42,
+		Root:         "assets",
+		Groups:       []string{"chr_main"},
+		Relation:     "main",
+		Phase:        "MDL",
+		Field:        "approval_status",
+		From:         func() *string { s := "check"; return &s }(),
+		To:           "approved",
+		ChangedBy:    "sam",
+		SessionID:    "s-1",
+	},
+	{TypeAssetRenamed, 1}: &AssetRenamedV1{
+		Root:         "assets",
+		FromGroups:   []string{"chr_main"},
+		ToGroups:     []string{"chr_hero"},
+		FromRelation: "main",
+		ToRelation:   "hero",
+		RenamedBy:    "sam",
+	},
+}
+
+// compileSchema compiles schemas/name.
+func compileSchema(t *testing.T, name string) *jsonschema.Schema {
+	t.Helper()
+	b, err := schemaFS.ReadFile("schemas/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(b))
+	if err != nil {
+		t.Fatalf("schemas/%s: %v", name, err)
+	}
+	c := jsonschema.NewCompiler()
+	c.AssertFormat()
+	if err := c.AddResource(name, doc); err != nil {
+		t.Fatalf("schemas/%s: %v", name, err)
+	}
+	s, err := c.Compile(name)
+	if err != nil {
+		t.Fatalf("schemas/%s: %v", name, err)
+	}
+	return s
+}
+
+// validate checks the JSON document b against s.
+func validate(s *jsonschema.Schema, b []byte) error {
+	inst, err := jsonschema.UnmarshalJSON(bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	return s.Validate(inst)
+}
+
+// schemaDoc holds the top-level properties and required list of a schema file.
+type schemaDoc struct {
+	Properties map[string]json.RawMessage `json:"properties"`
+	Required   []string                   `json:"required"`
+}
+
+// readSchemaDoc reads schemas/name.
+func readSchemaDoc(t *testing.T, name string) schemaDoc {
+	t.Helper()
+	b, err := schemaFS.ReadFile("schemas/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc schemaDoc
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Every schema file describes a registered payload, and every registered payload
+// marshals to a document its schema accepts, declaring exactly the payload's fields.
+func TestSchemasMatchPayloads(t *testing.T) {
+	entries, err := fs.ReadDir(schemaFS, "schemas")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if name == "envelope.json" {
+			continue
+		}
+		var tv typeVersion
+		base := strings.TrimSuffix(name, ".json")
+		i := strings.LastIndex(base, ".v")
+		if i < 0 {
+			t.Errorf("schemas/%s: not named <type>.v<version>.json", name)
+			continue
+		}
+		tv.typ = base[:i]
+		if _, err := fmt.Sscanf(base[i+2:], "%d", &tv.version); err != nil {
+			t.Errorf("schemas/%s: not named <type>.v<version>.json", name)
+			continue
+		}
+		if _, ok := registry[tv]; !ok {
+			t.Errorf("schemas/%s has no registered payload type", name)
+		}
+	}
+
+	for tv, ctor := range registry {
+		name := fmt.Sprintf("%s.v%d.json", tv.typ, tv.version)
+		t.Run(name, func(t *testing.T) {
+			if _, ok := Schema(tv.typ, tv.version); !ok {
+				t.Fatalf("%s v%d has no schemas/%s", tv.typ, tv.version, name)
+			}
+			sample, ok := samples[tv]
+			if !ok {
+				t.Fatalf("%s v%d has no sample payload in this test", tv.typ, tv.version)
+			}
+			s := compileSchema(t, name)
+
+			full, err := json.Marshal(sample)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := validate(s, full); err != nil {
+				t.Errorf("sample payload: %v", err)
+			}
+			// The zero payload leaves out the omitempty fields, so it still carries
+			// every required one.
+			zero, err := json.Marshal(ctor())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var present map[string]json.RawMessage
+			if err := json.Unmarshal(zero, &present); err != nil {
+				t.Fatal(err)
+			}
+			for _, name := range readSchemaDoc(t, name).Required {
+				if _, ok := present[name]; !ok {
+					t.Errorf("required property %s is omitempty in the payload", name)
+				}
+			}
+
+			var fields map[string]json.RawMessage
+			if err := json.Unmarshal(full, &fields); err != nil {
+				t.Fatal(err)
+			}
+			got, want := sortedKeys(fields), sortedKeys(readSchemaDoc(t, name).Properties)
+			if strings.Join(got, ",") != strings.Join(want, ",") {
+				t.Errorf("payload fields %v, schema properties %v", got, want)
+			}
+		})
+	}
+}
+
+// The envelope of every payload matches envelope.json, whose type enum lists exactly
+// the registered types.
+func TestEnvelopeMatchesSchema(t *testing.T) {
+	s := compileSchema(t, "envelope.json")
+	types := map[string]bool{}
+	for tv, sample := range samples {
+		types[tv.typ] = true
+		b, err := Marshal("rod", sample, time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := validate(s, b); err != nil {
+			t.Errorf("%s v%d envelope: %v", tv.typ, tv.version, err)
+		}
+	}
+
+	b, err := schemaFS.ReadFile("schemas/envelope.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc struct {
+		Properties struct {
+			Type struct {
+				Enum []string `json:"enum"`
+			} `json:"type"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		t.Fatal(err)
+	}
+	enum := append([]string(nil), doc.Properties.Type.Enum...)
+	sort.Strings(enum)
+	if got := sortedKeys(types); strings.Join(got, ",") != strings.Join(enum, ",") {
+		t.Errorf("registered types %v, envelope.json type enum %v", got, enum)
+	}
+}
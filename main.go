@@ -3,28 +3,38 @@ package main
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
 	"net/url"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"cloud.google.com/go/bigquery"
 	"cloud.google.com/go/logging/logadmin"
+	"cloud.google.com/go/storage"
 	"github.com/PolygonPictures/central30-web/front/database"
 	"github.com/PolygonPictures/central30-web/front/entity"
 	"github.com/PolygonPictures/central30-web/front/license"
+	"github.com/PolygonPictures/central30-web/front/metrics"
 	"github.com/PolygonPictures/central30-web/front/project"
 
 	"github.com/PolygonPictures/central30-web/front/delivery"
+	"github.com/PolygonPictures/central30-web/front/delivery/rpc"
+	"github.com/PolygonPictures/central30-web/front/delivery/rpc/reviewpb"
 	"github.com/PolygonPictures/central30-web/front/publishlog"
 	"github.com/PolygonPictures/central30-web/front/repository"
 	"github.com/PolygonPictures/central30-web/front/service"
 	"github.com/PolygonPictures/central30-web/front/setting"
 	"github.com/PolygonPictures/central30-web/front/setting/domain"
+	"github.com/PolygonPictures/central30-web/front/tracing"
 	httpHandler "github.com/PolygonPictures/central30-web/front/setting/handler/http"
 	legacyRepository "github.com/PolygonPictures/central30-web/front/setting/repository/legacy"
 	settingRepository "github.com/PolygonPictures/central30-web/front/setting/repository/mysql"
@@ -34,8 +44,10 @@ import (
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
+	"github.com/glebarez/sqlite"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
@@ -106,6 +118,26 @@ func mySQLConfigs() (string, string, string, string, string) {
 	return dbUser, dbPass, dbHost, dbPort, dbName
 }
 
+// mySQLReplicaConfigs reads the read replica: PPI_MYSQL_REPLICA_HOST (unset disables it),
+// PPI_MYSQL_REPLICA_PORT (defaults to the primary's port) and PPI_MYSQL_REPLICA_MAX_LAG,
+// the lag above which reads fall back to the primary (Go duration, default 30s).
+func mySQLReplicaConfigs(primaryPort string) (string, string, time.Duration) {
+	host := os.Getenv("PPI_MYSQL_REPLICA_HOST")
+	port := os.Getenv("PPI_MYSQL_REPLICA_PORT")
+	if port == "" {
+		port = primaryPort
+	}
+	maxLag := 30 * time.Second
+	if v := os.Getenv("PPI_MYSQL_REPLICA_MAX_LAG"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("invalid PPI_MYSQL_REPLICA_MAX_LAG %q: %v", v, err)
+		}
+		maxLag = d
+	}
+	return host, port, maxLag
+}
+
 func mongoConfigs() (string, string, string, string, string) {
 	dbUser := os.Getenv("PPI_MONGODB_USER")
 	dbPass := os.Getenv("PPI_MONGODB_PASSWORD")
@@ -115,6 +147,192 @@ func mongoConfigs() (string, string, string, string, string) {
 	return dbUser, dbPass, dbHost, dbPort, dbName
 }
 
+// rateLimitConfigs reads the per-client limit of the list and pivot reads;
+// PPI_RATE_LIMIT_RPS=0 disables it.
+func rateLimitConfigs() delivery.RateLimitConfig {
+	cfg := delivery.RateLimitConfig{RPS: 10, Burst: 20}
+	if v := os.Getenv("PPI_RATE_LIMIT_RPS"); v != "" {
+		rps, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			log.Fatalf("invalid PPI_RATE_LIMIT_RPS %q: %v", v, err)
+		}
+		cfg.RPS = rps
+	}
+	if v := os.Getenv("PPI_RATE_LIMIT_BURST"); v != "" {
+		burst, err := strconv.Atoi(v)
+		if err != nil {
+			log.Fatalf("invalid PPI_RATE_LIMIT_BURST %q: %v", v, err)
+		}
+		cfg.Burst = burst
+	}
+	return cfg
+}
+
+// pageLimitsConfig reads the asset pivot page limits: the JSON file PPI_PAGE_LIMITS_FILE
+// ({"per_page": 30, "max_per_page": 100, ..., "projects": {"rod": {"max_per_page": 30}}})
+// and over it PPI_PIVOT_PER_PAGE / PPI_PIVOT_MAX_PER_PAGE. Unset values keep
+// entity.DefaultPageLimits.
+func pageLimitsConfig() *entity.PageLimitsConfig {
+	cfg := &entity.PageLimitsConfig{}
+	if path := os.Getenv("PPI_PAGE_LIMITS_FILE"); path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			log.Fatalf("failed to read PPI_PAGE_LIMITS_FILE %q: %v", path, err)
+		}
+		if err := json.Unmarshal(b, cfg); err != nil {
+			log.Fatalf("invalid PPI_PAGE_LIMITS_FILE %q: %v", path, err)
+		}
+	}
+	for name, n := range map[string]*int{
+		"PPI_PIVOT_PER_PAGE":     &cfg.PerPage,
+		"PPI_PIVOT_MAX_PER_PAGE": &cfg.MaxPerPage,
+	} {
+		if v := os.Getenv(name); v != "" {
+			parsed, err := strconv.Atoi(v)
+			if err != nil || parsed < 1 {
+				log.Fatalf("invalid %s %q: must be a positive integer", name, v)
+			}
+			*n = parsed
+		}
+	}
+	return cfg
+}
+
+// trashRetention reads how long deleted review infos are kept before they are purged,
+// as a Go duration (PPI_TRASH_RETENTION=720h); 0 keeps them forever.
+// shutdownConfig reads the graceful shutdown settings: PPI_SHUTDOWN_DELAY (default
+// 5s) keeps serving after readiness fails, PPI_SHUTDOWN_TIMEOUT (default 30s) bounds
+// the wait for in-flight requests.
+func shutdownConfig() delivery.LifecycleConfig {
+	cfg := delivery.LifecycleConfig{
+		Delay:     5 * time.Second,
+		Timeout:   30 * time.Second,
+		Cancelled: 5 * time.Second,
+	}
+	for name, d := range map[string]*time.Duration{
+		"PPI_SHUTDOWN_DELAY":   &cfg.Delay,
+		"PPI_SHUTDOWN_TIMEOUT": &cfg.Timeout,
+	} {
+		if v := os.Getenv(name); v != "" {
+			parsed, err := time.ParseDuration(v)
+			if err != nil {
+				log.Fatalf("invalid %s %q: %v", name, v, err)
+			}
+			*d = parsed
+		}
+	}
+	return cfg
+}
+
+// queryTimeouts reads the query timeouts of the usecases as Go durations over
+// usecase.DefaultTimeoutConfig: PPI_READ_TIMEOUT, PPI_WRITE_TIMEOUT, PPI_PIVOT_TIMEOUT
+// (a project's query policy may replace it) and PPI_EXPORT_TIMEOUT (one export job).
+func queryTimeouts() usecase.TimeoutConfig {
+	cfg := usecase.DefaultTimeoutConfig()
+	for name, d := range map[string]*time.Duration{
+		"PPI_READ_TIMEOUT":   &cfg.Read,
+		"PPI_WRITE_TIMEOUT":  &cfg.Write,
+		"PPI_PIVOT_TIMEOUT":  &cfg.Pivot,
+		"PPI_EXPORT_TIMEOUT": &cfg.Export,
+	} {
+		if v := os.Getenv(name); v != "" {
+			parsed, err := time.ParseDuration(v)
+			if err != nil || parsed <= 0 {
+				log.Fatalf("invalid %s %q: must be a positive duration", name, v)
+			}
+			*d = parsed
+		}
+	}
+	return cfg
+}
+
+func trashRetention() time.Duration {
+	retention := 30 * 24 * time.Hour
+	if v := os.Getenv("PPI_TRASH_RETENTION"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			log.Fatalf("invalid PPI_TRASH_RETENTION %q: %v", v, err)
+		}
+		retention = d
+	}
+	return retention
+}
+
+// openExportStorage stores export files in the GCS bucket PPI_EXPORT_BUCKET, or in the
+// local directory PPI_EXPORT_DIR when no bucket is set.
+func openExportStorage() (entity.ExportStorage, error) {
+	if bucket := os.Getenv("PPI_EXPORT_BUCKET"); bucket != "" {
+		client, err := storage.NewClient(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return repository.NewGCSExportStorage(client, bucket, "review-exports/"), nil
+	}
+	dir := os.Getenv("PPI_EXPORT_DIR")
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "central30-exports")
+	}
+	return repository.NewFileExportStorage(dir)
+}
+
+// assetNotifiers returns the channels asset watchers are notified on: email through the
+// SMTP relay PPI_SMTP_ADDR (from PPI_SMTP_FROM) and Slack with the bot token
+// PPI_SLACK_BOT_TOKEN. A channel without configuration is left out.
+func assetNotifiers() []entity.AssetNotifier {
+	var notifiers []entity.AssetNotifier
+	if addr := os.Getenv("PPI_SMTP_ADDR"); addr != "" {
+		notifiers = append(notifiers, repository.NewSMTPAssetNotifier(repository.SMTPConfig{
+			Addr:     addr,
+			From:     os.Getenv("PPI_SMTP_FROM"),
+			Username: os.Getenv("PPI_SMTP_USERNAME"),
+			Password: os.Getenv("PPI_SMTP_PASSWORD"),
+		}))
+	}
+	if token := os.Getenv("PPI_SLACK_BOT_TOKEN"); token != "" {
+		notifiers = append(notifiers, repository.NewSlackAssetNotifier(token))
+	}
+	return notifiers
+}
+
+// openMediaSigner signs review media URLs for the store named by PPI_MEDIA_BACKEND:
+// "gcs" and "s3" use the bucket PPI_MEDIA_BUCKET, "local" serves the directory
+// PPI_MEDIA_DIR through /media with URLs signed by PPI_MEDIA_SECRET. It returns nil when
+// no backend is set.
+func openMediaSigner() (entity.MediaSigner, error) {
+	prefix := os.Getenv("PPI_MEDIA_PREFIX")
+	switch backend := os.Getenv("PPI_MEDIA_BACKEND"); backend {
+	case "gcs":
+		client, err := storage.NewClient(context.Background())
+		if err != nil {
+			return nil, err
+		}
+		return repository.NewGCSMediaSigner(client, os.Getenv("PPI_MEDIA_BUCKET"), prefix), nil
+	case "s3":
+		return repository.NewS3MediaSigner(repository.S3MediaConfig{
+			Bucket:          os.Getenv("PPI_MEDIA_BUCKET"),
+			Prefix:          prefix,
+			Region:          os.Getenv("PPI_MEDIA_S3_REGION"),
+			Endpoint:        os.Getenv("PPI_MEDIA_S3_ENDPOINT"),
+			AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+			SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		}), nil
+	case "":
+		return nil, nil
+	case "local":
+		secret := os.Getenv("PPI_MEDIA_SECRET")
+		if secret == "" {
+			return nil, fmt.Errorf("PPI_MEDIA_SECRET is required for local media")
+		}
+		baseURL := os.Getenv("PPI_MEDIA_BASE_URL")
+		if baseURL == "" {
+			baseURL = "/media"
+		}
+		return repository.NewLocalMediaSigner(os.Getenv("PPI_MEDIA_DIR"), baseURL, secret), nil
+	default:
+		return nil, fmt.Errorf("unknown PPI_MEDIA_BACKEND %q", backend)
+	}
+}
+
 // NewNeo4jConfig creates a new Neo4jConfig instance by reading the necessary configuration values
 // from environment variables.
 //
@@ -154,6 +372,42 @@ func openMySQL(dbUser, dbPass, dbHost, dbPort, dbName string) (*sql.DB, error) {
 	return openMySQLByDSN(dsn)
 }
 
+func openGorm(dbUser, dbPass, dbHost, dbPort, dbName string) (*gorm.DB, error) {
+	return gorm.Open(
+		mysql.Open(
+			fmt.Sprintf(
+				"%s:%s@(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+				dbUser,
+				dbPass,
+				dbHost,
+				dbPort,
+				dbName,
+			),
+		),
+		gormConfig(),
+	)
+}
+
+// openSQLite opens the SQLite database at path for local development; ":memory:" is
+// one in-memory database shared by the connections of the pool.
+func openSQLite(path string) (*gorm.DB, error) {
+	if path == ":memory:" {
+		path = "file::memory:?cache=shared"
+	}
+	return gorm.Open(sqlite.Open(path), gormConfig())
+}
+
+func gormConfig() *gorm.Config {
+	return &gorm.Config{
+		SkipDefaultTransaction: true,
+		NamingStrategy: schema.NamingStrategy{
+			TablePrefix:   "t_",
+			SingularTable: true,
+		},
+		DisableForeignKeyConstraintWhenMigrating: true,
+	}
+}
+
 func openMongo(dbUser, dbPass, dbHost, dbPort, dbName string) (*mongo.Database, error) {
 	val := url.Values{}
 	val.Add("connect", "direct")
@@ -304,6 +558,7 @@ func registerDataDepHandlers(router *gin.RouterGroup, dataDepUsecase *usecase.Da
 
 func main() {
 	ctx := context.Background()
+	timeouts := queryTimeouts()
 
 	projectID, publishLogDatasetID := bqConfigs()
 	client, err := openBigQuery(projectID)
@@ -318,33 +573,42 @@ func main() {
 	defer cloudLoggingClient.Close()
 
 	dbUser, dbPass, dbHost, dbPort, dbName := mySQLConfigs()
-	myDB, err := openMySQL(dbUser, dbPass, dbHost, dbPort, dbName)
-	if err != nil {
-		log.Fatal(err)
+	var myDB *sql.DB
+	var gormDB *gorm.DB
+	if path := os.Getenv("PPI_SQLITE_PATH"); path != "" {
+		// Local development without a MySQL server: a file (or ":memory:") database.
+		// The review list and pivot run on it; the MySQL-only features fail per request.
+		gormDB, err = openSQLite(path)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := repository.PrepareSQLite(gormDB); err != nil {
+			log.Fatal(err)
+		}
+		myDB, err = gormDB.DB()
+		if err != nil {
+			log.Fatal(err)
+		}
+	} else {
+		myDB, err = openMySQL(dbUser, dbPass, dbHost, dbPort, dbName)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		gormDB, err = openGorm(dbUser, dbPass, dbHost, dbPort, dbName)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
 
-	gormDB, err := gorm.Open(
-		mysql.Open(
-			fmt.Sprintf(
-				"%s:%s@(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-				dbUser,
-				dbPass,
-				dbHost,
-				dbPort,
-				dbName,
-			),
-		),
-		&gorm.Config{
-			SkipDefaultTransaction: true,
-			NamingStrategy: schema.NamingStrategy{
-				TablePrefix:   "t_",
-				SingularTable: true,
-			},
-			DisableForeignKeyConstraintWhenMigrating: true,
-		},
-	)
-	if err != nil {
-		log.Fatal(err)
+	// Read replica for the heavy review queries (pivot, counts, List), if configured.
+	var replicaDB *gorm.DB
+	replicaHost, replicaPort, replicaMaxLag := mySQLReplicaConfigs(dbPort)
+	if replicaHost != "" {
+		replicaDB, err = openGorm(dbUser, dbPass, replicaHost, replicaPort, dbName)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
 
 	dbUser, dbPass, dbHost, dbPort, dbName = mongoConfigs()
@@ -353,6 +617,16 @@ func main() {
 		log.Fatal(err)
 	}
 
+	shutdownTracing, err := tracing.Setup(context.Background(), "central30-web")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer shutdownTracing(context.Background())
+
+	// Background workers stop once the server has drained.
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+
 	binding.Validator = new(defaultValidator)
 	router := gin.New()
 	router.UseRawPath = true
@@ -366,7 +640,12 @@ func main() {
 		c.AbortWithStatus(http.StatusInternalServerError)
 	}))
 
+	lifecycle := delivery.NewLifecycle(shutdownConfig())
+	router.Use(lifecycle.Track())
 	router.Use(gin.Logger())
+	router.Use(delivery.Tracing())
+	router.Use(delivery.Metrics())
+	router.Use(delivery.Compression(delivery.DefaultCompressionConfig()))
 
 	// https://github.com/gin-gonic/gin/issues/1044
 	localFile := static.LocalFile("../client/build", false)
@@ -410,7 +689,14 @@ func main() {
 		c.String(http.StatusOK, "ok")
 	}
 	router.GET("/health", healthCheck)
-	router.GET("/ready", healthCheck)
+	router.GET("/ready", func(c *gin.Context) {
+		if lifecycle.Draining() {
+			c.String(http.StatusServiceUnavailable, "shutting down")
+			return
+		}
+		healthCheck(c)
+	})
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
     
 	// =========================================================================
 	// START: NEW UNAUTHENTICATED ENDPOINT BLOCK (Must use 'router.GET')
@@ -458,6 +744,7 @@ func main() {
 	// END: NEW UNAUTHENTICATED ENDPOINT BLOCK
 	// =========================================================================
 	apiRouter := router.Group("/api")
+	apiRouter.Use(delivery.ErrorEnvelope())
 	{
 		myRepo := database.NewMySQLRepository(myDB)
 		mongoRepo := database.NewMongoRepository(mongoDB)
@@ -487,7 +774,7 @@ func main() {
 		// MARK: Usecases (Services)
 
 		dataDepUsecase := usecase.NewDataDepUsecase(
-			dataDepRepo, projectInfoRepository, readTimeout, writeTimeout,
+			dataDepRepo, projectInfoRepository, timeouts.Read, timeouts.Write,
 		)
 
 		// MARK: HTTP Deliveries (Handlers)
@@ -515,7 +802,7 @@ func main() {
 			log.Fatal(err)
 		}
 
-		handler.SetRepositoryParams(pipelineParameterRepository, readTimeout, writeTimeout)
+		handler.SetRepositoryParams(pipelineParameterRepository, timeouts.Read, timeouts.Write)
 
 		// Authentication API
 
@@ -524,12 +811,27 @@ func main() {
 		if err != nil {
 			log.Fatalln(err)
 		}
-		authUsecase := usecase.NewAuth(authRepository, readTimeout, writeTimeout)
+		authUsecase := usecase.NewAuth(authRepository, timeouts.Read, timeouts.Write)
 		authDelivery := delivery.NewAuth(authUsecase)
 		router.Use(authDelivery.ParseQueryToken)
 		apiRouter.Use(authDelivery.ParseHeaderToken)
 		apiRouter.Use(authDelivery.CheckAccessPermission)
 		apiRouter.Use(authDelivery.CreateNewToken)
+		apiRouter.Use(delivery.UserFromAuthHeader)
+
+		projectMemberRepository, err := repository.NewProjectMember(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		projectMemberUsecase := usecase.NewProjectMember(
+			projectMemberRepository,
+			projectInfoRepository,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		projectMemberDelivery := delivery.NewProjectMember(projectMemberUsecase)
+		apiRouter.Use(projectMemberDelivery.RoleFromMembership)
 		apiRouter.GET("/auth/parser")
 		apiRouter.POST("/auth/login", authDelivery.Login)
 
@@ -541,8 +843,8 @@ func main() {
 		}
 		notificationUsecase := usecase.NewNotification(
 			notificationRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		notificationDelivery := delivery.NewNotification(notificationUsecase)
 		apiRouter.Use(notificationDelivery.SendNotification)
@@ -555,8 +857,8 @@ func main() {
 
 		projectInfoUsecase := usecase.NewProjectInfo(
 			projectInfoRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		projectInfoDelivery := delivery.NewProjectInfo(projectInfoUsecase)
 		apiRouter.GET("/projects", projectInfoDelivery.List)
@@ -569,8 +871,8 @@ func main() {
 		studioInfoUsecase := usecase.NewStudioInfo(
 			studioInfoRepository,
 			projectInfoRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		studioInfoDelivery := delivery.NewStudioInfo(studioInfoUsecase)
 		apiRouter.GET("/studios", studioInfoDelivery.List)
@@ -584,7 +886,7 @@ func main() {
 			repository.ConnectedCloudLoggingFinder{Client: cloudLoggingClient},
 			getGCPProjectID(),
 		)
-		dataSyncClientUseCase := usecase.NewDataSyncClient(dataSyncClientRepository, readTimeout)
+		dataSyncClientUseCase := usecase.NewDataSyncClient(dataSyncClientRepository, timeouts.Read)
 		dataSyncClientDelivery := delivery.NewDataSyncClient(dataSyncClientUseCase)
 		apiRouter.GET("/projects/:project/studios/:studio/dataSyncClient/status", dataSyncClientDelivery.GetStatus)
 
@@ -608,8 +910,8 @@ func main() {
 			studioInfoRepository,
 			directoryDeletionInfoRepository,
 			pipelineSettingRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		directoryDelivery := delivery.NewDirectory(directoryUsecase)
 		apiRouter.GET("/projects/:project/directories", directoryDelivery.List)
@@ -635,29 +937,676 @@ func main() {
 		if err != nil {
 			log.Fatalln(err)
 		}
+		// Optimizer hints for the pivot queries, editable without a deploy.
+		if path := os.Getenv("PPI_QUERY_TUNING_FILE"); path != "" {
+			queryTuning, err := repository.NewQueryTuning(path)
+			if err != nil {
+				log.Fatalln(err)
+			}
+			reviewInfoRepository.SetQueryTuning(queryTuning)
+			go queryTuning.Watch(workerCtx, 30*time.Second)
+		}
+		// Projects with at least this many assets get estimated pivot totals.
+		if v := os.Getenv("PPI_COUNT_ESTIMATE_ASSETS"); v != "" {
+			minAssets, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				log.Fatalf("invalid PPI_COUNT_ESTIMATE_ASSETS %q: %v", v, err)
+			}
+			maxAge := 10 * time.Minute
+			if v := os.Getenv("PPI_COUNT_ESTIMATE_MAX_AGE"); v != "" {
+				if maxAge, err = time.ParseDuration(v); err != nil {
+					log.Fatalf("invalid PPI_COUNT_ESTIMATE_MAX_AGE %q: %v", v, err)
+				}
+			}
+			reviewInfoRepository.SetCountEstimate(minAssets, maxAge)
+		}
+		// Pivot queries slower than this are logged with their EXPLAIN plan.
+		if v := os.Getenv("PPI_SLOW_QUERY_THRESHOLD"); v != "" {
+			threshold, err := time.ParseDuration(v)
+			if err != nil {
+				log.Fatalf("invalid PPI_SLOW_QUERY_THRESHOLD %q: %v", v, err)
+			}
+			reviewInfoRepository.SetSlowQueryLog(threshold)
+		}
+		// Shadow comparison of a ListAssetsPivot rewrite on a sample of the calls.
+		if impl := os.Getenv("PPI_PIVOT_SHADOW"); impl != "" {
+			sample := 0.01
+			if v := os.Getenv("PPI_PIVOT_SHADOW_SAMPLE"); v != "" {
+				if sample, err = strconv.ParseFloat(v, 64); err != nil {
+					log.Fatalf("invalid PPI_PIVOT_SHADOW_SAMPLE %q: %v", v, err)
+				}
+			}
+			if err := reviewInfoRepository.SetPivotShadow(impl, sample); err != nil {
+				log.Fatalln(err)
+			}
+		}
+		if replicaDB != nil {
+			reviewInfoRepository.SetReader(replicaDB, replicaMaxLag)
+			go reviewInfoRepository.WatchReplica(workerCtx, 10*time.Second)
+		}
+		// Replica lag for monitoring; 503 while reads fall back to the primary. /health
+		// itself does not depend on the replica, since the primary can take over.
+		router.GET("/health/replica", func(c *gin.Context) {
+			status := reviewInfoRepository.ReplicaStatus()
+			code := http.StatusOK
+			if status.Configured && !status.Healthy {
+				code = http.StatusServiceUnavailable
+			}
+			c.JSON(code, status)
+		})
+
+		reviewCertificateRepository, err := repository.NewReviewCertificate(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewCertificateUsecase := usecase.NewReviewCertificate(
+			reviewCertificateRepository,
+			reviewInfoRepository,
+			projectInfoRepository,
+			os.Getenv("PPI_REVIEW_CERTIFICATE_SECRET"),
+			timeouts.Read,
+			timeouts.Write,
+		)
 
+		reviewActivityRepository, err := repository.NewReviewActivity(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewActivityUsecase := usecase.NewReviewActivity(
+			reviewActivityRepository,
+			projectInfoRepository,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		// Pivot page cache: Redis when PPI_REDIS_ADDR is set, otherwise in-process LRU.
+		var pivotCache entity.Cache = repository.NewMemoryCache(0)
+		if addr := os.Getenv("PPI_REDIS_ADDR"); addr != "" {
+			redisClient := redis.NewClient(&redis.Options{
+				Addr:     addr,
+				Password: os.Getenv("PPI_REDIS_PASSWORD"),
+			})
+			ctx, cancel := context.WithTimeout(context.Background(), connectTimeout)
+			if err := redisClient.Ping(ctx).Err(); err != nil {
+				log.Printf("Redis unavailable (%v); falling back to in-process pivot cache.", err)
+			} else {
+				pivotCache = repository.NewRedisCache(redisClient, "central30:")
+			}
+			cancel()
+		}
+
+		// Deletes reach every instance: pivot pages in the in-process cache and the
+		// pivot totals cached by each instance's review info repository.
+		pivotBroadcast, err := repository.NewBroadcastCache(pivotCache, gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		pivotBroadcast.OnRemoteDelete(func(keys []string) {
+			for _, key := range keys {
+				if project, ok := usecase.PivotCacheProject(key); ok {
+					reviewInfoRepository.InvalidateLatestCounts(project)
+				}
+			}
+		})
+		go pivotBroadcast.Watch(workerCtx, 2*time.Second)
+		pivotCache = pivotBroadcast
+
+		// Kubernetes probes: /healthz restarts an instance whose MySQL handle went stale,
+		// /readyz also checks MongoDB, the migrations, the replica and the cache.
+		health := delivery.NewHealth(connectTimeout,
+			delivery.HealthCheck{Name: "shutdown", Critical: true, Check: func(ctx context.Context) error {
+				if lifecycle.Draining() {
+					return errors.New("shutting down")
+				}
+				return nil
+			}},
+			delivery.HealthCheck{Name: "mysql", Liveness: true, Critical: true, Check: func(ctx context.Context) error {
+				sqlDB, err := gormDB.DB()
+				if err != nil {
+					return err
+				}
+				return sqlDB.PingContext(ctx)
+			}},
+			delivery.HealthCheck{Name: "mongodb", Critical: true, Check: func(ctx context.Context) error {
+				return mongoDB.Client().Ping(ctx, readpref.Primary())
+			}},
+			delivery.HealthCheck{Name: "migrations", Critical: true, Check: func(ctx context.Context) error {
+				return repository.CheckMigrations(ctx, gormDB)
+			}},
+			delivery.HealthCheck{Name: "replica", Check: func(ctx context.Context) error {
+				status := reviewInfoRepository.ReplicaStatus()
+				switch {
+				case !status.Configured || status.Healthy:
+					return nil
+				case status.Error == "":
+					return errors.New("replica not checked yet")
+				}
+				return errors.New(status.Error)
+			}},
+			delivery.HealthCheck{Name: "cache", Check: func(ctx context.Context) error {
+				_, _, err := pivotCache.Get(ctx, "health:probe")
+				return err
+			}},
+		)
+		router.GET("/healthz", health.Liveness)
+		router.GET("/readyz", health.Readiness)
+
+		categoryAccessRepository, err := repository.NewCategoryAccess(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		categoryAccessUsecase := usecase.NewCategoryAccess(
+			categoryAccessRepository,
+			projectInfoRepository,
+			projectMemberUsecase,
+			pivotCache,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		pivotDefaultsRepository, err := repository.NewPivotDefaults(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		pageLimits := pageLimitsConfig()
+		pivotDefaultsUsecase := usecase.NewPivotDefaults(
+			pivotDefaultsRepository,
+			projectInfoRepository,
+			projectMemberUsecase,
+			pivotCache,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		pivotDefaultsUsecase.PageLimits = pageLimits
+
+		requiredPhasesRepository, err := repository.NewRequiredPhases(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		requiredPhasesUsecase := usecase.NewRequiredPhases(
+			requiredPhasesRepository,
+			projectInfoRepository,
+			projectMemberUsecase,
+			pivotCache,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		projectQueryPolicyRepository, err := repository.NewProjectQueryPolicy(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		projectQueryPolicyUsecase := usecase.NewProjectQueryPolicy(
+			projectQueryPolicyRepository,
+			projectInfoRepository,
+			pivotCache,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		reviewLockRepository, err := repository.NewReviewLock(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewLockUsecase := usecase.NewReviewLock(
+			reviewLockRepository,
+			projectInfoRepository,
+			projectMemberUsecase,
+			pivotCache,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		reviewStatusHistoryRepository, err := repository.NewReviewStatusHistory(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewStatusHistoryUsecase := usecase.NewReviewStatusHistory(
+			reviewStatusHistoryRepository,
+			projectInfoRepository,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		reviewWebhookRepository, err := repository.NewReviewWebhook(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewWebhookUsecase := usecase.NewReviewWebhook(
+			reviewWebhookRepository,
+			projectInfoRepository,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		go reviewWebhookUsecase.Run(workerCtx, 4)
+
+		reviewValidationRepository, err := repository.NewReviewValidation(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewStatusRepository, err := repository.NewReviewStatus(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewStatusUsecase := usecase.NewReviewStatus(
+			reviewStatusRepository,
+			reviewInfoRepository,
+			projectInfoRepository,
+			projectMemberUsecase,
+			categoryAccessUsecase,
+			timeouts.Read,
+			timeouts.Write,
+		)
+
+		reviewValidationUsecase := usecase.NewReviewValidation(
+			reviewValidationRepository,
+			reviewStatusRepository,
+			projectInfoRepository,
+			projectMemberUsecase,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		reviewIdempotencyRepository, err := repository.NewReviewIdempotency(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
 		reviewInfoUsecase := usecase.NewReviewInfo(
 			reviewInfoRepository,
 			projectInfoRepository,
 			studioInfoRepository,
 			mongoRepo,
-			readTimeout,
-			writeTimeout,
+			reviewCertificateUsecase,
+			reviewActivityUsecase,
+			categoryAccessUsecase,
+			reviewLockUsecase,
+			pivotDefaultsUsecase,
+			requiredPhasesUsecase,
+			projectQueryPolicyUsecase,
+			projectMemberUsecase,
+			reviewStatusHistoryUsecase,
+			reviewWebhookUsecase,
+			reviewValidationUsecase,
+			reviewIdempotencyRepository,
+			pivotCache,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		reviewInfoUsecase.PivotTimeout = timeouts.Pivot
+		reviewInfoUsecase.TrashRetention = trashRetention()
+		reviewInfoUsecase.PageLimits = pageLimits
+		reviewInfoUsecase.Comments = repository.NewReviewComment(mongoDB)
+		if tmpl := os.Getenv("PPI_THUMBNAIL_URL_TEMPLATE"); tmpl != "" {
+			reviewInfoUsecase.ThumbnailURL = usecase.ThumbnailURLTemplate(tmpl)
+		}
+		assetWatchRepository, err := repository.NewAssetWatch(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		assetWatchUsecase := usecase.NewAssetWatch(
+			assetWatchRepository,
+			projectInfoRepository,
+			assetNotifiers(),
+			timeouts.Read,
+			timeouts.Write,
 		)
+		go assetWatchUsecase.Run(workerCtx, 2)
+		reviewInfoUsecase.Watches = assetWatchUsecase
+
+		// gRPC API for internal consumers (render farm) on PPI_GRPC_ADDR, e.g. ":4001",
+		// sharing the usecases with the HTTP API (see delivery/rpc). Callers name the
+		// user they act for, so the API is only served with PPI_GRPC_TOKEN set.
+		if addr, token := os.Getenv("PPI_GRPC_ADDR"), os.Getenv("PPI_GRPC_TOKEN"); addr != "" && token == "" {
+			log.Println("PPI_GRPC_TOKEN is not set; the gRPC API is disabled.")
+		} else if addr != "" {
+			grpcServer := rpc.NewServer(token)
+			reviewpb.RegisterReviewServiceServer(
+				grpcServer,
+				rpc.NewReview(reviewInfoUsecase, projectMemberUsecase, reviewWebhookUsecase),
+			)
+			go func() {
+				if err := rpc.Serve(workerCtx, grpcServer, addr); err != nil {
+					log.Fatalln(err)
+				}
+			}()
+		}
 		reviewInfoDelivery := delivery.NewReviewInfo(
 			reviewInfoUsecase,
 		)
-		apiRouter.GET("/projects/:project/reviews", reviewInfoDelivery.List)
+		// Per-client limit of the heavy list and pivot reads; the other routes are not limited.
+		readLimiter := delivery.NewRateLimiter(rateLimitConfigs())
+		apiRouter.GET("/projects/:project/reviews", readLimiter.Middleware(), reviewInfoDelivery.List)
 		apiRouter.GET("/projects/:project/reviews/:id", reviewInfoDelivery.Get)
+		apiRouter.GET("/projects/:project/reviews/:id/comments", reviewInfoDelivery.ListComments)
 		apiRouter.POST("/projects/:project/reviews", reviewInfoDelivery.Post)
+		apiRouter.POST("/projects/:project/reviews/batch", reviewInfoDelivery.PostBatch)
 		apiRouter.PATCH("/projects/:project/reviews/:id", reviewInfoDelivery.Update)
 		apiRouter.DELETE("/projects/:project/reviews/:id", reviewInfoDelivery.Delete)
-		apiRouter.GET("/projects/:project/reviews/assets", reviewInfoDelivery.ListAssets)
+		apiRouter.GET("/projects/:project/reviews/trash", reviewInfoDelivery.ListTrash)
+		apiRouter.POST("/projects/:project/reviews/:id/restore", reviewInfoDelivery.Restore)
+		apiRouter.GET("/projects/:project/reviews/sync", reviewInfoDelivery.Sync)
+		apiRouter.GET("/projects/:project/reviews/compare", reviewInfoDelivery.Compare)
+		apiRouter.GET("/projects/reviews/overview", reviewInfoDelivery.Overview)
+		apiRouter.GET("/projects/:project/reviews/stats/submissions", reviewInfoDelivery.SubmissionStats)
+		apiRouter.GET("/projects/:project/reviews/stats/approval-latency", reviewInfoDelivery.ApprovalLatency)
+		apiRouter.GET("/projects/:project/reviews/stats/retakes", reviewInfoDelivery.RetakeStats)
+		apiRouter.GET("/projects/:project/reviews/workload", reviewInfoDelivery.Workload)
+		go reviewInfoUsecase.RunTrashPurge(workerCtx, time.Hour)
+		go reviewInfoUsecase.RunIdempotencyKeyPurge(workerCtx, time.Hour)
+		go reviewInfoUsecase.RunPivotPrewarm(workerCtx, time.Minute)
+		apiRouter.GET("/projects/:project/reviews/assets", readLimiter.Middleware(), reviewInfoDelivery.ListAssets)
+		pivotBreaker := delivery.NewCircuitBreaker("assets_pivot", delivery.DefaultCircuitBreakerConfig())
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/pivot",
+			readLimiter.Middleware(),
+			pivotBreaker.Middleware(),
+			reviewInfoDelivery.ListAssetsPivot,
+		)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/pivot/groups/:topNode",
+			readLimiter.Middleware(),
+			pivotBreaker.Middleware(),
+			reviewInfoDelivery.ListAssetsPivot,
+		)
+		apiRouter.POST("/projects/:project/reviews/assets/batch", reviewInfoDelivery.BatchAssetDetails)
+		apiRouter.POST("/projects/:project/reviews/assets/bulk-set-status", reviewInfoDelivery.BulkSetWorkStatus)
+		apiRouter.GET("/projects/:project/reviews/assets/unassigned", readLimiter.Middleware(), reviewInfoDelivery.ListUnassignedAssets)
+		apiRouter.GET("/projects/:project/reviews/assets/completion", reviewInfoDelivery.Completion)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/:asset/:relation/timeline",
+			reviewInfoDelivery.AssetTimeline,
+		)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/:asset/:relation/takes",
+			reviewInfoDelivery.ListTakes,
+		)
+		apiRouter.PUT(
+			"/projects/:project/reviews/assets/:asset/:relation/takes/pin",
+			reviewInfoDelivery.PinTake,
+		)
+		apiRouter.DELETE(
+			"/projects/:project/reviews/assets/:asset/:relation/takes/pin",
+			reviewInfoDelivery.UnpinTake,
+		)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/:asset/:relation/due-dates",
+			reviewInfoDelivery.ListDueDates,
+		)
+		apiRouter.PUT(
+			"/projects/:project/reviews/assets/:asset/:relation/due-dates/:phase",
+			reviewInfoDelivery.SetDueDate,
+		)
+		apiRouter.DELETE(
+			"/projects/:project/reviews/assets/:asset/:relation/due-dates/:phase",
+			reviewInfoDelivery.ClearDueDate,
+		)
+		apiRouter.PATCH(
+			"/projects/:project/reviews/assets/:asset/priority",
+			reviewInfoDelivery.SetAssetPriority,
+		)
+		apiRouter.GET("/projects/:project/reviews/tags", reviewInfoDelivery.ListProjectTags)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/:asset/:relation/tags",
+			reviewInfoDelivery.ListTags,
+		)
+		apiRouter.POST(
+			"/projects/:project/reviews/assets/:asset/:relation/tags",
+			reviewInfoDelivery.AddTags,
+		)
+		apiRouter.DELETE(
+			"/projects/:project/reviews/assets/:asset/:relation/tags/:tag",
+			reviewInfoDelivery.RemoveTag,
+		)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/:asset/:relation/notes",
+			reviewInfoDelivery.ListNotes,
+		)
+		apiRouter.POST(
+			"/projects/:project/reviews/assets/:asset/:relation/notes",
+			reviewInfoDelivery.CreateNote,
+		)
+		apiRouter.PATCH(
+			"/projects/:project/reviews/assets/:asset/:relation/notes/:id",
+			reviewInfoDelivery.UpdateNote,
+		)
+		apiRouter.DELETE(
+			"/projects/:project/reviews/assets/:asset/:relation/notes/:id",
+			reviewInfoDelivery.DeleteNote,
+		)
+		// Asset Watch API (status change notifications by email / Slack)
+		assetWatchDelivery := delivery.NewAssetWatch(assetWatchUsecase)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/:asset/:relation/watch",
+			assetWatchDelivery.Get,
+		)
+		apiRouter.POST(
+			"/projects/:project/reviews/assets/:asset/:relation/watch",
+			assetWatchDelivery.Watch,
+		)
+		apiRouter.DELETE(
+			"/projects/:project/reviews/assets/:asset/:relation/watch",
+			assetWatchDelivery.Unwatch,
+		)
+		apiRouter.GET(
+			"/projects/:project/reviews/assets/:asset/:relation/watchers",
+			assetWatchDelivery.ListWatchers,
+		)
 		apiRouter.GET(
 			"/projects/:project/assets/:asset/relations/:relation/reviewInfos",
 			reviewInfoDelivery.ListAssetReviewInfos,
 		)
 
+		// Review Media API (signed URLs for the files of review infos)
+		mediaSigner, err := openMediaSigner()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		if mediaSigner != nil {
+			reviewMediaUsecase := usecase.NewReviewMedia(
+				reviewInfoRepository,
+				projectInfoRepository,
+				mediaSigner,
+				os.Getenv("PPI_MEDIA_ROOT"),
+				timeouts.Read,
+			)
+			if v := os.Getenv("PPI_MEDIA_URL_TTL"); v != "" {
+				ttl, err := time.ParseDuration(v)
+				if err != nil {
+					log.Fatalf("invalid PPI_MEDIA_URL_TTL %q: %v", v, err)
+				}
+				reviewMediaUsecase.TTL = ttl
+			}
+			reviewMediaDelivery := delivery.NewReviewMedia(reviewMediaUsecase)
+			apiRouter.GET("/projects/:project/reviews/:id/files/:index/url", reviewMediaDelivery.FileURL)
+			router.GET("/media", reviewMediaDelivery.Serve)
+		}
+
+		// Review Export API (background CSV exports of the asset pivot)
+		reviewExportRepository, err := repository.NewReviewExport(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		exportStorage, err := openExportStorage()
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewExportUsecase := usecase.NewReviewExport(
+			reviewExportRepository,
+			reviewInfoUsecase,
+			projectInfoRepository,
+			exportStorage,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		reviewExportUsecase.ExportTimeout = timeouts.Export
+		go reviewExportUsecase.Run(workerCtx, 2)
+		reviewExportDelivery := delivery.NewReviewExport(reviewExportUsecase)
+		apiRouter.POST("/projects/:project/reviews/exports", reviewExportDelivery.Post)
+		apiRouter.GET("/projects/:project/reviews/exports/:id", reviewExportDelivery.Get)
+		apiRouter.GET("/projects/:project/reviews/exports/:id/download", reviewExportDelivery.Download)
+
+		// Review Playlist API (ordered takes for review sessions)
+		reviewPlaylistRepository, err := repository.NewReviewPlaylist(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewPlaylistUsecase := usecase.NewReviewPlaylist(
+			reviewPlaylistRepository,
+			projectInfoRepository,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		reviewPlaylistDelivery := delivery.NewReviewPlaylist(reviewPlaylistUsecase)
+		apiRouter.GET("/projects/:project/playlists", reviewPlaylistDelivery.List)
+		apiRouter.POST("/projects/:project/playlists", reviewPlaylistDelivery.Post)
+		apiRouter.GET("/projects/:project/playlists/:id", reviewPlaylistDelivery.Get)
+		apiRouter.PUT("/projects/:project/playlists/:id/order", reviewPlaylistDelivery.Reorder)
+		apiRouter.DELETE("/projects/:project/playlists/:id", reviewPlaylistDelivery.Delete)
+
+		// Review Session API (scheduled dailies and their iCalendar feed). The token feed
+		// for calendar apps is signed with PPI_CALENDAR_SECRET and disabled without it.
+		reviewSessionRepository, err := repository.NewReviewSession(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewSessionUsecase := usecase.NewReviewSession(
+			reviewSessionRepository,
+			reviewPlaylistRepository,
+			projectInfoRepository,
+			os.Getenv("PPI_CALENDAR_SECRET"),
+			timeouts.Read,
+			timeouts.Write,
+		)
+		reviewSessionDelivery := delivery.NewReviewSession(reviewSessionUsecase)
+		apiRouter.GET("/projects/:project/review-sessions", reviewSessionDelivery.List)
+		apiRouter.POST("/projects/:project/review-sessions", reviewSessionDelivery.Post)
+		apiRouter.GET("/projects/:project/review-sessions.ics", reviewSessionDelivery.Calendar)
+		apiRouter.GET("/projects/:project/review-sessions/feed", reviewSessionDelivery.Feed)
+		apiRouter.GET("/projects/:project/review-sessions/:id", reviewSessionDelivery.Get)
+		apiRouter.DELETE("/projects/:project/review-sessions/:id", reviewSessionDelivery.Delete)
+		router.GET("/calendar/:project/review-sessions.ics", reviewSessionDelivery.PublicCalendar)
+
+		// Review Certificate API
+		reviewCertificateDelivery := delivery.NewReviewCertificate(reviewCertificateUsecase)
+		apiRouter.GET(
+			"/projects/:project/reviews/:id/certificates",
+			reviewCertificateDelivery.List,
+		)
+		apiRouter.GET(
+			"/projects/:project/reviewCertificates/:certificate",
+			reviewCertificateDelivery.Get,
+		)
+		apiRouter.GET(
+			"/projects/:project/reviewCertificates/:certificate/verify",
+			reviewCertificateDelivery.Verify,
+		)
+
+		// Review Status History API (status change timeline)
+		reviewStatusHistoryDelivery := delivery.NewReviewStatusHistory(reviewStatusHistoryUsecase)
+		apiRouter.GET("/projects/:project/reviews/:id/history", reviewStatusHistoryDelivery.List)
+
+		// Review Webhook API (notifications to downstream tools)
+		reviewWebhookDelivery := delivery.NewReviewWebhook(reviewWebhookUsecase)
+		apiRouter.GET("/projects/:project/reviewWebhooks", reviewWebhookDelivery.List)
+		apiRouter.POST("/projects/:project/reviewWebhooks", reviewWebhookDelivery.Post)
+		apiRouter.DELETE("/projects/:project/reviewWebhooks/:id", reviewWebhookDelivery.Delete)
+
+		// Review event stream (server-sent events, see client.StreamEvents)
+		reviewEventsDelivery := delivery.NewReviewEvents(reviewWebhookUsecase, lifecycle.DrainStarted())
+		apiRouter.GET("/projects/:project/reviews/events", reviewEventsDelivery.Stream)
+
+		// Review Lock API (live review sessions)
+		reviewLockDelivery := delivery.NewReviewLock(reviewLockUsecase)
+		apiRouter.GET("/projects/:project/reviews/:id/lock", reviewLockDelivery.Get)
+		apiRouter.POST("/projects/:project/reviews/:id/lock", reviewLockDelivery.Post)
+		apiRouter.DELETE("/projects/:project/reviews/:id/lock", reviewLockDelivery.Delete)
+
+		// User Preference API (per-user UI layouts)
+		userPreferenceRepository, err := repository.NewUserPreference(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		userPreferenceUsecase := usecase.NewUserPreference(
+			userPreferenceRepository,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		userPreferenceDelivery := delivery.NewUserPreference(userPreferenceUsecase)
+		apiRouter.GET("/users/me/preferences/review-columns", userPreferenceDelivery.GetReviewColumns)
+		apiRouter.PUT("/users/me/preferences/review-columns", userPreferenceDelivery.PutReviewColumns)
+		apiRouter.DELETE("/users/me/preferences/review-columns", userPreferenceDelivery.DeleteReviewColumns)
+
+		// Project Member API (review roles)
+		apiRouter.GET("/projects/:project/members", projectMemberDelivery.List)
+		apiRouter.PUT("/projects/:project/members/:user", projectMemberDelivery.Put)
+		apiRouter.DELETE("/projects/:project/members/:user", projectMemberDelivery.Delete)
+
+		// Pivot Defaults API (per-project default sort/view)
+		pivotDefaultsDelivery := delivery.NewPivotDefaults(pivotDefaultsUsecase)
+		apiRouter.GET("/projects/:project/reviews/pivot-defaults", pivotDefaultsDelivery.Get)
+		apiRouter.PUT("/projects/:project/reviews/pivot-defaults", pivotDefaultsDelivery.Put)
+
+		requiredPhasesDelivery := delivery.NewRequiredPhases(requiredPhasesUsecase)
+		apiRouter.GET("/projects/:project/reviews/required-phases", requiredPhasesDelivery.Get)
+		apiRouter.PUT("/projects/:project/reviews/required-phases", requiredPhasesDelivery.Put)
+
+		projectQueryPolicyDelivery := delivery.NewProjectQueryPolicy(projectQueryPolicyUsecase)
+		apiRouter.GET("/projects/:project/reviews/query-policy", projectQueryPolicyDelivery.Get)
+
+		// Admin API for run-time tuning; only served with PPI_ADMIN_TOKEN set.
+		if adminToken := os.Getenv("PPI_ADMIN_TOKEN"); adminToken != "" {
+			adminDelivery := delivery.NewAdmin(reviewInfoUsecase, pivotBreaker)
+			adminRouter := apiRouter.Group("/admin", delivery.AdminToken(adminToken))
+			adminRouter.GET("/projects/:project/query-policy", projectQueryPolicyDelivery.Get)
+			adminRouter.PUT("/projects/:project/query-policy", projectQueryPolicyDelivery.Put)
+			adminRouter.POST("/projects/:project/cache/flush", adminDelivery.FlushCache)
+			adminRouter.GET("/cache-ttls", adminDelivery.GetCacheTTLs)
+			adminRouter.PUT("/cache-ttls", adminDelivery.PutCacheTTLs)
+			adminRouter.GET("/circuit-breakers", adminDelivery.ListCircuitBreakers)
+			adminRouter.PUT("/circuit-breakers/:endpoint", adminDelivery.PutCircuitBreaker)
+		} else {
+			log.Println("PPI_ADMIN_TOKEN is not set; the admin API is disabled.")
+		}
+
+		reviewValidationDelivery := delivery.NewReviewValidation(reviewValidationUsecase)
+		apiRouter.GET("/projects/:project/reviews/validation-rules", reviewValidationDelivery.Get)
+		apiRouter.PUT("/projects/:project/reviews/validation-rules", reviewValidationDelivery.Put)
+
+		// Review Status API (approval / work status vocabulary)
+		reviewStatusDelivery := delivery.NewReviewStatus(reviewStatusUsecase)
+		apiRouter.GET("/projects/:project/review-statuses", reviewStatusDelivery.List)
+		apiRouter.POST("/projects/:project/review-statuses", reviewStatusDelivery.Post)
+		apiRouter.GET("/projects/:project/review-statuses/summary", reviewStatusDelivery.Summary)
+		apiRouter.PATCH("/projects/:project/review-statuses/:id", reviewStatusDelivery.Update)
+		apiRouter.DELETE("/projects/:project/review-statuses/:id", reviewStatusDelivery.Delete)
+
+		// Category Access API (asset pivot visibility per role)
+		categoryAccessDelivery := delivery.NewCategoryAccess(categoryAccessUsecase)
+		apiRouter.GET("/projects/:project/categoryAccesses", categoryAccessDelivery.List)
+		apiRouter.POST("/projects/:project/categoryAccesses", categoryAccessDelivery.Post)
+		apiRouter.DELETE("/projects/:project/categoryAccesses/:id", categoryAccessDelivery.Delete)
+
+		// Review Activity API
+		reviewActivityDelivery := delivery.NewReviewActivity(reviewActivityUsecase)
+		apiRouter.GET("/projects/:project/reviewActivities", reviewActivityDelivery.List)
+		apiRouter.GET("/projects/:project/sessions/:id/decisions", reviewActivityDelivery.SessionDecisions)
+
+		// Review Import API (legacy spreadsheet backfill)
+		reviewImportRepository, err := repository.NewReviewImport(gormDB)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		reviewImportUsecase := usecase.NewReviewImport(
+			reviewImportRepository,
+			reviewInfoRepository,
+			projectInfoRepository,
+			projectMemberUsecase,
+			reviewValidationUsecase,
+			timeouts.Read,
+			timeouts.Write,
+		)
+		reviewImportDelivery := delivery.NewReviewImport(reviewImportUsecase)
+		apiRouter.POST("/projects/:project/reviews/imports", reviewImportDelivery.Post)
+
 		// --- Simple debug endpoint: prints to server log and returns 200 ---
 		apiRouter.GET("/debug/print", func(c *gin.Context) {
 			log.Println("👉 debug/print hit: hello from main.go")
@@ -672,15 +1621,15 @@ func main() {
 			reviewStatusLogRepository,
 			projectInfoRepository,
 			studioInfoRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		pipelineSettingUsecase := usecase.NewPipelineSetting(
 			pipelineSettingRepository,
 			projectInfoRepository,
 			studioInfoRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		reviewStatusLogDelivery := delivery.NewReviewStatusLog(
 			reviewStatusLogUsecase,
@@ -708,8 +1657,8 @@ func main() {
 		attachmentRepository := repository.NewCommentAttachment(cs)
 		attachmentUsecase := usecase.NewCommentAttachment(
 			attachmentRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		attachmentDelivery := delivery.NewCommentAttachment(attachmentUsecase)
 
@@ -767,8 +1716,8 @@ func main() {
 			studioInfoRepository,
 			pipelineSettingRepository,
 			mongoRepo,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		publishTransactionInfoDelivery := delivery.NewPublishTransactionInfo(
 			publishTransactionInfoUsecase,
@@ -793,8 +1742,8 @@ func main() {
 				pipelineParameterRepository,
 				projectInfoRepository,
 				studioInfoRepository,
-				readTimeout,
-				writeTimeout,
+				timeouts.Read,
+				timeouts.Write,
 			)
 			pipelineParameterDelivery := delivery.NewPipelineParameter(pipelineParameterUsecase)
 
@@ -861,8 +1810,8 @@ func main() {
 		groupCategoryUsecase := usecase.NewGroupCategory(
 			groupCategoryRepository,
 			projectInfoRepository,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		groupCategoryDelivery := delivery.NewGroupCategory(groupCategoryUsecase)
 		apiRouter.GET(
@@ -891,8 +1840,8 @@ func main() {
 			officialRevisionRepository,
 			projectInfoRepository,
 			mongoRepo,
-			readTimeout,
-			writeTimeout,
+			timeouts.Read,
+			timeouts.Write,
 		)
 		officialRevisionDelivery := delivery.NewOfficialRevision(officialRevisionUsecase)
 		apiRouter.GET("/projects/:project/officialRevisions", officialRevisionDelivery.List)
@@ -911,8 +1860,8 @@ func main() {
 				pipelineSettingRepository,
 				projectInfoRepository,
 				studioInfoRepository,
-				readTimeout,
-				writeTimeout,
+				timeouts.Read,
+				timeouts.Write,
 			)
 			pipelineSettingDelivery := delivery.NewPipelineSetting(pipelineSettingUsecase)
 
@@ -1226,7 +2175,7 @@ func main() {
 			}
 
 			repo0 := legacyRepository.NewRepository(db0)
-			uc0 := settingUsecase.NewSettingUsecase(repo0, readTimeout, writeTimeout)
+			uc0 := settingUsecase.NewSettingUsecase(repo0, timeouts.Read, timeouts.Write)
 			deliver0 := httpHandler.NewSettingDelivery(uc0)
 
 			settingRouter0.GET("/groups", setting.GetGroups)
@@ -1250,7 +2199,7 @@ func main() {
 		settingRouter := apiRouter.Group("/setting/rc1")
 		{
 			myRepo := settingRepository.NewRepository(myDB)
-			uc := settingUsecase.NewSettingUsecase(myRepo, readTimeout, writeTimeout)
+			uc := settingUsecase.NewSettingUsecase(myRepo, timeouts.Read, timeouts.Write)
 			deliver := httpHandler.NewSettingDelivery(uc)
 
 			settingRouter.GET("/groups", setting.GetGroups)
@@ -1323,6 +2272,14 @@ func main() {
 		}
 	}
 
+	// OpenAPI document of the review endpoints; built last so it sees every route.
+	openAPIDelivery, err := delivery.NewOpenAPI(router.Routes(), "/api")
+	if err != nil {
+		log.Fatal(err)
+	}
+	router.GET("/openapi.json", openAPIDelivery.JSON)
+	router.GET("/docs", openAPIDelivery.Docs)
+
 	s := &http.Server{
 		Addr:           ":4000",
 		Handler:        router,
@@ -1331,7 +2288,9 @@ func main() {
 		MaxHeaderBytes: 1 << 20,
 	}
 
-	if err := s.ListenAndServe(); err != nil {
+	signalCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	if err := lifecycle.Serve(signalCtx, s); err != nil {
 		log.Fatal(err)
 	}
 }
\ No newline at end of file
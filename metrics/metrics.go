@@ -0,0 +1,126 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		metrics/metrics.go
+
+	Module Description:
+		Prometheus metrics of the web API, served on /metrics.
+
+	Details:
+	- central30_http_requests_total{endpoint,method,code} and
+	  central30_http_request_duration_seconds{endpoint,method}: every API request;
+	  endpoint is the gin route template, so path parameters don't add series.
+	- central30_db_query_duration_seconds{query}: the heavy raw queries (pivot stages).
+	- central30_cache_requests_total{cache,result}: hit/miss per cache; the hit ratio is
+	    sum(rate(..{result="hit"}[5m])) / sum(rate(..[5m])) by (cache)
+	- central30_circuit_breaker_open{endpoint}: 1 while a breaker rejects requests, and
+	  central30_circuit_breaker_trips_total{endpoint}: how often it opened.
+	- central30_pivot_shadow_total{result}: shadow runs of ListAssetsPivot by result
+	  (match, mismatch, error, skipped); see repository/reviewInfoShadow.go.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added central30_pivot_shadow_total.
+
+	Functions:
+	* - ObserveQuery: Records the duration of a DB query.
+	* - ObserveCache: Records a cache hit or miss.
+	* - RegisterCircuitBreaker: Exposes the state of a circuit breaker.
+	* - Handler: The /metrics HTTP handler.
+	────────────────────────────────────────────────────────────────────────── */
+
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "central30"
+
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "API requests by route, method and status code.",
+	}, []string{"endpoint", "method", "code"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "API request latency by route and method.",
+		Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"endpoint", "method"})
+
+	DBQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "db_query_duration_seconds",
+		Help:      "Duration of the heavy DB queries by query name.",
+		Buckets:   []float64{.005, .01, .05, .1, .25, .5, 1, 2.5, 5, 10},
+	}, []string{"query"})
+
+	CacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Cache lookups by cache and result (hit, miss).",
+	}, []string{"cache", "result"})
+
+	CircuitBreakerTrips = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "circuit_breaker_trips_total",
+		Help:      "Times a circuit breaker opened.",
+	}, []string{"endpoint"})
+
+	PivotShadow = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pivot_shadow_total",
+		Help:      "Shadow runs of ListAssetsPivot by result (match, mismatch, error, skipped).",
+	}, []string{"result"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		HTTPRequests,
+		HTTPRequestDuration,
+		DBQueryDuration,
+		CacheRequests,
+		CircuitBreakerTrips,
+		PivotShadow,
+	)
+}
+
+// ObserveQuery records the duration of query since start; use it as
+// defer metrics.ObserveQuery("name", time.Now()).
+func ObserveQuery(query string, start time.Time) {
+	DBQueryDuration.WithLabelValues(query).Observe(time.Since(start).Seconds())
+}
+
+func ObserveCache(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	CacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+// RegisterCircuitBreaker exposes central30_circuit_breaker_open for endpoint, read from
+// open at scrape time. Call it once per endpoint.
+func RegisterCircuitBreaker(endpoint string, open func() bool) {
+	prometheus.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Name:        "circuit_breaker_open",
+		Help:        "1 while the circuit breaker of an endpoint rejects requests.",
+		ConstLabels: prometheus.Labels{"endpoint": endpoint},
+	}, func() float64 {
+		if open() {
+			return 1
+		}
+		return 0
+	}))
+}
+
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
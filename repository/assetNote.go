@@ -0,0 +1,198 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/assetNote.go
+
+	Module Description:
+		Free-form notes of asset relations and their summary on pivot rows.
+
+	Details:
+	- Notes are kept in t_asset_note, apart from the review rows, so coordinators can
+	  annotate an asset without touching its review infos.
+	- Pivot rows asked for notes carry the number of notes of the asset relation and
+	  its newest note, cut to pivotNoteSnippetLen characters on one line.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) ListNotes: Lists the notes of an asset relation, newest first.
+	* - (ReviewInfo) CreateNote: Adds a note to an asset relation.
+	* - (ReviewInfo) UpdateNote: Replaces the body of a note.
+	* - (ReviewInfo) DeleteNote: Deletes a note.
+	* - (ReviewInfo) FillPivotNotes: Sets the note count and newest note of pivot rows.
+	* - noteSnippet: Cuts a note body down to one short line.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+// pivotNoteSnippetLen bounds the characters of the note shown on a pivot row.
+const pivotNoteSnippetLen = 120
+
+// PivotNote is the newest note of a pivot row's asset relation.
+type PivotNote struct {
+	ID           int32     `json:"id"`
+	Snippet      string    `json:"snippet"`
+	CreatedBy    string    `json:"created_by"`
+	CreatedAtUtc time.Time `json:"created_at_utc"`
+}
+
+func (r *ReviewInfo) ListNotes(
+	db *gorm.DB,
+	params *entity.ListAssetNotesParams,
+) ([]*entity.AssetNote, error) {
+	var models []*model.AssetNote
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`root` = ?", "assets",
+	).Where(
+		"`group_1` = ?", params.Asset,
+	).Where(
+		"`relation` = ?", params.Relation,
+	).Order(
+		"`created_at_utc` desc, `id` desc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.AssetNote, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+func (r *ReviewInfo) CreateNote(
+	tx *gorm.DB,
+	params *entity.CreateAssetNoteParams,
+) (*entity.AssetNote, error) {
+	m := model.NewAssetNote(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// getNote returns entity.ErrRecordNotFound when the asset relation has no note id and
+// entity.ErrAssetNoteNotAuthor when user didn't write it.
+func getNote(tx *gorm.DB, project, asset, relation string, id int32, user string) (*model.AssetNote, error) {
+	var m model.AssetNote
+	if err := tx.Where(
+		"`project` = ?", project,
+	).Where(
+		"`root` = ?", "assets",
+	).Where(
+		"`group_1` = ?", asset,
+	).Where(
+		"`relation` = ?", relation,
+	).Where(
+		"`id` = ?", id,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	if m.CreatedBy != user {
+		return nil, entity.ErrAssetNoteNotAuthor
+	}
+	return &m, nil
+}
+
+func (r *ReviewInfo) UpdateNote(
+	tx *gorm.DB,
+	params *entity.UpdateAssetNoteParams,
+) (*entity.AssetNote, error) {
+	m, err := getNote(tx, params.Project, params.Asset, params.Relation, params.ID, params.User)
+	if err != nil {
+		return nil, err
+	}
+	m.Body = params.Body
+	m.ModifiedAtUtc = time.Now().UTC()
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewInfo) DeleteNote(
+	tx *gorm.DB,
+	params *entity.DeleteAssetNoteParams,
+) error {
+	m, err := getNote(tx, params.Project, params.Asset, params.Relation, params.ID, params.User)
+	if err != nil {
+		return err
+	}
+	return tx.Delete(m).Error
+}
+
+// FillPivotNotes sets NoteCount and LatestNote on the asset rows of project; rows of
+// other roots have no notes.
+func (r *ReviewInfo) FillPivotNotes(db *gorm.DB, project string, rows []*AssetPivot) error {
+	var pairs [][]any
+	for _, ap := range rows {
+		if ap.Root == "assets" {
+			pairs = append(pairs, []any{ap.Group1, ap.Relation})
+		}
+	}
+	if len(pairs) == 0 {
+		return nil
+	}
+	var notes []struct {
+		model.AssetNote
+		NoteCount int `gorm:"column:note_count"`
+	}
+	if err := db.Raw(`
+SELECT *
+FROM (
+  SELECT n.*,
+    COUNT(*) OVER (PARTITION BY n.group_1, n.relation) AS note_count,
+    ROW_NUMBER() OVER (PARTITION BY n.group_1, n.relation ORDER BY n.created_at_utc DESC, n.id DESC) AS rn
+  FROM t_asset_note AS n
+  WHERE n.project = ? AND n.root = ? AND (n.group_1, n.relation) IN ?
+) AS x
+WHERE rn = 1
+`, project, "assets", pairs).Scan(&notes).Error; err != nil {
+		return err
+	}
+
+	type assetRelation struct{ group1, relation string }
+	byAsset := make(map[assetRelation]int, len(notes))
+	for i, n := range notes {
+		byAsset[assetRelation{n.Group1, n.Relation}] = i
+	}
+	for _, ap := range rows {
+		i, ok := byAsset[assetRelation{ap.Group1, ap.Relation}]
+		if ap.Root != "assets" || !ok {
+			continue
+		}
+		n := notes[i]
+		ap.NoteCount = n.NoteCount
+		ap.LatestNote = &PivotNote{
+			ID:           n.ID,
+			Snippet:      noteSnippet(n.Body),
+			CreatedBy:    n.CreatedBy,
+			CreatedAtUtc: n.CreatedAtUtc,
+		}
+	}
+	return nil
+}
+
+// noteSnippet joins the lines of body and cuts it to pivotNoteSnippetLen characters,
+// marking a cut with an ellipsis.
+func noteSnippet(body string) string {
+	s := []rune(strings.Join(strings.Fields(body), " "))
+	if len(s) <= pivotNoteSnippetLen {
+		return string(s)
+	}
+	return strings.TrimSpace(string(s[:pivotNoteSnippetLen-1])) + "…"
+}
@@ -0,0 +1,144 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/assetWatch.go
+
+	Module Description:
+		Repository for the users watching the status changes of an asset.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Watch: Creates or replaces the watch of a user on an asset.
+	* - Get: Retrieves the watch of a user on an asset.
+	* - Unwatch: Removes the watch of a user on an asset.
+	* - ListWatchers: Lists the watches of an asset.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type AssetWatch struct {
+	db *gorm.DB
+}
+
+func NewAssetWatch(db *gorm.DB) (*AssetWatch, error) {
+	if err := db.AutoMigrate(&model.AssetWatch{}); err != nil {
+		return nil, err
+	}
+	return &AssetWatch{
+		db: db,
+	}, nil
+}
+
+func (r *AssetWatch) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *AssetWatch) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+// watchedAsset scopes a query on t_asset_watch to one asset.
+func watchedAsset(db *gorm.DB, project, asset, relation string) *gorm.DB {
+	return db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`group_1` = ?", asset,
+	).Where(
+		"`relation` = ?", relation,
+	)
+}
+
+func (r *AssetWatch) Watch(
+	tx *gorm.DB,
+	params *entity.WatchAssetParams,
+) (*entity.AssetWatch, error) {
+	m := model.NewAssetWatch(params)
+	if err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "project"}, {Name: "group_1"}, {Name: "relation"}, {Name: "user"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "slack_id"}),
+	}).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return r.Get(tx, &entity.GetAssetWatchParams{
+		Project:  params.Project,
+		Asset:    params.Asset,
+		Relation: params.Relation,
+		User:     params.User,
+	})
+}
+
+// Get returns entity.ErrRecordNotFound when the user does not watch the asset.
+func (r *AssetWatch) Get(
+	db *gorm.DB,
+	params *entity.GetAssetWatchParams,
+) (*entity.AssetWatch, error) {
+	var m model.AssetWatch
+	if err := watchedAsset(
+		db, params.Project, params.Asset, params.Relation,
+	).Where(
+		"`user` = ?", params.User,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// Unwatch returns entity.ErrRecordNotFound when the user does not watch the asset.
+func (r *AssetWatch) Unwatch(
+	tx *gorm.DB,
+	params *entity.UnwatchAssetParams,
+) error {
+	res := watchedAsset(
+		tx, params.Project, params.Asset, params.Relation,
+	).Where(
+		"`user` = ?", params.User,
+	).Delete(&model.AssetWatch{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *AssetWatch) ListWatchers(
+	db *gorm.DB,
+	params *entity.ListAssetWatchersParams,
+) ([]*entity.AssetWatch, error) {
+	var watches []*model.AssetWatch
+	if err := watchedAsset(
+		db, params.Project, params.Asset, params.Relation,
+	).Order(
+		"`id`",
+	).Find(&watches).Error; err != nil {
+		return nil, err
+	}
+	out := make([]*entity.AssetWatch, len(watches))
+	for i, w := range watches {
+		out[i] = w.Entity()
+	}
+	return out, nil
+}
@@ -0,0 +1,177 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/broadcastCache.go
+
+	Module Description:
+		entity.Cache decorator broadcasting deletes to every instance of the service.
+
+	Details:
+	- In-process caches (MemoryCache, the pivot count cache) only see writes handled by
+	  their own instance, so an approval made through instance A stayed visible as stale
+	  on B and C until the TTL ran out.
+	- Delete removes the keys locally and appends them to t_cache_invalidation. Every
+	  instance polls that table (Watch) and deletes the keys written by the others from
+	  its own cache, then notifies OnRemoteDelete listeners for caches kept outside it.
+	- The log only needs to outlive one poll interval; rows older than
+	  cacheInvalidationRetention are pruned while polling.
+	- A failure to record the broadcast is logged, not returned: the local delete has
+	  happened and the other instances fall back to their TTLs.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewBroadcastCache: Wraps a cache and starts from the current end of the log.
+	* - Get / Set / Delete: entity.Cache implementation.
+	* - OnRemoteDelete: Registers a listener for keys deleted by other instances.
+	* - Watch: Applies other instances' deletes until ctx is done.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+const (
+	cacheInvalidationRetention = 10 * time.Minute
+	cacheInvalidationBatch     = 500
+)
+
+type BroadcastCache struct {
+	inner    entity.Cache
+	db       *gorm.DB
+	instance string
+
+	mu        sync.Mutex
+	lastID    int64
+	listeners []func(keys []string)
+}
+
+func NewBroadcastCache(inner entity.Cache, db *gorm.DB) (*BroadcastCache, error) {
+	if err := db.AutoMigrate(&model.CacheInvalidation{}); err != nil {
+		return nil, err
+	}
+	var lastID int64
+	if err := db.Model(&model.CacheInvalidation{}).Select(
+		"COALESCE(MAX(`id`), 0)",
+	).Scan(&lastID).Error; err != nil {
+		return nil, err
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return &BroadcastCache{
+		inner:    inner,
+		db:       db,
+		instance: hex.EncodeToString(b),
+		lastID:   lastID,
+	}, nil
+}
+
+func (c *BroadcastCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	return c.inner.Get(ctx, key)
+}
+
+func (c *BroadcastCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.inner.Set(ctx, key, value, ttl)
+}
+
+func (c *BroadcastCache) Delete(ctx context.Context, keys ...string) error {
+	if err := c.inner.Delete(ctx, keys...); err != nil {
+		return err
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+	if err := c.db.WithContext(ctx).Create(&model.CacheInvalidation{
+		Instance:     c.instance,
+		Keys:         string(b),
+		CreatedAtUtc: time.Now().UTC(),
+	}).Error; err != nil {
+		log.Printf("[CACHE] broadcast delete %v: %v", keys, err)
+	}
+	return nil
+}
+
+// OnRemoteDelete registers fn to run with the keys of every delete made by another
+// instance. Register listeners before calling Watch.
+func (c *BroadcastCache) OnRemoteDelete(fn func(keys []string)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.listeners = append(c.listeners, fn)
+}
+
+func (c *BroadcastCache) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.poll(ctx); err != nil {
+				log.Printf("[CACHE] broadcast poll: %v", err)
+			}
+		}
+	}
+}
+
+func (c *BroadcastCache) poll(ctx context.Context) error {
+	c.mu.Lock()
+	lastID := c.lastID
+	listeners := c.listeners
+	c.mu.Unlock()
+
+	db := c.db.WithContext(ctx)
+	var rows []*model.CacheInvalidation
+	if err := db.Where(
+		"`id` > ?", lastID,
+	).Order(
+		"`id` asc",
+	).Limit(
+		cacheInvalidationBatch,
+	).Find(&rows).Error; err != nil {
+		return err
+	}
+	for _, row := range rows {
+		lastID = row.ID
+		if row.Instance == c.instance {
+			continue
+		}
+		var keys []string
+		if err := json.Unmarshal([]byte(row.Keys), &keys); err != nil {
+			log.Printf("[CACHE] broadcast row %d: %v", row.ID, err)
+			continue
+		}
+		if err := c.inner.Delete(ctx, keys...); err != nil {
+			log.Printf("[CACHE] broadcast apply %v: %v", keys, err)
+		}
+		for _, fn := range listeners {
+			fn(keys)
+		}
+	}
+
+	c.mu.Lock()
+	c.lastID = lastID
+	c.mu.Unlock()
+
+	return db.Where(
+		"`created_at_utc` < ?", time.Now().UTC().Add(-cacheInvalidationRetention),
+	).Delete(&model.CacheInvalidation{}).Error
+}
@@ -0,0 +1,103 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/categoryAccess.go
+
+	Module Description:
+		Repository for per-role category access rules on the asset pivot.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added TransactionWithContext for the authorized writes.
+
+	Functions:
+	* - Create: Stores a new rule.
+	* - List: Lists rules of a project, optionally for one role.
+	* - Delete: Removes a rule.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type CategoryAccess struct {
+	db *gorm.DB
+}
+
+func NewCategoryAccess(db *gorm.DB) (*CategoryAccess, error) {
+	if err := db.AutoMigrate(&model.CategoryAccess{}); err != nil {
+		return nil, err
+	}
+	return &CategoryAccess{
+		db: db,
+	}, nil
+}
+
+func (r *CategoryAccess) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *CategoryAccess) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *CategoryAccess) Create(
+	tx *gorm.DB,
+	params *entity.CreateCategoryAccessParams,
+) (*entity.CategoryAccess, error) {
+	m := model.NewCategoryAccess(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *CategoryAccess) List(
+	db *gorm.DB,
+	params *entity.ListCategoryAccessParams,
+) ([]*entity.CategoryAccess, error) {
+	stmt := db.Where("`project` = ?", params.Project)
+	if params.Role != nil {
+		stmt = stmt.Where("`role` = ?", *params.Role)
+	}
+	var models []*model.CategoryAccess
+	if err := stmt.Order(
+		"`role` asc, `top_group_node` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.CategoryAccess, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+func (r *CategoryAccess) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteCategoryAccessParams,
+) error {
+	res := tx.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).Delete(&model.CategoryAccess{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/fileExportStorage.go
+
+	Module Description:
+		Local directory backed entity.ExportStorage.
+
+	Details:
+	- Used when no export bucket is configured. Files are per instance, so a job's file
+	  can only be downloaded from the instance that ran it.
+	- Files are written to a temporary name first and renamed, so Open never sees a
+	  partial file.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewFileExportStorage: Creates a storage writing under dir.
+	* - Put / Open: entity.ExportStorage implementation.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+type FileExportStorage struct {
+	dir string
+}
+
+func NewFileExportStorage(dir string) (*FileExportStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileExportStorage{
+		dir: dir,
+	}, nil
+}
+
+func (s *FileExportStorage) path(name string) (string, error) {
+	if name == "" || strings.Contains(name, "..") || filepath.IsAbs(name) {
+		return "", fmt.Errorf("invalid export file name %q", name)
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(name)), nil
+}
+
+func (s *FileExportStorage) Put(_ context.Context, name, _ string, r io.Reader) error {
+	p, err := s.path(name)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(filepath.Dir(p), ".export-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), p)
+}
+
+func (s *FileExportStorage) Open(_ context.Context, name string) (io.ReadCloser, error) {
+	p, err := s.path(name)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, entity.ErrRecordNotFound
+	}
+	return f, err
+}
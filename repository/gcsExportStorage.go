@@ -0,0 +1,59 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/gcsExportStorage.go
+
+	Module Description:
+		Google Cloud Storage backed entity.ExportStorage.
+
+	Details:
+	- Objects are written under prefix in bucket. Their lifetime is left to the bucket's
+	  lifecycle rules (e.g. delete after 7 days).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewGCSExportStorage: Creates a storage on an existing client.
+	* - Put / Open: entity.ExportStorage implementation.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+type GCSExportStorage struct {
+	bucket *storage.BucketHandle
+	prefix string
+}
+
+func NewGCSExportStorage(client *storage.Client, bucket, prefix string) *GCSExportStorage {
+	return &GCSExportStorage{
+		bucket: client.Bucket(bucket),
+		prefix: prefix,
+	}
+}
+
+func (s *GCSExportStorage) Put(ctx context.Context, name, contentType string, r io.Reader) error {
+	w := s.bucket.Object(s.prefix + name).NewWriter(ctx)
+	w.ContentType = contentType
+	if _, err := io.Copy(w, r); err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func (s *GCSExportStorage) Open(ctx context.Context, name string) (io.ReadCloser, error) {
+	rc, err := s.bucket.Object(s.prefix + name).NewReader(ctx)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, entity.ErrRecordNotFound
+	}
+	return rc, err
+}
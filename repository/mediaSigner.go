@@ -0,0 +1,208 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/mediaSigner.go
+
+	Module Description:
+		entity.MediaSigner implementations for GCS, S3 and a local directory.
+
+	Details:
+	- GCSMediaSigner issues V4 signed URLs with the client's credentials (the service
+	  account needs iam.serviceAccounts.signBlob when it has no private key).
+	- S3MediaSigner presigns GET requests with AWS Signature Version 4, path-style, so
+	  it also works with S3-compatible stores (MinIO, ...) through Endpoint.
+	- LocalMediaSigner signs URLs to the app's own /media route with an HMAC of the key
+	  and the expiry; Resolve checks them and maps the key to a file under dir.
+	- Keys are joined below each signer's prefix as they are; callers make sure they
+	  are relative and clean.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewGCSMediaSigner / NewS3MediaSigner / NewLocalMediaSigner: Create the signers.
+	* - SignURL: entity.MediaSigner implementation of each signer.
+	* - (LocalMediaSigner) Resolve: Checks a signed local URL and returns its file.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+type GCSMediaSigner struct {
+	bucket *storage.BucketHandle
+	prefix string
+}
+
+func NewGCSMediaSigner(client *storage.Client, bucket, prefix string) *GCSMediaSigner {
+	return &GCSMediaSigner{
+		bucket: client.Bucket(bucket),
+		prefix: prefix,
+	}
+}
+
+func (s *GCSMediaSigner) SignURL(_ context.Context, key string, expires time.Time) (string, error) {
+	return s.bucket.SignedURL(s.prefix+key, &storage.SignedURLOptions{
+		Scheme:  storage.SigningSchemeV4,
+		Method:  "GET",
+		Expires: expires,
+	})
+}
+
+// S3MediaConfig configures an S3MediaSigner. Endpoint defaults to the AWS endpoint of
+// Region.
+type S3MediaConfig struct {
+	Bucket          string
+	Prefix          string
+	Region          string
+	Endpoint        string // e.g. https://minio.internal:9000
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type S3MediaSigner struct {
+	cfg S3MediaConfig
+	now func() time.Time
+}
+
+func NewS3MediaSigner(cfg S3MediaConfig) *S3MediaSigner {
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "https://s3." + cfg.Region + ".amazonaws.com"
+	}
+	cfg.Endpoint = strings.TrimRight(cfg.Endpoint, "/")
+	return &S3MediaSigner{cfg: cfg, now: time.Now}
+}
+
+// s3MaxExpires is the longest validity S3 accepts for a presigned URL.
+const s3MaxExpires = 7 * 24 * time.Hour
+
+func (s *S3MediaSigner) SignURL(_ context.Context, key string, expires time.Time) (string, error) {
+	endpoint, err := url.Parse(s.cfg.Endpoint)
+	if err != nil {
+		return "", err
+	}
+	now := s.now().UTC()
+	ttl := expires.Sub(now)
+	if ttl <= 0 || ttl > s3MaxExpires {
+		return "", fmt.Errorf("s3 presigned URLs must expire within %v", s3MaxExpires)
+	}
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	scope := day + "/" + s.cfg.Region + "/s3/aws4_request"
+
+	path := "/" + s3Escape(s.cfg.Bucket, false) + "/" + s3Escape(s.cfg.Prefix+key, true)
+	query := map[string]string{
+		"X-Amz-Algorithm":     "AWS4-HMAC-SHA256",
+		"X-Amz-Credential":    s.cfg.AccessKeyID + "/" + scope,
+		"X-Amz-Date":          amzDate,
+		"X-Amz-Expires":       strconv.Itoa(int(ttl.Seconds())),
+		"X-Amz-SignedHeaders": "host",
+	}
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = s3Escape(name, false) + "=" + s3Escape(query[name], false)
+	}
+	canonicalQuery := strings.Join(pairs, "&")
+
+	canonicalRequest := strings.Join([]string{
+		"GET",
+		path,
+		canonicalQuery,
+		"host:" + endpoint.Host + "\n",
+		"host",
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+
+	signingKey := []byte("AWS4" + s.cfg.SecretAccessKey)
+	for _, part := range []string{day, s.cfg.Region, "s3", "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return endpoint.Scheme + "://" + endpoint.Host + path + "?" + canonicalQuery +
+		"&X-Amz-Signature=" + signature, nil
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// s3Escape percent-encodes s as SigV4 requires: everything but unreserved characters,
+// keeping "/" when it separates path segments.
+func s3Escape(s string, keepSlash bool) string {
+	var sb strings.Builder
+	for _, b := range []byte(s) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9',
+			b == '-', b == '_', b == '.', b == '~', keepSlash && b == '/':
+			sb.WriteByte(b)
+		default:
+			fmt.Fprintf(&sb, "%%%02X", b)
+		}
+	}
+	return sb.String()
+}
+
+type LocalMediaSigner struct {
+	dir     string
+	baseURL string
+	secret  []byte
+	now     func() time.Time
+}
+
+// NewLocalMediaSigner serves files under dir through baseURL (the app's public URL
+// followed by its media route, e.g. https://central.example.com/media).
+func NewLocalMediaSigner(dir, baseURL, secret string) *LocalMediaSigner {
+	return &LocalMediaSigner{
+		dir:     dir,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		secret:  []byte(secret),
+		now:     time.Now,
+	}
+}
+
+func (s *LocalMediaSigner) signature(key string, expires int64) string {
+	return hex.EncodeToString(hmacSHA256(s.secret, key+"\n"+strconv.FormatInt(expires, 10)))
+}
+
+func (s *LocalMediaSigner) SignURL(_ context.Context, key string, expires time.Time) (string, error) {
+	q := url.Values{}
+	q.Set("key", key)
+	q.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	q.Set("signature", s.signature(key, expires.Unix()))
+	return s.baseURL + "?" + q.Encode(), nil
+}
+
+func (s *LocalMediaSigner) Resolve(key string, expires time.Time, signature string) (string, error) {
+	want := s.signature(key, expires.Unix())
+	if !hmac.Equal([]byte(want), []byte(signature)) || !s.now().Before(expires) {
+		return "", entity.ErrInvalidMediaSignature
+	}
+	if key == "" || strings.Contains(key, "..") || filepath.IsAbs(key) {
+		return "", entity.ErrInvalidMediaSignature
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(key)), nil
+}
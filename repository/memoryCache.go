@@ -0,0 +1,112 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/memoryCache.go
+
+	Module Description:
+		In-process entity.Cache with TTL and an LRU bound.
+
+	Details:
+	- Used when no Redis is configured. Entries are per instance; wrap it in a
+	  BroadcastCache so deletes reach the other instances too.
+	- Replaces the unbounded sync.Map page cache of the 27-01-2026 repository variant.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Point to BroadcastCache for multi-instance invalidation.
+
+	Functions:
+	* - NewMemoryCache: Creates a cache holding at most maxEntries entries.
+	* - Get / Set / Delete: entity.Cache implementation.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+const defaultMemoryCacheEntries = 1000
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time // zero: no expiry
+}
+
+type MemoryCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List // front = most recently used
+	items      map[string]*list.Element
+}
+
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	if maxEntries <= 0 {
+		maxEntries = defaultMemoryCacheEntries
+	}
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		items:      make(map[string]*list.Element, maxEntries),
+	}
+}
+
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	ent := el.Value.(*memoryCacheEntry)
+	if !ent.expiresAt.IsZero() && time.Now().After(ent.expiresAt) {
+		c.removeElement(el)
+		return nil, false, nil
+	}
+	c.ll.MoveToFront(el)
+	return ent.value, true, nil
+}
+
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		ent := el.Value.(*memoryCacheEntry)
+		ent.value = value
+		ent.expiresAt = expiresAt
+		c.ll.MoveToFront(el)
+		return nil
+	}
+	c.items[key] = c.ll.PushFront(&memoryCacheEntry{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+	for c.ll.Len() > c.maxEntries {
+		c.removeElement(c.ll.Back())
+	}
+	return nil
+}
+
+func (c *MemoryCache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if el, ok := c.items[key]; ok {
+			c.removeElement(el)
+		}
+	}
+	return nil
+}
+
+func (c *MemoryCache) removeElement(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*memoryCacheEntry).key)
+}
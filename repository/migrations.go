@@ -0,0 +1,89 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/migrations.go
+
+	Module Description:
+		Migration status of the tables the repositories create.
+
+	Details:
+	- Every repository runs AutoMigrate for its models when it is constructed; the app
+	  does not start when one fails. CheckMigrations verifies afterwards that the
+	  tables are still there, e.g. after a failover to a database restored from an
+	  older dump.
+	- One information_schema query per check (sqlite_master on SQLite), so it is cheap
+	  enough for readiness probes.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - List the tables in the SQL dialect of the database.
+
+	Functions:
+	* - CheckMigrations: Returns an error naming the migrated tables that are missing.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+// migratedModels are the models passed to AutoMigrate by the repositories.
+var migratedModels = []any{
+	&model.ReviewInfo{},
+	&model.CacheInvalidation{},
+	&model.CategoryAccess{},
+	&model.PivotDefaults{},
+	&model.ProjectMember{},
+	&model.ProjectQueryPolicy{},
+	&model.ReviewActivity{},
+	&model.ReviewCertificate{},
+	&model.ReviewExport{},
+	&model.ReviewIdempotencyKey{},
+	&model.ReviewImport{},
+	&model.ReviewLatest{},
+	&model.ReviewLock{},
+	&model.ReviewPlaylist{},
+	&model.ReviewPlaylistItem{},
+	&model.ReviewStatus{},
+	&model.ReviewStatusHistory{},
+	&model.ReviewTakePin{},
+	&model.ReviewValidationRules{},
+	&model.ReviewWebhook{},
+	&model.UserPreference{},
+}
+
+func CheckMigrations(ctx context.Context, db *gorm.DB) error {
+	db = db.WithContext(ctx)
+	tables := make([]string, 0, len(migratedModels))
+	for _, m := range migratedModels {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(m); err != nil {
+			return err
+		}
+		tables = append(tables, stmt.Schema.Table)
+	}
+
+	var existing []string
+	if err := db.Raw(dialectOf(db).Tables(), tables).Scan(&existing).Error; err != nil {
+		return err
+	}
+	found := make(map[string]bool, len(existing))
+	for _, t := range existing {
+		found[strings.ToLower(t)] = true
+	}
+	var missing []string
+	for _, t := range tables {
+		if !found[strings.ToLower(t)] {
+			missing = append(missing, t)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("missing tables: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
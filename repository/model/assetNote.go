@@ -0,0 +1,47 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// AssetNote is stored in t_asset_note. Rows are hard-deleted.
+type AssetNote struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(64);not null;index:idx_asset_note_asset,priority:1"`
+	Root          string    `gorm:"type:varchar(32);not null;index:idx_asset_note_asset,priority:2"`
+	Group1        string    `gorm:"column:group_1;type:varchar(255);not null;index:idx_asset_note_asset,priority:3"`
+	Relation      string    `gorm:"type:varchar(255);not null;index:idx_asset_note_asset,priority:4"`
+	Body          string    `gorm:"type:text;not null"`
+	CreatedBy     string    `gorm:"type:varchar(255);not null"`
+	CreatedAtUtc  time.Time `gorm:"not null"`
+	ModifiedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewAssetNote(params *entity.CreateAssetNoteParams) *AssetNote {
+	now := time.Now().UTC()
+	return &AssetNote{
+		Project:       params.Project,
+		Root:          "assets",
+		Group1:        params.Asset,
+		Relation:      params.Relation,
+		Body:          params.Body,
+		CreatedBy:     params.CreatedBy,
+		CreatedAtUtc:  now,
+		ModifiedAtUtc: now,
+	}
+}
+
+func (m *AssetNote) Entity() *entity.AssetNote {
+	return &entity.AssetNote{
+		ID:            m.ID,
+		Project:       m.Project,
+		Asset:         m.Group1,
+		Relation:      m.Relation,
+		Body:          m.Body,
+		CreatedBy:     m.CreatedBy,
+		CreatedAtUtc:  m.CreatedAtUtc,
+		ModifiedAtUtc: m.ModifiedAtUtc,
+	}
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// AssetWatch is stored in t_asset_watch; at most one watch per user and asset.
+type AssetWatch struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	Project      string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_asset_watch_key,priority:1"`
+	Group1       string    `gorm:"column:group_1;type:varchar(255);not null;uniqueIndex:idx_asset_watch_key,priority:2"`
+	Relation     string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_asset_watch_key,priority:3"`
+	User         string    `gorm:"type:varchar(128);not null;uniqueIndex:idx_asset_watch_key,priority:4"`
+	Email        string    `gorm:"type:varchar(255)"`
+	SlackID      string    `gorm:"type:varchar(64)"`
+	CreatedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewAssetWatch(params *entity.WatchAssetParams) *AssetWatch {
+	return &AssetWatch{
+		Project:      params.Project,
+		Group1:       params.Asset,
+		Relation:     params.Relation,
+		User:         params.User,
+		Email:        params.Email,
+		SlackID:      params.SlackID,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+}
+
+func (m *AssetWatch) Entity() *entity.AssetWatch {
+	return &entity.AssetWatch{
+		ID:           m.ID,
+		Project:      m.Project,
+		Asset:        m.Group1,
+		Relation:     m.Relation,
+		User:         m.User,
+		Email:        m.Email,
+		SlackID:      m.SlackID,
+		CreatedAtUtc: m.CreatedAtUtc,
+	}
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// CacheInvalidation is stored in t_cache_invalidation, one row per broadcast Delete.
+// Keys is a JSON array of cache keys.
+type CacheInvalidation struct {
+	ID           int64     `gorm:"primaryKey;autoIncrement"`
+	Instance     string    `gorm:"type:varchar(32);not null"`
+	Keys         string    `gorm:"type:text;not null"`
+	CreatedAtUtc time.Time `gorm:"not null;index"`
+}
@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// CategoryAccess is stored in t_category_access.
+type CategoryAccess struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	Project      string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_category_access_rule"`
+	Role         string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_category_access_rule"`
+	TopGroupNode string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_category_access_rule"`
+	CreatedBy    string    `gorm:"type:varchar(255)"`
+	CreatedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewCategoryAccess(params *entity.CreateCategoryAccessParams) *CategoryAccess {
+	return &CategoryAccess{
+		Project:      params.Project,
+		Role:         params.Role,
+		TopGroupNode: params.TopGroupNode,
+		CreatedBy:    params.CreatedBy,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+}
+
+func (m *CategoryAccess) Entity() *entity.CategoryAccess {
+	return &entity.CategoryAccess{
+		ID:           m.ID,
+		Project:      m.Project,
+		Role:         m.Role,
+		TopGroupNode: m.TopGroupNode,
+		CreatedBy:    m.CreatedBy,
+		CreatedAtUtc: m.CreatedAtUtc,
+	}
+}
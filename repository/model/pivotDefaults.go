@@ -0,0 +1,72 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// PivotDefaults is stored in t_pivot_defaults, one row per project.
+type PivotDefaults struct {
+	Project      string    `gorm:"type:varchar(255);primaryKey"`
+	OrderKey     string    `gorm:"type:varchar(64)"`
+	Direction    string    `gorm:"type:varchar(4)"`
+	View         string    `gorm:"type:varchar(16)"`
+	PerPage      int       `gorm:"not null;default:0"`
+	UpdatedBy    string    `gorm:"type:varchar(255)"`
+	UpdatedAtUtc time.Time `gorm:"not null"`
+
+	// AttentionWeights is a JSON entity.AttentionWeights; empty means the built-in weights.
+	AttentionWeights string `gorm:"type:varchar(255)"`
+	// OverallStatusRules is a JSON entity.OverallStatusRules; empty means the built-in rules.
+	OverallStatusRules string `gorm:"type:text"`
+}
+
+func NewPivotDefaults(params *entity.PutPivotDefaultsParams) *PivotDefaults {
+	m := &PivotDefaults{
+		Project:      params.Project,
+		OrderKey:     params.OrderKey,
+		Direction:    params.Direction,
+		View:         params.View,
+		PerPage:      params.PerPage,
+		UpdatedBy:    params.UpdatedBy,
+		UpdatedAtUtc: time.Now().UTC(),
+	}
+	if params.AttentionWeights != nil {
+		if b, err := json.Marshal(params.AttentionWeights); err == nil {
+			m.AttentionWeights = string(b)
+		}
+	}
+	if params.OverallStatusRules != nil {
+		if b, err := json.Marshal(params.OverallStatusRules); err == nil {
+			m.OverallStatusRules = string(b)
+		}
+	}
+	return m
+}
+
+func (m *PivotDefaults) Entity() *entity.PivotDefaults {
+	e := &entity.PivotDefaults{
+		Project:      m.Project,
+		OrderKey:     m.OrderKey,
+		Direction:    m.Direction,
+		View:         m.View,
+		PerPage:      m.PerPage,
+		UpdatedBy:    m.UpdatedBy,
+		UpdatedAtUtc: m.UpdatedAtUtc,
+	}
+	if m.AttentionWeights != "" {
+		var w entity.AttentionWeights
+		if err := json.Unmarshal([]byte(m.AttentionWeights), &w); err == nil {
+			e.AttentionWeights = &w
+		}
+	}
+	if m.OverallStatusRules != "" {
+		var rules entity.OverallStatusRules
+		if err := json.Unmarshal([]byte(m.OverallStatusRules), &rules); err == nil {
+			e.OverallStatusRules = &rules
+		}
+	}
+	return e
+}
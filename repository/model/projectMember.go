@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ProjectMember is stored in t_project_member, one row per project and user.
+type ProjectMember struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	Project      string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_project_member_user"`
+	User         string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_project_member_user"`
+	Role         string    `gorm:"type:varchar(32);not null"`
+	UpdatedBy    string    `gorm:"type:varchar(255)"`
+	UpdatedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewProjectMember(params *entity.PutProjectMemberParams) *ProjectMember {
+	return &ProjectMember{
+		Project:      params.Project,
+		User:         params.User,
+		Role:         params.Role,
+		UpdatedBy:    params.UpdatedBy,
+		UpdatedAtUtc: time.Now().UTC(),
+	}
+}
+
+func (m *ProjectMember) Entity() *entity.ProjectMember {
+	return &entity.ProjectMember{
+		ID:           m.ID,
+		Project:      m.Project,
+		User:         m.User,
+		Role:         m.Role,
+		UpdatedBy:    m.UpdatedBy,
+		UpdatedAtUtc: m.UpdatedAtUtc,
+	}
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ProjectQueryPolicy is stored in t_project_query_policy, one row per project.
+type ProjectQueryPolicy struct {
+	Project        string    `gorm:"type:varchar(255);primaryKey"`
+	MaxPerPage     int       `gorm:"not null;default:0"`
+	TimeoutSeconds int       `gorm:"not null;default:0"`
+	UpdatedBy      string    `gorm:"type:varchar(255)"`
+	UpdatedAtUtc   time.Time `gorm:"not null"`
+
+	// AllowedViews is a comma-separated list; empty allows every view.
+	AllowedViews string `gorm:"type:varchar(64)"`
+}
+
+func NewProjectQueryPolicy(params *entity.PutProjectQueryPolicyParams) *ProjectQueryPolicy {
+	return &ProjectQueryPolicy{
+		Project:        params.Project,
+		MaxPerPage:     params.MaxPerPage,
+		TimeoutSeconds: params.TimeoutSeconds,
+		UpdatedBy:      params.UpdatedBy,
+		UpdatedAtUtc:   time.Now().UTC(),
+		AllowedViews:   strings.Join(params.AllowedViews, ","),
+	}
+}
+
+func (m *ProjectQueryPolicy) Entity() *entity.ProjectQueryPolicy {
+	e := &entity.ProjectQueryPolicy{
+		Project:        m.Project,
+		MaxPerPage:     m.MaxPerPage,
+		TimeoutSeconds: m.TimeoutSeconds,
+		AllowedViews:   []string{},
+		UpdatedBy:      m.UpdatedBy,
+		UpdatedAtUtc:   m.UpdatedAtUtc,
+	}
+	if m.AllowedViews != "" {
+		e.AllowedViews = strings.Split(m.AllowedViews, ",")
+	}
+	return e
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// RequiredPhases is stored in t_required_phases, one row per project.
+type RequiredPhases struct {
+	Project      string    `gorm:"type:varchar(255);primaryKey"`
+	UpdatedBy    string    `gorm:"type:varchar(255)"`
+	UpdatedAtUtc time.Time `gorm:"not null"`
+
+	// JSON objects mapping a relation / top group node to its phases.
+	ByRelation     string `gorm:"type:text"`
+	ByTopGroupNode string `gorm:"type:text"`
+}
+
+func marshalPhaseMap(m map[string][]string) string {
+	if len(m) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func unmarshalPhaseMap(s string) map[string][]string {
+	m := map[string][]string{}
+	if s != "" {
+		_ = json.Unmarshal([]byte(s), &m)
+	}
+	return m
+}
+
+func NewRequiredPhases(params *entity.PutRequiredPhasesParams) *RequiredPhases {
+	return &RequiredPhases{
+		Project:        params.Project,
+		UpdatedBy:      params.UpdatedBy,
+		UpdatedAtUtc:   time.Now().UTC(),
+		ByRelation:     marshalPhaseMap(params.ByRelation),
+		ByTopGroupNode: marshalPhaseMap(params.ByTopGroupNode),
+	}
+}
+
+func (m *RequiredPhases) Entity() *entity.RequiredPhases {
+	return &entity.RequiredPhases{
+		Project:        m.Project,
+		ByRelation:     unmarshalPhaseMap(m.ByRelation),
+		ByTopGroupNode: unmarshalPhaseMap(m.ByTopGroupNode),
+		UpdatedBy:      m.UpdatedBy,
+		UpdatedAtUtc:   m.UpdatedAtUtc,
+	}
+}
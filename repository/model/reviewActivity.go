@@ -0,0 +1,66 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewActivity is stored in t_review_activity, one row per raw event. Groups is kept
+// "/"-joined, the same form the review info API accepts in its groups filter.
+type ReviewActivity struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(255);not null;index:idx_review_activity_project_at"`
+	ReviewInfoID  int32     `gorm:"not null;index"`
+	Root          string    `gorm:"type:varchar(255)"`
+	Groups        string    `gorm:"type:varchar(1024)"`
+	Relation      string    `gorm:"type:varchar(255)"`
+	Phase         string    `gorm:"type:varchar(255)"`
+	Field         string    `gorm:"type:varchar(32);not null"`
+	Value         string    `gorm:"type:varchar(255)"`
+	Actor         string    `gorm:"type:varchar(255)"`
+	SessionID     string    `gorm:"type:varchar(64);index"`
+	OccurredAtUtc time.Time `gorm:"not null;index:idx_review_activity_project_at"`
+}
+
+func NewReviewActivity(params *entity.CreateReviewActivityParams) *ReviewActivity {
+	at := params.OccurredAtUtc
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	return &ReviewActivity{
+		Project:       params.Project,
+		ReviewInfoID:  params.ReviewInfoID,
+		Root:          params.Root,
+		Groups:        strings.Join(params.Groups, "/"),
+		Relation:      params.Relation,
+		Phase:         params.Phase,
+		Field:         params.Field,
+		Value:         params.Value,
+		Actor:         params.Actor,
+		SessionID:     params.SessionID,
+		OccurredAtUtc: at,
+	}
+}
+
+func (m *ReviewActivity) Entity() *entity.ReviewActivity {
+	var groups []string
+	if m.Groups != "" {
+		groups = strings.Split(m.Groups, "/")
+	}
+	return &entity.ReviewActivity{
+		ID:            m.ID,
+		Project:       m.Project,
+		ReviewInfoID:  m.ReviewInfoID,
+		Root:          m.Root,
+		Groups:        groups,
+		Relation:      m.Relation,
+		Phase:         m.Phase,
+		Field:         m.Field,
+		Value:         m.Value,
+		Actor:         m.Actor,
+		SessionID:     m.SessionID,
+		OccurredAtUtc: m.OccurredAtUtc,
+	}
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewAssetPriority is stored in t_review_asset_priority; a row flags its asset.
+type ReviewAssetPriority struct {
+	ID       int32     `gorm:"primaryKey;autoIncrement"`
+	Project  string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_asset_priority_key,priority:1"`
+	Root     string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_review_asset_priority_key,priority:2"`
+	Group1   string    `gorm:"column:group_1;type:varchar(255);not null;uniqueIndex:idx_review_asset_priority_key,priority:3"`
+	SetBy    string    `gorm:"type:varchar(255)"`
+	SetAtUtc time.Time `gorm:"not null"`
+}
+
+func (m *ReviewAssetPriority) Entity() *entity.AssetPriority {
+	setAt := m.SetAtUtc
+	return &entity.AssetPriority{
+		Project:  m.Project,
+		Asset:    m.Group1,
+		Priority: true,
+		SetBy:    m.SetBy,
+		SetAtUtc: &setAt,
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewCertificate is stored in t_review_certificate. Rows are insert-only; there is no
+// modified_* or deleted column on purpose.
+type ReviewCertificate struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(255);not null;index:idx_review_certificate_review"`
+	ReviewInfoID  int32     `gorm:"not null;index:idx_review_certificate_review"`
+	Approver      string    `gorm:"type:varchar(255);not null"`
+	ApprovedAtUtc time.Time `gorm:"not null"`
+	Manifest      string    `gorm:"type:longtext;not null"` // not JSON: MySQL would reformat it and break the hash
+	Hash          string    `gorm:"type:char(64);not null;uniqueIndex"`
+	Signature     string    `gorm:"type:char(64);not null"`
+	CreatedAtUtc  time.Time `gorm:"not null"`
+}
+
+func NewReviewCertificate(params *entity.CreateReviewCertificateParams) *ReviewCertificate {
+	return &ReviewCertificate{
+		Project:       params.Project,
+		ReviewInfoID:  params.ReviewInfoID,
+		Approver:      params.Approver,
+		ApprovedAtUtc: params.ApprovedAtUtc,
+		Manifest:      string(params.Manifest),
+		Hash:          params.Hash,
+		Signature:     params.Signature,
+		CreatedAtUtc:  time.Now().UTC(),
+	}
+}
+
+func (m *ReviewCertificate) Entity() *entity.ReviewCertificate {
+	return &entity.ReviewCertificate{
+		ID:            m.ID,
+		Project:       m.Project,
+		ReviewInfoID:  m.ReviewInfoID,
+		Approver:      m.Approver,
+		ApprovedAtUtc: m.ApprovedAtUtc,
+		Manifest:      json.RawMessage(m.Manifest),
+		Hash:          m.Hash,
+		Signature:     m.Signature,
+		CreatedAtUtc:  m.CreatedAtUtc,
+	}
+}
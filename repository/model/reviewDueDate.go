@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewDueDate is stored in t_review_due_date; at most one due date per asset phase.
+type ReviewDueDate struct {
+	ID       int32     `gorm:"primaryKey;autoIncrement"`
+	Project  string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_due_date_key,priority:1"`
+	Root     string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_review_due_date_key,priority:2"`
+	Group1   string    `gorm:"column:group_1;type:varchar(255);not null;uniqueIndex:idx_review_due_date_key,priority:3"`
+	Relation string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_review_due_date_key,priority:4"`
+	Phase    string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_due_date_key,priority:5"`
+	DueDate  time.Time `gorm:"type:date;not null;index"`
+	SetBy    string    `gorm:"type:varchar(255)"`
+	SetAtUtc time.Time `gorm:"not null"`
+}
+
+func (m *ReviewDueDate) Entity() *entity.ReviewDueDate {
+	return &entity.ReviewDueDate{
+		Project:  m.Project,
+		Asset:    m.Group1,
+		Relation: m.Relation,
+		Phase:    m.Phase,
+		DueDate:  m.DueDate.Format(entity.ReviewDueDateLayout),
+		SetBy:    m.SetBy,
+		SetAtUtc: m.SetAtUtc,
+	}
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewExport is stored in t_review_export, one row per export job.
+type ReviewExport struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(255);not null;index"`
+	Status        string    `gorm:"type:varchar(16);not null;index"`
+	Filters       string    `gorm:"type:varchar(4096);not null"` // JSON of entity.ReviewExportFilters
+	Role          string    `gorm:"type:varchar(255)"`
+	Rows          int       `gorm:"not null;default:0"`
+	ObjectName    string    `gorm:"type:varchar(1024)"`
+	Error         string    `gorm:"type:varchar(1024)"`
+	CreatedBy     string    `gorm:"type:varchar(255)"`
+	CreatedAtUtc  time.Time `gorm:"not null"`
+	StartedAtUtc  *time.Time
+	FinishedAtUtc *time.Time
+}
+
+func NewReviewExport(params *entity.CreateReviewExportParams) *ReviewExport {
+	m := &ReviewExport{
+		Project:      params.Project,
+		Status:       entity.ReviewExportStatusQueued,
+		Role:         params.Role,
+		CreatedBy:    params.CreatedBy,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+	if b, err := json.Marshal(params.Filters); err == nil {
+		m.Filters = string(b)
+	}
+	return m
+}
+
+func (m *ReviewExport) Entity() *entity.ReviewExport {
+	e := &entity.ReviewExport{
+		ID:            m.ID,
+		Project:       m.Project,
+		Status:        m.Status,
+		Role:          m.Role,
+		Rows:          m.Rows,
+		ObjectName:    m.ObjectName,
+		Error:         m.Error,
+		CreatedBy:     m.CreatedBy,
+		CreatedAtUtc:  m.CreatedAtUtc,
+		StartedAtUtc:  m.StartedAtUtc,
+		FinishedAtUtc: m.FinishedAtUtc,
+	}
+	if m.Filters != "" {
+		_ = json.Unmarshal([]byte(m.Filters), &e.Filters)
+	}
+	return e
+}
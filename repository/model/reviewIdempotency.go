@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewIdempotencyKey is stored in t_review_idempotency_key, one row per project and key.
+type ReviewIdempotencyKey struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	Project      string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_review_idempotency_key,priority:1"`
+	Key          string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_review_idempotency_key,priority:2"`
+	Fingerprint  string    `gorm:"type:char(64);not null"`
+	ReviewInfoID int32     `gorm:"not null"`
+	CreatedAtUtc time.Time `gorm:"not null;index"`
+}
+
+func NewReviewIdempotencyKey(e *entity.ReviewIdempotencyKey) *ReviewIdempotencyKey {
+	return &ReviewIdempotencyKey{
+		Project:      e.Project,
+		Key:          e.Key,
+		Fingerprint:  e.Fingerprint,
+		ReviewInfoID: e.ReviewInfoID,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+}
+
+func (m *ReviewIdempotencyKey) Entity() *entity.ReviewIdempotencyKey {
+	return &entity.ReviewIdempotencyKey{
+		Project:      m.Project,
+		Key:          m.Key,
+		Fingerprint:  m.Fingerprint,
+		ReviewInfoID: m.ReviewInfoID,
+		CreatedAtUtc: m.CreatedAtUtc,
+	}
+}
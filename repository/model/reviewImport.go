@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewImport marks a t_review_info row as backfilled from a legacy spreadsheet.
+type ReviewImport struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(255);not null;index:idx_review_import_batch"`
+	BatchID       string    `gorm:"type:varchar(64);not null;index:idx_review_import_batch"`
+	ReviewInfoID  int32     `gorm:"not null;uniqueIndex"`
+	SourceLine    int       `gorm:"not null"`
+	ImportedBy    string    `gorm:"type:varchar(255)"`
+	ImportedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewReviewImport(params *entity.CreateReviewImportParams) *ReviewImport {
+	return &ReviewImport{
+		Project:       params.Project,
+		BatchID:       params.BatchID,
+		ReviewInfoID:  params.ReviewInfoID,
+		SourceLine:    params.SourceLine,
+		ImportedBy:    params.ImportedBy,
+		ImportedAtUtc: time.Now().UTC(),
+	}
+}
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+// ReviewLatest is stored in t_review_latest: a copy of the latest live t_review_info row
+// of every asset phase, maintained by the review info writes (see repository/reviewLatest.go).
+// Group2 and Group3 are "" for the assets root, whose assets are keyed by group_1 and
+// relation alone. They are left out of the key index, which would exceed the InnoDB
+// key length with them; the writes keep one row per key themselves.
+type ReviewLatest struct {
+	ID                        int32   `gorm:"primaryKey;autoIncrement"`
+	ReviewInfoID              int32   `gorm:"not null;index"`
+	Project                   string  `gorm:"type:varchar(64);not null;index:idx_review_latest_asset,priority:1"`
+	Root                      string  `gorm:"type:varchar(32);not null;index:idx_review_latest_asset,priority:2"`
+	Group1                    string  `gorm:"column:group_1;type:varchar(255);not null;index:idx_review_latest_asset,priority:3"`
+	Group2                    string  `gorm:"column:group_2;type:varchar(255);not null;default:''"`
+	Group3                    string  `gorm:"column:group_3;type:varchar(255);not null;default:''"`
+	Relation                  string  `gorm:"type:varchar(255);not null;index:idx_review_latest_asset,priority:4"`
+	Phase                     string  `gorm:"type:varchar(64);not null;index:idx_review_latest_asset,priority:5"`
+	Component                 *string `gorm:"type:varchar(255)"`
+	WorkStatus                *string `gorm:"type:varchar(255)"`
+	ApprovalStatus            *string `gorm:"type:varchar(255)"`
+	SubmittedUser             *string `gorm:"type:varchar(255)"`
+	ApprovalStatusUpdatedUser *string `gorm:"type:varchar(255)"`
+	Studio                    *string `gorm:"type:varchar(255)"`
+	SubmittedAtUtc            *time.Time
+	ModifiedAtUtc             time.Time `gorm:"not null"`
+	Take                      *string   `gorm:"type:varchar(255)"`
+	Groups                    *string   `gorm:"type:json"`
+}
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewLock is stored in t_review_lock; at most one row per review info. Expired rows are
+// ignored by readers and overwritten by the next acquire.
+type ReviewLock struct {
+	ReviewInfoID  int32     `gorm:"primaryKey;autoIncrement:false"`
+	Project       string    `gorm:"type:varchar(255);not null;index"`
+	Holder        string    `gorm:"type:varchar(255);not null"`
+	Reason        string    `gorm:"type:varchar(255)"`
+	AcquiredAtUtc time.Time `gorm:"not null"`
+	ExpiresAtUtc  time.Time `gorm:"not null;index"`
+}
+
+func NewReviewLock(params *entity.AcquireReviewLockParams, now time.Time) *ReviewLock {
+	return &ReviewLock{
+		ReviewInfoID:  params.ReviewInfoID,
+		Project:       params.Project,
+		Holder:        params.Holder,
+		Reason:        params.Reason,
+		AcquiredAtUtc: now,
+		ExpiresAtUtc:  now.Add(params.TTL),
+	}
+}
+
+func (m *ReviewLock) Entity() *entity.ReviewLock {
+	return &entity.ReviewLock{
+		Project:       m.Project,
+		ReviewInfoID:  m.ReviewInfoID,
+		Holder:        m.Holder,
+		Reason:        m.Reason,
+		AcquiredAtUtc: m.AcquiredAtUtc,
+		ExpiresAtUtc:  m.ExpiresAtUtc,
+	}
+}
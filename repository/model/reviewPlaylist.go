@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewPlaylist is stored in t_review_playlist. Rows are hard-deleted with their items.
+type ReviewPlaylist struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(255);not null;index"`
+	Name          string    `gorm:"type:varchar(255);not null"`
+	Description   string    `gorm:"type:varchar(1024)"`
+	CreatedBy     string    `gorm:"type:varchar(255)"`
+	CreatedAtUtc  time.Time `gorm:"not null"`
+	ModifiedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewReviewPlaylist(params *entity.CreateReviewPlaylistParams) *ReviewPlaylist {
+	now := time.Now().UTC()
+	return &ReviewPlaylist{
+		Project:       params.Project,
+		Name:          params.Name,
+		Description:   params.Description,
+		CreatedBy:     params.CreatedBy,
+		CreatedAtUtc:  now,
+		ModifiedAtUtc: now,
+	}
+}
+
+func (m *ReviewPlaylist) Entity(items []*ReviewPlaylistItem) *entity.ReviewPlaylist {
+	e := &entity.ReviewPlaylist{
+		ID:            m.ID,
+		Project:       m.Project,
+		Name:          m.Name,
+		Description:   m.Description,
+		CreatedBy:     m.CreatedBy,
+		CreatedAtUtc:  m.CreatedAtUtc,
+		ModifiedAtUtc: m.ModifiedAtUtc,
+		Items:         make([]*entity.ReviewPlaylistItem, len(items)),
+	}
+	for i, item := range items {
+		e.Items[i] = item.Entity()
+	}
+	return e
+}
+
+// ReviewPlaylistItem is stored in t_review_playlist_item.
+type ReviewPlaylistItem struct {
+	ID           int32  `gorm:"primaryKey;autoIncrement"`
+	PlaylistID   int32  `gorm:"not null;index:idx_review_playlist_item_position,priority:1"`
+	Position     int    `gorm:"not null;index:idx_review_playlist_item_position,priority:2"`
+	Root         string `gorm:"type:varchar(255);not null"`
+	Asset        string `gorm:"type:varchar(255);not null"`
+	Relation     string `gorm:"type:varchar(255);not null"`
+	Phase        string `gorm:"type:varchar(255);not null"`
+	Take         string `gorm:"type:varchar(255);not null"`
+	ReviewInfoID *int32
+}
+
+func NewReviewPlaylistItem(playlistID int32, position int, params *entity.ReviewPlaylistItemParams) *ReviewPlaylistItem {
+	root := params.Root
+	if root == "" {
+		root = "assets"
+	}
+	return &ReviewPlaylistItem{
+		PlaylistID:   playlistID,
+		Position:     position,
+		Root:         root,
+		Asset:        params.Asset,
+		Relation:     params.Relation,
+		Phase:        params.Phase,
+		Take:         params.Take,
+		ReviewInfoID: params.ReviewInfoID,
+	}
+}
+
+func (m *ReviewPlaylistItem) Entity() *entity.ReviewPlaylistItem {
+	return &entity.ReviewPlaylistItem{
+		ID:           m.ID,
+		Position:     m.Position,
+		Root:         m.Root,
+		Asset:        m.Asset,
+		Relation:     m.Relation,
+		Phase:        m.Phase,
+		Take:         m.Take,
+		ReviewInfoID: m.ReviewInfoID,
+	}
+}
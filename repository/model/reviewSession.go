@@ -0,0 +1,77 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewSession is stored in t_review_session. Rows are hard-deleted.
+type ReviewSession struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(255);not null;index:idx_review_session_start,priority:1"`
+	Title         string    `gorm:"type:varchar(255);not null"`
+	Description   string    `gorm:"type:varchar(2048)"`
+	Location      string    `gorm:"type:varchar(255)"`
+	StartsAtUtc   time.Time `gorm:"not null;index:idx_review_session_start,priority:2"`
+	EndsAtUtc     time.Time `gorm:"not null"`
+	PlaylistID    *int32
+	CreatedBy     string    `gorm:"type:varchar(255)"`
+	CreatedAtUtc  time.Time `gorm:"not null"`
+	ModifiedAtUtc time.Time `gorm:"not null"`
+
+	// Assets is a JSON array of entity.ReviewSessionAsset.
+	Assets string `gorm:"type:text"`
+}
+
+func NewReviewSession(params *entity.CreateReviewSessionParams) *ReviewSession {
+	now := time.Now().UTC()
+	m := &ReviewSession{
+		Project:       params.Project,
+		Title:         params.Title,
+		Description:   params.Description,
+		Location:      params.Location,
+		StartsAtUtc:   params.StartsAtUtc.UTC(),
+		EndsAtUtc:     params.EndsAtUtc.UTC(),
+		PlaylistID:    params.PlaylistID,
+		CreatedBy:     params.CreatedBy,
+		CreatedAtUtc:  now,
+		ModifiedAtUtc: now,
+	}
+	if len(params.Assets) > 0 {
+		assets := make([]*entity.ReviewSessionAsset, len(params.Assets))
+		for i, a := range params.Assets {
+			asset := *a
+			if asset.Root == "" {
+				asset.Root = "assets"
+			}
+			assets[i] = &asset
+		}
+		if b, err := json.Marshal(assets); err == nil {
+			m.Assets = string(b)
+		}
+	}
+	return m
+}
+
+func (m *ReviewSession) Entity() *entity.ReviewSession {
+	e := &entity.ReviewSession{
+		ID:            m.ID,
+		Project:       m.Project,
+		Title:         m.Title,
+		Description:   m.Description,
+		Location:      m.Location,
+		StartsAtUtc:   m.StartsAtUtc,
+		EndsAtUtc:     m.EndsAtUtc,
+		PlaylistID:    m.PlaylistID,
+		Assets:        []*entity.ReviewSessionAsset{},
+		CreatedBy:     m.CreatedBy,
+		CreatedAtUtc:  m.CreatedAtUtc,
+		ModifiedAtUtc: m.ModifiedAtUtc,
+	}
+	if m.Assets != "" {
+		_ = json.Unmarshal([]byte(m.Assets), &e.Assets)
+	}
+	return e
+}
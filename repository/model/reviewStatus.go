@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewStatus is stored in t_review_status, one row per status of a project vocabulary.
+type ReviewStatus struct {
+	ID            int32     `gorm:"primaryKey;autoIncrement"`
+	Project       string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_review_status_key,priority:1"`
+	Type          string    `gorm:"type:varchar(16);not null;uniqueIndex:idx_review_status_key,priority:2"`
+	Key           string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_status_key,priority:3"`
+	Label         string    `gorm:"type:varchar(255);not null"`
+	Color         string    `gorm:"type:varchar(16)"`
+	SortOrder     int       `gorm:"not null;default:0"`
+	CreatedAtUtc  time.Time `gorm:"not null"`
+	ModifiedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewReviewStatus(params *entity.CreateReviewStatusParams) *ReviewStatus {
+	now := time.Now().UTC()
+	return &ReviewStatus{
+		Project:       params.Project,
+		Type:          params.Type,
+		Key:           params.Key,
+		Label:         params.Label,
+		Color:         params.Color,
+		SortOrder:     params.Order,
+		CreatedAtUtc:  now,
+		ModifiedAtUtc: now,
+	}
+}
+
+func (m *ReviewStatus) Entity() *entity.ReviewStatus {
+	return &entity.ReviewStatus{
+		ID:            m.ID,
+		Project:       m.Project,
+		Type:          m.Type,
+		Key:           m.Key,
+		Label:         m.Label,
+		Color:         m.Color,
+		Order:         m.SortOrder,
+		CreatedAtUtc:  m.CreatedAtUtc,
+		ModifiedAtUtc: m.ModifiedAtUtc,
+	}
+}
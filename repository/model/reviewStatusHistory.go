@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewStatusHistory is stored in t_review_status_history, one row per status change.
+type ReviewStatusHistory struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	Project      string    `gorm:"type:varchar(255);not null;index:idx_review_status_history_review"`
+	ReviewInfoID int32     `gorm:"not null;index:idx_review_status_history_review"`
+	Field        string    `gorm:"type:varchar(32);not null"`
+	FromValue    string    `gorm:"type:varchar(255)"`
+	ToValue      string    `gorm:"type:varchar(255)"`
+	ChangedBy    string    `gorm:"type:varchar(255)"`
+	SessionID    string    `gorm:"type:varchar(64)"`
+	ChangedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewReviewStatusHistory(params *entity.CreateReviewStatusHistoryParams) *ReviewStatusHistory {
+	at := params.ChangedAtUtc
+	if at.IsZero() {
+		at = time.Now().UTC()
+	}
+	return &ReviewStatusHistory{
+		Project:      params.Project,
+		ReviewInfoID: params.ReviewInfoID,
+		Field:        params.Field,
+		FromValue:    params.FromValue,
+		ToValue:      params.ToValue,
+		ChangedBy:    params.ChangedBy,
+		SessionID:    params.SessionID,
+		ChangedAtUtc: at,
+	}
+}
+
+func (m *ReviewStatusHistory) Entity() *entity.ReviewStatusHistory {
+	return &entity.ReviewStatusHistory{
+		ID:           m.ID,
+		Project:      m.Project,
+		ReviewInfoID: m.ReviewInfoID,
+		Field:        m.Field,
+		FromValue:    m.FromValue,
+		ToValue:      m.ToValue,
+		ChangedBy:    m.ChangedBy,
+		SessionID:    m.SessionID,
+		ChangedAtUtc: m.ChangedAtUtc,
+	}
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewTag is stored in t_review_tag; one row per tag of an asset relation.
+type ReviewTag struct {
+	ID          int32     `gorm:"primaryKey;autoIncrement"`
+	Project     string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_tag_key,priority:1;index:idx_review_tag_tag,priority:1"`
+	Root        string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_review_tag_key,priority:2"`
+	Group1      string    `gorm:"column:group_1;type:varchar(255);not null;uniqueIndex:idx_review_tag_key,priority:3"`
+	Relation    string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_review_tag_key,priority:4"`
+	Tag         string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_tag_key,priority:5;index:idx_review_tag_tag,priority:2"`
+	TaggedBy    string    `gorm:"type:varchar(255)"`
+	TaggedAtUtc time.Time `gorm:"not null"`
+}
+
+func (m *ReviewTag) Entity() *entity.ReviewTag {
+	return &entity.ReviewTag{
+		Project:     m.Project,
+		Asset:       m.Group1,
+		Relation:    m.Relation,
+		Tag:         m.Tag,
+		TaggedBy:    m.TaggedBy,
+		TaggedAtUtc: m.TaggedAtUtc,
+	}
+}
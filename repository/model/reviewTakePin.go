@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewTakePin is stored in t_review_take_pin; at most one pinned review info per asset
+// phase. refreshReviewLatest copies the pinned row into t_review_latest while it is live.
+type ReviewTakePin struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	Project      string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_take_pin_key,priority:1"`
+	Root         string    `gorm:"type:varchar(32);not null;uniqueIndex:idx_review_take_pin_key,priority:2"`
+	Group1       string    `gorm:"column:group_1;type:varchar(255);not null;uniqueIndex:idx_review_take_pin_key,priority:3"`
+	Relation     string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_review_take_pin_key,priority:4"`
+	Phase        string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_review_take_pin_key,priority:5"`
+	ReviewInfoID int32     `gorm:"not null;index"`
+	Take         string    `gorm:"type:varchar(255);not null"`
+	PinnedBy     string    `gorm:"type:varchar(255)"`
+	PinnedAtUtc  time.Time `gorm:"not null"`
+}
+
+func (m *ReviewTakePin) Entity() *entity.ReviewTakePin {
+	return &entity.ReviewTakePin{
+		Project:      m.Project,
+		Asset:        m.Group1,
+		Relation:     m.Relation,
+		Phase:        m.Phase,
+		ReviewInfoID: m.ReviewInfoID,
+		Take:         m.Take,
+		PinnedBy:     m.PinnedBy,
+		PinnedAtUtc:  m.PinnedAtUtc,
+	}
+}
@@ -0,0 +1,61 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewValidationRules is stored in t_review_validation_rules, one row per project.
+type ReviewValidationRules struct {
+	Project      string    `gorm:"type:varchar(255);primaryKey"`
+	UpdatedBy    string    `gorm:"type:varchar(255)"`
+	UpdatedAtUtc time.Time `gorm:"not null"`
+
+	// JSON string arrays; empty means any value.
+	Phases           string `gorm:"type:varchar(2048)"`
+	ApprovalStatuses string `gorm:"type:varchar(2048)"`
+	WorkStatuses     string `gorm:"type:varchar(2048)"`
+}
+
+func marshalStrings(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func unmarshalStrings(s string) []string {
+	values := []string{}
+	if s != "" {
+		_ = json.Unmarshal([]byte(s), &values)
+	}
+	return values
+}
+
+func NewReviewValidationRules(params *entity.PutReviewValidationRulesParams) *ReviewValidationRules {
+	return &ReviewValidationRules{
+		Project:          params.Project,
+		UpdatedBy:        params.UpdatedBy,
+		UpdatedAtUtc:     time.Now().UTC(),
+		Phases:           marshalStrings(params.Phases),
+		ApprovalStatuses: marshalStrings(params.ApprovalStatuses),
+		WorkStatuses:     marshalStrings(params.WorkStatuses),
+	}
+}
+
+func (m *ReviewValidationRules) Entity() *entity.ReviewValidationRules {
+	return &entity.ReviewValidationRules{
+		Project:          m.Project,
+		Phases:           unmarshalStrings(m.Phases),
+		ApprovalStatuses: unmarshalStrings(m.ApprovalStatuses),
+		WorkStatuses:     unmarshalStrings(m.WorkStatuses),
+		UpdatedBy:        m.UpdatedBy,
+		UpdatedAtUtc:     m.UpdatedAtUtc,
+	}
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// ReviewWebhook is stored in t_review_webhook. Rows are hard-deleted on unregistration.
+type ReviewWebhook struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	Project      string    `gorm:"type:varchar(255);not null;index"`
+	URL          string    `gorm:"type:varchar(1024);not null"`
+	EventTypes   string    `gorm:"type:varchar(255);not null"` // JSON array of event types
+	Secret       string    `gorm:"type:varchar(255);not null"`
+	CreatedBy    string    `gorm:"type:varchar(255)"`
+	CreatedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewReviewWebhook(params *entity.CreateReviewWebhookParams) *ReviewWebhook {
+	m := &ReviewWebhook{
+		Project:      params.Project,
+		URL:          params.URL,
+		Secret:       params.Secret,
+		CreatedBy:    params.CreatedBy,
+		CreatedAtUtc: time.Now().UTC(),
+	}
+	if b, err := json.Marshal(params.EventTypes); err == nil {
+		m.EventTypes = string(b)
+	}
+	return m
+}
+
+func (m *ReviewWebhook) Entity() *entity.ReviewWebhook {
+	e := &entity.ReviewWebhook{
+		ID:           m.ID,
+		Project:      m.Project,
+		URL:          m.URL,
+		EventTypes:   []string{},
+		Secret:       m.Secret,
+		CreatedBy:    m.CreatedBy,
+		CreatedAtUtc: m.CreatedAtUtc,
+	}
+	if m.EventTypes != "" {
+		_ = json.Unmarshal([]byte(m.EventTypes), &e.EventTypes)
+	}
+	return e
+}
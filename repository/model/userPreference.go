@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// UserPreference is stored in t_user_preference, one row per user and key.
+type UserPreference struct {
+	ID           int32     `gorm:"primaryKey;autoIncrement"`
+	User         string    `gorm:"type:varchar(255);not null;uniqueIndex:idx_user_preference_key"`
+	Key          string    `gorm:"type:varchar(64);not null;uniqueIndex:idx_user_preference_key"`
+	Value        string    `gorm:"type:text;not null"`
+	UpdatedAtUtc time.Time `gorm:"not null"`
+}
+
+func NewUserPreference(params *entity.PutUserPreferenceParams) *UserPreference {
+	return &UserPreference{
+		User:         params.User,
+		Key:          params.Key,
+		Value:        string(params.Value),
+		UpdatedAtUtc: time.Now().UTC(),
+	}
+}
+
+func (m *UserPreference) Entity() *entity.UserPreference {
+	return &entity.UserPreference{
+		User:         m.User,
+		Key:          m.Key,
+		Value:        []byte(m.Value),
+		UpdatedAtUtc: m.UpdatedAtUtc,
+	}
+}
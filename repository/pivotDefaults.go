@@ -0,0 +1,79 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/pivotDefaults.go
+
+	Module Description:
+		Repository for per-project asset pivot defaults (sort, direction, view, per_page).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves the defaults of a project.
+	* - Put: Creates or replaces the defaults of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type PivotDefaults struct {
+	db *gorm.DB
+}
+
+func NewPivotDefaults(db *gorm.DB) (*PivotDefaults, error) {
+	if err := db.AutoMigrate(&model.PivotDefaults{}); err != nil {
+		return nil, err
+	}
+	return &PivotDefaults{
+		db: db,
+	}, nil
+}
+
+func (r *PivotDefaults) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *PivotDefaults) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *PivotDefaults) Get(
+	db *gorm.DB,
+	params *entity.GetPivotDefaultsParams,
+) (*entity.PivotDefaults, error) {
+	var m model.PivotDefaults
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *PivotDefaults) Put(
+	tx *gorm.DB,
+	params *entity.PutPivotDefaultsParams,
+) (*entity.PivotDefaults, error) {
+	m := model.NewPivotDefaults(params)
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
@@ -0,0 +1,130 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/projectMember.go
+
+	Module Description:
+		Repository for per-project review roles (viewer, artist, lead, supervisor).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - List: Lists the members of a project.
+	* - Count: Counts the members of a project.
+	* - Get: Retrieves the membership of one user.
+	* - Put: Creates or replaces the role of one user.
+	* - Delete: Removes one user from a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProjectMember struct {
+	db *gorm.DB
+}
+
+func NewProjectMember(db *gorm.DB) (*ProjectMember, error) {
+	if err := db.AutoMigrate(&model.ProjectMember{}); err != nil {
+		return nil, err
+	}
+	return &ProjectMember{
+		db: db,
+	}, nil
+}
+
+func (r *ProjectMember) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ProjectMember) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ProjectMember) List(
+	db *gorm.DB,
+	params *entity.ListProjectMemberParams,
+) ([]*entity.ProjectMember, error) {
+	var models []*model.ProjectMember
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Order("`user`").Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ProjectMember, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+func (r *ProjectMember) Count(db *gorm.DB, project string) (int64, error) {
+	var n int64
+	if err := db.Model(&model.ProjectMember{}).Where(
+		"`project` = ?", project,
+	).Count(&n).Error; err != nil {
+		return 0, err
+	}
+	return n, nil
+}
+
+func (r *ProjectMember) Get(db *gorm.DB, project, user string) (*entity.ProjectMember, error) {
+	var m model.ProjectMember
+	if err := db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`user` = ?", user,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ProjectMember) Put(
+	tx *gorm.DB,
+	params *entity.PutProjectMemberParams,
+) (*entity.ProjectMember, error) {
+	m := model.NewProjectMember(params)
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project"}, {Name: "user"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "updated_by", "updated_at_utc"}),
+	}).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return r.Get(tx, params.Project, params.User)
+}
+
+func (r *ProjectMember) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteProjectMemberParams,
+) error {
+	res := tx.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`user` = ?", params.User,
+	).Delete(&model.ProjectMember{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
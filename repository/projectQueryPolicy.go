@@ -0,0 +1,80 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/projectQueryPolicy.go
+
+	Module Description:
+		Repository for per-project query policies of the asset pivot (max per_page,
+		timeout, allowed views).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves the query policy of a project.
+	* - Put: Creates or replaces the query policy of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ProjectQueryPolicy struct {
+	db *gorm.DB
+}
+
+func NewProjectQueryPolicy(db *gorm.DB) (*ProjectQueryPolicy, error) {
+	if err := db.AutoMigrate(&model.ProjectQueryPolicy{}); err != nil {
+		return nil, err
+	}
+	return &ProjectQueryPolicy{
+		db: db,
+	}, nil
+}
+
+func (r *ProjectQueryPolicy) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ProjectQueryPolicy) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ProjectQueryPolicy) Get(
+	db *gorm.DB,
+	params *entity.GetProjectQueryPolicyParams,
+) (*entity.ProjectQueryPolicy, error) {
+	var m model.ProjectQueryPolicy
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ProjectQueryPolicy) Put(
+	tx *gorm.DB,
+	params *entity.PutProjectQueryPolicyParams,
+) (*entity.ProjectQueryPolicy, error) {
+	m := model.NewProjectQueryPolicy(params)
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
@@ -0,0 +1,206 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/queryTuning.go
+
+	Module Description:
+		Per-project, per-query-stage optimizer hints for the raw pivot queries.
+
+	Details:
+	- When MySQL misestimates the pivot CTEs, DBAs can steer the plan from a JSON file
+	  (PPI_QUERY_TUNING_FILE) instead of waiting for a deploy:
+	      {
+	        "*":       {"pivot_keys": {"optimizer": ["NO_MERGE(latest_phase)"]}},
+	        "project": {"pivot_count": {"force_index": ["idx_review_info_latest"],
+	                                    "sql_big_result": true}}
+	      }
+	  Project entries replace the "*" entry of the same stage.
+	- A stage is one query block: optimizer hints and SQL_BIG_RESULT go right after its
+	  SELECT, FORCE INDEX after its t_review_info table reference.
+	- Hints are spliced into SQL text, so only identifier-like text is accepted; a file
+	  failing validation is rejected as a whole and the previous config stays in effect.
+	- Watch reloads the file when its modification time changes.
+	- The hints are MySQL syntax; queries on another dialect run without them.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Drop the hints on dialects that do not take them.
+
+	Functions:
+	* - NewQueryTuning: Loads a tuning file.
+	* - (QueryTuning) Reload: Re-reads the tuning file if it changed.
+	* - (QueryTuning) Watch: Reloads the tuning file periodically until ctx is done.
+	* - (QueryTuning) Hint: Returns the hint of a project and stage.
+	* - (ReviewInfo) SetQueryTuning: Attaches a tuning config to the repository.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+// Query stages of ListAssetsPivot that accept hints.
+const (
+	QueryStagePivotCount  = "pivot_count"  // latest_phase scan of CountLatestSubmissions
+	QueryStagePivotKeys   = "pivot_keys"   // latest_phase scan choosing the page's assets
+	QueryStagePivotPhases = "pivot_phases" // latest_phase scan fetching the page's phases
+)
+
+const allProjectsTuningKey = "*"
+
+var (
+	optimizerHintPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\([A-Za-z0-9_@ ,=.'-]*\))?$`)
+	indexNamePattern     = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+	queryStages          = map[string]bool{
+		QueryStagePivotCount:  true,
+		QueryStagePivotKeys:   true,
+		QueryStagePivotPhases: true,
+	}
+)
+
+// QueryHint is the tuning of one query stage.
+type QueryHint struct {
+	Optimizer    []string `json:"optimizer"`      // e.g. "NO_MERGE(latest_phase)", "BKA(ri)"
+	ForceIndex   []string `json:"force_index"`    // index names of t_review_info
+	SQLBigResult bool     `json:"sql_big_result"` // adds SQL_BIG_RESULT to the SELECT
+}
+
+func (h QueryHint) validate() error {
+	for _, o := range h.Optimizer {
+		if !optimizerHintPattern.MatchString(o) {
+			return fmt.Errorf("invalid optimizer hint %q", o)
+		}
+	}
+	for _, idx := range h.ForceIndex {
+		if !indexNamePattern.MatchString(idx) {
+			return fmt.Errorf("invalid index name %q", idx)
+		}
+	}
+	return nil
+}
+
+// forDialect returns h, or no hint when d does not take MySQL query hints.
+func (h QueryHint) forDialect(d reviewquery.Dialect) QueryHint {
+	if !d.Hints() {
+		return QueryHint{}
+	}
+	return h
+}
+
+// selectModifiers is placed right after SELECT; "" when nothing is set.
+func (h QueryHint) selectModifiers() string {
+	s := ""
+	if len(h.Optimizer) > 0 {
+		s += " /*+ " + strings.Join(h.Optimizer, " ") + " */"
+	}
+	if h.SQLBigResult {
+		s += " SQL_BIG_RESULT"
+	}
+	return s
+}
+
+// indexHint is placed after the t_review_info table reference; "" when nothing is set.
+func (h QueryHint) indexHint() string {
+	if len(h.ForceIndex) == 0 {
+		return ""
+	}
+	return " FORCE INDEX (`" + strings.Join(h.ForceIndex, "`, `") + "`)"
+}
+
+type QueryTuning struct {
+	path    string
+	mu      sync.RWMutex
+	modTime time.Time
+	hints   map[string]map[string]QueryHint
+}
+
+func NewQueryTuning(path string) (*QueryTuning, error) {
+	t := &QueryTuning{path: path}
+	if err := t.Reload(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Reload re-reads the file when its modification time changed. On error the
+// previously loaded hints are kept.
+func (t *QueryTuning) Reload() error {
+	info, err := os.Stat(t.path)
+	if err != nil {
+		return err
+	}
+	t.mu.RLock()
+	unchanged := info.ModTime().Equal(t.modTime)
+	t.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	b, err := os.ReadFile(t.path)
+	if err != nil {
+		return err
+	}
+	var hints map[string]map[string]QueryHint
+	if err := json.Unmarshal(b, &hints); err != nil {
+		return fmt.Errorf("query tuning %s: %w", t.path, err)
+	}
+	for project, stages := range hints {
+		for stage, h := range stages {
+			if !queryStages[stage] {
+				return fmt.Errorf("query tuning %s: unknown stage %q for %q", t.path, stage, project)
+			}
+			if err := h.validate(); err != nil {
+				return fmt.Errorf("query tuning %s: %s/%s: %w", t.path, project, stage, err)
+			}
+		}
+	}
+
+	t.mu.Lock()
+	t.hints = hints
+	t.modTime = info.ModTime()
+	t.mu.Unlock()
+	log.Printf("[TUNING] loaded query hints from %s", t.path)
+	return nil
+}
+
+func (t *QueryTuning) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := t.Reload(); err != nil {
+				log.Printf("[TUNING] reload failed, keeping previous hints: %v", err)
+			}
+		}
+	}
+}
+
+func (t *QueryTuning) Hint(project, stage string) QueryHint {
+	if t == nil {
+		return QueryHint{}
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if h, ok := t.hints[project][stage]; ok {
+		return h
+	}
+	return t.hints[allProjectsTuningKey][stage]
+}
+
+// SetQueryTuning attaches t to the pivot queries; nil removes all hints.
+func (r *ReviewInfo) SetQueryTuning(t *QueryTuning) {
+	r.tuning = t
+}
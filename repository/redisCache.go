@@ -0,0 +1,66 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/redisCache.go
+
+	Module Description:
+		Redis-backed entity.Cache shared by every instance of the service.
+
+	Details:
+	- All keys are namespaced with prefix. Size bounds are left to the Redis server
+	  (maxmemory + an LRU eviction policy such as allkeys-lru).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewRedisCache: Creates a cache on an existing client.
+	* - Get / Set / Delete: entity.Cache implementation.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisCache struct {
+	client *redis.Client
+	prefix string
+}
+
+func NewRedisCache(client *redis.Client, prefix string) *RedisCache {
+	return &RedisCache{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (c *RedisCache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	b, err := c.client.Get(ctx, c.prefix+key).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+func (c *RedisCache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return c.client.Set(ctx, c.prefix+key, value, ttl).Err()
+}
+
+func (c *RedisCache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	prefixed := make([]string, len(keys))
+	for i, k := range keys {
+		prefixed[i] = c.prefix + k
+	}
+	return c.client.Del(ctx, prefixed...).Err()
+}
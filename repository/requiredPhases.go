@@ -0,0 +1,79 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/requiredPhases.go
+
+	Module Description:
+		Repository for the per-project required phases of assets by relation and top group node.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves the required phases of a project.
+	* - Put: Creates or replaces the required phases of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type RequiredPhases struct {
+	db *gorm.DB
+}
+
+func NewRequiredPhases(db *gorm.DB) (*RequiredPhases, error) {
+	if err := db.AutoMigrate(&model.RequiredPhases{}); err != nil {
+		return nil, err
+	}
+	return &RequiredPhases{
+		db: db,
+	}, nil
+}
+
+func (r *RequiredPhases) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *RequiredPhases) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *RequiredPhases) Get(
+	db *gorm.DB,
+	params *entity.GetRequiredPhasesParams,
+) (*entity.RequiredPhases, error) {
+	var m model.RequiredPhases
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *RequiredPhases) Put(
+	tx *gorm.DB,
+	params *entity.PutRequiredPhasesParams,
+) (*entity.RequiredPhases, error) {
+	m := model.NewRequiredPhases(params)
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
@@ -0,0 +1,98 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewActivity.go
+
+	Module Description:
+		Repository for raw review activity events (submissions and status changes).
+
+	Details:
+	- Rows are written in the same transaction as the review info change they describe.
+	- Collapsing of repeated events happens in the usecase, not here.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Create: Stores a raw activity event.
+	* - List: Lists raw events of a project, newest first.
+	* - ListBySession: Lists the events of one review session, oldest first.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewActivity struct {
+	db *gorm.DB
+}
+
+func NewReviewActivity(db *gorm.DB) (*ReviewActivity, error) {
+	if err := db.AutoMigrate(&model.ReviewActivity{}); err != nil {
+		return nil, err
+	}
+	return &ReviewActivity{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewActivity) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewActivity) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewActivityParams,
+) error {
+	return tx.Create(model.NewReviewActivity(params)).Error
+}
+
+func (r *ReviewActivity) List(
+	db *gorm.DB,
+	params *entity.ListReviewActivityParams,
+) ([]*entity.ReviewActivity, error) {
+	stmt := db.Where("`project` = ?", params.Project)
+	if params.Since != nil {
+		stmt = stmt.Where("`occurred_at_utc` >= ?", *params.Since)
+	}
+	if params.Limit > 0 {
+		stmt = stmt.Limit(params.Limit)
+	}
+	var models []*model.ReviewActivity
+	if err := stmt.Order(
+		"`occurred_at_utc` desc, `id` desc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewActivity, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+func (r *ReviewActivity) ListBySession(
+	db *gorm.DB,
+	params *entity.ListReviewSessionDecisionsParams,
+) ([]*entity.ReviewActivity, error) {
+	var models []*model.ReviewActivity
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`session_id` = ?", params.SessionID,
+	).Order(
+		"`occurred_at_utc` asc, `id` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewActivity, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
@@ -0,0 +1,68 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewAssetPriority.go
+
+	Module Description:
+		Priority flags of assets and the asset pivot's priority_first sort.
+
+	Details:
+	- A priority flag is per asset (group_1) of a project, over all its relations. It
+	  is kept in t_review_asset_priority apart from the review rows; unflagging deletes
+	  the row.
+	- Pivot rows carry priority. sort=priority_first puts flagged assets first, each
+	  half in the default order (group_1, relation, submitted_at_utc).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) SetAssetPriority: Flags or unflags an asset.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PriorityFirstOrderKey sorts flagged assets of the pivot before the others.
+const PriorityFirstOrderKey = "priority_first"
+
+// SetAssetPriority flags the asset, or removes its flag when params.Priority is false.
+// Flagging a flagged asset keeps it flagged and records who did it last.
+func (r *ReviewInfo) SetAssetPriority(
+	tx *gorm.DB,
+	params *entity.SetAssetPriorityParams,
+) (*entity.AssetPriority, error) {
+	if !params.Priority {
+		if err := tx.Where(
+			"`project` = ?", params.Project,
+		).Where(
+			"`root` = ?", "assets",
+		).Where(
+			"`group_1` = ?", params.Asset,
+		).Delete(&model.ReviewAssetPriority{}).Error; err != nil {
+			return nil, err
+		}
+		return &entity.AssetPriority{Project: params.Project, Asset: params.Asset}, nil
+	}
+	m := &model.ReviewAssetPriority{
+		Project:  params.Project,
+		Root:     "assets",
+		Group1:   params.Asset,
+		SetBy:    params.SetBy,
+		SetAtUtc: time.Now().UTC(),
+	}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project"}, {Name: "root"}, {Name: "group_1"}},
+		DoUpdates: clause.AssignmentColumns([]string{"set_by", "set_at_utc"}),
+	}).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
@@ -0,0 +1,96 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewCertificate.go
+
+	Module Description:
+		Repository for approval certificates issued on final approval of a review.
+
+	Details:
+	- Certificates are insert-only. There is intentionally no Update/Delete.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Create: Stores a new certificate.
+	* - Get: Retrieves a certificate by ID.
+	* - ListByReviewInfo: Lists certificates issued for one review info (newest first).
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewCertificate struct {
+	db *gorm.DB
+}
+
+func NewReviewCertificate(db *gorm.DB) (*ReviewCertificate, error) {
+	if err := db.AutoMigrate(&model.ReviewCertificate{}); err != nil {
+		return nil, err
+	}
+	return &ReviewCertificate{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewCertificate) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewCertificate) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewCertificateParams,
+) (*entity.ReviewCertificate, error) {
+	m := model.NewReviewCertificate(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewCertificate) Get(
+	db *gorm.DB,
+	params *entity.GetReviewCertificateParams,
+) (*entity.ReviewCertificate, error) {
+	var m model.ReviewCertificate
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewCertificate) ListByReviewInfo(
+	db *gorm.DB,
+	params *entity.ListReviewCertificateParams,
+) ([]*entity.ReviewCertificate, error) {
+	var models []*model.ReviewCertificate
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`review_info_id` = ?", params.ReviewInfoID,
+	).Order(
+		"`id` desc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewCertificate, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
@@ -0,0 +1,154 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewComment.go
+
+	Module Description:
+		Read access to the review comment documents in MongoDB.
+
+	Details:
+	- Review comments are created as "comment" documents by ReviewInfo.Create (see
+	  usecase.createReviewComment); each carries the project and the take path of the
+	  review it belongs to, which is what they are counted by.
+	- Counting is one aggregation per call, whatever the number of take paths.
+	- Listing returns the comments of a take oldest first, at most limit of them.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added ListByTakePath.
+
+	Functions:
+	* - NewReviewComment: Creates a new ReviewComment repository.
+	* - (ReviewComment) ListByTakePath: Lists the comment documents of a take.
+	* - (ReviewComment) CountByTakePath: Counts the comment documents of takes.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const reviewCommentCollection = "comment"
+
+type ReviewComment struct {
+	db *mongo.Database
+}
+
+func NewReviewComment(db *mongo.Database) *ReviewComment {
+	return &ReviewComment{db: db}
+}
+
+// reviewCommentDoc is the stored form of entity.ReviewComment.
+type reviewCommentDoc struct {
+	ID                any     `bson:"_id"`
+	Take              string  `bson:"take"`
+	Type              string  `bson:"type"`
+	Tool              string  `bson:"tool"`
+	OriginalCommentID *string `bson:"original_comment_id"`
+	SubmittedUser     string  `bson:"submitted_user"`
+	SubmittedAtUtc    string  `bson:"submitted_at_utc"`
+	CommentData       []struct {
+		Language              string `bson:"language"`
+		Text                  string `bson:"text"`
+		Attachments           any    `bson:"attachments"`
+		NeedTranslation       bool   `bson:"need_translation"`
+		IsTranslated          bool   `bson:"is_translated"`
+		ResponsiblePersonRole string `bson:"responsible_person_role"`
+	} `bson:"comment_data"`
+}
+
+func (d *reviewCommentDoc) entity() *entity.ReviewComment {
+	e := &entity.ReviewComment{
+		Take:              d.Take,
+		Type:              d.Type,
+		Tool:              d.Tool,
+		OriginalCommentID: d.OriginalCommentID,
+		SubmittedUser:     d.SubmittedUser,
+		SubmittedAtUtc:    d.SubmittedAtUtc,
+		CommentData:       make([]*entity.ReviewCommentEntry, len(d.CommentData)),
+	}
+	switch id := d.ID.(type) {
+	case primitive.ObjectID:
+		e.ID = id.Hex()
+	default:
+		e.ID = fmt.Sprint(id)
+	}
+	for i, c := range d.CommentData {
+		e.CommentData[i] = &entity.ReviewCommentEntry{
+			Language:              c.Language,
+			Text:                  c.Text,
+			Attachments:           c.Attachments,
+			NeedTranslation:       c.NeedTranslation,
+			IsTranslated:          c.IsTranslated,
+			ResponsiblePersonRole: c.ResponsiblePersonRole,
+		}
+	}
+	return e
+}
+
+func (r *ReviewComment) ListByTakePath(
+	ctx context.Context,
+	project, takePath string,
+	limit int,
+) ([]*entity.ReviewComment, error) {
+	cur, err := r.db.Collection(reviewCommentCollection).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"project": project, "path": takePath}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+		{{Key: "$limit", Value: limit}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var docs []*reviewCommentDoc
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, err
+	}
+	comments := make([]*entity.ReviewComment, len(docs))
+	for i, d := range docs {
+		comments[i] = d.entity()
+	}
+	return comments, nil
+}
+
+// CountByTakePath returns the number of comment documents per take path; take paths
+// without comments are missing from the map.
+func (r *ReviewComment) CountByTakePath(
+	ctx context.Context,
+	project string,
+	takePaths []string,
+) (map[string]int64, error) {
+	counts := map[string]int64{}
+	if len(takePaths) == 0 {
+		return counts, nil
+	}
+	cur, err := r.db.Collection(reviewCommentCollection).Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"project": project,
+			"path":    bson.M{"$in": takePaths},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   "$path",
+			"count": bson.M{"$sum": 1},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var rows []struct {
+		Path  string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	if err := cur.All(ctx, &rows); err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		counts[row.Path] = row.Count
+	}
+	return counts, nil
+}
@@ -0,0 +1,192 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewDueDate.go
+
+	Module Description:
+		Due dates of asset phases and the asset pivot's due date filter and sort.
+
+	Details:
+	- A due date is a calendar day per asset phase, kept in t_review_due_date apart
+	  from the review rows, so it can be set before the phase's first submission.
+	- An asset's next_due_date is the earliest due date of its phases whose latest take
+	  is not approved (entity.OverallStatusRules.ApprovedStatuses); a phase without a
+	  take is open. Approving a phase thus moves the asset to its next deadline.
+	- An asset is overdue on a day when its next_due_date is before that day.
+	- sort=due_date orders by next_due_date, assets without one last.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - The pivot fill query uses unquoted columns, which PostgreSQL reads too.
+	* - 15-10-2026 - The pivot fill query selects by group_1, as SQLite has no row value lists.
+
+	Functions:
+	* - (ReviewInfo) ListDueDates: Lists the due dates of an asset relation.
+	* - (ReviewInfo) SetDueDate: Sets the due date of an asset phase.
+	* - (ReviewInfo) ClearDueDate: Removes the due date of an asset phase.
+	* - buildAssetDueCTE: CTE with the next_due_date of every asset of latest_phase.
+	* - (ReviewInfo) fillPivotDueDates: Sets the due dates of pivot rows.
+	* - formatDueDate: Formats a due date read back from MySQL.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// DueDateOrderKey sorts the asset pivot by next_due_date.
+const DueDateOrderKey = "due_date"
+
+func (r *ReviewInfo) ListDueDates(
+	db *gorm.DB,
+	params *entity.ListReviewDueDatesParams,
+) ([]*entity.ReviewDueDate, error) {
+	var models []*model.ReviewDueDate
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`root` = ?", "assets",
+	).Where(
+		"`group_1` = ?", params.Asset,
+	).Where(
+		"`relation` = ?", params.Relation,
+	).Order(
+		"`due_date` asc, `phase` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewDueDate, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+// SetDueDate sets the due date of the asset phase, replacing any previous one.
+func (r *ReviewInfo) SetDueDate(
+	tx *gorm.DB,
+	params *entity.SetReviewDueDateParams,
+) (*entity.ReviewDueDate, error) {
+	dueDate, err := time.Parse(entity.ReviewDueDateLayout, params.DueDate)
+	if err != nil {
+		return nil, err
+	}
+	m := &model.ReviewDueDate{
+		Project:  params.Project,
+		Root:     "assets",
+		Group1:   params.Asset,
+		Relation: params.Relation,
+		Phase:    params.Phase,
+		DueDate:  dueDate,
+		SetBy:    params.SetBy,
+		SetAtUtc: time.Now().UTC(),
+	}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "project"}, {Name: "root"}, {Name: "group_1"}, {Name: "relation"}, {Name: "phase"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"due_date", "set_by", "set_at_utc"}),
+	}).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// ClearDueDate returns entity.ErrRecordNotFound when the asset phase has no due date.
+func (r *ReviewInfo) ClearDueDate(
+	tx *gorm.DB,
+	params *entity.ClearReviewDueDateParams,
+) error {
+	res := assetPhase(
+		tx, params.Project, params.Asset, params.Relation, params.Phase,
+	).Delete(&model.ReviewDueDate{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
+
+// buildAssetDueCTE returns the asset_due CTE (preceded by a comma) with the
+// next_due_date of every asset of project and root that has an open due phase. The
+// latest_phase CTE must carry project, root, group_1, relation, phase,
+// approval_status and rn.
+func buildAssetDueCTE(project, root string, approvedStatuses []string) (string, []any) {
+	openCond := ""
+	args := []any{project, root}
+	if len(approvedStatuses) > 0 {
+		openCond = `
+    AND (lp.approval_status IS NULL OR LOWER(lp.approval_status) NOT IN ?)`
+		args = append(args, lowerValues(approvedStatuses))
+	}
+	return `,
+asset_due AS (
+  SELECT dd.project, dd.root, dd.group_1, dd.relation, MIN(dd.due_date) AS next_due_date
+  FROM t_review_due_date AS dd
+  LEFT JOIN latest_phase AS lp
+    ON lp.project = dd.project
+   AND lp.root = dd.root
+   AND lp.group_1 = dd.group_1
+   AND lp.relation = dd.relation
+   AND lp.phase = dd.phase
+   AND lp.rn = 1
+  WHERE dd.project = ? AND dd.root = ?` + openCond + `
+  GROUP BY dd.project, dd.root, dd.group_1, dd.relation
+)`, args
+}
+
+// fillPivotDueDates sets DueDates on rows from t_review_due_date; rows of other roots
+// than assets have none.
+func (r *ReviewInfo) fillPivotDueDates(ctx context.Context, project, root string, rows []*AssetPivot) error {
+	if len(rows) == 0 || root != "assets" {
+		return nil
+	}
+	// by group_1 only: SQLite takes no row value lists; other relations of the assets
+	// are dropped by the lookup below
+	group1s := make([]string, len(rows))
+	for i, ap := range rows {
+		group1s[i] = ap.Group1
+	}
+	var models []*model.ReviewDueDate
+	if err := r.ReadWithContext(ctx, project).Where(
+		"project = ?", project,
+	).Where(
+		"root = ?", root,
+	).Where(
+		"group_1 IN ?", group1s,
+	).Find(&models).Error; err != nil {
+		return err
+	}
+
+	type assetRelation struct{ group1, relation string }
+	byAsset := make(map[assetRelation]map[string]string, len(models))
+	for _, m := range models {
+		k := assetRelation{m.Group1, m.Relation}
+		if byAsset[k] == nil {
+			byAsset[k] = map[string]string{}
+		}
+		byAsset[k][strings.ToLower(m.Phase)] = m.DueDate.Format(entity.ReviewDueDateLayout)
+	}
+	for _, ap := range rows {
+		ap.DueDates = byAsset[assetRelation{ap.Group1, ap.Relation}]
+	}
+	return nil
+}
+
+// formatDueDate formats a DATE column scanned into a time; nil stays nil.
+func formatDueDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	v := t.Format(entity.ReviewDueDateLayout)
+	return &v
+}
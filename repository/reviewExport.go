@@ -0,0 +1,146 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewExport.go
+
+	Module Description:
+		Repository for asynchronous asset pivot export jobs.
+
+	Details:
+	- Claim moves a job from queued to running atomically, so with several instances
+	  (each requeueing the queued jobs on start) a job still runs only once.
+	- Jobs left running by a stopped instance stay running; they are not resumed.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Create: Queues an export job.
+	* - Get: Returns an export job of a project.
+	* - Claim: Marks a queued export job as running.
+	* - Finish: Marks a running export job as done or failed.
+	* - ListQueued: Lists the queued jobs of every project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewExport struct {
+	db *gorm.DB
+}
+
+func NewReviewExport(db *gorm.DB) (*ReviewExport, error) {
+	if err := db.AutoMigrate(&model.ReviewExport{}); err != nil {
+		return nil, err
+	}
+	return &ReviewExport{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewExport) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewExport) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewExport) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewExportParams,
+) (*entity.ReviewExport, error) {
+	m := model.NewReviewExport(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewExport) Get(
+	db *gorm.DB,
+	params *entity.GetReviewExportParams,
+) (*entity.ReviewExport, error) {
+	var m model.ReviewExport
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// Claim reports false when the job was no longer queued, i.e. someone else runs it.
+func (r *ReviewExport) Claim(tx *gorm.DB, id int32) (bool, error) {
+	res := tx.Model(&model.ReviewExport{}).Where(
+		"`id` = ?", id,
+	).Where(
+		"`status` = ?", entity.ReviewExportStatusQueued,
+	).Updates(map[string]interface{}{
+		"status":         entity.ReviewExportStatusRunning,
+		"started_at_utc": time.Now().UTC(),
+	})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected == 1, nil
+}
+
+func (r *ReviewExport) Finish(
+	tx *gorm.DB,
+	params *entity.FinishReviewExportParams,
+) error {
+	res := tx.Model(&model.ReviewExport{}).Where(
+		"`id` = ?", params.ID,
+	).Where(
+		"`status` = ?", entity.ReviewExportStatusRunning,
+	).Updates(map[string]interface{}{
+		"status":          params.Status,
+		"rows":            params.Rows,
+		"object_name":     params.ObjectName,
+		"error":           params.Error,
+		"finished_at_utc": time.Now().UTC(),
+	})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *ReviewExport) ListQueued(db *gorm.DB) ([]*entity.ReviewExport, error) {
+	var models []*model.ReviewExport
+	if err := db.Where(
+		"`status` = ?", entity.ReviewExportStatusQueued,
+	).Order(
+		"`id` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewExport, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
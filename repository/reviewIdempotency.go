@@ -0,0 +1,105 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewIdempotency.go
+
+	Module Description:
+		Repository for the idempotency keys of review info creates.
+
+	Details:
+	- (project, key) is unique, so of two concurrent creates with the same key only one
+	  can commit; the other fails on insert and replays the winner's row.
+	- Keys older than the caller's cutoff are treated as absent and replaced on Create.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves a key recorded after a cutoff.
+	* - Create: Records the review info a key created.
+	* - PurgeBefore: Removes keys recorded before a cutoff.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewIdempotency struct {
+	db *gorm.DB
+}
+
+func NewReviewIdempotency(db *gorm.DB) (*ReviewIdempotency, error) {
+	if err := db.AutoMigrate(&model.ReviewIdempotencyKey{}); err != nil {
+		return nil, err
+	}
+	return &ReviewIdempotency{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewIdempotency) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewIdempotency) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewIdempotency) Get(
+	db *gorm.DB,
+	project, key string,
+	since time.Time,
+) (*entity.ReviewIdempotencyKey, error) {
+	var m model.ReviewIdempotencyKey
+	if err := db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`key` = ?", key,
+	).Where(
+		"`created_at_utc` >= ?", since,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// Create records e, replacing a record of the same key made before since.
+func (r *ReviewIdempotency) Create(
+	tx *gorm.DB,
+	e *entity.ReviewIdempotencyKey,
+	since time.Time,
+) error {
+	if err := tx.Where(
+		"`project` = ?", e.Project,
+	).Where(
+		"`key` = ?", e.Key,
+	).Where(
+		"`created_at_utc` < ?", since,
+	).Delete(&model.ReviewIdempotencyKey{}).Error; err != nil {
+		return err
+	}
+	return tx.Create(model.NewReviewIdempotencyKey(e)).Error
+}
+
+func (r *ReviewIdempotency) PurgeBefore(tx *gorm.DB, cutoff time.Time) (int64, error) {
+	res := tx.Where(
+		"`created_at_utc` < ?", cutoff,
+	).Delete(&model.ReviewIdempotencyKey{})
+	return res.RowsAffected, res.Error
+}
@@ -0,0 +1,55 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewImport.go
+
+	Module Description:
+		Repository recording which review info rows were backfilled by a legacy import.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Refresh t_review_latest after backdating.
+
+	Functions:
+	* - Create: Marks a review info row as imported and backdates its modified_at_utc.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewImport struct {
+	db *gorm.DB
+}
+
+func NewReviewImport(db *gorm.DB) (*ReviewImport, error) {
+	if err := db.AutoMigrate(&model.ReviewImport{}); err != nil {
+		return nil, err
+	}
+	return &ReviewImport{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewImport) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewImportParams,
+) error {
+	if err := tx.Model(
+		&model.ReviewInfo{},
+	).Where(
+		"`id` = ?", params.ReviewInfoID,
+	).Update(
+		"modified_at_utc", params.BackdatedAtUtc,
+	).Error; err != nil {
+		return err
+	}
+	// The backdated row may no longer be the latest of its phase.
+	if err := refreshReviewLatest(tx, params.ReviewInfoID); err != nil {
+		return err
+	}
+	return tx.Create(model.NewReviewImport(params)).Error
+}
@@ -0,0 +1,3728 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+    	reviewInfo/reviewInfo.go
+
+	Module Description:
+		Repository for managing review information in the database.
+	Details:
+	- Implements CRUD operations for review information.
+	- Supports listing assets and their review information.
+	- Provides functions for counting and listing latest submissions with dynamic filtering and sorting.
+
+	Update and Modification History:
+	* - 29-10-2025 - SanjayK PSI - Implemented dynamic filtering and sorting for latest submissions.
+	* - 17-11-2025 - SanjayK PSI - Added phase-aware status filtering and sorting.
+	* - 22-11-2025 - SanjayK PSI - Fixed bugs related to phase-specific filtering and sorting.
+	* - 16-01-2026 - SanjayK PSI - Added asset pivot listing with grouped view  and sorting.
+	* - 02-02-2026 - SanjayK PSI - Added component field to AssetPivot and related functions for better component tracking.
+	* - 05-02-2026 - Added take fields for each phase (MDL, RIG, BLD, DSN, LDV)
+	* - 03-06-2026 - Added review-queue shot functions (CountReviewShots, ListReviewShots, ListReviewShotsPivot).
+	* - 15-10-2026 - Restrict asset pivot keys and counts to the caller's allowed top group nodes.
+	* - 15-10-2026 - Surface active review locks per phase in ListAssetsPivot rows.
+	* - 15-10-2026 - Serve ListAssetsPivot totals from a stale-while-revalidate count cache.
+	* - 15-10-2026 - Deterministic key order and keyset cursors for ListAssetsPivot pages.
+	* - 15-10-2026 - Historical "as of" reads for ListAssetsPivot and its count/key queries.
+	* - 15-10-2026 - Score pivot rows with attention_score and sort by it (sort=attention).
+	* - 15-10-2026 - Added ListAssetSubmissions for the asset timeline.
+	* - 15-10-2026 - Apply per-project query hints (queryTuning.go) to the pivot count, key and phase scans.
+	* - 15-10-2026 - Extracted buildAssetKeysSQL so the grouped pivot shares the asset key filters.
+	* - 15-10-2026 - Added Path and Children to GroupedAssetBucket for the nested category tree.
+	* - 15-10-2026 - Record pivot count, key and phase query durations as metrics.
+	* - 15-10-2026 - Trace the count, key-fetch, phase-fetch and fill steps of ListAssetsPivot.
+	* - 15-10-2026 - Filter List, ListAssets and the asset pivot by submitted_user / approval_status_updated_user.
+	* - 15-10-2026 - Restrict the pivot phase fetch to the requested fields.
+	* - 15-10-2026 - Read the pivot count, key and phase queries from the read replica.
+	* - 15-10-2026 - Serve current pivot reads from t_review_latest, kept up to date by Create/Update/Delete (reviewLatest.go).
+	* - 15-10-2026 - Log slow pivot queries with their plan (reviewInfoSlowQuery.go).
+	* - 15-10-2026 - ListAssetsPivot can skip the total count and detect the next page from limit+1 keys.
+	* - 15-10-2026 - ListAssetsPivot reports whether its total is an estimate.
+	* - 15-10-2026 - Pivot rows carry the review info ID of every phase cell; added TakePaths.
+	* - 15-10-2026 - Added PivotThumbnail and AssetPivot.Thumbnails.
+	* - 15-10-2026 - Filter the asset pivot by studio; pivot rows carry the studio of every phase cell.
+	* - 15-10-2026 - Filter the asset pivot and its count by relation (exact or prefix).
+	* - 15-10-2026 - Make the asset pivot root-agnostic: assets are keyed by group_1/group_2/group_3/relation outside the assets root and group categories are read from the row's root.
+	* - 15-10-2026 - Roll the phases of every pivot row up into overall_status; filter and sort by it.
+	* - 15-10-2026 - Roll up only the phases required of an asset by relation or top group node.
+	* - 15-10-2026 - Filter the asset pivot by overdue assets and sort it by due date; pivot rows carry their due dates (reviewDueDate.go).
+	* - 15-10-2026 - Pivot rows carry the asset's priority flag; added sort=priority_first (reviewAssetPriority.go).
+	* - 15-10-2026 - Filter review infos, assets and the asset pivot by tag; pivot rows carry their tags (reviewTag.go).
+	* - 15-10-2026 - Pivot rows carry their note count and newest note on request (assetNote.go).
+	* - 15-10-2026 - Update writes whole-second timestamps, so its result carries the stored version.
+	* - 15-10-2026 - Added UpdateMetadata (take path, target components, duration, review comments).
+	* - 15-10-2026 - buildTopGroupNodeFilter matches assets without a category for a "" node.
+	* - 15-10-2026 - buildOrderClause ends in a deterministic asset key tie-breaker.
+	* - 15-10-2026 - Added sort=latest_activity over every phase of an asset (reviewLatestActivity.go).
+	* - 15-10-2026 - buildOrderClause takes nulls=first|last for where missing values sort.
+	* - 15-10-2026 - Natural name ordering (natural=true, reviewInfoNaturalSort.go).
+	* - 15-10-2026 - Split ListAssetsPivot into an AssetPivotQuery and listAssetsPivot for the shadow comparison.
+	* - 15-10-2026 - The key, count and phase queries are built by repository/reviewquery.
+	* - 15-10-2026 - The pivot queries are built in the SQL dialect of the database (MySQL, PostgreSQL).
+	* - 15-10-2026 - List and the pivot run on SQLite for local development.
+	* - 15-10-2026 - Added GetForUpdate for the If-Match check of updates.
+	* - 15-10-2026 - UpdateMetadata keeps modified_at_utc, so a corrected older take stays older.
+	* - 15-10-2026 - ListAssetsPivot takes an AssetPivotQuery instead of positional arguments.
+	* - 15-10-2026 - Relation prefixes match with the dialect's LIKE escape (SQLite needs ESCAPE).
+
+	Functions:
+	* - List: Lists review information based on provided parameters.
+	* - Get: Retrieves a specific review information record.
+	* - Create: Creates a new review information record.
+	* - Update: Updates an existing review information record.
+	* - UpdateMetadata: Corrects the submission metadata of a review information record.
+	* - Delete: Marks a review information record as deleted.
+	* - ListAssets: Lists unique assets based on review information.
+	* - ListShotReviewInfos: Lists review information for a specific shot.
+	* - ListAssetReviewInfos: Lists review information for a specific asset.
+	* - ListAssetSubmissions: Lists every submission of an asset, oldest first.
+	* - CountLatestSubmissions: Counts latest submissions with dynamic filtering.
+	* - ListLatestSubmissionsDynamic: Lists latest submissions with dynamic filtering and sorting.
+	* - buildPhaseAwareStatusWhere: Constructs a WHERE clause for phase-aware status filtering.
+	* - buildUserWhere: Constructs a WHERE clause for submitted_user / approval_status_updated_user filtering.
+	* - buildStudioWhere: Constructs a WHERE clause for studio filtering.
+	* - buildRelationWhere: Constructs a WHERE clause for exact or prefix relation filtering.
+	* - buildOrderClause: Constructs an ORDER BY clause based on sorting parameters, ending in the asset key.
+	* - buildOrderKeyClause: Constructs the ORDER BY terms of one sort key.
+	* - buildTopGroupNodeFilter: Constructs the category access condition for asset keys.
+	* - buildAsOfCond: Constructs the modified_at_utc <= as_of condition for historical reads.
+	* - buildAssetKeysSQL: Constructs the query selecting the assets in scope of the pivot filters.
+	* - pivotScope: Constructs the latest_phase scope and status filters shared by the key and count queries.
+	* - dialectOf: Returns the SQL dialect of a connection.
+	* - groupsIndexCond: Matches an element of the groups JSON array in the SQL dialect.
+	* - ListAssetsPivot: Lists pivoted assets with filtering and sorting options.
+	* - listAssetsPivot: The current implementation of ListAssetsPivot (keys_phases).
+	* - CountReviewShots: Counts unique review-queue shot groups (check status).
+	* - ListReviewShots: Lists paged latest per-phase review-queue shot rows.
+	* - ListReviewShotsPivot: Lists review-queue shots pivoted into ShotPivot.
+	* - ListReviewShotPhases: Returns the distinct review-queue phases (dynamic phase set).
+
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/metrics"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+	"github.com/PolygonPictures/central30-web/front/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReviewInfo struct {
+	db      *gorm.DB
+	counts  *latestCountCache
+	tuning  *QueryTuning
+	replica *replica      // nil without a read replica, see reviewInfoReplica.go
+	slow    *slowQueryLog // nil when disabled, see reviewInfoSlowQuery.go
+	shadow  *pivotShadow  // nil when disabled, see reviewInfoShadow.go
+}
+
+func NewReviewInfo(db *gorm.DB) (*ReviewInfo, error) {
+	info := model.ReviewInfo{}
+
+	// Specification change: https://jira.ppi.co.jp/browse/POTOO-2406
+	migrator := db.Migrator()
+	if migrator.HasTable(&info) && !migrator.HasColumn(&info, "take_path") {
+		if err := migrator.RenameColumn(&info, "path", "take_path"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := db.AutoMigrate(&info); err != nil {
+		return nil, err
+	}
+	if err := migrateReviewLatest(db); err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&model.ReviewDueDate{}); err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&model.ReviewAssetPriority{}); err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&model.ReviewTag{}); err != nil {
+		return nil, err
+	}
+	if err := db.AutoMigrate(&model.AssetNote{}); err != nil {
+		return nil, err
+	}
+
+	return &ReviewInfo{
+		db:     db,
+		counts: newLatestCountCache(),
+	}, nil
+}
+
+func (r *ReviewInfo) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewInfo) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewInfo) List(
+	db *gorm.DB,
+	params *entity.ListReviewInfoParams,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	tags []string,
+) ([]*entity.ReviewInfo, int, error) {
+	stmt := db
+	if cond, args := buildUserWhere(submittedUsers, approvalUpdatedUsers); cond != "" {
+		stmt = stmt.Where(strings.TrimPrefix(cond, " AND "), args...)
+	}
+	if cond, args := buildTagWhere(params.Project, tags); cond != "" {
+		stmt = stmt.Where(strings.TrimPrefix(cond, " AND "), args...)
+	}
+	for i, g := range params.Group {
+		stmt = stmt.Where(fmt.Sprintf("group_%d = ?", i+1), g)
+	}
+	stmt = stmt.Where("`project` = ?", params.Project)
+	if params.Studio != nil {
+		stmt = stmt.Where("`studio` = ?", *params.Studio)
+	}
+	if params.TaskID != nil {
+		stmt = stmt.Where("`task_id` = ?", *params.TaskID)
+	}
+	if params.SubtaskID != nil {
+		stmt = stmt.Where("`subtask_id` = ?", *params.SubtaskID)
+	}
+	if params.Root != nil {
+		stmt = stmt.Where("`root` = ?", *params.Root)
+	}
+	d := dialectOf(db)
+	for i, g := range params.Group {
+		stmt = stmt.Where(groupsIndexCond(d, i), g)
+	}
+	if params.Relation != nil {
+		stmt = stmt.Where("relation IN (?)", params.Relation)
+	}
+	if params.Phase != nil {
+		stmt = stmt.Where("phase IN (?)", params.Phase)
+	}
+	if params.Component != nil {
+		stmt = stmt.Where("`component` = ?", *params.Component)
+	}
+	if params.Take != nil {
+		stmt = stmt.Where("`take` = ?", *params.Take)
+	}
+
+	order := "`id` desc"
+	if params.OrderBy != nil {
+		order = *params.OrderBy
+	}
+	showDeleted := false
+	if params.ModifiedSince != nil {
+		stmt = stmt.Where("`modified_at_utc` >= ?", *params.ModifiedSince)
+		order = "`modified_at_utc` asc"
+		showDeleted = true
+	} else {
+		stmt.Where("`deleted` = ?", 0)
+	}
+
+	var total int64
+	var m model.ReviewInfo
+	if err := stmt.Model(&m).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []*model.ReviewInfo
+	perPage := params.GetPerPage()
+	offset := perPage * (params.GetPage() - 1)
+	if err := stmt.Order(
+		order,
+	).Limit(perPage).Offset(offset).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var entities []*entity.ReviewInfo
+	for _, m := range models {
+		entities = append(entities, m.Entity(showDeleted))
+	}
+	return entities, int(total), nil
+}
+
+// groupsIndexCond matches element i of the groups JSON array against one argument.
+func groupsIndexCond(d reviewquery.Dialect, i int) string {
+	if d == reviewquery.MySQL {
+		return fmt.Sprintf("`groups`->\"$[%d]\" = ?", i)
+	}
+	return d.JSONIndex(d.Ident("groups"), i) + " = ?"
+}
+
+type ReviewInfoAndShotProperty struct {
+	model.ReviewInfo
+	model.ShotProperty
+}
+
+func (r *ReviewInfo) List2(
+	db *gorm.DB,
+	params *entity.ListReviewInfoParams,
+) ([]*entity.ReviewInfo, []*entity.ShotProperty, int, error) {
+	stmtA := db.Select(
+		"*",
+	).Model(
+		&model.ReviewInfo{},
+	)
+
+	for i, g := range params.Group {
+		stmtA = stmtA.Where(fmt.Sprintf("group_%d = ?", i+1), g)
+	}
+	stmtA = stmtA.Where("project = ?", params.Project)
+	if params.Studio != nil {
+		stmtA = stmtA.Where("studio = ?", *params.Studio)
+	}
+	if params.TaskID != nil {
+		stmtA = stmtA.Where("task_id = ?", *params.TaskID)
+	}
+	if params.SubtaskID != nil {
+		stmtA = stmtA.Where("subtask_id = ?", *params.SubtaskID)
+	}
+	if params.Root != nil {
+		stmtA = stmtA.Where("root = ?", *params.Root)
+	}
+	for i, g := range params.Group {
+		stmtA = stmtA.Where(fmt.Sprintf("`groups`->\"$[%d]\" = ?", i), g)
+	}
+	if params.Relation != nil {
+		stmtA = stmtA.Where("relation IN (?)", params.Relation)
+	}
+	if params.Phase != nil {
+		stmtA = stmtA.Where("phase IN (?)", params.Phase)
+	}
+	if params.Component != nil {
+		stmtA = stmtA.Where("component = ?", *params.Component)
+	}
+	if params.Take != nil {
+		stmtA = stmtA.Where("take = ?", *params.Take)
+	}
+
+	order := "a.id desc"
+	if params.OrderBy != nil {
+		order = *params.OrderBy
+	}
+	showDeleted := false
+	if params.ModifiedSince != nil {
+		stmtA = stmtA.Where("modified_at_utc >= ?", *params.ModifiedSince)
+		order = "a.modified_at_utc asc"
+		showDeleted = true
+	} else {
+		stmtA = stmtA.Where("deleted = ?", 0)
+	}
+
+	stmtB := db.Select(
+		"*",
+	).Model(
+		&model.ShotProperty{},
+	)
+
+	stmt := db.Select(
+		"a.*",
+		"b.*",
+	).Table(
+		"(?) AS a", stmtA,
+	).Joins(
+		"LEFT JOIN (?) AS b ON a.project = b.project AND a.take_path = b.path AND a.deleted = b.deleted", stmtB,
+	)
+
+	var total int64
+	var m ReviewInfoAndShotProperty
+	if err := stmt.Model(&m).Count(&total).Error; err != nil {
+		return nil, nil, 0, err
+	}
+
+	var models []*ReviewInfoAndShotProperty
+
+	perPage := params.GetPerPage()
+	offset := perPage * (params.GetPage() - 1)
+	if err := stmt.Order(
+		order,
+	).Limit(perPage).Offset(offset).Find(&models).Error; err != nil {
+		return nil, nil, 0, err
+	}
+
+	var entities []*entity.ReviewInfo
+	var shotEntities []*entity.ShotProperty
+	for _, m := range models {
+		entities = append(entities, m.ReviewInfo.Entity(showDeleted))
+		// entities[len(entities)-1].ShotData = entity.JSONObject(m.ShotProperty.Data)
+		shotEntities = append(shotEntities, m.ShotProperty.Entity(showDeleted))
+	}
+	return entities, shotEntities, int(total), nil
+}
+
+func (r *ReviewInfo) Get(
+	db *gorm.DB,
+	params *entity.GetReviewParams,
+) (*entity.ReviewInfo, error) {
+	var m model.ReviewInfo
+	if err := db.Where(
+		"`deleted` = ?", 0,
+	).Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(false), nil
+}
+
+// GetForUpdate is Get locking the row until tx ends, so that what the caller checks
+// on it still holds when it writes.
+func (r *ReviewInfo) GetForUpdate(
+	tx *gorm.DB,
+	params *entity.GetReviewParams,
+) (*entity.ReviewInfo, error) {
+	return r.Get(tx.Clauses(clause.Locking{Strength: "UPDATE"}), params)
+}
+
+func (r *ReviewInfo) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewInfoParams,
+) (*entity.ReviewInfo, error) {
+	m := model.NewReviewInfo(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	if err := refreshReviewLatest(tx, m.ID); err != nil {
+		return nil, err
+	}
+	return m.Entity(false), nil
+}
+
+func (r *ReviewInfo) Update(
+	tx *gorm.DB,
+	params *entity.UpdateReviewInfoParams,
+) (*entity.ReviewInfo, error) {
+	// Whole seconds, so the returned row (and its entity.ReviewInfoVersion) matches the
+	// stored one whatever the column precision.
+	now := time.Now().UTC().Truncate(time.Second)
+	modifiedBy := ""
+	if params.ModifiedBy != nil {
+		modifiedBy = *params.ModifiedBy
+	}
+	var m model.ReviewInfo
+	if err := tx.Where(
+		"`deleted` = ?", 0,
+	).Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	var modified = false
+	if params.ApprovalStatus != nil {
+		m.ApprovalStatus = *params.ApprovalStatus
+		m.ApprovalStatusUpdatedAtUtc = now
+		modified = true
+	}
+	if params.ApprovalStatusUpdatedUser != nil {
+		m.ApprovalStatusUpdatedUser = *params.ApprovalStatusUpdatedUser
+		m.ApprovalStatusUpdatedAtUtc = now
+		modified = true
+	}
+	if params.WorkStatus != nil {
+		m.WorkStatus = *params.WorkStatus
+		m.WorkStatusUpdatedAtUtc = now
+		modified = true
+	}
+	if params.WorkStatusUpdatedUser != nil {
+		m.WorkStatusUpdatedUser = *params.WorkStatusUpdatedUser
+		m.WorkStatusUpdatedAtUtc = now
+		modified = true
+	}
+	if !modified {
+		return nil, errors.New("no value is given to change")
+	}
+	m.ModifiedAtUTC = now
+	m.ModifiedBy = modifiedBy
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	if err := refreshReviewLatest(tx, m.ID); err != nil {
+		return nil, err
+	}
+	return m.Entity(false), nil
+}
+
+// UpdateMetadata applies the non-nil fields of patch to a live review info and returns
+// it. modified_at_utc is left alone: it picks the latest take of a phase, and correcting
+// an older take must not make it the latest. The correction is recorded in the status
+// history instead.
+func (r *ReviewInfo) UpdateMetadata(
+	tx *gorm.DB,
+	project string,
+	id int32,
+	patch *entity.ReviewInfoMetadataPatch,
+	modifiedBy string,
+) (*entity.ReviewInfo, error) {
+	var m model.ReviewInfo
+	if err := tx.Where(
+		"`deleted` = ?", 0,
+	).Where(
+		"`project` = ?", project,
+	).Where(
+		"`id` = ?", id,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	if patch.TakePath != nil {
+		m.TakePath = *patch.TakePath
+	}
+	if patch.TargetComponents != nil {
+		m.TargetComponents = *patch.TargetComponents
+	}
+	if patch.Duration != nil {
+		m.Duration = patch.Duration
+	}
+	if patch.ReviewComments != nil {
+		m.ReviewComments = *patch.ReviewComments
+	}
+	m.ModifiedBy = modifiedBy
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	if err := refreshReviewLatest(tx, m.ID); err != nil {
+		return nil, err
+	}
+	return m.Entity(false), nil
+}
+
+func (r *ReviewInfo) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteReviewInfoParams,
+) error {
+	now := time.Now().UTC()
+	var modifiedBy string
+	if params.ModifiedBy != nil {
+		modifiedBy = *params.ModifiedBy
+	}
+	var m model.ReviewInfo
+	if err := tx.Where(
+		"`deleted` = ?", 0,
+	).Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return entity.ErrRecordNotFound
+		}
+		return err
+	}
+	m.Deleted = m.ID
+	m.ModifiedAtUTC = now
+	m.ModifiedBy = modifiedBy
+	if err := tx.Save(m).Error; err != nil {
+		return err
+	}
+	return refreshReviewLatest(tx, m.ID)
+}
+
+func (r *ReviewInfo) ListAssets(
+	db *gorm.DB,
+	params *entity.AssetListParams,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	tags []string,
+) ([]*entity.Asset, int, error) {
+	stmt := db
+	// An asset matches when any of its reviews matches.
+	if cond, args := buildUserWhere(submittedUsers, approvalUpdatedUsers); cond != "" {
+		stmt = stmt.Where(strings.TrimPrefix(cond, " AND "), args...)
+	}
+	if cond, args := buildTagWhere(params.Project, tags); cond != "" {
+		stmt = stmt.Where(strings.TrimPrefix(cond, " AND "), args...)
+	}
+	stmt = stmt.Model(
+		&ReviewInfo{},
+	).Where(
+		"deleted = ?", 0,
+	).Where(
+		"project = ?", params.Project,
+	).Where(
+		"root = ?", "assets",
+	).Group(
+		"project",
+	).Group(
+		"root",
+	).Group(
+		"group_1",
+	).Group(
+		"relation",
+	)
+
+	var total int64
+	if err := stmt.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	stmt = stmt.Order(
+		"group_1",
+	).Order(
+		"relation",
+	)
+
+	var reviews []*model.ReviewInfo
+	perPage := params.GetPerPage()
+	offset := perPage * (params.GetPage() - 1)
+	if err := stmt.Select(
+		"project", "root", "group_1", "relation",
+	).Limit(perPage).Offset(offset).Find(&reviews).Error; err != nil {
+		return nil, 0, err
+	}
+
+	assets := make([]*entity.Asset, len(reviews))
+	for i, review := range reviews {
+		assets[i] = &entity.Asset{
+			Name:     review.Group1,
+			Relation: review.Relation,
+		}
+	}
+	return assets, int(total), nil
+}
+
+func (r *ReviewInfo) ListAssetReviewInfos(
+	db *gorm.DB,
+	params *entity.AssetReviewInfoListParams,
+) ([]*entity.ReviewInfo, error) {
+	stmtA := db.Select(
+		"project",
+		"root",
+		"group_1",
+		"relation",
+		"phase",
+		"MAX(modified_at_utc) AS modified_at_utc",
+	).Model(
+		&model.ReviewInfo{},
+	).Where(
+		"project = ?", params.Project,
+	).Where(
+		"root = ?", "assets",
+	).Where(
+		"group_1 = ?", params.Asset,
+	).Where(
+		"relation = ?", params.Relation,
+	).Where(
+		"deleted = ?", 0,
+	).Group(
+		"project",
+	).Group(
+		"root",
+	).Group(
+		"group_1",
+	).Group(
+		"relation",
+	).Group(
+		"phase",
+	)
+
+	stmtB := db.Select(
+		"*",
+	).Model(
+		&model.ReviewInfo{},
+	).Where(
+		"project = ?", params.Project,
+	).Where(
+		"root = ?", "assets",
+	).Where(
+		"group_1 = ?", params.Asset,
+	).Where(
+		"relation = ?", params.Relation,
+	).Where(
+		"deleted = ?", 0,
+	)
+
+	stmt := db.Select(
+		"b.*",
+	).Table(
+		"(?) AS a", stmtA,
+	).Joins(
+		"LEFT OUTER JOIN (?) AS b ON a.project = b.project AND a.root = b.root AND a.group_1 = b.group_1 AND a.relation = b.relation AND a.phase = b.phase AND a.modified_at_utc = b.modified_at_utc", stmtB,
+	)
+
+	var reviews []*model.ReviewInfo
+	if err := stmt.Scan(&reviews).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	reviewInfos := make([]*entity.ReviewInfo, len(reviews))
+	for i, review := range reviews {
+		reviewInfos[i] = review.Entity(false)
+	}
+	return reviewInfos, nil
+}
+
+// TakePaths returns the take path of every given review info of project, deleted or not.
+func (r *ReviewInfo) TakePaths(db *gorm.DB, project string, ids []int32) (map[int32]string, error) {
+	paths := make(map[int32]string, len(ids))
+	if len(ids) == 0 {
+		return paths, nil
+	}
+	var rows []struct {
+		ID       int32
+		TakePath string
+	}
+	if err := db.Model(&model.ReviewInfo{}).Select(
+		"`id`, `take_path`",
+	).Where(
+		"`project` = ?", project,
+	).Where(
+		"`id` IN ?", ids,
+	).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		paths[row.ID] = row.TakePath
+	}
+	return paths, nil
+}
+
+func (r *ReviewInfo) ListAssetSubmissions(
+	db *gorm.DB,
+	params *entity.AssetTimelineParams,
+) ([]*entity.ReviewInfo, error) {
+	var reviews []*model.ReviewInfo
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`root` = ?", "assets",
+	).Where(
+		"`group_1` = ?", params.Asset,
+	).Where(
+		"`relation` = ?", params.Relation,
+	).Where(
+		"`deleted` = ?", 0,
+	).Order(
+		"`submitted_at_utc` asc, `id` asc",
+	).Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+
+	reviewInfos := make([]*entity.ReviewInfo, len(reviews))
+	for i, review := range reviews {
+		reviewInfos[i] = review.Entity(false)
+	}
+	return reviewInfos, nil
+}
+
+func (r *ReviewInfo) ListShots(
+	db *gorm.DB,
+	params *entity.AssetListParams,
+) ([]*entity.Shot, int, error) {
+	stmt := db.Model(
+		&ReviewInfo{},
+	).Where(
+		"project = ?", params.Project,
+	).Where(
+		"root = ?", "shots",
+	).Where(
+		"deleted = ?", 0,
+	).Group(
+		"project",
+	).Group(
+		"root",
+	).Group(
+		"group_1",
+	).Group(
+		"group_2",
+	).Group(
+		"group_3",
+	).Group(
+		"relation",
+	)
+
+	var total int64
+	if err := stmt.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	stmt = stmt.Order(
+		"group_1",
+	).Order(
+		"group_2",
+	).Order(
+		"group_3",
+	).Order(
+		"relation",
+	)
+
+	var reviews []*model.ReviewInfo
+	perPage := params.GetPerPage()
+	offset := perPage * (params.GetPage() - 1)
+	if err := stmt.Select(
+		"project", "root", "group_1", "group_2", "group_3", "relation",
+	).Limit(perPage).Offset(offset).Find(&reviews).Error; err != nil {
+		return nil, 0, err
+	}
+
+	shots := make([]*entity.Shot, len(reviews))
+	for i, review := range reviews {
+		shots[i] = &entity.Shot{
+			Group1:   review.Group1,
+			Group2:   review.Group2,
+			Group3:   review.Group3,
+			Relation: review.Relation,
+		}
+	}
+	return shots, int(total), nil
+}
+
+func (r *ReviewInfo) ListShotReviewInfos(
+	db *gorm.DB,
+	params *entity.ShotReviewInfoListParams,
+) ([]*entity.ReviewInfo, error) {
+	stmtA := db.Select(
+		"project",
+		"root",
+		"group_1",
+		"group_2",
+		"group_3",
+		"relation",
+		"phase",
+		"MAX(modified_at_utc) AS modified_at_utc",
+	).Model(
+		&model.ReviewInfo{},
+	).Where(
+		"project = ?", params.Project,
+	).Where(
+		"root = ?", "shots",
+	).Where(
+		"group_1 = ?", params.Groups[0],
+	).Where(
+		"group_2 = ?", params.Groups[1],
+	).Where(
+		"group_3 = ?", params.Groups[2],
+	).Where(
+		"relation = ?", params.Relation,
+	).Where(
+		"deleted = ?", 0,
+	).Group(
+		"project",
+	).Group(
+		"root",
+	).Group(
+		"group_1",
+	).Group(
+		"group_2",
+	).Group(
+		"group_3",
+	).Group(
+		"relation",
+	).Group(
+		"phase",
+	)
+
+	stmtB := db.Select(
+		"*",
+	).Model(
+		&model.ReviewInfo{},
+	).Where(
+		"project = ?", params.Project,
+	).Where(
+		"root = ?", "shots",
+	).Where(
+		"group_1 = ?", params.Groups[0],
+	).Where(
+		"group_2 = ?", params.Groups[1],
+	).Where(
+		"group_3 = ?", params.Groups[2],
+	).Where(
+		"relation = ?", params.Relation,
+	).Where(
+		"deleted = ?", 0,
+	)
+
+	stmt := db.Select(
+		"b.*",
+	).Table(
+		"(?) AS a", stmtA,
+	).Joins(
+		"LEFT OUTER JOIN (?) AS b ON a.project = b.project AND a.root = b.root AND a.group_1 = b.group_1 AND a.group_2 = b.group_2 AND a.group_3 = b.group_3 AND a.relation = b.relation AND a.phase = b.phase AND a.modified_at_utc = b.modified_at_utc", stmtB,
+	)
+
+	var reviews []*model.ReviewInfo
+	if err := stmt.Scan(&reviews).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	reviewInfos := make([]*entity.ReviewInfo, len(reviews))
+	for i, review := range reviews {
+		reviewInfos[i] = review.Entity(false)
+	}
+	return reviewInfos, nil
+}
+
+// ---- Asset Pivot Row ----
+/* ──────────────────────────────────────────────────────────────────────────
+	LatestSubmissionRow struct represents a row of data for the latest submissions,
+	containing fields for project, root, group, relation, component, phase, and submission date.
+	Each field is tagged for JSON serialization and GORM column mapping, facilitating
+	easy integration with database queries and API responses.
+   ────────────────────────────────────────────────────────────────────────── */
+// ---- Latest Submission row ----
+type LatestSubmissionRow struct {
+	Root           string     `json:"root"               gorm:"column:root"`
+	Project        string     `json:"project"            gorm:"column:project"`
+	Group1         string     `json:"group_1"            gorm:"column:group_1"`
+	Group2         string     `json:"group_2,omitempty"  gorm:"column:group_2"`
+	Group3         string     `json:"group_3,omitempty"  gorm:"column:group_3"`
+	Relation       string     `json:"relation"           gorm:"column:relation"`
+	Component      string     `json:"component"          gorm:"column:component"`
+	Phase          string     `json:"phase"              gorm:"column:phase"`
+	SubmittedAtUTC *time.Time `json:"submitted_at_utc"   gorm:"column:submitted_at_utc"`
+	AttentionScore float64    `json:"attention_score"    gorm:"column:attention_score"`
+	OverallStatus  string     `json:"overall_status"     gorm:"column:overall_status"`
+	NextDueDate    nullTime   `json:"next_due_date"      gorm:"column:next_due_date"`
+	Priority       bool       `json:"priority"           gorm:"column:priority"`
+	LatestActivity nullTime   `json:"latest_activity_at" gorm:"column:latest_activity_at"`
+	SortKey        string     `json:"-"                  gorm:"column:sort_key"` // JSON array, see reviewInfoCursor.go
+}
+
+/*
+	 ____________________________________________________________________________________
+		The LatestSubmissionRow struct represents a row of data for the latest submissions,
+		containing fields for project, root, group, relation, component, phase, and submission date.
+		Each field is tagged for JSON serialization and GORM column mapping, facilitating
+		easy integration with database queries and API responses.
+	  ____________________________________________________________________________________
+*/
+type AssetPivot struct {
+	Root      string `json:"root"`
+	Project   string `json:"project"`
+	Group1    string `json:"group_1"`
+	Group2    string `json:"group_2,omitempty"` // "" in the assets root
+	Group3    string `json:"group_3,omitempty"` // "" in the assets root
+	Relation  string `json:"relation"`
+	Component string `json:"component"`
+
+	// Grouping info
+	LeafGroupName     string `json:"leaf_group_name"`
+	GroupCategoryPath string `json:"group_category_path"`
+	TopGroupNode      string `json:"top_group_node"`
+
+	// Latest review info fields (for ListAssetsPivot2)
+	WorkStatus     *string    `json:"work_status"`
+	ApprovalStatus *string    `json:"approval_status"`
+	SubmittedAtUTC *time.Time `json:"submitted_at_utc"`
+	ModifiedAtUTC  *time.Time `json:"modified_at_utc"`
+	Take           *string    `json:"take"` // Added take field for generic phase
+
+	// MDL Phase
+	MDLWorkStatus     *string    `json:"mdl_work_status"`
+	MDLApprovalStatus *string    `json:"mdl_approval_status"`
+	MDLSubmittedAtUTC *time.Time `json:"mdl_submitted_at_utc"`
+	MDLTake           *string    `json:"mdl_take"` // Added take for MDL
+
+	// RIG Phase
+	RIGWorkStatus     *string    `json:"rig_work_status"`
+	RIGApprovalStatus *string    `json:"rig_approval_status"`
+	RIGSubmittedAtUTC *time.Time `json:"rig_submitted_at_utc"`
+	RIGTake           *string    `json:"rig_take"` // Added take for RIG
+
+	// BLD Phase
+	BLDWorkStatus     *string    `json:"bld_work_status"`
+	BLDApprovalStatus *string    `json:"bld_approval_status"`
+	BLDSubmittedAtUTC *time.Time `json:"bld_submitted_at_utc"`
+	BLDTake           *string    `json:"bld_take"` // Added take for BLD
+
+	// DSN Phase
+	DSNWorkStatus     *string    `json:"dsn_work_status"`
+	DSNApprovalStatus *string    `json:"dsn_approval_status"`
+	DSNSubmittedAtUTC *time.Time `json:"dsn_submitted_at_utc"`
+	DSNTake           *string    `json:"dsn_take"` // Added take for DSN
+
+	// LDV Phase
+	LDVWorkStatus     *string    `json:"ldv_work_status"`
+	LDVApprovalStatus *string    `json:"ldv_approval_status"`
+	LDVSubmittedAtUTC *time.Time `json:"ldv_submitted_at_utc"`
+	LDVTake           *string    `json:"ldv_take"` // Added take for LDV
+
+	// Active live-review locks keyed by phase (e.g. "mdl"); omitted when nothing is locked.
+	Locks map[string]*PivotLock `json:"locks,omitempty"`
+
+	// Review info behind each phase cell keyed by phase, for per-cell requests (comments).
+	ReviewInfoIDs map[string]int32 `json:"review_info_ids,omitempty"`
+
+	// Studio of the latest submission of each phase cell keyed by phase.
+	Studios map[string]string `json:"studios,omitempty"`
+
+	// Comment counts keyed by phase; only filled on request (see usecase ListAssetsPivot).
+	CommentCounts map[string]int64 `json:"comment_counts,omitempty"`
+
+	// Thumbnail and preview of each phase cell keyed by phase; only filled on request.
+	Thumbnails map[string]*PivotThumbnail `json:"thumbnails,omitempty"`
+
+	// Triage score, higher needs attention sooner (see reviewInfoAttention.go).
+	AttentionScore float64 `json:"attention_score"`
+
+	// Roll-up of the phases (see reviewInfoOverallStatus.go); set by the list and
+	// grouped views.
+	OverallStatus string `json:"overall_status,omitempty"`
+
+	// Phases the asset requires when the project maps its relation or top group node
+	// (entity.RequiredPhases); the other phase cells are not applicable.
+	RequiredPhases []string `json:"required_phases,omitempty"`
+
+	// Due dates (YYYY-MM-DD) keyed by phase, the earliest one of a phase not approved
+	// yet, and whether that one has passed (see reviewDueDate.go).
+	DueDates    map[string]string `json:"due_dates,omitempty"`
+	NextDueDate *string           `json:"next_due_date,omitempty"`
+	Overdue     bool              `json:"overdue,omitempty"`
+
+	// Whether the asset is flagged as a priority (see reviewAssetPriority.go).
+	Priority bool `json:"priority"`
+
+	// Newest modification of the asset over all its phases; set by the list view
+	// (see reviewLatestActivity.go).
+	LatestActivityAt *time.Time `json:"latest_activity_at,omitempty"`
+
+	// Tags of the asset relation, A→Z (see reviewTag.go).
+	Tags []string `json:"tags,omitempty"`
+
+	// Number of notes of the asset relation and the newest one; only filled on request
+	// (see assetNote.go).
+	NoteCount  int        `json:"note_count,omitempty"`
+	LatestNote *PivotNote `json:"latest_note,omitempty"`
+
+	// Phases serialised to JSON; nil means all (see reviewInfoFields.go).
+	fields []string
+}
+
+// PivotThumbnail is the image and video of a pivot cell's review, when it has them.
+type PivotThumbnail struct {
+	ReviewInfoID int32  `json:"review_info_id"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	PreviewURL   string `json:"preview_url,omitempty"`
+}
+
+// PivotLock is the lock info surfaced in a pivot cell.
+type PivotLock struct {
+	ReviewInfoID int32     `json:"review_info_id"`
+	Holder       string    `json:"holder"`
+	ExpiresAtUTC time.Time `json:"expires_at_utc"`
+}
+
+/*
+	 ____________________________________________________________________________________
+		The AssetPivot struct represents a pivoted view of asset review information,
+		containing fields for project, root, group, relation, component, and phase.
+		It also includes grouping information such as leaf group name, group category path,
+		and top group node. Additionally, it has fields for the latest work status, approval status,
+		submission date for the generic phase and specific fields for MDL, RIG, BLD, DSN, and LDV phases,
+		including their respective work status, approval status, submission date, and take information.
+	  ____________________________________________________________________________________
+*/
+type phaseRow struct {
+	Project        string     `gorm:"column:project"`
+	Root           string     `gorm:"column:root"`
+	Group1         string     `gorm:"column:group_1"`
+	Group2         string     `gorm:"column:group_2"`
+	Group3         string     `gorm:"column:group_3"`
+	Relation       string     `gorm:"column:relation"`
+	Phase          string     `gorm:"column:phase"`
+	WorkStatus     *string    `gorm:"column:work_status"`
+	ApprovalStatus *string    `gorm:"column:approval_status"`
+	SubmittedAtUTC *time.Time `gorm:"column:submitted_at_utc"`
+	Component      *string    `gorm:"column:component"`
+	Take           *string    `gorm:"column:take"` // Added take field
+	Studio         *string    `gorm:"column:studio"`
+
+	LeafGroupName     string `gorm:"column:leaf_group_name"`
+	GroupCategoryPath string `gorm:"column:group_category_path"`
+	TopGroupNode      string `gorm:"column:top_group_node"`
+
+	// Live review lock on this phase's latest row (nil when unlocked)
+	ReviewInfoID     int32      `gorm:"column:review_info_id"`
+	LockHolder       *string    `gorm:"column:lock_holder"`
+	LockExpiresAtUTC *time.Time `gorm:"column:lock_expires_at_utc"`
+}
+
+// ---- Sort Direction ----
+type SortDirection string
+
+// SortDirection constants
+const (
+	SortASC  SortDirection = "ASC"
+	SortDESC SortDirection = "DESC"
+)
+
+// ---- Grouped Asset Bucket ----
+type GroupedAssetBucket struct {
+	TopGroupNode string       `json:"top_group_node"` // camera / character / prop / ...
+	ItemCount    int          `json:"item_count"`
+	Items        []AssetPivot `json:"items"`
+	TotalCount   *int         `json:"total_count"` // optional total count across pages
+
+	// Nested category tree (see NestBucketsByCategoryPath); empty in the flat view.
+	Path     string               `json:"path,omitempty"` // e.g. character/hero
+	Children []GroupedAssetBucket `json:"children,omitempty"`
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	GroupAndSortByTopNode groups a slice of AssetPivot items by their TopGroupNode field,
+	sorts the group headers alphabetically (A→Z, case-insensitive), and always places the
+	"Unassigned" group last. Within each group, the items are sorted by their Group1 field
+	in either ascending or descending order, as specified by the dir parameter.
+	Returns a slice of GroupedAssetBucket, each containing a group header and its sorted items.
+
+	Parameters:
+	- rows: Slice of AssetPivot items to be grouped and sorted.
+	- dir: SortDirection specifying ascending or descending order for items within each group.
+
+	Returns:
+	- []GroupedAssetBucket: Slice of grouped and sorted asset buckets.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func GroupAndSortByTopNode(rows []AssetPivot, dir SortDirection) []GroupedAssetBucket {
+	grouped := make(map[string][]AssetPivot)
+	order := make([]string, 0)
+
+	// group and collect TopGroupNode keys
+	for _, row := range rows {
+		key := strings.TrimSpace(row.TopGroupNode)
+		if key == "" {
+			key = "Unassigned" // represents NULL / no group
+		}
+		if _, exists := grouped[key]; !exists {
+			grouped[key] = []AssetPivot{}
+			order = append(order, key)
+		}
+		grouped[key] = append(grouped[key], row)
+	}
+
+	/* ____________________________________________________________________________________
+	Sorting logic for group headers (TopGroupNode):
+		1. "Unassigned" group (representing NULL/empty) is always placed last.
+		2. All other group headers are sorted alphabetically (A→Z), case-insensitive.
+	____________________________________________________________________________________*/
+	isUnassigned := func(s string) bool {
+		return strings.EqualFold(strings.TrimSpace(s), "unassigned")
+	}
+
+	sort.Slice(order, func(i, j int) bool {
+		ai := strings.TrimSpace(order[i])
+		aj := strings.TrimSpace(order[j])
+
+		aui := isUnassigned(ai)
+		auj := isUnassigned(aj)
+
+		// Unassigned always last
+		if aui && !auj {
+			return false
+		}
+		if !aui && auj {
+			return true
+		}
+
+		// Always A→Z (case-insensitive)
+		return strings.ToLower(ai) < strings.ToLower(aj)
+	})
+
+	// sort children inside each group by Group1 using requested dir
+	for _, key := range order {
+		children := grouped[key]
+		sort.SliceStable(children, func(i, j int) bool {
+			gi := strings.ToLower(children[i].Group1)
+			gj := strings.ToLower(children[j].Group1)
+
+			if dir == SortDESC {
+				return gi > gj
+			}
+			return gi < gj
+		})
+		grouped[key] = children
+	}
+
+	result := make([]GroupedAssetBucket, 0, len(order))
+	for _, key := range order {
+		result = append(result, GroupedAssetBucket{
+			TopGroupNode: key,
+			Items:        grouped[key],
+		})
+	}
+	return result
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	buildPhaseAwareStatusWhere constructs a SQL WHERE clause segment that filters rows based on the provided
+	approvalStatuses and workStatuses. It generates case-insensitive "IN" conditions for the columns
+	"approval_status" and "work_status" if their respective status slices are non-empty.
+	The function returns the WHERE clause string (prefixed with " AND ") and a slice of arguments
+	corresponding to the status values, all converted to lowercase and trimmed of whitespace.
+	If both status slices are empty, it returns an empty string and nil arguments.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func buildPhaseAwareStatusWhere(_ string, approvalStatuses, workStatuses []string) (string, []any) {
+	buildIn := func(col string, vals []string) (string, []any) {
+		if len(vals) == 0 {
+			return "", nil
+		}
+		ph := strings.Repeat("?,", len(vals))
+		ph = ph[:len(ph)-1]
+
+		args := make([]any, len(vals))
+		for i, v := range vals {
+			args[i] = strings.ToLower(strings.TrimSpace(v))
+		}
+
+		return fmt.Sprintf("LOWER(%s) IN (%s)", col, ph), args
+	}
+
+	clauses := []string{}
+	args := []any{}
+
+	if c, a := buildIn("approval_status", approvalStatuses); c != "" {
+		clauses = append(clauses, "("+c+")")
+		args = append(args, a...)
+	}
+	if c, a := buildIn("work_status", workStatuses); c != "" {
+		clauses = append(clauses, "("+c+")")
+		args = append(args, a...)
+	}
+
+	if len(clauses) == 0 {
+		return "", nil
+	}
+	return " AND " + strings.Join(clauses, " AND "), args
+}
+
+// buildUserWhere returns the " AND ..." condition matching rows submitted by one of
+// submittedUsers and whose approval status was last set by one of approvalUpdatedUsers,
+// case-insensitively. Empty lists do not filter.
+func buildUserWhere(submittedUsers, approvalUpdatedUsers []string) (string, []any) {
+	cond := ""
+	var args []any
+	for _, f := range []struct {
+		col   string
+		users []string
+	}{
+		{"submitted_user", submittedUsers},
+		{"approval_status_updated_user", approvalUpdatedUsers},
+	} {
+		if len(f.users) == 0 {
+			continue
+		}
+		cond += " AND LOWER(" + f.col + ") IN (" + strings.TrimSuffix(strings.Repeat("?,", len(f.users)), ",") + ")"
+		for _, u := range f.users {
+			args = append(args, strings.ToLower(strings.TrimSpace(u)))
+		}
+	}
+	return cond, args
+}
+
+// buildStudioWhere returns the " AND ..." condition matching rows of one of studios,
+// case-insensitively. An empty list does not filter.
+func buildStudioWhere(studios []string) (string, []any) {
+	if len(studios) == 0 {
+		return "", nil
+	}
+	args := make([]any, len(studios))
+	for i, st := range studios {
+		args[i] = strings.ToLower(strings.TrimSpace(st))
+	}
+	return " AND LOWER(studio) IN (" + strings.TrimSuffix(strings.Repeat("?,", len(studios)), ",") + ")", args
+}
+
+// relationLikeEscaper escapes the LIKE wildcards of a relation prefix.
+var relationLikeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+// buildRelationWhere returns the " AND ..." condition matching rows whose relation is one
+// of relations, or starts with one of them when mode is entity.PivotRelationModePrefix
+// (e.g. "charA:" matches "charA:costumeB"), in the LIKE syntax of d. An empty list does
+// not filter.
+func buildRelationWhere(d reviewquery.Dialect, relations []string, mode string) (string, []any) {
+	if len(relations) == 0 {
+		return "", nil
+	}
+	args := make([]any, len(relations))
+	if mode != entity.PivotRelationModePrefix {
+		for i, rel := range relations {
+			args[i] = strings.TrimSpace(rel)
+		}
+		return " AND relation IN (" + strings.TrimSuffix(strings.Repeat("?,", len(relations)), ",") + ")", args
+	}
+	conds := make([]string, len(relations))
+	for i, rel := range relations {
+		conds[i] = d.Like("relation")
+		args[i] = relationLikeEscaper.Replace(strings.TrimSpace(rel)) + "%"
+	}
+	return " AND (" + strings.Join(conds, " OR ") + ")", args
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	buildOrderClause constructs the SQL ORDER BY clause of the pivot for key, dir and nulls:
+	the key's own terms (buildOrderKeyClause) followed by project, root, group_1,
+	group_2, group_3 and relation. That tail identifies an asset, so rows sharing
+	every sort value still come back in one fixed order and offset or keyset pages
+	never repeat or skip them.
+
+	Parameters:
+
+		d     reviewquery.Dialect - SQL dialect of the natural sort key.
+		alias string - Optional table alias to prefix column names.
+		key   string - The column or logical key to sort by.
+		dir   string - Sort direction ("ASC" or "DESC").
+		nulls string - Where missing values sort ("first" or "last"); "" keeps the key's default.
+		natural bool - Order group_1 and relation naturally (asset2 before asset10).
+
+	Returns:
+
+		string - The constructed SQL ORDER BY clause.
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func buildOrderClause(d reviewquery.Dialect, alias, key, dir, nulls string, natural bool) string {
+	tail := make([]string, 0, 6)
+	for _, c := range []string{"project", "root", "group_1", "group_2", "group_3", "relation"} {
+		if alias != "" {
+			c = alias + "." + c
+		}
+		tail = append(tail, c+" ASC")
+	}
+	return buildOrderKeyClause(d, alias, key, dir, nulls, natural) + ", " + strings.Join(tail, ", ")
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	buildOrderKeyClause constructs the ORDER BY terms of one sort key based on the
+	provided alias, key, and direction. It supports various keys for sorting, including
+	generic columns (e.g., submitted_at_utc, modified_at_utc, phase), name/relation
+	combinations, phase-specific submitted dates, work status, and approval status. The
+	direction (dir) is normalized to "ASC" or "DESC", defaulting to "ASC" if invalid.
+	The alias is prepended to column names if provided. For unrecognized keys, a
+	default ordering by group_1, relation, and submitted_at_utc is used. The function
+	ensures proper handling of NULL values and alphabetical sorting where applicable:
+	missing values sort last in either direction unless nulls is "first". Keys that
+	leave NULLs to MySQL (first ascending, last descending) get an explicit NULL term
+	only when nulls is set. natural orders the group_1 and relation terms by their
+	natural sort key instead of LOWER().
+	The terms need not be unique per row; buildOrderClause adds the tie-breaker.
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func buildOrderKeyClause(d reviewquery.Dialect, alias, key, dir, nulls string, natural bool) string {
+	dir = strings.ToUpper(strings.TrimSpace(dir))
+	if dir != "ASC" && dir != "DESC" {
+		dir = "ASC"
+	}
+	nulls = strings.ToLower(strings.TrimSpace(nulls))
+	// direction of the "is missing" terms: 1 (missing) after 0 by default
+	nullsDir := "ASC"
+	if nulls == entity.PivotNullsFirst {
+		nullsDir = "DESC"
+	}
+
+	col := func(c string) string {
+		if alias == "" {
+			return c
+		}
+		return alias + "." + c
+	}
+
+	// name orders a name column case-insensitively, or naturally (see reviewInfoNaturalSort.go).
+	name := func(c string) string {
+		if natural {
+			return naturalSortExpr(d, col(c))
+		}
+		return "LOWER(" + col(c) + ")"
+	}
+
+	sortComponent := func() string {
+		return fmt.Sprintf(
+			"CASE WHEN %s IS NULL OR %s = '' THEN 1 ELSE 0 END %s, LOWER(TRIM(%s)) %s",
+			col("component"),
+			col("component"), nullsDir,
+			col("component"),
+			dir,
+		)
+	}
+
+	// explicitNulls places the NULLs of c for keys that otherwise leave them to MySQL.
+	explicitNulls := func(c string) string {
+		if nulls != entity.PivotNullsFirst && nulls != entity.PivotNullsLast {
+			return ""
+		}
+		return fmt.Sprintf("(%s IS NULL) %s, ", c, nullsDir)
+	}
+
+	switch key {
+	// generic columns
+	case "submitted_at_utc", "modified_at_utc":
+		return explicitNulls(col(key)) + col(key) + " " + dir
+
+	case "phase":
+		return col(key) + " " + dir
+
+	// name / relation
+	case "group1_only":
+		// PRIMARY for LIST VIEW:
+		// ORDER BY group_1, relation, submitted_at_utc (NULL last unless nulls=first)
+		return fmt.Sprintf(
+			"%s %s, %s ASC, (%s IS NULL) %s, %s %s",
+			name("group_1"), dir,
+			name("relation"),
+			col("submitted_at_utc"), nullsDir,
+			col("submitted_at_utc"), dir,
+		)
+
+	case "relation_only":
+		return fmt.Sprintf(
+			"%s %s, %s ASC, (%s IS NULL) %s, %s %s",
+			name("relation"), dir,
+			name("group_1"),
+			col("submitted_at_utc"), nullsDir,
+			col("submitted_at_utc"), dir,
+		)
+
+	case "component", "component_only":
+		return fmt.Sprintf(
+			"%s, %s ASC",
+			sortComponent(),
+			name("group_1"),
+		)
+
+	case "group_rel_submitted":
+		return fmt.Sprintf(
+			"%s ASC, %s ASC, (%s IS NULL) %s, %s %s",
+			name("group_1"),
+			name("relation"),
+			col("submitted_at_utc"), nullsDir,
+			col("submitted_at_utc"), dir,
+		)
+
+	// phase-specific submitted date (NULLs where MySQL puts them unless nulls is set)
+	case "mdl_submitted", "rig_submitted", "bld_submitted", "dsn_submitted", "ldv_submitted":
+		phase := strings.ToUpper(strings.Split(key, "_")[0])
+		return fmt.Sprintf(
+			"(CASE WHEN %s = '%s' THEN 0 ELSE 1 END) ASC, %s%s %s, %s ASC",
+			col("phase"), phase,
+			explicitNulls(col("submitted_at_utc")), col("submitted_at_utc"), dir,
+			name("group_1"),
+		)
+
+	// work columns (alphabetical, NULL last unless nulls=first)
+	case "mdl_work", "rig_work", "bld_work", "dsn_work", "ldv_work":
+		phase := strings.ToUpper(strings.Split(key, "_")[0])
+		return fmt.Sprintf(
+			"(CASE WHEN %s = '%s' THEN 0 ELSE 1 END) ASC, (%s IS NULL) %s, LOWER(%s) %s, %s ASC",
+			col("phase"), phase,
+			col("work_status"), nullsDir,
+			col("work_status"), dir,
+			name("group_1"),
+		)
+
+	case "work_status":
+		return fmt.Sprintf(
+			"(%s IS NULL) %s, LOWER(%s) %s, %s ASC",
+			col("work_status"), nullsDir,
+			col("work_status"), dir,
+			name("group_1"),
+		)
+
+	// approval columns (alphabetical, NULL last unless nulls=first)
+	case "mdl_appr", "rig_appr", "bld_appr", "dsn_appr", "ldv_appr":
+		phase := strings.ToUpper(strings.Split(key, "_")[0])
+		return fmt.Sprintf(
+			"(CASE WHEN %s = '%s' THEN 0 ELSE 1 END) ASC, (%s IS NULL) %s, LOWER(%s) %s, %s ASC",
+			col("phase"), phase,
+			col("approval_status"), nullsDir,
+			col("approval_status"), dir,
+			name("group_1"),
+		)
+
+	// ============================================
+	// ALTERNATIVE: Using RIGHT() function (d.Right)
+	// ============================================
+	case "mdl_take", "rig_take", "bld_take", "dsn_take", "ldv_take":
+		phase := strings.ToUpper(strings.Split(key, "_")[0])
+		return fmt.Sprintf(
+			"(CASE WHEN %s = '%s' THEN 0 ELSE 1 END) ASC, "+
+				"CASE WHEN %s IS NULL OR %s = '' THEN 1 ELSE 0 END %s, "+
+				"%s %s, "+
+				"%s ASC",
+			col("phase"), phase,
+			col("take"), col("take"), nullsDir,
+			d.Unsigned(d.Right(col("take"), 4)), dir,
+			name("group_1"),
+		)
+
+	case "take":
+		return fmt.Sprintf(
+			"CASE WHEN %s IS NULL OR %s = '' THEN 1 ELSE 0 END %s, "+
+				"%s %s, "+
+				"%s ASC",
+			col("take"), col("take"), nullsDir,
+			d.Unsigned(d.Right(col("take"), 4)), dir,
+			name("group_1"),
+		)
+
+	// triage order, see reviewInfoAttention.go
+	case AttentionOrderKey:
+		return fmt.Sprintf(
+			"%s %s, %s ASC",
+			col("attention_score"), dir,
+			name("group_1"),
+		)
+
+	// workflow order retake, in_progress, approved, see reviewInfoOverallStatus.go
+	case OverallStatusOrderKey:
+		return fmt.Sprintf(
+			"(CASE %s WHEN '%s' THEN 0 WHEN '%s' THEN 1 ELSE 2 END) %s, %s ASC",
+			col("overall_status"), entity.OverallStatusRetake, entity.OverallStatusInProgress, dir,
+			name("group_1"),
+		)
+
+	// earliest open due date first, assets without one last, see reviewDueDate.go
+	case DueDateOrderKey:
+		return fmt.Sprintf(
+			"(%s IS NULL) %s, %s %s, %s ASC",
+			col("next_due_date"), nullsDir,
+			col("next_due_date"), dir,
+			name("group_1"),
+		)
+
+	// most recently touched first (dir desc), assets without activity last, see reviewLatestActivity.go
+	case LatestActivityOrderKey:
+		return fmt.Sprintf(
+			"(%s IS NULL) %s, %s %s, %s ASC",
+			col("latest_activity_at"), nullsDir,
+			col("latest_activity_at"), dir,
+			name("group_1"),
+		)
+
+	// flagged assets first, then the default order, see reviewAssetPriority.go
+	case PriorityFirstOrderKey:
+		return fmt.Sprintf("%s DESC, %s", col("priority"), buildOrderKeyClause(d, alias, "", dir, nulls, natural))
+
+	// default: group_1 + relation + submitted_at_utc
+	default:
+		return fmt.Sprintf(
+			"%s %s, %s ASC, LOWER(%s) ASC, (%s IS NULL) %s, %s %s",
+			name("group_1"), dir,
+			name("relation"),
+			d.TrimLeading(col("component"), "_"),
+			col("submitted_at_utc"), nullsDir,
+			col("submitted_at_utc"), dir,
+		)
+	}
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	buildTopGroupNodeFilter restricts t_review_info rows (referenced as alias) to assets
+	whose group category's top node is in allowed. The category is resolved the same way
+	as in the pivot phase fetch: groups[0] -> t_group_category_group -> t_group_category.
+	- nil allowed: unrestricted, no condition.
+	- empty allowed: the caller may see nothing.
+	- "" in allowed: assets without a category (the Unassigned bucket) match too.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func buildTopGroupNodeFilter(d reviewquery.Dialect, alias string, allowed []string) (string, []any) {
+	if allowed == nil {
+		return "", nil
+	}
+	if len(allowed) == 0 {
+		return " AND 1 = 0", nil
+	}
+	nodes := make([]string, 0, len(allowed))
+	unassigned := false
+	for _, node := range allowed {
+		if node == "" {
+			unassigned = true
+			continue
+		}
+		nodes = append(nodes, node)
+	}
+	category := func(nodeCond string) string {
+		return `EXISTS (
+      SELECT 1
+      FROM t_group_category_group AS acg
+      JOIN t_group_category AS acc
+        ON acc.id = acg.group_category_id
+       AND acc.deleted = 0
+       AND acc.root = ` + alias + `.root
+      WHERE acg.project = ` + alias + `.project
+        AND acg.deleted = 0
+        AND acg.path = ` + d.JSONIndex(alias+"."+d.Ident("groups"), 0) + nodeCond + `
+    )`
+	}
+	switch {
+	case !unassigned:
+		return `
+    AND ` + category(`
+        AND `+d.PathHead("acc.path")+` IN ?`), []any{nodes}
+	case len(nodes) == 0:
+		return `
+    AND NOT ` + category(""), nil
+	default:
+		return `
+    AND (` + category(`
+        AND `+d.PathHead("acc.path")+` IN ?`) + ` OR NOT ` + category("") + `)`, []any{nodes}
+	}
+}
+
+// buildAsOfCond limits t_review_info rows (referenced as alias) to those written at or
+// before asOf, so the latest-row CTEs reconstruct the state at that moment.
+// A nil asOf means "now" and adds no condition.
+func buildAsOfCond(alias string, asOf *time.Time) (string, []any) {
+	if asOf == nil {
+		return "", nil
+	}
+	col := "modified_at_utc"
+	if alias != "" {
+		col = alias + "." + col
+	}
+	return " AND " + col + " <= ?", []any{asOf.UTC()}
+}
+
+/*
+	──────────────────────────────────────────────────────────────────────────
+	CountLatestSubmissions returns the count of latest review submissions for a given project and asset root,
+	optionally filtered by asset name prefix, approval statuses, and work statuses.
+	The function ignores the preferredPhase parameter for filtering but keeps it for API compatibility.
+	It queries the database for the latest (by modified_at_utc) review info per asset and relation,
+	applying the specified filters, and returns the total count.
+	Returns an error if the project is not specified or if the database query fails.
+
+	Parameters:
+	ctx              - Context for database operations.
+	project          - Project identifier (required).
+	root             - Asset root; defaults to "assets" if empty.
+	assetNameKey     - Optional asset name prefix filter (case-insensitive).
+	preferredPhase   - Phase parameter (ignored in filtering; kept for compatibility).
+	approvalStatuses - List of approval statuses to filter by.
+	workStatuses     - List of work statuses to filter by.
+	submittedUsers   - Users who submitted the latest row of a phase (case-insensitive).
+	approvalUpdatedUsers - Users who last set the approval status of a phase (case-insensitive).
+	studios          - Studios of the latest row of a phase (case-insensitive).
+	relations        - Relations of the asset; relationMode "prefix" matches their prefixes, else exact.
+	tags             - Tags of the asset (see reviewTag.go); any of them matches.
+	overdueOn        - Only assets overdue on this day (see reviewDueDate.go); nil does not filter.
+	allowedTopGroupNodes - Top group nodes the caller may see; nil means unrestricted.
+	asOf             - Optional point in time to count as of; nil means now.
+
+	Returns:
+	int64 - Count of latest submissions matching the filters.
+	error - Error if project is missing or database query fails.
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) CountLatestSubmissions(
+	ctx context.Context,
+	project, root, assetNameKey string,
+	preferredPhase string, // kept for API compatibility; ignored in filtering
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	overallStatuses []string,
+	overallRules entity.OverallStatusRules,
+	requiredPhases *entity.RequiredPhases,
+	overdueOn *time.Time,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+) (int64, error) {
+	if project == "" {
+		return 0, fmt.Errorf("project is required")
+	}
+	if root == "" {
+		root = "assets"
+	}
+
+	db := r.ReadWithContext(ctx, project)
+	d := dialectOf(r.db)
+
+	// DBA-tuned plan, see queryTuning.go
+	hint := r.tuning.Hint(project, QueryStagePivotCount)
+
+	scope, statusFilter := pivotScope(
+		d, hint, project, root, preferredPhase, assetNameKey,
+		approvalStatuses, workStatuses, submittedUsers, approvalUpdatedUsers, studios,
+		relations, relationMode, tags, allowedTopGroupNodes, asOf,
+	)
+	q := reviewquery.CountQuery{Scope: scope, Filter: statusFilter}
+
+	// overall status filter, rolled up over every phase of an asset
+	if len(overallStatuses) > 0 {
+		q.CTEs = reviewquery.Join(q.CTEs, frag(buildOverallStatusCTE(d, overallRules, requiredPhases)))
+		where, whereArgs := buildOverallStatusWhere("s", overallStatuses)
+		q.Filter = reviewquery.Join(q.Filter, reviewquery.Frag{SQL: `
+    AND (project, root, group_1, group_2, group_3, relation) IN (
+      SELECT s.project, s.root, s.group_1, s.group_2, s.group_3, s.relation
+      FROM asset_status AS s
+      WHERE 1 = 1` + where + `
+    )`, Args: whereArgs})
+	}
+
+	// overdue filter over the open due dates of an asset
+	if overdueOn != nil {
+		q.CTEs = reviewquery.Join(q.CTEs, frag(buildAssetDueCTE(project, root, overallRules.ApprovedStatuses)))
+		q.Filter = reviewquery.Join(q.Filter, reviewquery.Frag{SQL: `
+    AND (project, root, group_1, relation) IN (
+      SELECT ad.project, ad.root, ad.group_1, ad.relation
+      FROM asset_due AS ad
+      WHERE ad.next_due_date < ?
+    )`, Args: []any{overdueOn.Format(entity.ReviewDueDateLayout)}})
+	}
+	sql, args := q.Build()
+
+	defer metrics.ObserveQuery(QueryStagePivotCount, time.Now())
+	var total int64
+	if err := r.scanPivot(db, QueryStagePivotCount, project, &total, sql, args...); err != nil {
+		return 0, fmt.Errorf("CountLatestSubmissions: %w", err)
+	}
+
+	return total, nil
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	buildAssetKeysSQL returns the query selecting the assets (project, root, group_1,
+	group_2, group_3, relation, component) in scope of the asset pivot filters: name prefix, phase-aware
+	statuses of the latest row per phase, overall status, overdue, category access and
+	as-of time, each with its overall_status, next_due_date, priority and latest_activity_at. It is used as a derived
+	table by the list and grouped pivot queries.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func buildAssetKeysSQL(
+	d reviewquery.Dialect,
+	hint QueryHint,
+	project, root, preferredPhase, assetNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	overallStatuses []string,
+	overallRules entity.OverallStatusRules,
+	requiredPhases *entity.RequiredPhases,
+	overdueOn *time.Time,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+) (string, []any) {
+	scope, statusFilter := pivotScope(
+		d, hint, project, root, preferredPhase, assetNameKey,
+		approvalStatuses, workStatuses, submittedUsers, approvalUpdatedUsers, studios,
+		relations, relationMode, tags, allowedTopGroupNodes, asOf,
+	)
+	scope.Columns = []string{latestActivityColumn(scope.Ref) + " AS latest_activity_at"}
+
+	q := reviewquery.KeyQuery{
+		Scope: scope,
+		// overall status of every asset and its filter
+		Overall: frag(buildOverallStatusCTE(d, overallRules, requiredPhases)),
+		// next due date of every asset
+		Due:    frag(buildAssetDueCTE(project, root, overallRules.ApprovedStatuses)),
+		Filter: reviewquery.Join(statusFilter, frag(buildOverallStatusWhere("s", overallStatuses))),
+	}
+	// overdue filter
+	if overdueOn != nil {
+		q.Filter = reviewquery.Join(q.Filter, reviewquery.Frag{
+			SQL:  " AND ad.next_due_date < ?",
+			Args: []any{overdueOn.Format(entity.ReviewDueDateLayout)},
+		})
+	}
+	return q.Build()
+}
+
+// pivotScope returns the latest_phase scope of the pivot filters that apply to single
+// rows (name prefix, relations, tags, category access, as-of time), and the status
+// filters on the latest rows.
+func pivotScope(
+	d reviewquery.Dialect,
+	hint QueryHint,
+	project, root, preferredPhase, assetNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+) (reviewquery.Scope, reviewquery.Frag) {
+	hint = hint.forDialect(d)
+
+	// latest row per phase: t_review_latest, or t_review_info for historical views
+	src := latestPhaseSourceFor(hint, "", pivotPhasePartition(""), asOf)
+
+	// name prefix filter
+	var name reviewquery.Frag
+	if key := strings.ToLower(strings.TrimSpace(assetNameKey)); key != "" {
+		name = reviewquery.Frag{SQL: " AND LOWER(group_1) LIKE ?", Args: []any{key + "%"}}
+	}
+	scope := reviewquery.Scope{
+		Dialect:   d,
+		Project:   project,
+		Root:      root,
+		Modifiers: hint.selectModifiers(),
+		From:      src.from,
+		Ref:       src.ref,
+		Rank:      src.rank,
+		Live:      src.live,
+		Group2:    pivotGroupColumn(src.ref, "group_2"),
+		Group3:    pivotGroupColumn(src.ref, "group_3"),
+		Rows: reviewquery.Join(
+			name,
+			frag(buildRelationWhere(d, relations, relationMode)),
+			frag(buildTagWhere(project, tags)),
+			frag(buildTopGroupNodeFilter(d, src.ref, allowedTopGroupNodes)),
+			frag(buildAsOfCond("", asOf)),
+		),
+	}
+	status := reviewquery.Join(
+		frag(buildPhaseAwareStatusWhere(preferredPhase, approvalStatuses, workStatuses)),
+		frag(buildUserWhere(submittedUsers, approvalUpdatedUsers)),
+		frag(buildStudioWhere(studios)),
+	)
+	return scope, status
+}
+
+// frag wraps a condition builder's result, e.g. frag(buildStudioWhere(studios)).
+func frag(sql string, args []any) reviewquery.Frag {
+	return reviewquery.Frag{SQL: sql, Args: args}
+}
+
+// dialectOf returns the SQL dialect of db. A read replica runs the engine of its
+// primary, so the pivot takes the dialect of r.db whichever connection it reads from.
+func dialectOf(db *gorm.DB) reviewquery.Dialect {
+	return reviewquery.DialectFor(db.Dialector.Name())
+}
+
+/*
+	──────────────────────────────────────────────────────────────────────────
+
+	ListLatestSubmissionsDynamic retrieves a list of the latest review submissions
+	for a specified project and asset root, with dynamic filtering and sorting options.
+	Parameters:
+	- ctx: Context for database operations.
+	- project: Project identifier (required).
+	- root: Asset root; defaults to "assets" if empty.
+	- preferredPhase: Phase to prioritize in sorting; if empty or "none", no bias is applied.
+	- orderKey: Column or logical key to sort by (e.g., "submitted_at_utc", "group1_only").
+	- direction: Sort direction ("ASC" or "DESC").
+	- nulls: Where missing values sort ("first" or "last"); "" keeps the key's default.
+	- natural: Order names naturally, digit runs by value (see reviewInfoNaturalSort.go).
+	- limit: Maximum number of results to return; defaults to 60 if <= 0.
+	- offset: Number of results to skip; defaults to 0 if < 0. Ignored when after is set.
+	- after: Optional keyset cursor; only assets sorting after it are returned.
+	- assetNameKey: Optional asset name prefix filter (case-insensitive).
+	- approvalStatuses: List of approval statuses to filter by.
+	- workStatuses: List of work statuses to filter by.
+	- submittedUsers: Users who submitted the latest row of a phase (case-insensitive).
+	- approvalUpdatedUsers: Users who last set the approval status of a phase (case-insensitive).
+	- studios: Studios of the latest row of a phase (case-insensitive).
+	- relations: Relations of the asset; relationMode "prefix" matches their prefixes, else exact.
+	- tags: Tags of the asset (see reviewTag.go); any of them matches.
+	- overallStatuses: overall_status values of the asset (see reviewInfoOverallStatus.go).
+	- overallRules: Rules rolling the phases of an asset up into its overall_status.
+	- requiredPhases: Phases required of an asset by relation or top group node; nil uses overallRules.
+	- overdueOn: Only assets overdue on this day (see reviewDueDate.go); nil does not filter.
+	- allowedTopGroupNodes: Top group nodes the caller may see; nil means unrestricted.
+	- asOf: Optional point in time to list as of; nil means now.
+	- weights: Weights of the attention_score signals.
+	- scoredAt: Reference time for the time-in-status signal.
+	Returns:
+	- []LatestSubmissionRow: Slice of latest submission rows matching the filters.
+	- error: Error if project is missing or database query fails.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) ListLatestSubmissionsDynamic(
+	ctx context.Context,
+	project string,
+	root string,
+	preferredPhase string,
+	orderKey string,
+	direction string,
+	nulls string,
+	natural bool,
+	limit, offset int,
+	after *AssetPivotCursor,
+	assetNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	overallStatuses []string,
+	overallRules entity.OverallStatusRules,
+	requiredPhases *entity.RequiredPhases,
+	overdueOn *time.Time,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+	weights entity.AttentionWeights,
+	scoredAt time.Time,
+) ([]LatestSubmissionRow, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+	if root == "" {
+		root = "assets"
+	}
+	if limit <= 0 {
+		limit = 60
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	// phaseGuard: 1 = no phase bias, 0 = prefer preferredPhase
+	phaseGuard := 0
+	if preferredPhase == "" || strings.EqualFold(preferredPhase, "none") {
+		phaseGuard = 1
+	}
+
+	d := dialectOf(r.db)
+
+	// Total order of the page: phase bias, then the requested sort, which ends in the
+	// asset key so every asset has a unique sort key (keyset cursors depend on it).
+	sortTerms := []orderTerm{{expr: "_bias"}}
+	sortTerms = append(sortTerms, splitOrderClause(buildOrderClause(d, "", orderKey, direction, nulls, natural))...)
+	orderClause := make([]string, len(sortTerms))
+	for i, t := range sortTerms {
+		orderClause[i] = d.Order(t.expr, t.desc)
+	}
+
+	cursorCond := ""
+	var cursorArgs []any
+	if after != nil {
+		if !after.matches(orderKey, direction, nulls, natural, preferredPhase) {
+			return nil, entity.ErrInvalidPivotCursor
+		}
+		values, err := after.args()
+		if err != nil {
+			return nil, err
+		}
+		cursorCond, cursorArgs, err = buildCursorCondition(sortTerms, values)
+		if err != nil {
+			return nil, err
+		}
+		offset = 0
+	}
+
+	// historical view: every latest-row lookup ignores rows written after asOf
+	asOfCond, asOfArgs := buildAsOfCond("", asOf)
+
+	// per-asset attention signals and their weighted score
+	attentionCTEs, attentionArgs := buildAttentionCTEs(d, project, root, asOf, scoredAt)
+	attentionScore, attentionScoreArgs := buildAttentionScore(d, weights)
+
+	// DBA-tuned plan, see queryTuning.go
+	hint := r.tuning.Hint(project, QueryStagePivotKeys)
+
+	// keys subquery: which assets (root+project+group_1+group_2+group_3+relation) are in scope
+	keysSQL, keysArgs := buildAssetKeysSQL(
+		d, hint, project, root, preferredPhase, assetNameKey,
+		approvalStatuses, workStatuses, submittedUsers, approvalUpdatedUsers, studios,
+		relations, relationMode, tags, overallStatuses, overallRules, requiredPhases, overdueOn, allowedTopGroupNodes, asOf,
+	)
+
+	// latest row per phase: t_review_latest, or t_review_info for historical views
+	latestRows := `
+      SELECT root, project, group_1, group_2, group_3, phase, relation, component,
+        work_status, approval_status, submitted_at_utc, modified_at_utc, take
+      FROM t_review_latest
+      WHERE project = ? AND root = ?`
+	latestArgs := []any{project, root}
+	if asOf != nil {
+		group2 := pivotGroupColumn("", "group_2")
+		group3 := pivotGroupColumn("", "group_3")
+		latestRows = fmt.Sprintf(`
+      SELECT b.*
+      FROM (
+        SELECT
+          project,
+          root,
+          group_1,
+          %[2]s AS group_2,
+          %[3]s AS group_3,
+          relation,
+          component,
+          phase,
+          MAX(modified_at_utc) AS modified_at_utc
+        FROM t_review_info
+        WHERE project = ? AND root = ? AND deleted = 0%[1]s
+        GROUP BY project, root, group_1, %[2]s, %[3]s, relation, phase, component
+      ) AS a
+      LEFT JOIN (
+        SELECT
+          root,
+          project,
+          group_1,
+          %[2]s AS group_2,
+          %[3]s AS group_3,
+          phase,
+          relation,
+          component,
+          work_status,
+          approval_status,
+          submitted_at_utc,
+          modified_at_utc,
+          take
+        FROM t_review_info
+        WHERE project = ? AND root = ? AND deleted = 0%[1]s
+      ) AS b
+        ON a.project = b.project
+       AND a.root    = b.root
+       AND a.group_1 = b.group_1
+       AND a.group_2 = b.group_2
+       AND a.group_3 = b.group_3
+       AND a.relation = b.relation
+       AND a.phase    = b.phase
+       AND a.modified_at_utc = b.modified_at_utc`, asOfCond, group2, group3)
+		// 'a' CTE, 'b' join
+		latestArgs = append(latestArgs, asOfArgs...)
+		latestArgs = append(latestArgs, project, root)
+		latestArgs = append(latestArgs, asOfArgs...)
+	}
+
+	q := fmt.Sprintf(`
+WITH ordered AS (
+  SELECT *
+  FROM (
+    SELECT b.*, fk.overall_status, fk.next_due_date, fk.priority, fk.latest_activity_at
+    FROM (%s
+    ) AS b
+    INNER JOIN ( %s ) AS fk
+      ON b.project = fk.project
+     AND b.root    = fk.root
+     AND b.group_1 = fk.group_1
+     AND b.group_2 = fk.group_2
+     AND b.group_3 = fk.group_3
+     AND b.relation = fk.relation
+     AND b.component = fk.component
+  ) AS k
+),%s
+ranked AS (
+  SELECT
+    b.*,
+    CASE
+      WHEN ? = 1 THEN 0
+      WHEN b.phase = ? THEN 0
+      ELSE 1
+    END AS _bias,
+    %s AS attention_score,
+    ROW_NUMBER() OVER (
+      PARTITION BY b.root, b.project, b.group_1, b.group_2, b.group_3, b.relation
+      ORDER BY
+        CASE
+          WHEN ? = 1 THEN 0
+          WHEN b.phase = ? THEN 0
+          ELSE 1
+        END,
+        LOWER(b.group_1)   ASC,
+        LOWER(b.relation)  ASC,
+        b.modified_at_utc  DESC
+    ) AS _rank
+  FROM ordered b
+  LEFT JOIN attention_retakes AS ar
+    ON ar.project = b.project
+   AND ar.root = b.root
+   AND ar.group_1 = b.group_1
+   AND ar.group_2 = b.group_2
+   AND ar.group_3 = b.group_3
+   AND ar.relation = b.relation
+  LEFT JOIN attention_signals AS sg
+    ON sg.project = b.project
+   AND sg.root = b.root
+   AND sg.group_1 = b.group_1
+   AND sg.group_2 = b.group_2
+   AND sg.group_3 = b.group_3
+   AND sg.relation = b.relation
+)
+SELECT
+  root,
+  project,
+  group_1,
+  group_2,
+  group_3,
+  relation,
+  component,
+  phase,
+  submitted_at_utc,
+  attention_score,
+  overall_status,
+  next_due_date,
+  priority,
+  latest_activity_at,
+  %s AS sort_key
+FROM ranked
+WHERE _rank = 1%s
+ORDER BY %s
+LIMIT ? OFFSET ?;
+`, latestRows, keysSQL, attentionCTEs, attentionScore,
+		buildSortKeyColumns(d, sortTerms), cursorCond, strings.Join(orderClause, ", "))
+
+	// latest rows
+	args := latestArgs
+	// keys subquery
+	args = append(args, keysArgs...)
+	// attention CTEs
+	args = append(args, attentionArgs...)
+	// phase bias, attention score, cursor + limit/offset
+	args = append(args, phaseGuard, preferredPhase)
+	args = append(args, attentionScoreArgs...)
+	args = append(args, phaseGuard, preferredPhase)
+	args = append(args, cursorArgs...)
+	args = append(args, limit, offset)
+
+	defer metrics.ObserveQuery(QueryStagePivotKeys, time.Now())
+	var rows []LatestSubmissionRow
+	if err := r.scanPivot(r.ReadWithContext(ctx, project), QueryStagePivotKeys, project, &rows, q, args...); err != nil {
+		return nil, fmt.Errorf("ListLatestSubmissionsDynamic: %w", err)
+	}
+
+	return rows, nil
+}
+
+// AssetPivotQuery is the query of one ListAssetsPivot call.
+type AssetPivotQuery struct {
+	Project        string // required
+	Root           string // defaults to "assets"
+	PreferredPhase string // phase to prioritize in sorting; "" or "none" applies no bias
+	OrderKey       string // column or logical key to sort by (e.g. "submitted_at_utc", "group1_only")
+	Direction      string // "ASC" or "DESC"
+	Nulls          string // where missing values sort ("first" or "last"); "" keeps the key's default
+	Natural        bool   // order names naturally, digit runs by value (see reviewInfoNaturalSort.go)
+	Limit          int    // defaults to 60 if <= 0
+	Offset         int    // ignored when After is set
+	// After is the keyset cursor from a previous page (see reviewInfoCursor.go).
+	After *AssetPivotCursor
+
+	AssetNameKey         string   // asset name prefix (case-insensitive)
+	ApprovalStatuses     []string // approval statuses
+	WorkStatuses         []string // work statuses
+	SubmittedUsers       []string // users who submitted the latest row of a phase (case-insensitive)
+	ApprovalUpdatedUsers []string // users who last set the approval status of a phase (case-insensitive)
+	Studios              []string // studios of the latest row of a phase (case-insensitive)
+	Relations            []string // relations of the asset; RelationMode "prefix" matches their prefixes, else exact
+	RelationMode         string
+	Tags                 []string // tags of the asset (see reviewTag.go); any of them matches
+	OverallStatuses      []string // overall_status values (see reviewInfoOverallStatus.go)
+	// OverallRules roll the phases of an asset up into its overall_status.
+	OverallRules entity.OverallStatusRules
+	// RequiredPhases are the phases required of an asset by relation or top group node;
+	// nil uses OverallRules.
+	RequiredPhases *entity.RequiredPhases
+	// OverdueOn keeps the assets overdue on this day (see reviewDueDate.go); nil does not filter.
+	OverdueOn *time.Time
+	// AllowedTopGroupNodes are the top group nodes the caller may see; nil means unrestricted.
+	AllowedTopGroupNodes []string
+	// AsOf rebuilds the latest-take-per-phase state as it was then (rows modified
+	// later are ignored); nil means now.
+	AsOf *time.Time
+
+	Fields  []string                // normalised phase selection (see reviewInfoFields.go); nil fetches every phase
+	Weights entity.AttentionWeights // weights of the attention_score signals (see reviewInfoAttention.go)
+	// SkipCount skips the total count (returned as 0) and fetches Limit+1 keys instead,
+	// so the cursor is only returned when another page really follows.
+	SkipCount bool
+	// ScoredAt is the reference time of the attention score, shared by the pages of a
+	// cursor and by the shadow run.
+	ScoredAt time.Time
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	ListAssetsPivot retrieves a paginated list of AssetPivot rows for a specified project and asset root,
+	optionally filtered by asset name prefix, preferred phase, approval statuses, and work statuses.
+	Parameters:
+	- ctx: Context for database operations.
+	- q: The query (see AssetPivotQuery); Project is required. Without a cursor or AsOf, a
+	  zero ScoredAt is set to now.
+	Returns:
+	- []AssetPivot: Slice of AssetPivot rows matching the filters.
+	- int64: Total count of assets matching the filters (for pagination).
+	- bool: Whether the total is an estimate (large projects, see reviewInfoCountEstimate.go).
+	- *AssetPivotCursor: Cursor for the page after this one; nil on a short (last) page.
+	- error: Error if project is missing or database query fails.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) ListAssetsPivot(
+	ctx context.Context,
+	q AssetPivotQuery,
+) ([]AssetPivot, int64, bool, *AssetPivotCursor, error) {
+	// Pages after the first score against the first page's reference time.
+	switch {
+	case q.After != nil && q.After.ScoredAt != nil:
+		q.ScoredAt = *q.After.ScoredAt
+	case q.AsOf != nil:
+		q.ScoredAt = q.AsOf.UTC()
+	case q.ScoredAt.IsZero():
+		q.ScoredAt = time.Now().UTC()
+	}
+	rows, total, estimated, next, err := r.listAssetsPivot(ctx, q)
+	if err != nil {
+		return nil, 0, false, nil, err
+	}
+	r.shadowAssetsPivot(q, rows, total, estimated)
+	return rows, total, estimated, next, nil
+}
+
+// listAssetsPivot is the current implementation of ListAssetsPivot: the total, then
+// the ordered page keys, then the phases of those keys.
+func (r *ReviewInfo) listAssetsPivot(
+	ctx context.Context,
+	q AssetPivotQuery,
+) ([]AssetPivot, int64, bool, *AssetPivotCursor, error) {
+	if q.Project == "" {
+		return nil, 0, false, nil, fmt.Errorf("project is required")
+	}
+	if q.Root == "" {
+		q.Root = "assets"
+	}
+
+	// 1) Get total count for pagination (after filters); cached across pages
+	var total int64
+	var estimated bool
+	keysLimit := q.Limit
+	if q.SkipCount {
+		// One extra key tells whether another page follows.
+		keysLimit = q.Limit + 1
+	} else {
+		countCtx, span := tracing.Start(ctx, "pivot.count")
+		var err error
+		total, estimated, err = r.cachedCountLatestSubmissions(
+			countCtx,
+			q.Project,
+			q.Root,
+			q.AssetNameKey,
+			q.PreferredPhase,
+			q.ApprovalStatuses,
+			q.WorkStatuses,
+			q.SubmittedUsers,
+			q.ApprovalUpdatedUsers,
+			q.Studios,
+			q.Relations,
+			q.RelationMode,
+			q.Tags,
+			q.OverallStatuses,
+			q.OverallRules,
+			q.RequiredPhases,
+			q.OverdueOn,
+			q.AllowedTopGroupNodes,
+			q.AsOf,
+		)
+		tracing.End(span, err)
+		if err != nil {
+			return nil, 0, false, nil, err
+		}
+	}
+
+	// 2) Get page "keys" (one primary row per asset, correctly ordered)
+	keysCtx, span := tracing.Start(ctx, "pivot.keys", attribute.Int("limit", q.Limit), attribute.Int("offset", q.Offset))
+	keys, err := r.ListLatestSubmissionsDynamic(
+		keysCtx,
+		q.Project,
+		q.Root,
+		q.PreferredPhase,
+		q.OrderKey,
+		q.Direction,
+		q.Nulls,
+		q.Natural,
+		keysLimit,
+		q.Offset,
+		q.After,
+		q.AssetNameKey,
+		q.ApprovalStatuses,
+		q.WorkStatuses,
+		q.SubmittedUsers,
+		q.ApprovalUpdatedUsers,
+		q.Studios,
+		q.Relations,
+		q.RelationMode,
+		q.Tags,
+		q.OverallStatuses,
+		q.OverallRules,
+		q.RequiredPhases,
+		q.OverdueOn,
+		q.AllowedTopGroupNodes,
+		q.AsOf,
+		q.Weights,
+		q.ScoredAt,
+	)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, 0, false, nil, err
+	}
+	if len(keys) == 0 {
+		return []AssetPivot{}, total, estimated, nil, nil
+	}
+	more := q.Limit > 0 && len(keys) == q.Limit
+	if q.SkipCount {
+		more = len(keys) > q.Limit
+		if more {
+			keys = keys[:q.Limit]
+		}
+	}
+
+	// The next page starts after the sort key of this page's last asset.
+	var next *AssetPivotCursor
+	if more {
+		var sortKey []json.RawMessage
+		if err := json.Unmarshal([]byte(keys[len(keys)-1].SortKey), &sortKey); err != nil {
+			return nil, 0, false, nil, fmt.Errorf("ListAssetsPivot.sortKey: %w", err)
+		}
+		next = &AssetPivotCursor{
+			Version:        assetPivotCursorVersion,
+			OrderKey:       q.OrderKey,
+			Direction:      strings.ToUpper(strings.TrimSpace(q.Direction)),
+			Nulls:          strings.ToLower(strings.TrimSpace(q.Nulls)),
+			Natural:        q.Natural,
+			PreferredPhase: q.PreferredPhase,
+			SortKey:        sortKey,
+		}
+		if q.OrderKey == AttentionOrderKey {
+			scoredAt := q.ScoredAt
+			next.ScoredAt = &scoredAt
+		}
+	}
+
+	// 3) Fetch the latest row per phase strictly for this page's assets.
+	assetKeys := make([]entity.PivotAssetKey, len(keys))
+	for i, k := range keys {
+		component := k.Component
+		assetKeys[i] = entity.PivotAssetKey{
+			Group1: k.Group1, Group2: k.Group2, Group3: k.Group3, Relation: k.Relation, Component: &component,
+		}
+	}
+	phasesCtx, span := tracing.Start(ctx, "pivot.phases", attribute.Int("assets", len(assetKeys)))
+	phases, err := r.fetchPivotPhases(phasesCtx, q.Project, q.Root, assetKeys, q.AsOf, q.Fields)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, 0, false, nil, fmt.Errorf("ListAssetsPivot.phaseFetch: %w", err)
+	}
+
+	// 4) Stitch phases into pivot rows, preserving the page order from `keys`.
+	_, span = tracing.Start(ctx, "pivot.fill", attribute.Int("phase_rows", len(phases)))
+	defer span.End()
+	m := make(map[pivotAssetID]*AssetPivot, len(keys))
+	orderedPtrs := make([]*AssetPivot, 0, len(keys))
+
+	// create base pivot row per asset in the same order as `keys`
+	for _, k := range keys {
+		id := pivotAssetID{k.Project, k.Root, k.Group1, k.Group2, k.Group3, k.Relation, k.Component}
+		ap := &AssetPivot{
+			Root:      k.Root,
+			Project:   k.Project,
+			Group1:    k.Group1,
+			Group2:    k.Group2,
+			Group3:    k.Group3,
+			Relation:  k.Relation,
+			Component: k.Component,
+
+			AttentionScore: k.AttentionScore,
+			OverallStatus:  k.OverallStatus,
+			NextDueDate:    formatDueDate(k.NextDueDate.ptr()),
+			Priority:       k.Priority,
+
+			LatestActivityAt: k.LatestActivity.ptr(),
+
+			fields: q.Fields,
+		}
+		m[id] = ap
+		orderedPtrs = append(orderedPtrs, ap)
+	}
+
+	// fill per-phase fields + grouping info
+	for _, pr := range phases {
+		ap, ok := m[pr.assetID()]
+		if !ok {
+			continue
+		}
+		applyPivotPhase(ap, pr)
+	}
+	if err := r.fillPivotDueDates(ctx, q.Project, q.Root, orderedPtrs); err != nil {
+		return nil, 0, false, nil, fmt.Errorf("ListAssetsPivot.dueDates: %w", err)
+	}
+	if err := r.fillPivotTags(ctx, q.Project, q.Root, orderedPtrs); err != nil {
+		return nil, 0, false, nil, fmt.Errorf("ListAssetsPivot.tags: %w", err)
+	}
+
+	// 5) Convert []*AssetPivot → []AssetPivot in the same order as keys.
+	ordered := make([]AssetPivot, len(orderedPtrs))
+	for i, ap := range orderedPtrs {
+		ordered[i] = *ap
+	}
+
+	return ordered, total, estimated, next, nil
+}
+
+// pivotAssetID identifies one pivot row: an asset relation and component. g2 and g3
+// are "" in the assets root.
+type pivotAssetID struct {
+	p, r, g, g2, g3, rel, comp string
+}
+
+func (pr phaseRow) assetID() pivotAssetID {
+	comp := ""
+	if pr.Component != nil {
+		comp = *pr.Component
+	}
+	return pivotAssetID{pr.Project, pr.Root, pr.Group1, pr.Group2, pr.Group3, pr.Relation, comp}
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	fetchPivotPhases returns the latest row of every phase of the given assets in one
+	query, with grouping info and live review locks. A key without Component matches
+	every component of its asset. With asOf set, rows written later and locks are ignored.
+	A non-empty fields selection only reads those phases.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) fetchPivotPhases(
+	ctx context.Context,
+	project, root string,
+	keys []entity.PivotAssetKey,
+	asOf *time.Time,
+	fields []string,
+) ([]phaseRow, error) {
+	if len(keys) == 0 {
+		return []phaseRow{}, nil
+	}
+
+	// DBA-tuned plan, see queryTuning.go
+	d := dialectOf(r.db)
+	hint := r.tuning.Hint(project, QueryStagePivotPhases).forDialect(d)
+
+	// latest row per phase: t_review_latest, or t_review_info for historical views
+	group2 := pivotGroupColumn("ri", "group_2")
+	group3 := pivotGroupColumn("ri", "group_3")
+	src := latestPhaseSourceFor(hint, "ri",
+		"ri.project, ri.root, ri.group_1, "+group2+", "+group3+", ri.relation, ri.component, ri.phase", asOf)
+
+	// Historical views only see rows written by asOf. Locks are live state, so an
+	// as-of page never shows any.
+	sql, params := reviewquery.PhaseQuery{
+		Scope: reviewquery.Scope{
+			Dialect:   d,
+			Project:   project,
+			Root:      root,
+			Modifiers: hint.selectModifiers(),
+			From:      src.from,
+			Ref:       src.ref,
+			Rank:      src.rank,
+			Live:      src.live,
+			Group2:    group2,
+			Group3:    group3,
+			Rows:      reviewquery.Join(frag(buildAsOfCond("ri", asOf)), frag(buildPhaseFieldCond("ri", fields))),
+		},
+		IDColumn: src.idColumn,
+		Keys:     keys,
+		NoLocks:  asOf != nil,
+	}.Build()
+
+	defer metrics.ObserveQuery(QueryStagePivotPhases, time.Now())
+	var phases []phaseRow
+	if err := r.scanPivot(r.ReadWithContext(ctx, project), QueryStagePivotPhases, project, &phases, sql, params...); err != nil {
+		return nil, err
+	}
+	return phases, nil
+}
+
+// applyPivotPhase copies one latest phase row into its pivot row.
+func applyPivotPhase(ap *AssetPivot, pr phaseRow) {
+	//  -- Add your code here----
+	if pr.Component != nil && *pr.Component != "" {
+		ap.Component = strings.TrimPrefix(*pr.Component, "_")
+	}
+
+	// Grouping info (set once)
+	if ap.LeafGroupName == "" {
+		ap.LeafGroupName = pr.LeafGroupName
+		ap.GroupCategoryPath = pr.GroupCategoryPath
+		ap.TopGroupNode = pr.TopGroupNode
+	}
+
+	if pr.ReviewInfoID != 0 {
+		if ap.ReviewInfoIDs == nil {
+			ap.ReviewInfoIDs = map[string]int32{}
+		}
+		ap.ReviewInfoIDs[strings.ToLower(pr.Phase)] = pr.ReviewInfoID
+	}
+
+	if pr.Studio != nil && *pr.Studio != "" {
+		if ap.Studios == nil {
+			ap.Studios = map[string]string{}
+		}
+		ap.Studios[strings.ToLower(pr.Phase)] = *pr.Studio
+	}
+
+	if pr.LockHolder != nil && pr.LockExpiresAtUTC != nil {
+		if ap.Locks == nil {
+			ap.Locks = map[string]*PivotLock{}
+		}
+		ap.Locks[strings.ToLower(pr.Phase)] = &PivotLock{
+			ReviewInfoID: pr.ReviewInfoID,
+			Holder:       *pr.LockHolder,
+			ExpiresAtUTC: *pr.LockExpiresAtUTC,
+		}
+	}
+
+	switch strings.ToLower(pr.Phase) {
+	case "mdl":
+		ap.MDLWorkStatus = pr.WorkStatus
+		ap.MDLApprovalStatus = pr.ApprovalStatus
+		ap.MDLSubmittedAtUTC = pr.SubmittedAtUTC
+		ap.MDLTake = pr.Take // Added take for MDL
+
+	case "rig":
+		ap.RIGWorkStatus = pr.WorkStatus
+		ap.RIGApprovalStatus = pr.ApprovalStatus
+		ap.RIGSubmittedAtUTC = pr.SubmittedAtUTC
+		ap.RIGTake = pr.Take // Added take for RIG
+
+	case "bld":
+		ap.BLDWorkStatus = pr.WorkStatus
+		ap.BLDApprovalStatus = pr.ApprovalStatus
+		ap.BLDSubmittedAtUTC = pr.SubmittedAtUTC
+		ap.BLDTake = pr.Take // Added take for BLD
+
+	case "dsn":
+		ap.DSNWorkStatus = pr.WorkStatus
+		ap.DSNApprovalStatus = pr.ApprovalStatus
+		ap.DSNSubmittedAtUTC = pr.SubmittedAtUTC
+		ap.DSNTake = pr.Take // Added take for DSN
+
+	case "ldv":
+		ap.LDVWorkStatus = pr.WorkStatus
+		ap.LDVApprovalStatus = pr.ApprovalStatus
+		ap.LDVSubmittedAtUTC = pr.SubmittedAtUTC
+		ap.LDVTake = pr.Take // Added take for LDV
+	}
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	LatestPerPhaseForAssets returns the pivot rows (latest row per phase) of the given
+	assets in one round trip, for detail panes opened on several assets at once.
+	Rows follow the order of keys; a key without Component yields one row per component,
+	ordered by component. Assets without any submission are omitted.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) LatestPerPhaseForAssets(
+	ctx context.Context,
+	project, root string,
+	keys []entity.PivotAssetKey,
+	asOf *time.Time,
+) ([]AssetPivot, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+	if root == "" {
+		root = "assets"
+	}
+	phases, err := r.fetchPivotPhases(ctx, project, root, keys, asOf, nil)
+	if err != nil {
+		return nil, fmt.Errorf("LatestPerPhaseForAssets: %w", err)
+	}
+
+	// position of the first key selecting a row
+	keyIndex := func(pr phaseRow) int {
+		for i, k := range keys {
+			if k.Group1 != pr.Group1 || k.Group2 != pr.Group2 || k.Group3 != pr.Group3 || k.Relation != pr.Relation {
+				continue
+			}
+			if k.Component == nil || *k.Component == pr.assetID().comp {
+				return i
+			}
+		}
+		return len(keys)
+	}
+
+	rows := map[pivotAssetID]*AssetPivot{}
+	index := map[pivotAssetID]int{}
+	var ids []pivotAssetID
+	for _, pr := range phases {
+		id := pr.assetID()
+		ap, ok := rows[id]
+		if !ok {
+			ap = &AssetPivot{
+				Root:      pr.Root,
+				Project:   pr.Project,
+				Group1:    pr.Group1,
+				Group2:    pr.Group2,
+				Group3:    pr.Group3,
+				Relation:  pr.Relation,
+				Component: id.comp,
+			}
+			rows[id] = ap
+			index[id] = keyIndex(pr)
+			ids = append(ids, id)
+		}
+		applyPivotPhase(ap, pr)
+	}
+	sort.SliceStable(ids, func(i, j int) bool {
+		if index[ids[i]] != index[ids[j]] {
+			return index[ids[i]] < index[ids[j]]
+		}
+		return ids[i].comp < ids[j].comp
+	})
+
+	out := make([]AssetPivot, len(ids))
+	for i, id := range ids {
+		out[i] = *rows[id]
+	}
+	return out, nil
+}
+
+/* ──────────────────────────────────────────────────────────────────────────
+	ShotPivot represents a pivoted view of review information for shots, structured to facilitate
+	easy access to phase-specific data. Each ShotPivot contains identifying information (root, project, group1-3, relation, component)
+	and a map of phases to their corresponding data (work status, approval status, submission time, and take).
+───────────────────────────────────────────────────────────────────────────
+*/
+
+type ShotPivot struct {
+	Root      string `json:"root"`
+	Project   string `json:"project"`
+	Group1    string `json:"group_1"`
+	Group2    string `json:"group_2"`
+	Group3    string `json:"group_3"`
+	Relation  string `json:"relation"`
+	Component string `json:"component"`
+
+	Phases map[string]PhaseData `json:"phases"`
+}
+
+type PhaseData struct {
+	WorkStatus     *string    `json:"work_status"`
+	ApprovalStatus *string    `json:"approval_status"`
+	SubmittedAtUTC *time.Time `json:"submitted_at_utc"`
+	Take           *string    `json:"take"`
+}
+
+type shotKeyRow struct {
+	Project   string `gorm:"column:project"`
+	Root      string `gorm:"column:root"`
+	Group1    string `gorm:"column:group_1"`
+	Group2    string `gorm:"column:group_2"`
+	Group3    string `gorm:"column:group_3"`
+	Relation  string `gorm:"column:relation"`
+	Component string `gorm:"column:component"`
+}
+
+type shotPhaseRow struct {
+	Project        string     `gorm:"column:project"`
+	Root           string     `gorm:"column:root"`
+	Group1         string     `gorm:"column:group_1"`
+	Group2         string     `gorm:"column:group_2"`
+	Group3         string     `gorm:"column:group_3"`
+	Relation       string     `gorm:"column:relation"`
+	Component      *string    `gorm:"column:component"`
+	Phase          string     `gorm:"column:phase"`
+	WorkStatus     *string    `gorm:"column:work_status"`
+	ApprovalStatus *string    `gorm:"column:approval_status"`
+	SubmittedAtUTC *time.Time `gorm:"column:submitted_at_utc"`
+	Take           *string    `gorm:"column:take"`
+}
+
+type shotPivotResponse struct {
+	Items       []ShotPivot    `json:"items"`
+	Total       int64          `json:"total"`
+	Page        int            `json:"page"`
+	PerPage     int            `json:"per_page"`
+	GroupCounts map[string]int `json:"group_counts"`
+}
+
+func getStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+var ShotPhases = []string{
+	"lay", "anm", "gnz", "mat", "matnuke",
+	"fx", "cmp", "dsp", "cloth", "cfxhair",
+	"crd", "drw", "rtcgnz", "rtcmat",
+	"rtcmatnuke", "rtcdrw",
+}
+
+func buildPhaseAggregationSQL() string {
+	var sb strings.Builder
+
+	for _, p := range ShotPhases {
+		phase := strings.ToLower(p)
+
+		sb.WriteString(fmt.Sprintf(`
+MAX(CASE WHEN LOWER(phase)='%s' THEN submitted_at_utc END) AS %s_submitted,
+MAX(CASE WHEN LOWER(phase)='%s' THEN work_status END) AS %s_work,
+MAX(CASE WHEN LOWER(phase)='%s' THEN approval_status END) AS %s_appr,
+MAX(CASE WHEN LOWER(phase)='%s' THEN CAST(RIGHT(take,4) AS UNSIGNED) END) AS %s_take,
+`,
+			phase, phase,
+			phase, phase,
+			phase, phase,
+			phase, phase,
+		))
+	}
+	result := strings.TrimRight(sb.String(), ",\n")
+	result = strings.TrimRightFunc(result, func(r rune) bool {
+		return r == ','
+	})
+	return result
+}
+
+func buildShotOrderClause(alias, key, dir string) string {
+	dir = strings.ToUpper(strings.TrimSpace(dir))
+	if dir != "ASC" && dir != "DESC" {
+		dir = "ASC"
+	}
+
+	col := func(c string) string {
+		if alias == "" {
+			return c
+		}
+		return alias + "." + c
+	}
+
+	// Always sort NULLs/empty last, regardless of ASC/DESC
+	nullLast := func(c string) string {
+		return fmt.Sprintf("CASE WHEN %s IS NULL OR %s='' THEN 1 ELSE 0 END", c, c)
+	}
+
+	switch key {
+
+	case "group1_only":
+		return fmt.Sprintf(
+			"%s, LOWER(%s) %s, LOWER(%s), LOWER(%s), LOWER(%s)",
+			nullLast(col("group_1")),
+			col("group_1"), dir,
+			col("group_2"),
+			col("group_3"),
+			col("relation"),
+		)
+
+	case "group2_only":
+		return fmt.Sprintf(
+			"%s, LOWER(%s) %s, LOWER(%s), LOWER(%s), LOWER(%s)",
+			nullLast(col("group_2")),
+			col("group_2"), dir,
+			col("group_1"),
+			col("group_3"),
+			col("relation"),
+		)
+
+	case "group3_only":
+		return fmt.Sprintf(
+			"%s, LOWER(%s) %s, LOWER(%s), LOWER(%s), LOWER(%s)",
+			nullLast(col("group_3")),
+			col("group_3"), dir,
+			col("group_1"),
+			col("group_2"),
+			col("relation"),
+		)
+
+	case "relation_only":
+		return fmt.Sprintf(
+			"%s, LOWER(%s) %s, LOWER(%s), LOWER(%s), LOWER(%s)",
+			nullLast(col("relation")),
+			col("relation"), dir,
+			col("group_1"),
+			col("group_2"),
+			col("group_3"),
+		)
+	}
+
+	// dynamic phase sorting
+	parts := strings.SplitN(key, "_", 2)
+	if len(parts) == 2 {
+		phase := strings.ToLower(parts[0])
+		field := parts[1]
+
+		switch field {
+		case "work", "appr", "take":
+			col := fmt.Sprintf("%s_%s", phase, field)
+			return fmt.Sprintf(
+				"CASE WHEN %s IS NULL OR %s='' THEN 1 ELSE 0 END, %s %s",
+				col, col, col, dir,
+			)
+		case "submitted":
+			col := fmt.Sprintf("%s_%s", phase, field)
+			return fmt.Sprintf(
+				"CASE WHEN %s IS NULL THEN 1 ELSE 0 END, CAST(%s AS DATETIME) %s",
+				col, col, dir,
+			)
+		}
+	}
+
+	// default fallback
+	return fmt.Sprintf(
+		"%s, LOWER(%s), LOWER(%s), LOWER(%s), LOWER(%s)",
+		nullLast(col("group_1")),
+		col("group_1"),
+		col("group_2"),
+		col("group_3"),
+		col("relation"),
+	)
+}
+
+// buildShotStatusHaving builds a HAVING clause that filters aggregated shot rows.
+// Because status values are spread across pivot columns (lay_appr, anm_appr, ...),
+// we use OR across all phase columns so a shot is included if ANY phase matches.
+func buildShotStatusHaving(approvalStatuses, workStatuses []string) (string, []any) {
+	if len(approvalStatuses) == 0 && len(workStatuses) == 0 {
+		return "", nil
+	}
+
+	var clauses []string
+	var args []any
+
+	// approval: OR across every phase's _appr column
+	if len(approvalStatuses) > 0 {
+		ph := strings.TrimRight(strings.Repeat("?,", len(approvalStatuses)), ",")
+		vals := make([]any, len(approvalStatuses))
+		for i, v := range approvalStatuses {
+			vals[i] = strings.ToLower(strings.TrimSpace(v))
+		}
+		var apprCols []string
+		for _, p := range ShotPhases {
+			col := strings.ToLower(p) + "_appr"
+			apprCols = append(apprCols, fmt.Sprintf("LOWER(%s) IN (%s)", col, ph))
+			args = append(args, vals...)
+		}
+		clauses = append(clauses, "("+strings.Join(apprCols, " OR ")+")")
+	}
+
+	// work: OR across every phase's _work column
+	if len(workStatuses) > 0 {
+		ph := strings.TrimRight(strings.Repeat("?,", len(workStatuses)), ",")
+		vals := make([]any, len(workStatuses))
+		for i, v := range workStatuses {
+			vals[i] = strings.ToLower(strings.TrimSpace(v))
+		}
+		var workCols []string
+		for _, p := range ShotPhases {
+			col := strings.ToLower(p) + "_work"
+			workCols = append(workCols, fmt.Sprintf("LOWER(%s) IN (%s)", col, ph))
+			args = append(args, vals...)
+		}
+		clauses = append(clauses, "("+strings.Join(workCols, " OR ")+")")
+	}
+
+	return " HAVING " + strings.Join(clauses, " AND "), args
+}
+
+func (r *ReviewInfo) CountShotSubmissions(
+	ctx context.Context,
+	project string,
+	shotNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	group1Filter []string,
+) (int64, error) {
+
+	aggSQL := buildPhaseAggregationSQL()
+	havingClause, havingArgs := buildShotStatusHaving(approvalStatuses, workStatuses)
+
+	// Build group1 filter condition
+	group1Cond := ""
+	var group1Args []any
+	if len(group1Filter) > 0 {
+		placeholders := make([]string, len(group1Filter))
+		for i := range group1Filter {
+			placeholders[i] = "?"
+			group1Args = append(group1Args, group1Filter[i])
+		}
+		group1Cond = " AND group_1 IN (" + strings.Join(placeholders, ",") + ")"
+	}
+
+	// name search
+	nameCond := ""
+	var nameArgs []any
+	if strings.TrimSpace(shotNameKey) != "" {
+		like := "%" + strings.ToLower(strings.TrimSpace(shotNameKey)) + "%"
+		nameCond = ` AND (
+            LOWER(group_1) LIKE ? OR
+            LOWER(group_2) LIKE ? OR
+            LOWER(group_3) LIKE ? OR
+            LOWER(relation) LIKE ?
+        )`
+		nameArgs = []any{like, like, like, like}
+	}
+
+	query := `
+WITH latest_phase AS (
+  SELECT *,
+    ROW_NUMBER() OVER (
+      PARTITION BY project, root, group_1, group_2, group_3, relation, component, phase
+      ORDER BY modified_at_utc DESC
+    ) rn
+  FROM t_review_info
+  WHERE project = ? AND root='shots' AND deleted=0` + nameCond + group1Cond + `
+),
+filtered AS (
+  SELECT * FROM latest_phase WHERE rn=1
+),
+aggregated AS (
+  SELECT
+    group_1, group_2, group_3, relation,
+    COALESCE(component,'') AS component,
+    ` + aggSQL + `
+  FROM filtered
+  GROUP BY group_1, group_2, group_3, relation, component` + havingClause + `
+)
+SELECT COUNT(*) FROM aggregated;
+`
+
+	args := []any{project}
+	args = append(args, nameArgs...)
+	args = append(args, group1Args...)
+	args = append(args, havingArgs...)
+
+	var total int64
+	err := r.db.WithContext(ctx).Raw(query, args...).Scan(&total).Error
+	return total, err
+}
+
+func (r *ReviewInfo) ListLatestShotsDynamic(
+	ctx context.Context,
+	project, orderKey, direction string,
+	limit, offset int,
+	shotNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	group1Filter []string,
+) ([]shotKeyRow, error) {
+
+	aggSQL := buildPhaseAggregationSQL()
+	orderClause := buildShotOrderClause("", orderKey, direction)
+	havingClause, havingArgs := buildShotStatusHaving(approvalStatuses, workStatuses)
+
+	// Build group1 filter condition
+	group1Cond := ""
+	var group1Args []any
+	if len(group1Filter) > 0 {
+		placeholders := make([]string, len(group1Filter))
+		for i := range group1Filter {
+			placeholders[i] = "?"
+			group1Args = append(group1Args, group1Filter[i])
+		}
+		group1Cond = " AND group_1 IN (" + strings.Join(placeholders, ",") + ")"
+	}
+
+	// name search
+	nameCond := ""
+	var nameArgs []any
+	if strings.TrimSpace(shotNameKey) != "" {
+		like := "%" + strings.ToLower(strings.TrimSpace(shotNameKey)) + "%"
+		nameCond = ` AND (
+            LOWER(group_1) LIKE ? OR
+            LOWER(group_2) LIKE ? OR
+            LOWER(group_3) LIKE ? OR
+            LOWER(relation) LIKE ?
+        )`
+		nameArgs = []any{like, like, like, like}
+	}
+
+	query := `
+WITH latest_phase AS (
+  SELECT *,
+    ROW_NUMBER() OVER (
+      PARTITION BY project, root, group_1, group_2, group_3, relation, component, phase
+      ORDER BY modified_at_utc DESC
+    ) rn
+  FROM t_review_info
+  WHERE project = ? AND root='shots' AND deleted=0` + nameCond + group1Cond + `
+),
+filtered AS (
+  SELECT * FROM latest_phase WHERE rn=1
+),
+aggregated AS (
+  SELECT
+    project,
+    root,
+    group_1,
+    group_2,
+    group_3,
+    relation,
+    COALESCE(component,'') AS component,
+    ` + aggSQL + `
+  FROM filtered
+  GROUP BY project, root, group_1, group_2, group_3, relation, component` + havingClause + `
+)
+SELECT project, root, group_1, group_2, group_3, relation, component
+FROM aggregated
+ORDER BY ` + orderClause + `
+LIMIT ? OFFSET ?
+`
+
+	args := []any{project}
+	args = append(args, nameArgs...)
+	args = append(args, group1Args...)
+	args = append(args, havingArgs...)
+	args = append(args, limit, offset)
+
+	var rows []shotKeyRow
+	err := r.db.WithContext(ctx).
+		Raw(query, args...).
+		Scan(&rows).Error
+
+	return rows, err
+}
+
+func (r *ReviewInfo) ListShotsPivot(
+	ctx context.Context,
+	project, orderKey, direction string,
+	limit, offset int,
+	shotNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	group1Filter []string,
+
+) ([]ShotPivot, int64, map[string]int, error) {
+
+	total, err := r.CountShotSubmissions(ctx, project, shotNameKey, approvalStatuses, workStatuses, group1Filter)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	keys, err := r.ListLatestShotsDynamic(ctx, project, orderKey, direction, limit, offset, shotNameKey, approvalStatuses, workStatuses, group1Filter)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	if len(keys) == 0 {
+		return []ShotPivot{}, total, nil, nil
+	}
+
+	var sb strings.Builder
+	var args []any
+
+	sb.WriteString(`
+SELECT
+  project, root,
+  group_1, group_2, group_3,
+  relation, component,
+  phase, work_status, approval_status,
+  submitted_at_utc,
+  RIGHT(take,4) AS take
+FROM t_review_info
+WHERE project=? AND root='shots' AND deleted=0 AND (
+`)
+	args = append(args, project)
+
+	for i, k := range keys {
+		if i > 0 {
+			sb.WriteString(" OR ")
+		}
+		sb.WriteString("(group_1=? AND group_2=? AND group_3=? AND relation=? AND COALESCE(component,'')=?)")
+		args = append(args, k.Group1, k.Group2, k.Group3, k.Relation, k.Component)
+	}
+
+	sb.WriteString(")")
+
+	var rows []shotPhaseRow
+	if err := r.db.WithContext(ctx).
+		Raw(sb.String(), args...).
+		Scan(&rows).Error; err != nil {
+		return nil, 0, nil, err
+	}
+
+	type key struct {
+		p, r, g1, g2, g3, rel, comp string
+	}
+
+	m := map[key]*ShotPivot{}
+	order := []*ShotPivot{}
+
+	for _, k := range keys {
+		id := key{k.Project, k.Root, k.Group1, k.Group2, k.Group3, k.Relation, k.Component}
+
+		sp := &ShotPivot{
+			Project:   k.Project,
+			Root:      k.Root,
+			Group1:    k.Group1,
+			Group2:    k.Group2,
+			Group3:    k.Group3,
+			Relation:  k.Relation,
+			Component: k.Component,
+			Phases:    map[string]PhaseData{},
+		}
+
+		m[id] = sp
+		order = append(order, sp)
+	}
+
+	for _, r := range rows {
+		id := key{r.Project, r.Root, r.Group1, r.Group2, r.Group3, r.Relation, getStr(r.Component)}
+
+		if sp, ok := m[id]; ok {
+			sp.Phases[strings.ToLower(r.Phase)] = PhaseData{
+				WorkStatus:     r.WorkStatus,
+				ApprovalStatus: r.ApprovalStatus,
+				SubmittedAtUTC: r.SubmittedAtUTC,
+				Take:           r.Take,
+			}
+		}
+	}
+
+	final := make([]ShotPivot, len(order))
+	for i, v := range order {
+		final[i] = *v
+	}
+
+	// group counts — always unfiltered so the filter menu shows all groups
+	groupCountQuery := `
+		SELECT group_1, COUNT(DISTINCT CONCAT(group_1, group_2, group_3, relation, COALESCE(component, ''))) AS cnt
+		FROM t_review_info
+		WHERE project = ? AND root = 'shots' AND deleted = 0
+		GROUP BY group_1
+	`
+
+	type groupCountRow struct {
+		Group1 string `gorm:"column:group_1"`
+		Count  int    `gorm:"column:cnt"`
+	}
+
+	var groupCountRows []groupCountRow
+	if err := r.db.WithContext(ctx).
+		Raw(groupCountQuery, project).
+		Scan(&groupCountRows).Error; err != nil {
+		return nil, 0, nil, err
+	}
+
+	groupCounts := make(map[string]int)
+	for _, gc := range groupCountRows {
+		groupCounts[gc.Group1] = gc.Count
+	}
+
+	return final, total, groupCounts, nil
+}
+
+/* ──────────────────────────────────────────────────────────────────────────
+	Review-Queue Shots ("check" status) — new functions
+
+	These implement the standalone "new shots query" (query_reviews.sql) and its
+	companion count (count_reviews.sql). They list the latest review row per
+	(group_1, group_2, group_3, relation, phase) for shots whose work_status OR
+	approval_status is in the requested status set, restricted to a fixed phase
+	whitelist, paginated at the shot-group level, and pivoted into ShotPivot.
+
+	Differences vs ListShotsPivot:
+	- No `component` in the grouping / pivot key.
+	- NULL-safe matching on group_2 / group_3 (uses <=>).
+	- Phase whitelist + status filter are applied at the source rows (WHERE),
+	  not as a HAVING over pivot columns.
+	- `take` is parsed with SUBSTRING_INDEX(take, 't', -1) (text after last 't').
+
+	Functions:
+	* - CountReviewShots: Counts unique review-queue shot groups (count_reviews.sql).
+	* - ListReviewShots: Lists the paged latest per-phase review rows (query_reviews.sql).
+	* - ListReviewShotsPivot: Orchestrates count + list and pivots into []ShotPivot.
+   ────────────────────────────────────────────────────────────────────────── */
+
+// ReviewShotPhases is the default phase whitelist used by the review-queue query.
+// MySQL's default collation is case-insensitive, so the mixed-case values match
+// regardless of how phases are stored.
+var ReviewShotPhases = []string{
+	"lay", "anm", "cmp", "mat", "cloth", "snd", "rel", "gnz", "dsp",
+	"cfxCloth", "crd", "drw", "rtcgnz", "rtcmat", "rtcMatNuke", "rtcDrw",
+}
+
+// ReviewShotStatuses is the default status set. A shot row qualifies when its
+// work_status OR approval_status is in this set.
+var ReviewShotStatuses = []string{"check"}
+
+// reviewShotPhaseRow is a single latest-per-phase review row returned by
+// ListReviewShots. group_2 / group_3 are nullable, mirroring the <=> matching
+// in the source query.
+type reviewShotPhaseRow struct {
+	Project        string     `gorm:"column:project"`
+	Root           string     `gorm:"column:root"`
+	Group1         string     `gorm:"column:group_1"`
+	Group2         *string    `gorm:"column:group_2"`
+	Group3         *string    `gorm:"column:group_3"`
+	Relation       string     `gorm:"column:relation"`
+	Phase          string     `gorm:"column:phase"`
+	WorkStatus     *string    `gorm:"column:work_status"`
+	ApprovalStatus *string    `gorm:"column:approval_status"`
+	SubmittedAtUTC *time.Time `gorm:"column:submitted_at_utc"`
+	Take           *string    `gorm:"column:take"`
+}
+
+// buildReviewShotPhaseIn builds a `phase IN (?, ?, ...)` clause and its args.
+// Falls back to ReviewShotPhases when no phases are supplied.
+func buildReviewShotPhaseIn(phases []string) (string, []any) {
+	if len(phases) == 0 {
+		phases = ReviewShotPhases
+	}
+	ph := strings.TrimRight(strings.Repeat("?,", len(phases)), ",")
+	args := make([]any, len(phases))
+	for i, p := range phases {
+		args[i] = p
+	}
+	return "phase IN (" + ph + ")", args
+}
+
+// buildReviewShotStatusWhere builds `(work_status IN (...) OR approval_status IN (...))`.
+// The same status set is applied to both columns, so its values are returned twice
+// (work first, then approval) to match the placeholder order. Falls back to
+// ReviewShotStatuses when none are supplied.
+func buildReviewShotStatusWhere(statuses []string) (string, []any) {
+	if len(statuses) == 0 {
+		statuses = ReviewShotStatuses
+	}
+	ph := strings.TrimRight(strings.Repeat("?,", len(statuses)), ",")
+	args := make([]any, 0, len(statuses)*2)
+	for _, s := range statuses { // work_status IN (...)
+		args = append(args, s)
+	}
+	for _, s := range statuses { // approval_status IN (...)
+		args = append(args, s)
+	}
+	clause := fmt.Sprintf("(work_status IN (%s) OR approval_status IN (%s))", ph, ph)
+	return clause, args
+}
+
+// buildReviewShotSort builds the per-group ordering for the review-queue pivot.
+// Groups are the unit of pagination, so the sort is applied to aggregated group
+// rows (grp_agg), not to individual phase rows.
+//
+// Returns:
+//   - sortSelect: an extra aggregate column for grp_agg aliased _sort_val
+//     (e.g. the requested phase's take/status/submitted), or "" when sorting
+//     purely by group columns.
+//   - sortArgs: bind args for sortSelect (the phase name), or nil.
+//   - groupOrder: the ORDER BY expression (referenced by both the _group_rank
+//     window and the LIMIT/OFFSET ordering in unique_groups).
+//
+// Supported orderKey values:
+//   - "group1_only"/"group2_only"/"group3_only"/"relation_only" (or the bare
+//     column names) — sort by that group column, NULL/empty last.
+//   - "<phase>_work" / "<phase>_appr" / "<phase>_take" / "<phase>_submitted" —
+//     sort groups by that phase's value (e.g. "anm_take", "lay_submitted").
+//
+// dir is "ASC"/"DESC" (already normalized by the caller). NULL/empty values
+// always sort last regardless of direction.
+func buildReviewShotSort(orderKey, dir string) (sortSelect string, sortArgs []any, groupOrder string) {
+	// stable, grouped tiebreaker appended to every ordering
+	groupTie := "group_1 ASC, group_2 ASC, group_3 ASC, relation ASC, _min_phase_order ASC"
+
+	defaultOrder := "group_1 IS NULL, group_1 " + dir + ", group_2 ASC, group_3 ASC, relation ASC, _min_phase_order ASC"
+
+	switch orderKey {
+	case "", "group1_only", "group_1":
+		return "", nil, defaultOrder
+	case "group2_only", "group_2":
+		return "", nil, "(group_2 IS NULL OR group_2 = '') ASC, group_2 " + dir +
+			", group_1 ASC, group_3 ASC, relation ASC, _min_phase_order ASC"
+	case "group3_only", "group_3":
+		return "", nil, "(group_3 IS NULL OR group_3 = '') ASC, group_3 " + dir +
+			", group_1 ASC, group_2 ASC, relation ASC, _min_phase_order ASC"
+	case "relation_only", "relation":
+		return "", nil, "(relation IS NULL OR relation = '') ASC, relation " + dir +
+			", group_1 ASC, group_2 ASC, group_3 ASC, _min_phase_order ASC"
+	}
+
+	// phase-specific: "<phase>_<field>"
+	parts := strings.SplitN(orderKey, "_", 2)
+	if len(parts) == 2 {
+		phase := strings.ToLower(strings.TrimSpace(parts[0]))
+		switch parts[1] {
+		case "work":
+			return "MAX(CASE WHEN LOWER(phase) = ? THEN work_status END) AS _sort_val",
+				[]any{phase},
+				"(_sort_val IS NULL OR _sort_val = '') ASC, LOWER(_sort_val) " + dir + ", " + groupTie
+		case "appr":
+			return "MAX(CASE WHEN LOWER(phase) = ? THEN approval_status END) AS _sort_val",
+				[]any{phase},
+				"(_sort_val IS NULL OR _sort_val = '') ASC, LOWER(_sort_val) " + dir + ", " + groupTie
+		case "take":
+			// take is already parsed to its numeric tail by SUBSTRING_INDEX upstream
+			return "MAX(CASE WHEN LOWER(phase) = ? THEN CAST(NULLIF(take, '') AS UNSIGNED) END) AS _sort_val",
+				[]any{phase},
+				"(_sort_val IS NULL) ASC, _sort_val " + dir + ", " + groupTie
+		case "submitted":
+			return "MAX(CASE WHEN LOWER(phase) = ? THEN submitted_at_utc END) AS _sort_val",
+				[]any{phase},
+				"(_sort_val IS NULL) ASC, _sort_val " + dir + ", " + groupTie
+		}
+	}
+
+	// unrecognized key -> default group ordering
+	return "", nil, defaultOrder
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	CountReviewShots implements count_reviews.sql: the number of unique shot
+	groups that have at least one phase (in the whitelist) whose work_status OR
+	approval_status is in the requested set.
+
+	The GROUP BY matches the pagination unit in ListReviewShots
+	(project, root, group_1, group_2, group_3, relation), so this total lines up
+	exactly with the number of pageable groups (the footer's "of N").
+
+	Parameters:
+	- ctx:      Context for database operations.
+	- project:  Project identifier (required).
+	- phases:   Phase whitelist; defaults to ReviewShotPhases when empty.
+	- statuses: Status set applied to work_status OR approval_status; defaults to ReviewShotStatuses.
+
+	Returns the total count, or an error if project is missing or the query fails.
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) CountReviewShots(
+	ctx context.Context,
+	project string,
+	phases []string,
+	statuses []string,
+) (int64, error) {
+	if project == "" {
+		return 0, fmt.Errorf("project is required")
+	}
+
+	phaseClause, phaseArgs := buildReviewShotPhaseIn(phases)
+	statusClause, statusArgs := buildReviewShotStatusWhere(statuses)
+
+	query := `
+WITH unique_groups AS (
+    SELECT project, root, group_1, group_2, group_3, relation
+    FROM t_review_info
+    WHERE project = ? AND root = 'shots' AND deleted = 0
+      AND ` + phaseClause + `
+      AND ` + statusClause + `
+    GROUP BY project, root, group_1, group_2, group_3, relation
+)
+SELECT COUNT(*) FROM unique_groups;
+`
+
+	args := []any{project}
+	args = append(args, phaseArgs...)
+	args = append(args, statusArgs...)
+
+	var total int64
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&total).Error; err != nil {
+		return 0, fmt.Errorf("CountReviewShots: %w", err)
+	}
+	return total, nil
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	ListReviewShots implements query_reviews.sql. For shots matching the phase
+	whitelist and status set, it keeps the latest review row per
+	(group_1, group_2, group_3, relation, phase) (by modified_at_utc), pages the
+	resulting shot groups, and returns every kept phase row for the paged groups.
+
+	The result is ordered by group (group_1 dir, group_2, group_3, relation) and
+	then by the original phase-order key, so the caller can pivot rows in a
+	stable, page-consistent order.
+
+	Parameters:
+	- ctx:       Context for database operations.
+	- project:   Project identifier (required).
+	- phases:    Phase whitelist; defaults to ReviewShotPhases when empty.
+	- statuses:  Status set applied to work_status OR approval_status; defaults to ReviewShotStatuses.
+	- direction: Sort direction for group_1 ("ASC"/"DESC"); defaults to "ASC".
+	- limit:     Max number of shot groups; defaults to 15 when <= 0.
+	- offset:    Number of shot groups to skip; defaults to 0 when < 0.
+
+	Returns the per-phase rows for the paged groups, or an error.
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) ListReviewShots(
+	ctx context.Context,
+	project string,
+	phases []string,
+	statuses []string,
+	orderKey string,
+	direction string,
+	limit, offset int,
+) ([]reviewShotPhaseRow, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+	if limit <= 0 {
+		limit = 15
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	dir := strings.ToUpper(strings.TrimSpace(direction))
+	if dir != "ASC" && dir != "DESC" {
+		dir = "ASC"
+	}
+
+	phaseClause, phaseArgs := buildReviewShotPhaseIn(phases)
+	statusClause, statusArgs := buildReviewShotStatusWhere(statuses)
+
+	// Per-group sort: optional aggregate sort column + the group ORDER BY.
+	sortSelect, sortArgs, groupOrder := buildReviewShotSort(orderKey, dir)
+	sortCol := ""
+	if sortSelect != "" {
+		sortCol = ",\n           " + sortSelect
+	}
+
+	// The phase/status filters appear in both the MAX() subquery (a) and the
+	// detail subquery (b), so their args are supplied twice (see args assembly).
+	query := `
+WITH latest_reviews AS (
+    SELECT b.*
+    FROM (
+        SELECT project, root, group_1, group_2, group_3, relation, phase,
+               MAX(modified_at_utc) AS modified_at_utc
+        FROM t_review_info
+        WHERE project = ? AND root = 'shots' AND deleted = 0
+          AND ` + phaseClause + `
+          AND ` + statusClause + `
+        GROUP BY project, root, group_1, group_2, group_3, relation, phase
+    ) a
+    LEFT JOIN (
+        SELECT project, root, group_1, group_2, group_3, relation, phase,
+               work_status, approval_status, submitted_at_utc, modified_at_utc,
+               SUBSTRING_INDEX(take, 't', -1) AS take
+        FROM t_review_info
+        WHERE project = ? AND root = 'shots' AND deleted = 0
+          AND ` + phaseClause + `
+          AND ` + statusClause + `
+    ) b
+      ON  a.project = b.project
+      AND a.root    = b.root
+      AND a.group_1 = b.group_1
+      AND a.group_2 <=> b.group_2
+      AND a.group_3 <=> b.group_3
+      AND a.relation = b.relation
+      AND a.phase    = b.phase
+      AND a.modified_at_utc = b.modified_at_utc
+),
+latest_reviews_sort AS (
+    SELECT *, ROW_NUMBER() OVER (ORDER BY group_1 ASC) AS _sort_order
+    FROM latest_reviews
+),
+latest_reviews_phase_sort AS (
+    SELECT *,
+        CASE WHEN phase = '' THEN _sort_order ELSE 99999999 + _sort_order END AS _phase_order
+    FROM latest_reviews_sort
+),
+grp_agg AS (
+    SELECT project, root, group_1, group_2, group_3, relation,
+           MIN(_phase_order) AS _min_phase_order` + sortCol + `
+    FROM latest_reviews_phase_sort
+    GROUP BY project, root, group_1, group_2, group_3, relation
+),
+unique_groups AS (
+    SELECT *, ROW_NUMBER() OVER (ORDER BY ` + groupOrder + `) AS _group_rank
+    FROM grp_agg
+    ORDER BY ` + groupOrder + `
+    LIMIT ? OFFSET ?
+),
+all_phase_reviews AS (
+    SELECT r.*, ug._group_rank
+    FROM latest_reviews_phase_sort r
+    RIGHT JOIN unique_groups ug
+      ON  r.group_1 = ug.group_1
+      AND r.group_2 <=> ug.group_2
+      AND r.group_3 <=> ug.group_3
+      AND r.relation = ug.relation
+)
+SELECT project, root, group_1, group_2, group_3, relation, phase,
+       work_status, approval_status, submitted_at_utc, take
+FROM all_phase_reviews
+ORDER BY _group_rank ASC, _phase_order ASC;
+`
+
+	args := []any{project}
+	args = append(args, phaseArgs...)
+	args = append(args, statusArgs...)
+	args = append(args, project)
+	args = append(args, phaseArgs...)
+	args = append(args, statusArgs...)
+	args = append(args, sortArgs...) // grp_agg sort column (0 or 1 arg)
+	args = append(args, limit, offset)
+
+	var rows []reviewShotPhaseRow
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("ListReviewShots: %w", err)
+	}
+	return rows, nil
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	ListReviewShotsPivot orchestrates CountReviewShots + ListReviewShots and
+	pivots the per-phase rows into one ShotPivot per shot group, preserving the
+	paged group order returned by ListReviewShots. Each phase is keyed by its
+	lowercase name in ShotPivot.Phases. Component is left empty because the
+	review-queue query does not group by component.
+
+	Returns the page of ShotPivot rows, the total group count, or an error.
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) ListReviewShotsPivot(
+	ctx context.Context,
+	project string,
+	phases []string,
+	statuses []string,
+	orderKey string,
+	direction string,
+	limit, offset int,
+) ([]ShotPivot, []string, int64, error) {
+	if project == "" {
+		return nil, nil, 0, fmt.Errorf("project is required")
+	}
+
+	// Resolve the phase set dynamically from live data when the caller did not
+	// pass an explicit subset, instead of relying on a hardcoded list. An empty
+	// result simply yields an empty page.
+	if len(phases) == 0 {
+		dyn, err := r.ListReviewShotPhases(ctx, project, statuses)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		phases = dyn
+	}
+
+	total, err := r.CountReviewShots(ctx, project, phases, statuses)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	rows, err := r.ListReviewShots(ctx, project, phases, statuses, orderKey, direction, limit, offset)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if len(rows) == 0 {
+		return []ShotPivot{}, phases, total, nil
+	}
+
+	type key struct {
+		project, root, g1, g2, g3, rel string
+	}
+
+	m := make(map[key]*ShotPivot, len(rows))
+	order := make([]*ShotPivot, 0, len(rows))
+
+	for _, row := range rows {
+		g2 := getStr(row.Group2)
+		g3 := getStr(row.Group3)
+		id := key{row.Project, row.Root, row.Group1, g2, g3, row.Relation}
+
+		sp, ok := m[id]
+		if !ok {
+			sp = &ShotPivot{
+				Project:  row.Project,
+				Root:     row.Root,
+				Group1:   row.Group1,
+				Group2:   g2,
+				Group3:   g3,
+				Relation: row.Relation,
+				Phases:   map[string]PhaseData{},
+			}
+			m[id] = sp
+			order = append(order, sp)
+		}
+
+		sp.Phases[strings.ToLower(row.Phase)] = PhaseData{
+			WorkStatus:     row.WorkStatus,
+			ApprovalStatus: row.ApprovalStatus,
+			SubmittedAtUTC: row.SubmittedAtUTC,
+			Take:           row.Take,
+		}
+	}
+
+	final := make([]ShotPivot, len(order))
+	for i, sp := range order {
+		final[i] = *sp
+	}
+	return final, phases, total, nil
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	ListReviewShotPhases returns the distinct phases that currently have at
+	least one shot whose work_status OR approval_status is in the requested set,
+	for the given project. This is the dynamic replacement for the hardcoded
+	ReviewShotPhases list — the phase set is derived from live data instead of
+	being maintained in code. Phases are returned alphabetically; if you need a
+	canonical pipeline ordering (e.g. lay → anm → cmp ...), source the list from
+	project pipeline settings instead and pass it in explicitly.
+
+	Parameters:
+	- ctx:      Context for database operations.
+	- project:  Project identifier (required).
+	- statuses: Status set applied to work_status OR approval_status; defaults to ReviewShotStatuses.
+
+	Returns the distinct phase names, or an error.
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) ListReviewShotPhases(
+	ctx context.Context,
+	project string,
+	statuses []string,
+) ([]string, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+
+	statusClause, statusArgs := buildReviewShotStatusWhere(statuses)
+
+	query := `
+SELECT DISTINCT phase
+FROM t_review_info
+WHERE project = ? AND root = 'shots' AND deleted = 0
+  AND phase IS NOT NULL AND phase <> ''
+  AND ` + statusClause + `
+ORDER BY phase;
+`
+
+	args := []any{project}
+	args = append(args, statusArgs...)
+
+	var rows []struct {
+		Phase string `gorm:"column:phase"`
+	}
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("ListReviewShotPhases: %w", err)
+	}
+
+	phases := make([]string, 0, len(rows))
+	for _, row := range rows {
+		phases = append(phases, row.Phase)
+	}
+	return phases, nil
+}
+
+// ReviewShotGroupCount is one (group_1, group_2) bucket with its shot count,
+// used to build the group-view tree totals server-side (so the client no longer
+// needs to load every shot just to compute "DRK101 (4/13)" style totals).
+type ReviewShotGroupCount struct {
+	Group1    string `gorm:"column:group_1" json:"group_1"`
+	Group2    string `gorm:"column:group_2" json:"group_2"`
+	ShotCount int    `gorm:"column:shot_count" json:"shot_count"`
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	ListReviewShotGroupCounts returns, per (group_1, group_2), the number of
+	distinct review-queue shots (group_1, group_2, group_3, relation) under it.
+	From this the client can derive:
+	  - per group_1: total shots and number of group_2s,
+	  - per group_1/group_2: shot count,
+	  - the group_1 filter-menu counts,
+	without fetching any shot rows. This lets group view paginate per page
+	instead of loading the entire data set.
+
+	Parameters mirror the pivot: project (required), phases (defaults to the
+	dynamic set when empty), statuses (defaults to ReviewShotStatuses).
+
+──────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) ListReviewShotGroupCounts(
+	ctx context.Context,
+	project string,
+	phases []string,
+	statuses []string,
+) ([]ReviewShotGroupCount, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+
+	// Resolve the phase set dynamically when none supplied, matching the pivot.
+	if len(phases) == 0 {
+		dyn, err := r.ListReviewShotPhases(ctx, project, statuses)
+		if err != nil {
+			return nil, err
+		}
+		phases = dyn
+	}
+
+	phaseClause, phaseArgs := buildReviewShotPhaseIn(phases)
+	statusClause, statusArgs := buildReviewShotStatusWhere(statuses)
+
+	query := `
+WITH groups AS (
+    SELECT DISTINCT project, root, group_1, group_2, group_3, relation
+    FROM t_review_info
+    WHERE project = ? AND root = 'shots' AND deleted = 0
+      AND ` + phaseClause + `
+      AND ` + statusClause + `
+)
+SELECT group_1, COALESCE(group_2, '') AS group_2, COUNT(*) AS shot_count
+FROM groups
+GROUP BY group_1, group_2
+ORDER BY group_1, group_2;
+`
+
+	args := []any{project}
+	args = append(args, phaseArgs...)
+	args = append(args, statusArgs...)
+
+	var rows []ReviewShotGroupCount
+	if err := r.db.WithContext(ctx).Raw(query, args...).Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("ListReviewShotGroupCounts: %w", err)
+	}
+	return rows, nil
+}
@@ -0,0 +1,125 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoAttention.go
+
+	Module Description:
+		attention_score of asset pivot rows, the "what needs me most" triage order.
+
+	Details:
+	- attention_score = Retakes         * retakes ever given to the asset
+	                  + StaleDownstream * phases submitted before their upstream phase's
+	                                      latest submission
+	                  + TimeInStatus    * days the longest-waiting unapproved phase has
+	                                      sat unchanged
+	  with the weights of entity.AttentionWeights (per project, see PivotDefaults).
+	- Every ListLatestSubmissionsDynamic row carries the score; sort=attention orders by it.
+	- Time in status is measured at one reference time per listing (asOf when set), which
+	  the page cursor carries so later pages score against the same moment.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Key the signals by group_2 and group_3 (see pivotGroupColumn).
+	* - 15-10-2026 - Build the time in status and the rounding in the SQL dialect.
+
+	Functions:
+	* - buildAttentionCTEs: CTEs computing the per-asset attention signals.
+	* - buildAttentionScore: Select expression combining the signals with weights.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+// AttentionOrderKey sorts the asset pivot by attention_score.
+const AttentionOrderKey = "attention"
+
+const (
+	attentionRetakeStatus  = "retake"
+	attentionSettledStatus = "approved"
+)
+
+// attentionUpstreamPhases maps a phase to the phase its work is built from. A phase whose
+// latest submission predates its upstream's latest submission is stale.
+var attentionUpstreamPhases = map[string]string{
+	"MDL": "DSN",
+	"RIG": "MDL",
+	"BLD": "MDL",
+	"LDV": "MDL",
+}
+
+// buildAttentionCTEs returns the attention_retakes and attention_signals CTEs (each
+// followed by a comma) for the assets of the `ordered` CTE of ListLatestSubmissionsDynamic.
+func buildAttentionCTEs(d reviewquery.Dialect, project, root string, asOf *time.Time, scoredAt time.Time) (string, []any) {
+	asOfCond, asOfArgs := buildAsOfCond("", asOf)
+
+	phases := make([]string, 0, len(attentionUpstreamPhases))
+	for phase := range attentionUpstreamPhases {
+		phases = append(phases, phase)
+	}
+	sort.Strings(phases)
+	pairs := make([]string, len(phases))
+	for i, phase := range phases {
+		pairs[i] = "(UPPER(o.phase) = '" + phase + "' AND UPPER(up.phase) = '" + attentionUpstreamPhases[phase] + "')"
+	}
+
+	group2 := pivotGroupColumn("", "group_2")
+	group3 := pivotGroupColumn("", "group_3")
+
+	sql := `
+attention_retakes AS (
+  SELECT project, root, group_1, ` + group2 + ` AS group_2, ` + group3 + ` AS group_3,
+    relation, COUNT(*) AS retakes
+  FROM t_review_info
+  WHERE project = ? AND root = ? AND deleted = 0
+    AND LOWER(approval_status) = ?` + asOfCond + `
+  GROUP BY project, root, group_1, ` + group2 + `, ` + group3 + `, relation
+),
+attention_signals AS (
+  SELECT
+    o.project,
+    o.root,
+    o.group_1,
+    o.group_2,
+    o.group_3,
+    o.relation,
+    MAX(
+      CASE
+        WHEN LOWER(COALESCE(o.approval_status, '')) = ? THEN 0
+        ELSE ` + d.Greatest(d.SecondsBetween("o.modified_at_utc", "?"), "0") + `
+      END
+    ) / 86400 AS days_in_status,
+    COUNT(DISTINCT CASE WHEN up.submitted_at_utc > o.submitted_at_utc THEN o.phase END) AS stale_downstream
+  FROM ordered AS o
+  LEFT JOIN ordered AS up
+    ON up.project = o.project
+   AND up.root = o.root
+   AND up.group_1 = o.group_1
+   AND up.group_2 = o.group_2
+   AND up.group_3 = o.group_3
+   AND up.relation = o.relation
+   AND (` + strings.Join(pairs, "\n     OR ") + `)
+  GROUP BY o.project, o.root, o.group_1, o.group_2, o.group_3, o.relation
+),`
+
+	args := []any{project, root, attentionRetakeStatus}
+	args = append(args, asOfArgs...)
+	args = append(args, attentionSettledStatus, scoredAt.UTC())
+	return sql, args
+}
+
+// buildAttentionScore returns the attention_score expression over the attention_retakes
+// (ar) and attention_signals (sg) joins. Rounding keeps the value stable in cursors.
+func buildAttentionScore(d reviewquery.Dialect, w entity.AttentionWeights) (string, []any) {
+	return d.Round(`
+      ? * COALESCE(ar.retakes, 0)
+      + ? * COALESCE(sg.stale_downstream, 0)
+      + ? * COALESCE(sg.days_in_status, 0)`, 4),
+		[]any{w.Retakes, w.StaleDownstream, w.TimeInStatus}
+}
@@ -0,0 +1,78 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoBulkStatus.go
+
+	Module Description:
+		Target lookup of the bulk work status change of the asset pivot.
+
+	Details:
+	- The targets are the latest submissions of t_review_latest matching the pivot's
+	  name, status and phase filters; top group nodes go through the same category
+	  filter as the per-role access restriction.
+	- Submissions already at the target work status are left out, so a repeated
+	  request matches nothing.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Return the asset, phase and current status of every target (dry runs).
+
+	Functions:
+	* - (ReviewInfo) BulkWorkStatusTargets: Lists the submissions to change.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"gorm.io/gorm"
+)
+
+// BulkWorkStatusTargets returns the latest submissions matching params whose work
+// status is not params.WorkStatus yet, at most limit+1 of them so the caller can tell
+// an over-wide filter. topGroupNodes restricts the assets (nil means unrestricted).
+func (r *ReviewInfo) BulkWorkStatusTargets(
+	db *gorm.DB,
+	params *entity.BulkSetWorkStatusParams,
+	topGroupNodes []string,
+	limit int,
+) ([]*entity.BulkWorkStatusChange, error) {
+	root := params.Root
+	if root == "" {
+		root = "assets"
+	}
+	sql := `
+SELECT l.review_info_id, l.group_1, l.relation, LOWER(l.phase) AS phase, l.work_status
+FROM t_review_latest AS l
+WHERE l.project = ? AND l.root = ?
+  AND (l.work_status IS NULL OR LOWER(l.work_status) <> ?)`
+	args := []any{params.Project, root, strings.ToLower(params.WorkStatus)}
+
+	if phase := strings.ToLower(strings.TrimSpace(params.Phase)); phase != "" && phase != "none" {
+		sql += " AND LOWER(l.phase) = ?"
+		args = append(args, phase)
+	}
+	if name := strings.TrimSpace(params.AssetNameKey); name != "" {
+		sql += " AND LOWER(l.group_1) LIKE ?"
+		args = append(args, strings.ToLower(name)+"%")
+	}
+	statusWhere, statusArgs := buildPhaseAwareStatusWhere("", params.ApprovalStatuses, params.WorkStatuses)
+	sql += statusWhere
+	args = append(args, statusArgs...)
+
+	accessCond, accessArgs := buildTopGroupNodeFilter(dialectOf(r.db), "l", topGroupNodes)
+	sql += accessCond
+	args = append(args, accessArgs...)
+
+	sql += `
+ORDER BY l.review_info_id
+LIMIT ?`
+	args = append(args, limit+1)
+
+	var rows []*entity.BulkWorkStatusChange
+	if err := db.Raw(sql, args...).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,89 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoCompletion.go
+
+	Module Description:
+		Phase completion matrix of a project for milestone reports.
+
+	Details:
+	- The asset phases come from t_review_latest, one row per asset phase; whether any
+	  take of a phase was approved is an indexed EXISTS on t_review_info, so the matrix
+	  costs one scan of the summary instead of the pivot's phase fetch.
+	- Only PivotPhases are read; their order gives the bits of the masks.
+	- Rows carry the asset's top group node, so the usecase can apply RequiredPhases.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Read the top group node of every asset phase.
+
+	Functions:
+	* - (ReviewInfo) PhaseApprovals: Lists the asset phases of a project with their approval.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// PhaseApprovals lists the PivotPhases rows of params.Project's assets under
+// params.Root, ordered by asset, each telling whether a live take of the phase carries
+// approvedStatus and the asset's top group node. allowedTopGroupNodes restricts the assets (nil means unrestricted).
+func (r *ReviewInfo) PhaseApprovals(
+	ctx context.Context,
+	params *entity.ReviewCompletionParams,
+	approvedStatus string,
+	allowedTopGroupNodes []string,
+) ([]*entity.ReviewPhaseApproval, error) {
+	root := params.Root
+	if root == "" {
+		root = "assets"
+	}
+	accessCond, accessArgs := buildTopGroupNodeFilter(dialectOf(r.db), "l", allowedTopGroupNodes)
+
+	sql := `
+SELECT
+  l.group_1,
+  l.group_2,
+  l.group_3,
+  l.relation,
+  COALESCE(SUBSTRING_INDEX(gc.path, '/', 1), '') AS top_group_node,
+  LOWER(l.phase) AS phase,
+  EXISTS (
+    SELECT 1
+    FROM t_review_info AS ri
+    WHERE ri.project = l.project
+      AND ri.root = l.root
+      AND ri.group_1 = l.group_1
+      AND ` + pivotGroupColumn("ri", "group_2") + ` = l.group_2
+      AND ` + pivotGroupColumn("ri", "group_3") + ` = l.group_3
+      AND ri.relation = l.relation
+      AND ri.phase = l.phase
+      AND ri.deleted = 0
+      AND LOWER(ri.approval_status) = ?
+  ) AS approved
+FROM t_review_latest AS l
+LEFT JOIN t_group_category_group AS gcg
+       ON gcg.project = l.project
+      AND gcg.deleted = 0
+      AND gcg.path = JSON_UNQUOTE(JSON_EXTRACT(l.` + "`groups`" + `, '$[0]'))
+LEFT JOIN t_group_category AS gc
+       ON gc.id = gcg.group_category_id
+      AND gc.deleted = 0
+      AND gc.root = l.root
+WHERE l.project = ? AND l.root = ? AND LOWER(l.phase) IN ?` + accessCond + `
+ORDER BY l.group_1, l.group_2, l.group_3, l.relation`
+
+	args := []any{strings.ToLower(approvedStatus), params.Project, root, entity.PivotPhases}
+	args = append(args, accessArgs...)
+
+	var out []*entity.ReviewPhaseApproval
+	if err := r.ReadWithContext(ctx, params.Project).Raw(sql, args...).Scan(&out).Error; err != nil {
+		return nil, fmt.Errorf("PhaseApprovals: %w", err)
+	}
+	return out, nil
+}
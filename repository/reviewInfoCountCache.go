@@ -0,0 +1,414 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoCountCache.go
+
+	Module Description:
+		Stale-while-revalidate cache for CountLatestSubmissions totals.
+
+	Details:
+	- CountLatestSubmissions is the dominant cost of a pivot page and its result only
+	  depends on the filters, not on the page, so pages 2..N reuse the page-1 total.
+	- Within countFreshFor a cached total is returned as is. Up to countStaleFor it is
+	  still returned, but one background recount per key refreshes it. Older entries
+	  are recounted synchronously.
+	- Writes drop the project's totals through InvalidateLatestCounts; totals of large
+	  projects are only marked dirty and served as estimates (reviewInfoCountEstimate.go).
+	- The fresh and stale windows default to countFreshFor and countStaleFor and can be
+	  changed at run time through SetCountCacheTTLs; FlushLatestCounts drops every total
+	  of a project, estimated or not.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Key totals by as_of as well.
+	* - 15-10-2026 - Cache the per top group node counts of the grouped pivot too.
+	* - 15-10-2026 - Record cache hits and misses as metrics.
+	* - 15-10-2026 - Key totals by the submitted / approval user filters as well.
+	* - 15-10-2026 - Note writes for the read replica routing in InvalidateLatestCounts.
+	* - 15-10-2026 - Serve dirty totals of large projects as estimates.
+	* - 15-10-2026 - Key totals by the studio filter as well.
+	* - 15-10-2026 - Key totals by the relation filter as well.
+	* - 15-10-2026 - Key totals by the overall status filter and its rules as well.
+	* - 15-10-2026 - Key totals by the required phases of the overall status filter.
+	* - 15-10-2026 - Key totals by the overdue filter day as well.
+	* - 15-10-2026 - Key totals by the tag filter as well.
+	* - 15-10-2026 - Made the fresh and stale windows adjustable; added FlushLatestCounts.
+
+	Functions:
+	* - cachedCountLatestSubmissions: CountLatestSubmissions through the cache.
+	* - cachedCountAssetsByTopGroupNode: CountAssetsByTopGroupNode through the cache.
+	* - InvalidateLatestCounts: Drops every cached total of a project.
+	* - FlushLatestCounts: Drops every cached total of a project, large or not.
+	* - CountCacheTTLs / SetCountCacheTTLs: Read and change the fresh and stale windows.
+	* - overallStatusKeyRules: Returns the overall status rules a total depends on.
+	* - requiredPhasesKey: Returns the required phases a total depends on.
+	* - overdueKey: Returns the overdue filter day a total depends on.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/metrics"
+)
+
+const (
+	countFreshFor        = 10 * time.Second
+	countStaleFor        = 60 * time.Second
+	countRefreshTimeout  = 30 * time.Second
+	maxCountCacheEntries = 2000
+)
+
+type countCacheKey struct {
+	Kind                 string                     `json:"k,omitempty"` // "" for totals, "groups" for bucket counts
+	Project              string                     `json:"p"`
+	Root                 string                     `json:"r"`
+	AssetNameKey         string                     `json:"n"`
+	ApprovalStatuses     []string                   `json:"a"`
+	WorkStatuses         []string                   `json:"w"`
+	SubmittedUsers       []string                   `json:"su,omitempty"`
+	ApprovalUpdatedUsers []string                   `json:"au,omitempty"`
+	Studios              []string                   `json:"st,omitempty"`
+	Relations            []string                   `json:"rl,omitempty"`
+	RelationMode         string                     `json:"rm,omitempty"`
+	Tags                 []string                   `json:"tg,omitempty"`
+	OverallStatuses      []string                   `json:"os,omitempty"`
+	OverallRules         *entity.OverallStatusRules `json:"or,omitempty"` // only set with OverallStatuses or OverdueOn
+	RequiredPhases       *entity.RequiredPhases     `json:"rq,omitempty"` // only set with OverallStatuses
+	OverdueOn            string                     `json:"od,omitempty"` // YYYY-MM-DD
+	AllowedTopGroupNodes []string                   `json:"t"`
+	AsOf                 *time.Time                 `json:"at,omitempty"`
+}
+
+// overallStatusKeyRules returns the rules for a count cache key: the counts only
+// depend on them when filtering by overall status or by overdue assets, whose open
+// phases are the ones not approved.
+func overallStatusKeyRules(
+	statuses []string,
+	rules entity.OverallStatusRules,
+	overdueOn *time.Time,
+) *entity.OverallStatusRules {
+	if len(statuses) == 0 && overdueOn == nil {
+		return nil
+	}
+	return &rules
+}
+
+// overdueKey returns the overdue filter day for a count cache key.
+func overdueKey(overdueOn *time.Time) string {
+	if overdueOn == nil {
+		return ""
+	}
+	return overdueOn.Format(entity.ReviewDueDateLayout)
+}
+
+// requiredPhasesKey returns the phase mappings for a count cache key, which like the
+// rules only matter when filtering by overall status.
+func requiredPhasesKey(statuses []string, required *entity.RequiredPhases) *entity.RequiredPhases {
+	if len(statuses) == 0 || required.Empty() {
+		return nil
+	}
+	return &entity.RequiredPhases{ByRelation: required.ByRelation, ByTopGroupNode: required.ByTopGroupNode}
+}
+
+type countCacheEntry struct {
+	project    string
+	value      countCacheValue
+	fetchedAt  time.Time
+	refreshing bool
+	writes     int // writes to the project since fetchedAt (estimated totals only)
+}
+
+// countCacheMetric names the cache of k in the cache metrics.
+func countCacheMetric(k countCacheKey) string {
+	if k.Kind == "" {
+		return "pivot_count"
+	}
+	return "pivot_group_count"
+}
+
+type countCacheValue struct {
+	total  int64
+	groups []TopGroupNodeCount
+}
+
+type latestCountCache struct {
+	mu       sync.Mutex
+	entries  map[string]*countCacheEntry
+	freshFor time.Duration
+	staleFor time.Duration
+
+	estimate *countEstimate // nil when totals are always exact
+}
+
+func newLatestCountCache() *latestCountCache {
+	return &latestCountCache{
+		entries:  map[string]*countCacheEntry{},
+		freshFor: countFreshFor,
+		staleFor: countStaleFor,
+	}
+}
+
+// put stores total under key, evicting expired (then oldest) entries beyond the bound.
+// Caller holds c.mu.
+func (c *latestCountCache) put(key, project string, v countCacheValue, at time.Time) {
+	c.entries[key] = &countCacheEntry{project: project, value: v, fetchedAt: at}
+	if len(c.entries) <= maxCountCacheEntries {
+		return
+	}
+	var oldestKey string
+	var oldestAt time.Time
+	for k, e := range c.entries {
+		if at.Sub(e.fetchedAt) >= c.staleFor {
+			delete(c.entries, k)
+			continue
+		}
+		if oldestKey == "" || e.fetchedAt.Before(oldestAt) {
+			oldestKey, oldestAt = k, e.fetchedAt
+		}
+	}
+	if len(c.entries) > maxCountCacheEntries {
+		delete(c.entries, oldestKey)
+	}
+}
+
+func (r *ReviewInfo) cachedCountLatestSubmissions(
+	ctx context.Context,
+	project, root, assetNameKey string,
+	preferredPhase string,
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	overallStatuses []string,
+	overallRules entity.OverallStatusRules,
+	requiredPhases *entity.RequiredPhases,
+	overdueOn *time.Time,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+) (int64, bool, error) {
+	// Historical totals never change, so they are not worth estimating.
+	estimate := asOf == nil && r.estimatesCounts(ctx, project)
+	v, estimated, err := r.counts.get(ctx, countCacheKey{
+		Project:              project,
+		Root:                 root,
+		AssetNameKey:         assetNameKey,
+		ApprovalStatuses:     approvalStatuses,
+		WorkStatuses:         workStatuses,
+		SubmittedUsers:       submittedUsers,
+		ApprovalUpdatedUsers: approvalUpdatedUsers,
+		Studios:              studios,
+		Relations:            relations,
+		RelationMode:         relationMode,
+		Tags:                 tags,
+		OverallStatuses:      overallStatuses,
+		OverallRules:         overallStatusKeyRules(overallStatuses, overallRules, overdueOn),
+		RequiredPhases:       requiredPhasesKey(overallStatuses, requiredPhases),
+		OverdueOn:            overdueKey(overdueOn),
+		AllowedTopGroupNodes: allowedTopGroupNodes,
+		AsOf:                 asOf,
+	}, func(ctx context.Context) (countCacheValue, error) {
+		total, err := r.CountLatestSubmissions(
+			ctx, project, root, assetNameKey, preferredPhase,
+			approvalStatuses, workStatuses, submittedUsers, approvalUpdatedUsers, studios,
+			relations, relationMode, tags, overallStatuses, overallRules, requiredPhases, overdueOn, allowedTopGroupNodes, asOf,
+		)
+		return countCacheValue{total: total}, err
+	}, estimate)
+	return v.total, estimated, err
+}
+
+// The status filters of the bucket counts are phase-aware, so unlike the totals they are
+// keyed by preferredPhase as well.
+func (r *ReviewInfo) cachedCountAssetsByTopGroupNode(
+	ctx context.Context,
+	project, root, preferredPhase, assetNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	overallStatuses []string,
+	overallRules entity.OverallStatusRules,
+	requiredPhases *entity.RequiredPhases,
+	overdueOn *time.Time,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+) ([]TopGroupNodeCount, error) {
+	v, _, err := r.counts.get(ctx, countCacheKey{
+		Kind:                 "groups:" + preferredPhase,
+		Project:              project,
+		Root:                 root,
+		AssetNameKey:         assetNameKey,
+		ApprovalStatuses:     approvalStatuses,
+		WorkStatuses:         workStatuses,
+		SubmittedUsers:       submittedUsers,
+		ApprovalUpdatedUsers: approvalUpdatedUsers,
+		Studios:              studios,
+		Relations:            relations,
+		RelationMode:         relationMode,
+		Tags:                 tags,
+		OverallStatuses:      overallStatuses,
+		OverallRules:         overallStatusKeyRules(overallStatuses, overallRules, overdueOn),
+		RequiredPhases:       requiredPhasesKey(overallStatuses, requiredPhases),
+		OverdueOn:            overdueKey(overdueOn),
+		AllowedTopGroupNodes: allowedTopGroupNodes,
+		AsOf:                 asOf,
+	}, func(ctx context.Context) (countCacheValue, error) {
+		groups, err := r.CountAssetsByTopGroupNode(
+			ctx, project, root, preferredPhase, assetNameKey,
+			approvalStatuses, workStatuses, submittedUsers, approvalUpdatedUsers, studios,
+			relations, relationMode, tags, overallStatuses, overallRules, requiredPhases, overdueOn, allowedTopGroupNodes, asOf,
+		)
+		return countCacheValue{groups: groups}, err
+	}, false)
+	return v.groups, err
+}
+
+// get returns the cached value of k, loading it with load when missing or expired and
+// refreshing it in the background when stale. With estimate set, a dirty or expired
+// value younger than the estimate max age is returned too, reported as estimated.
+// A nil cache always loads.
+func (c *latestCountCache) get(
+	ctx context.Context,
+	k countCacheKey,
+	load func(ctx context.Context) (countCacheValue, error),
+	estimate bool,
+) (countCacheValue, bool, error) {
+	if c == nil {
+		v, err := load(ctx)
+		return v, false, err
+	}
+	b, err := json.Marshal(k)
+	if err != nil {
+		v, err := load(ctx)
+		return v, false, err
+	}
+	key := string(b)
+	project := k.Project
+
+	now := time.Now()
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	hit := ok && e.writes == 0 && now.Sub(e.fetchedAt) < c.staleFor
+	estimated := ok && !hit && estimate && c.estimate != nil && now.Sub(e.fetchedAt) < c.estimate.maxAge
+	metrics.ObserveCache(countCacheMetric(k), hit || estimated)
+	if hit || estimated {
+		v := e.value
+		if (estimated || now.Sub(e.fetchedAt) >= c.freshFor) && !e.refreshing {
+			e.refreshing = true
+			writes := e.writes
+			go func() {
+				refreshCtx, cancel := context.WithTimeout(context.Background(), countRefreshTimeout)
+				defer cancel()
+				v, err := load(refreshCtx)
+				c.mu.Lock()
+				defer c.mu.Unlock()
+				if err != nil {
+					log.Printf("[COUNT] background recount for %s failed: %v", project, err)
+					if cur, ok := c.entries[key]; ok {
+						cur.refreshing = false
+					}
+					return
+				}
+				// Skip if the entry was invalidated while recounting; writes during the
+				// recount keep the new value dirty.
+				if cur, ok := c.entries[key]; ok && cur == e {
+					c.put(key, project, v, time.Now())
+					if ne, ok := c.entries[key]; ok {
+						ne.writes = e.writes - writes
+					}
+				}
+			}()
+		}
+		c.mu.Unlock()
+		return v, estimated, nil
+	}
+	c.mu.Unlock()
+
+	v, err := load(ctx)
+	if err != nil {
+		return countCacheValue{}, false, err
+	}
+	c.mu.Lock()
+	c.put(key, project, v, now)
+	c.mu.Unlock()
+	return v, false, nil
+}
+
+// InvalidateLatestCounts drops every cached total of project; call it after writes.
+// It also pins the project's reads to the primary while the replica catches up.
+func (r *ReviewInfo) InvalidateLatestCounts(project string) {
+	r.replica.noteWrite(project)
+	if r.counts == nil {
+		return
+	}
+	large := r.counts.estimate.large(project)
+	r.counts.mu.Lock()
+	defer r.counts.mu.Unlock()
+	for k, e := range r.counts.entries {
+		if e.project != project {
+			continue
+		}
+		if large {
+			e.writes++
+			continue
+		}
+		delete(r.counts.entries, k)
+	}
+}
+
+// FlushLatestCounts drops every cached total of project, including the dirty totals
+// of large projects that InvalidateLatestCounts keeps as estimates.
+func (r *ReviewInfo) FlushLatestCounts(project string) {
+	r.replica.noteWrite(project)
+	if r.counts == nil {
+		return
+	}
+	r.counts.mu.Lock()
+	defer r.counts.mu.Unlock()
+	for k, e := range r.counts.entries {
+		if e.project == project {
+			delete(r.counts.entries, k)
+		}
+	}
+}
+
+// CountCacheTTLs returns how long a cached total is fresh, and how long it is served
+// at all.
+func (r *ReviewInfo) CountCacheTTLs() (fresh, stale time.Duration) {
+	if r.counts == nil {
+		return 0, 0
+	}
+	r.counts.mu.Lock()
+	defer r.counts.mu.Unlock()
+	return r.counts.freshFor, r.counts.staleFor
+}
+
+// SetCountCacheTTLs changes the fresh and stale windows of the cached totals; a zero
+// value keeps the current window. Cached totals are judged by the new windows.
+func (r *ReviewInfo) SetCountCacheTTLs(fresh, stale time.Duration) {
+	if r.counts == nil {
+		return
+	}
+	r.counts.mu.Lock()
+	defer r.counts.mu.Unlock()
+	if fresh > 0 {
+		r.counts.freshFor = fresh
+	}
+	if stale > 0 {
+		r.counts.staleFor = stale
+	}
+}
@@ -0,0 +1,104 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoCountEstimate.go
+
+	Module Description:
+		Estimated pivot totals for very large projects.
+
+	Details:
+	- Projects with at least the configured number of assets (PPI_COUNT_ESTIMATE_ASSETS)
+	  get periodic exact counts instead of one per write: a write marks their cached
+	  totals dirty rather than dropping them, and a dirty or stale total younger than
+	  maxAge is returned as an estimate while one background recount refreshes it.
+	- A total served that way is flagged (total_is_estimate), so the UI can show
+	  "~12,400". A total counted for the request, or cached without writes since, is
+	  exact.
+	- The asset count deciding whether a project is large comes from t_review_latest and
+	  is itself refreshed every maxAge.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) SetCountEstimate: Enables estimated totals above an asset count.
+	* - (ReviewInfo) estimatesCounts: Reports whether the totals of a project may be estimated.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+type countEstimate struct {
+	minAssets int64
+	maxAge    time.Duration
+
+	mu    sync.Mutex
+	sizes map[string]projectSize
+}
+
+type projectSize struct {
+	assets    int64
+	countedAt time.Time
+}
+
+// SetCountEstimate lets projects with at least minAssets assets return cached totals up
+// to maxAge old as estimates; minAssets <= 0 disables estimates.
+func (r *ReviewInfo) SetCountEstimate(minAssets int64, maxAge time.Duration) {
+	if minAssets <= 0 {
+		r.counts.estimate = nil
+		return
+	}
+	r.counts.estimate = &countEstimate{
+		minAssets: minAssets,
+		maxAge:    maxAge,
+		sizes:     map[string]projectSize{},
+	}
+}
+
+// estimatesCounts reports whether totals of project may be estimates, counting the
+// project's assets when its size is unknown or older than maxAge. A failed size count
+// disables estimates for the request.
+func (r *ReviewInfo) estimatesCounts(ctx context.Context, project string) bool {
+	e := r.counts.estimate
+	if e == nil {
+		return false
+	}
+	now := time.Now()
+	e.mu.Lock()
+	size, ok := e.sizes[project]
+	e.mu.Unlock()
+	if ok && now.Sub(size.countedAt) < e.maxAge {
+		return size.assets >= e.minAssets
+	}
+
+	var assets int64
+	if err := r.ReadWithContext(ctx, project).Raw(`
+SELECT COUNT(*) FROM (
+  SELECT DISTINCT root, group_1, relation
+  FROM t_review_latest
+  WHERE project = ?
+) AS a`, project).Scan(&assets).Error; err != nil {
+		log.Printf("[COUNT] asset count of %s failed: %v", project, err)
+		return false
+	}
+	e.mu.Lock()
+	e.sizes[project] = projectSize{assets: assets, countedAt: now}
+	e.mu.Unlock()
+	return assets >= e.minAssets
+}
+
+// large reports whether project was large when last sized; it never queries.
+func (e *countEstimate) large(project string) bool {
+	if e == nil {
+		return false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	size, ok := e.sizes[project]
+	return ok && size.assets >= e.minAssets
+}
@@ -0,0 +1,212 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoCursor.go
+
+	Module Description:
+		Keyset page cursors for ListAssetsPivot.
+
+	Details:
+	- A cursor carries the sort key values of the last asset of a page, not its offset.
+	  The next page is "every asset sorting after that key", so approvals or submissions
+	  landing between two fetches no longer shift rows into the previous page (skipped)
+	  or out of it (duplicated).
+	- The sort key is the phase bias and every term of buildOrderClause, which ends in
+	  project/root/group_1/group_2/group_3/relation as a unique tie-breaker, read back
+	  from the database as a JSON array (JSON_ARRAY, or JSON_BUILD_ARRAY on PostgreSQL).
+	- Re-sending the same cursor returns the same page, so clients may retry freely.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Carry the attention_score reference time.
+	* - 15-10-2026 - Version 2: group_2 and group_3 joined the tie-breaker.
+	* - 15-10-2026 - Version 3: the tie-breaker moved into buildOrderClause with project and root.
+	* - 15-10-2026 - Carry the nulls placement; absent in older cursors, which means the default.
+	* - 15-10-2026 - Carry natural name ordering.
+	* - 15-10-2026 - Build the sort key array in the SQL dialect.
+
+	Functions:
+	* - (AssetPivotCursor) Encode: Serialises a cursor into an opaque URL-safe token.
+	* - DecodeAssetPivotCursor: Parses a token produced by Encode.
+	* - splitOrderClause: Splits an ORDER BY clause into its terms.
+	* - buildSortKeyColumns: Selects the sort key of a row as a JSON array.
+	* - buildCursorCondition: Restricts rows to those sorting after a cursor.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+const assetPivotCursorVersion = 3
+
+// AssetPivotCursor points just past one asset of a ListAssetsPivot page. The sort
+// parameters are kept so a cursor can't be replayed against a different ordering.
+// ScoredAt pins the time-in-status reference of sort=attention across pages.
+type AssetPivotCursor struct {
+	Version        int               `json:"v"`
+	OrderKey       string            `json:"o"`
+	Direction      string            `json:"d"`
+	Nulls          string            `json:"n,omitempty"`
+	Natural        bool              `json:"a,omitempty"`
+	PreferredPhase string            `json:"p"`
+	SortKey        []json.RawMessage `json:"k"`
+	ScoredAt       *time.Time        `json:"t,omitempty"`
+}
+
+func (c *AssetPivotCursor) Encode() string {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeAssetPivotCursor(token string) (*AssetPivotCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimSpace(token))
+	if err != nil {
+		return nil, entity.ErrInvalidPivotCursor
+	}
+	var c AssetPivotCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.Version != assetPivotCursorVersion || len(c.SortKey) == 0 {
+		return nil, entity.ErrInvalidPivotCursor
+	}
+	return &c, nil
+}
+
+// matches reports whether c was issued for the given (normalised) sort parameters.
+func (c *AssetPivotCursor) matches(orderKey, direction, nulls string, natural bool, preferredPhase string) bool {
+	return c.OrderKey == orderKey &&
+		strings.EqualFold(c.Direction, direction) &&
+		strings.EqualFold(c.Nulls, strings.TrimSpace(nulls)) &&
+		c.Natural == natural &&
+		strings.EqualFold(c.PreferredPhase, preferredPhase)
+}
+
+// args converts the JSON sort key back into query arguments; JSON null becomes nil.
+func (c *AssetPivotCursor) args() ([]any, error) {
+	out := make([]any, len(c.SortKey))
+	for i, raw := range c.SortKey {
+		var v any
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, entity.ErrInvalidPivotCursor
+		}
+		switch v.(type) {
+		case nil, string, float64, bool:
+			out[i] = v
+		default:
+			return nil, entity.ErrInvalidPivotCursor
+		}
+	}
+	return out, nil
+}
+
+type orderTerm struct {
+	expr string
+	desc bool
+}
+
+// splitOrderClause splits an ORDER BY clause as built by buildOrderClause into its
+// terms, ignoring commas nested in parentheses or string literals.
+func splitOrderClause(clause string) []orderTerm {
+	var parts []string
+	depth, start, inQuote := 0, 0, false
+	for i, ch := range clause {
+		switch {
+		case ch == '\'':
+			inQuote = !inQuote
+		case inQuote:
+		case ch == '(':
+			depth++
+		case ch == ')':
+			depth--
+		case ch == ',' && depth == 0:
+			parts = append(parts, clause[start:i])
+			start = i + 1
+		}
+	}
+	parts = append(parts, clause[start:])
+
+	terms := make([]orderTerm, 0, len(parts))
+	for _, p := range parts {
+		p = strings.TrimSpace(p)
+		upper := strings.ToUpper(p)
+		switch {
+		case strings.HasSuffix(upper, " DESC"):
+			terms = append(terms, orderTerm{expr: strings.TrimSpace(p[:len(p)-5]), desc: true})
+		case strings.HasSuffix(upper, " ASC"):
+			terms = append(terms, orderTerm{expr: strings.TrimSpace(p[:len(p)-4])})
+		default:
+			terms = append(terms, orderTerm{expr: p})
+		}
+	}
+	return terms
+}
+
+// buildSortKeyColumns returns the select expression for the sort key of a row.
+func buildSortKeyColumns(d reviewquery.Dialect, terms []orderTerm) string {
+	exprs := make([]string, len(terms))
+	for i, t := range terms {
+		exprs[i] = t.expr
+	}
+	return d.JSONArray(exprs)
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	buildCursorCondition expands "row sorts after values" for terms with mixed
+	directions into
+	    t0 > v0 OR (t0 = v0 AND t1 > v1) OR (t0 = v0 AND t1 = v1 AND t2 > v2) ...
+	following MySQL's NULL ordering (NULL first ascending, last descending).
+	The last term must be unique per row for pages not to overlap.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func buildCursorCondition(terms []orderTerm, values []any) (string, []any, error) {
+	if len(values) != len(terms) {
+		return "", nil, fmt.Errorf("%w: expected %d sort values, got %d",
+			entity.ErrInvalidPivotCursor, len(terms), len(values))
+	}
+
+	var ors []string
+	var args []any
+	for i, t := range terms {
+		var ands []string
+		var andArgs []any
+		for j := 0; j < i; j++ {
+			if values[j] == nil {
+				ands = append(ands, terms[j].expr+" IS NULL")
+			} else {
+				ands = append(ands, terms[j].expr+" = ?")
+				andArgs = append(andArgs, values[j])
+			}
+		}
+		switch {
+		case values[i] == nil && t.desc:
+			// NULL sorts last descending: nothing follows on this term.
+			continue
+		case values[i] == nil:
+			ands = append(ands, t.expr+" IS NOT NULL")
+		case t.desc:
+			ands = append(ands, "("+t.expr+" < ? OR "+t.expr+" IS NULL)")
+			andArgs = append(andArgs, values[i])
+		default:
+			ands = append(ands, t.expr+" > ?")
+			andArgs = append(andArgs, values[i])
+		}
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+		args = append(args, andArgs...)
+	}
+	if len(ors) == 0 {
+		return " AND 1 = 0", nil, nil
+	}
+	return " AND (" + strings.Join(ors, "\n    OR ") + ")", args, nil
+}
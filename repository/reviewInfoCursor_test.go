@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// seedCursorAssets writes ast01..ast10 with one MDL take each, submitted a minute apart,
+// and returns the review info ID of every asset.
+func seedCursorAssets(t *testing.T, r *ReviewInfo) map[string]int32 {
+	t.Helper()
+	ids := map[string]int32{}
+	for i := 1; i <= 10; i++ {
+		name := fmt.Sprintf("ast%02d", i)
+		ids[name] = insertPivotRow(t, r, "rod", pivotTestRow{
+			Group1:         name,
+			Relation:       "main",
+			Phase:          "MDL",
+			Take:           "take0001",
+			Category:       "chr",
+			WorkStatus:     "wip",
+			ApprovalStatus: "check",
+			SubmittedAt:    pivotTestEpoch.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	return ids
+}
+
+// Writes landing between two page fetches must not shift assets across the cursor: an
+// asset whose sort key does not change comes back exactly once, an asset inserted
+// after the cursor comes back, and one inserted before it or deleted does not.
+func TestListAssetsPivotCursorConcurrentWrites(t *testing.T) {
+	for _, tc := range []struct {
+		orderKey       string
+		direction      string
+		firstPage      []string
+		insertBefore   pivotTestRow // sorts before the cursor of the first page
+		insertAfter    pivotTestRow // sorts after it
+		updateSeen     string       // asset on the first page whose status changes
+		updateUnseen   string       // asset on a later page whose status changes
+		deleteUnseen   string       // asset on a later page deleted
+		wantAfterFirst []string
+	}{
+		{
+			orderKey:     "group1_only",
+			direction:    "asc",
+			firstPage:    []string{"ast01/main", "ast02/main", "ast03/main"},
+			insertBefore: pivotTestRow{Group1: "ast00", SubmittedAt: pivotTestEpoch},
+			insertAfter:  pivotTestRow{Group1: "ast05b", SubmittedAt: pivotTestEpoch},
+			updateSeen:   "ast02",
+			updateUnseen: "ast06",
+			deleteUnseen: "ast08",
+			wantAfterFirst: []string{
+				"ast04/main", "ast05/main", "ast05b/main", "ast06/main", "ast07/main", "ast09/main", "ast10/main",
+			},
+		},
+		{
+			orderKey:     "submitted_at_utc",
+			direction:    "desc",
+			firstPage:    []string{"ast10/main", "ast09/main", "ast08/main"},
+			insertBefore: pivotTestRow{Group1: "ast11", SubmittedAt: pivotTestEpoch.Add(time.Hour)},
+			insertAfter:  pivotTestRow{Group1: "ast00", SubmittedAt: pivotTestEpoch},
+			updateSeen:   "ast09",
+			updateUnseen: "ast05",
+			deleteUnseen: "ast03",
+			wantAfterFirst: []string{
+				"ast07/main", "ast06/main", "ast05/main", "ast04/main", "ast02/main", "ast01/main", "ast00/main",
+			},
+		},
+	} {
+		t.Run(tc.orderKey+"_"+tc.direction, func(t *testing.T) {
+			r := newPivotTestRepo(t, openPivotTestDB(t))
+			ids := seedCursorAssets(t, r)
+			page := pivotPage{OrderKey: tc.orderKey, Direction: tc.direction, Limit: 3}
+
+			rows, _, next := listPivotPage(t, r, page)
+			if got := pivotAssetNames(rows); !reflect.DeepEqual(got, tc.firstPage) {
+				t.Fatalf("first page = %v, want %v", got, tc.firstPage)
+			}
+			if next == nil {
+				t.Fatal("first page without a cursor")
+			}
+
+			now := pivotTestEpoch.Add(24 * time.Hour)
+			for _, row := range []pivotTestRow{tc.insertBefore, tc.insertAfter} {
+				row.Relation, row.Phase, row.Take, row.Category = "main", "MDL", "take0001", "chr"
+				row.WorkStatus, row.ApprovalStatus = "wip", "check"
+				insertPivotRow(t, r, "rod", row)
+			}
+			updatePivotRow(t, r, ids[tc.updateSeen], "approved", now)
+			updatePivotRow(t, r, ids[tc.updateUnseen], "retake", now)
+			deletePivotRow(t, r, ids[tc.deleteUnseen], now)
+
+			// Round-trip the cursor as a client does.
+			after, err := DecodeAssetPivotCursor(next.Encode())
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			seen := map[string]bool{}
+			for _, name := range tc.firstPage {
+				seen[name] = true
+			}
+			for pages := 0; after != nil; pages++ {
+				if pages > 10 {
+					t.Fatal("cursor pages do not end")
+				}
+				page.After = after
+				rows, _, after = listPivotPage(t, r, page)
+				for _, name := range pivotAssetNames(rows) {
+					if seen[name] {
+						t.Errorf("%s returned twice", name)
+					}
+					seen[name] = true
+					got = append(got, name)
+				}
+			}
+			if !reflect.DeepEqual(got, tc.wantAfterFirst) {
+				t.Errorf("pages after the first = %v, want %v", got, tc.wantAfterFirst)
+			}
+		})
+	}
+}
+
+// Re-sending a cursor returns the same page.
+func TestListAssetsPivotCursorRetry(t *testing.T) {
+	r := newPivotTestRepo(t, openPivotTestDB(t))
+	seedCursorAssets(t, r)
+	page := pivotPage{OrderKey: "group1_only", Direction: "asc", Limit: 4}
+	_, _, next := listPivotPage(t, r, page)
+	page.After = next
+	first, _, _ := listPivotPage(t, r, page)
+	again, _, _ := listPivotPage(t, r, page)
+	if !reflect.DeepEqual(pivotAssetNames(first), pivotAssetNames(again)) {
+		t.Errorf("same cursor, different pages: %v, then %v", pivotAssetNames(first), pivotAssetNames(again))
+	}
+}
+
+// A cursor edited by the client, replayed against another ordering or left over from
+// an older cursor version (a client holding one across a deploy) is rejected with
+// ErrInvalidPivotCursor instead of returning a wrong page.
+func TestListAssetsPivotCursorRejected(t *testing.T) {
+	r := newPivotTestRepo(t, openPivotTestDB(t))
+	seedCursorAssets(t, r)
+	page := pivotPage{OrderKey: "group1_only", Direction: "asc", Limit: 3}
+	_, _, next := listPivotPage(t, r, page)
+	token := next.Encode()
+
+	for _, tc := range []struct {
+		name  string
+		token string
+	}{
+		{"not base64", token + "!"},
+		{"truncated", token[:len(token)/2]},
+		{"not json", "bm90IGpzb24"},
+		{"older version", func() string {
+			old := *next
+			old.Version = assetPivotCursorVersion - 1
+			return old.Encode()
+		}()},
+		{"no sort key", func() string {
+			empty := *next
+			empty.SortKey = nil
+			return empty.Encode()
+		}()},
+	} {
+		if _, err := DecodeAssetPivotCursor(tc.token); !errors.Is(err, entity.ErrInvalidPivotCursor) {
+			t.Errorf("%s: DecodeAssetPivotCursor error = %v, want ErrInvalidPivotCursor", tc.name, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		name   string
+		page   pivotPage
+		cursor func(c *AssetPivotCursor)
+	}{
+		{"other order key", pivotPage{OrderKey: "relation_only", Direction: "asc", Limit: 3}, nil},
+		{"other direction", pivotPage{OrderKey: "group1_only", Direction: "desc", Limit: 3}, nil},
+		{"sort key shortened", page, func(c *AssetPivotCursor) { c.SortKey = c.SortKey[:len(c.SortKey)-1] }},
+		{"sort key value of another type", page, func(c *AssetPivotCursor) { c.SortKey[0] = []byte(`{"x":1}`) }},
+	} {
+		c, err := DecodeAssetPivotCursor(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tc.cursor != nil {
+			tc.cursor(c)
+		}
+		tc.page.After = c
+		_, _, _, err = queryPivotPage(r, tc.page)
+		if !errors.Is(err, entity.ErrInvalidPivotCursor) {
+			t.Errorf("%s: ListAssetsPivot error = %v, want ErrInvalidPivotCursor", tc.name, err)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoFields.go
+
+	Module Description:
+		Sparse fieldsets (phase column selection) for ListAssetsPivot.
+
+	Details:
+	- A selection names the phases whose column groups the client shows, e.g. [mdl rig].
+	  Empty means every phase, which keeps the full payload for existing clients.
+	- The phase fetch only reads the selected phases, and the rows leave the other
+	  phase keys out of their JSON instead of sending them as null.
+	- The selection does not change which assets match; filters and sorts still see
+	  every phase. Leaf group info comes from the selected phases only, so an asset with
+	  none of them submitted shows empty grouping columns.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NormalizePivotFields: Validates a selection and puts it in canonical form.
+	* - LimitPivotFields / LimitGroupedPivotFields: Restrict rows to a selection.
+	* - (AssetPivot) MarshalJSON: Leaves unselected phase keys out of a row.
+	* - buildPhaseFieldCond: Restricts the phase fetch to a selection.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// pivotPhaseKeys are the JSON key suffixes of one phase column group of AssetPivot.
+var pivotPhaseKeys = []string{"_work_status", "_approval_status", "_submitted_at_utc", "_take"}
+
+// NormalizePivotFields lowercases and deduplicates fields and orders them like
+// entity.PivotPhases, so equal selections share cache keys. A selection of every phase
+// is returned as nil.
+func NormalizePivotFields(fields []string) ([]string, error) {
+	seen := map[string]bool{}
+	for _, f := range fields {
+		f = strings.ToLower(strings.TrimSpace(f))
+		if f == "" {
+			continue
+		}
+		known := false
+		for _, phase := range entity.PivotPhases {
+			if f == phase {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return nil, fmt.Errorf("%w: %q", entity.ErrInvalidPivotFields, f)
+		}
+		seen[f] = true
+	}
+	if len(seen) == 0 || len(seen) == len(entity.PivotPhases) {
+		return nil, nil
+	}
+	out := make([]string, 0, len(seen))
+	for _, phase := range entity.PivotPhases {
+		if seen[phase] {
+			out = append(out, phase)
+		}
+	}
+	return out, nil
+}
+
+// LimitPivotFields makes rows serialise only the phases in fields; nil keeps them all.
+func LimitPivotFields(rows []AssetPivot, fields []string) {
+	for i := range rows {
+		rows[i].fields = fields
+	}
+}
+
+// LimitGroupedPivotFields applies LimitPivotFields to every bucket and nested child.
+func LimitGroupedPivotFields(buckets []GroupedAssetBucket, fields []string) {
+	for i := range buckets {
+		LimitPivotFields(buckets[i].Items, fields)
+		LimitGroupedPivotFields(buckets[i].Children, fields)
+	}
+}
+
+func (ap AssetPivot) MarshalJSON() ([]byte, error) {
+	type plain AssetPivot
+	b, err := json.Marshal(plain(ap))
+	if err != nil || len(ap.fields) == 0 {
+		return b, err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return nil, err
+	}
+	for _, phase := range entity.PivotPhases {
+		if ap.selects(phase) {
+			continue
+		}
+		for _, key := range pivotPhaseKeys {
+			delete(obj, phase+key)
+		}
+	}
+	return json.Marshal(obj)
+}
+
+func (ap AssetPivot) selects(phase string) bool {
+	for _, f := range ap.fields {
+		if f == phase {
+			return true
+		}
+	}
+	return false
+}
+
+// buildPhaseFieldCond returns an AND condition on alias.phase for a normalised
+// selection, or "" when every phase is selected.
+func buildPhaseFieldCond(alias string, fields []string) (string, []any) {
+	if len(fields) == 0 {
+		return "", nil
+	}
+	args := make([]any, len(fields))
+	for i, f := range fields {
+		args[i] = f
+	}
+	ph := strings.TrimRight(strings.Repeat("?,", len(fields)), ",")
+	return " AND LOWER(" + alias + ".phase) IN (" + ph + ")", args
+}
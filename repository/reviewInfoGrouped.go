@@ -0,0 +1,602 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoGrouped.go
+
+	Module Description:
+		Grouped asset pivot paginated by top group node buckets.
+
+	Details:
+	- GroupAndSortByTopNode groups one page of assets, so a bucket could be split across
+	  pages and its header repeated. ListAssetsPivotGrouped pages over the buckets
+	  themselves: every page holds groupsPerPage complete buckets.
+	- An asset's top group node is taken from its latest row (any phase); assets without
+	  a category fall into "Unassigned", which sorts last like in GroupAndSortByTopNode.
+	- Bucket counts only depend on the filters; the number of buckets of a project is
+	  small, so all of them are counted (CountAssetsByTopGroupNode, cached like the pivot
+	  totals) and the page is cut in Go. Every bucket carries its total as TotalCount.
+	- NestBucketsByCategoryPath turns the flat buckets into a tree following the full
+	  category path (character → hero → humans). Items sit on the deepest node of their
+	  path within the requested depth; nodes are counted over their whole subtree.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added CountAssetsByTopGroupNode; bucket counts go through the count cache.
+	* - 15-10-2026 - Added NestBucketsByCategoryPath for the nested category tree.
+	* - 15-10-2026 - Added ListUnassignedAssets for the unassigned-assets report.
+	* - 15-10-2026 - Record grouped pivot query durations as metrics.
+	* - 15-10-2026 - Trace the steps of ListAssetsPivotGrouped.
+	* - 15-10-2026 - Filter buckets by submitted / approval user like the list view.
+	* - 15-10-2026 - Only fetch and serialise the selected phases (fields).
+	* - 15-10-2026 - Read the bucket counts and items from the read replica.
+	* - 15-10-2026 - Filter buckets by studio like the list view.
+	* - 15-10-2026 - Filter buckets by relation like the list view.
+	* - 15-10-2026 - Group assets of any root, keyed by group_2/group_3 outside assets.
+	* - 15-10-2026 - Filter buckets by overall status; items carry their overall_status.
+	* - 15-10-2026 - Roll up only the required phases of an asset.
+	* - 15-10-2026 - Filter buckets by overdue assets; items carry their due dates.
+	* - 15-10-2026 - Items carry their priority flag.
+	* - 15-10-2026 - Filter buckets by tag; items carry their tags.
+	* - 15-10-2026 - groupsOnly lists every bucket with its count and no items.
+	* - 15-10-2026 - Build the bucket queries in the SQL dialect of the database.
+	* - 15-10-2026 - ListAssetsPivotGrouped takes an AssetPivotGroupedQuery instead of positional arguments.
+
+	Functions:
+	* - (ReviewInfo) ListAssetsPivotGrouped: Lists a page of complete top group node buckets.
+	* - (ReviewInfo) CountAssetsByTopGroupNode: Counts the filtered assets per top group node.
+	* - buildAssetTopGroupSQL: Asset keys with the top group node of each asset.
+	* - NestBucketsByCategoryPath: Splits buckets into sub-buckets along group_category_path.
+	* - (ReviewInfo) ListUnassignedAssets: Lists the assets of the "Unassigned" bucket.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/metrics"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+	"github.com/PolygonPictures/central30-web/front/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+)
+
+const unassignedTopGroupNode = "Unassigned"
+
+// TopGroupNodeCount is the number of pivot assets of one top group node bucket.
+type TopGroupNodeCount struct {
+	TopGroupNode string `gorm:"column:top_group_node" json:"top_group_node"` // "" = no category
+	Count        int    `gorm:"column:item_count" json:"count"`
+}
+
+type topGroupAsset struct {
+	Project       string     `gorm:"column:project"`
+	Root          string     `gorm:"column:root"`
+	Group1        string     `gorm:"column:group_1"`
+	Group2        string     `gorm:"column:group_2"`
+	Group3        string     `gorm:"column:group_3"`
+	Relation      string     `gorm:"column:relation"`
+	Component     string     `gorm:"column:component"`
+	TopGroupNode  string     `gorm:"column:top_group_node"`
+	OverallStatus string     `gorm:"column:overall_status"`
+	NextDueDate   nullTime   `gorm:"column:next_due_date"`
+	Priority      bool       `gorm:"column:priority"`
+}
+
+// buildAssetTopGroupSQL wraps the buildAssetKeysSQL assets with their top group node
+// (empty when the asset's latest row has no category).
+func buildAssetTopGroupSQL(
+	d reviewquery.Dialect,
+	hint QueryHint,
+	project, root, preferredPhase, assetNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	overallStatuses []string,
+	overallRules entity.OverallStatusRules,
+	requiredPhases *entity.RequiredPhases,
+	overdueOn *time.Time,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+) (string, []any) {
+	keysSQL, keysArgs := buildAssetKeysSQL(
+		d, hint, project, root, preferredPhase, assetNameKey,
+		approvalStatuses, workStatuses, submittedUsers, approvalUpdatedUsers, studios,
+		relations, relationMode, tags, overallStatuses, overallRules, requiredPhases, overdueOn, allowedTopGroupNodes, asOf,
+	)
+	asOfCond, asOfArgs := buildAsOfCond("ri", asOf)
+
+	sql := `
+SELECT
+  k.project,
+  k.root,
+  k.group_1,
+  k.group_2,
+  k.group_3,
+  k.relation,
+  k.component,
+  k.overall_status,
+  k.next_due_date,
+  k.priority,
+  COALESCE((
+    SELECT ` + d.PathHead("gc.path") + `
+    FROM t_review_info AS ri
+    JOIN t_group_category_group AS gcg
+      ON gcg.project = ri.project
+     AND gcg.deleted = 0
+     AND gcg.path = ` + d.JSONIndex("ri."+d.Ident("groups"), 0) + `
+    JOIN t_group_category AS gc
+      ON gc.id = gcg.group_category_id
+     AND gc.deleted = 0
+     AND gc.root = ri.root
+    WHERE ri.project = k.project
+      AND ri.root = k.root
+      AND ri.group_1 = k.group_1
+      AND ` + pivotGroupColumn("ri", "group_2") + ` = k.group_2
+      AND ` + pivotGroupColumn("ri", "group_3") + ` = k.group_3
+      AND ri.relation = k.relation
+      AND ri.deleted = 0` + asOfCond + `
+    ORDER BY ri.modified_at_utc DESC
+    LIMIT 1
+  ), '') AS top_group_node
+FROM (` + keysSQL + `) AS k
+`
+
+	args := append([]any{}, asOfArgs...)
+	args = append(args, keysArgs...)
+	return sql, args
+}
+
+// AssetPivotGroupedQuery is the query of one ListAssetsPivotGrouped call. The filters
+// are those of AssetPivotQuery.
+type AssetPivotGroupedQuery struct {
+	Project        string // required
+	Root           string // defaults to "assets"
+	PreferredPhase string // phase the status filters apply to; "none" means any phase
+	Direction      string // sort direction of the items within a bucket ("ASC" or "DESC")
+	GroupPage      int    // 1-based bucket page; defaults to 1 if < 1
+	GroupsPerPage  int    // buckets per page; defaults to 10 if <= 0
+
+	AssetNameKey         string
+	ApprovalStatuses     []string
+	WorkStatuses         []string
+	SubmittedUsers       []string
+	ApprovalUpdatedUsers []string
+	Studios              []string
+	Relations            []string
+	RelationMode         string
+	Tags                 []string
+	OverallStatuses      []string
+	OverallRules         entity.OverallStatusRules
+	RequiredPhases       *entity.RequiredPhases
+	OverdueOn            *time.Time
+	AllowedTopGroupNodes []string
+	AsOf                 *time.Time
+
+	Fields []string // normalised phase selection (see reviewInfoFields.go); nil fetches every phase
+	// GroupsOnly returns every bucket with its TotalCount and no items, from the bucket
+	// counts alone; GroupPage and GroupsPerPage are ignored.
+	GroupsOnly bool
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	ListAssetsPivotGrouped retrieves one page of top group node buckets of the asset
+	pivot. Buckets are ordered A→Z (case-insensitive) with "Unassigned" last, and each
+	bucket is returned complete, its items sorted by group_1 in the given direction.
+	Parameters:
+	- ctx: Context for database operations.
+	- q: The query (see AssetPivotGroupedQuery); Project is required.
+	Returns:
+	- []GroupedAssetBucket: The buckets of the page; TotalCount is the bucket size over all pages.
+	- int64: Total number of buckets.
+	- int64: Total number of assets over all buckets.
+	- error: Error if project is missing or database query fails.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) ListAssetsPivotGrouped(
+	ctx context.Context,
+	q AssetPivotGroupedQuery,
+) ([]GroupedAssetBucket, int64, int64, error) {
+	if q.Project == "" {
+		return nil, 0, 0, fmt.Errorf("project is required")
+	}
+	if q.Root == "" {
+		q.Root = "assets"
+	}
+	if q.GroupPage < 1 {
+		q.GroupPage = 1
+	}
+	if q.GroupsPerPage <= 0 {
+		q.GroupsPerPage = 10
+	}
+	dir := "ASC"
+	if strings.EqualFold(strings.TrimSpace(q.Direction), "desc") {
+		dir = "DESC"
+	}
+
+	// DBA-tuned plan, see queryTuning.go
+	hint := r.tuning.Hint(q.Project, QueryStagePivotKeys)
+
+	assetsSQL, assetsArgs := buildAssetTopGroupSQL(
+		dialectOf(r.db), hint, q.Project, q.Root, q.PreferredPhase, q.AssetNameKey,
+		q.ApprovalStatuses, q.WorkStatuses, q.SubmittedUsers, q.ApprovalUpdatedUsers, q.Studios,
+		q.Relations, q.RelationMode, q.Tags, q.OverallStatuses, q.OverallRules, q.RequiredPhases, q.OverdueOn, q.AllowedTopGroupNodes, q.AsOf,
+	)
+
+	// 1) Every bucket with its size, in bucket order.
+	countCtx, span := tracing.Start(ctx, "pivot.group_counts")
+	counts, err := r.cachedCountAssetsByTopGroupNode(
+		countCtx, q.Project, q.Root, q.PreferredPhase, q.AssetNameKey,
+		q.ApprovalStatuses, q.WorkStatuses, q.SubmittedUsers, q.ApprovalUpdatedUsers, q.Studios,
+		q.Relations, q.RelationMode, q.Tags, q.OverallStatuses, q.OverallRules, q.RequiredPhases, q.OverdueOn, q.AllowedTopGroupNodes, q.AsOf,
+	)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("ListAssetsPivotGrouped.count: %w", err)
+	}
+
+	var totalAssets int64
+	for _, c := range counts {
+		totalAssets += int64(c.Count)
+	}
+	totalGroups := int64(len(counts))
+
+	// Collapsed group headers: the counts are all there is to return.
+	if q.GroupsOnly {
+		buckets := make([]GroupedAssetBucket, len(counts))
+		for i, c := range counts {
+			label := c.TopGroupNode
+			if label == "" {
+				label = unassignedTopGroupNode
+			}
+			total := c.Count
+			buckets[i] = GroupedAssetBucket{
+				TopGroupNode: label,
+				Items:        []AssetPivot{},
+				TotalCount:   &total,
+			}
+		}
+		return buckets, totalGroups, totalAssets, nil
+	}
+
+	start := (q.GroupPage - 1) * q.GroupsPerPage
+	if start >= len(counts) {
+		return []GroupedAssetBucket{}, totalGroups, totalAssets, nil
+	}
+	end := start + q.GroupsPerPage
+	if end > len(counts) {
+		end = len(counts)
+	}
+	pageCounts := counts[start:end]
+
+	// 2) The assets of the page's buckets.
+	pageNodes := make([]string, len(pageCounts))
+	for i, c := range pageCounts {
+		pageNodes[i] = c.TopGroupNode
+	}
+	itemsCtx, span := tracing.Start(ctx, "pivot.group_items", attribute.Int("buckets", len(pageNodes)))
+	itemsStart := time.Now()
+	var assets []topGroupAsset
+	itemsSQL := `
+SELECT project, root, group_1, group_2, group_3, relation, component, overall_status, next_due_date, priority, top_group_node
+FROM (` + assetsSQL + `) AS x
+WHERE top_group_node IN ?
+ORDER BY LOWER(group_1) ` + dir + `, group_2 ASC, group_3 ASC, relation ASC, component ASC
+`
+	itemsArgs := append(append([]any{}, assetsArgs...), pageNodes)
+	err = r.ReadWithContext(itemsCtx, q.Project).Raw(itemsSQL, itemsArgs...).Scan(&assets).Error
+	metrics.ObserveQuery("pivot_group_items", itemsStart)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("ListAssetsPivotGrouped.items: %w", err)
+	}
+
+	// 3) Latest row per phase of those assets.
+	assetKeys := make([]entity.PivotAssetKey, len(assets))
+	for i, a := range assets {
+		component := a.Component
+		assetKeys[i] = entity.PivotAssetKey{
+			Group1: a.Group1, Group2: a.Group2, Group3: a.Group3, Relation: a.Relation, Component: &component,
+		}
+	}
+	phasesCtx, span := tracing.Start(ctx, "pivot.phases", attribute.Int("assets", len(assetKeys)))
+	phases, err := r.fetchPivotPhases(phasesCtx, q.Project, q.Root, assetKeys, q.AsOf, q.Fields)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("ListAssetsPivotGrouped.phaseFetch: %w", err)
+	}
+
+	_, span = tracing.Start(ctx, "pivot.fill", attribute.Int("phase_rows", len(phases)))
+	defer span.End()
+
+	m := make(map[pivotAssetID]*AssetPivot, len(assets))
+	byNode := make(map[string][]*AssetPivot, len(pageCounts))
+	rows := make([]*AssetPivot, 0, len(assets))
+	for _, a := range assets {
+		ap := &AssetPivot{
+			Root:         a.Root,
+			Project:      a.Project,
+			Group1:       a.Group1,
+			Group2:       a.Group2,
+			Group3:       a.Group3,
+			Relation:     a.Relation,
+			Component:    a.Component,
+			TopGroupNode: a.TopGroupNode,
+
+			OverallStatus: a.OverallStatus,
+			NextDueDate:   formatDueDate(a.NextDueDate.ptr()),
+			Priority:      a.Priority,
+
+			fields: q.Fields,
+		}
+		m[pivotAssetID{a.Project, a.Root, a.Group1, a.Group2, a.Group3, a.Relation, a.Component}] = ap
+		byNode[a.TopGroupNode] = append(byNode[a.TopGroupNode], ap)
+		rows = append(rows, ap)
+	}
+	for _, pr := range phases {
+		if ap, ok := m[pr.assetID()]; ok {
+			applyPivotPhase(ap, pr)
+		}
+	}
+	if err := r.fillPivotDueDates(ctx, q.Project, q.Root, rows); err != nil {
+		return nil, 0, 0, fmt.Errorf("ListAssetsPivotGrouped.dueDates: %w", err)
+	}
+	if err := r.fillPivotTags(ctx, q.Project, q.Root, rows); err != nil {
+		return nil, 0, 0, fmt.Errorf("ListAssetsPivotGrouped.tags: %w", err)
+	}
+
+	// 4) Buckets in page order.
+	buckets := make([]GroupedAssetBucket, 0, len(pageCounts))
+	for _, c := range pageCounts {
+		label := c.TopGroupNode
+		if label == "" {
+			label = unassignedTopGroupNode
+		}
+		items := make([]AssetPivot, len(byNode[c.TopGroupNode]))
+		for i, ap := range byNode[c.TopGroupNode] {
+			items[i] = *ap
+		}
+		total := c.Count
+		buckets = append(buckets, GroupedAssetBucket{
+			TopGroupNode: label,
+			ItemCount:    len(items),
+			Items:        items,
+			TotalCount:   &total,
+		})
+	}
+
+	return buckets, totalGroups, totalAssets, nil
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	CountAssetsByTopGroupNode counts the assets matching the pivot filters per top group
+	node, over all pages. Buckets are ordered like the grouped view: A→Z
+	(case-insensitive), the "" (unassigned) bucket last.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func (r *ReviewInfo) CountAssetsByTopGroupNode(
+	ctx context.Context,
+	project, root, preferredPhase, assetNameKey string,
+	approvalStatuses []string,
+	workStatuses []string,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	studios []string,
+	relations []string,
+	relationMode string,
+	tags []string,
+	overallStatuses []string,
+	overallRules entity.OverallStatusRules,
+	requiredPhases *entity.RequiredPhases,
+	overdueOn *time.Time,
+	allowedTopGroupNodes []string,
+	asOf *time.Time,
+) ([]TopGroupNodeCount, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+	if root == "" {
+		root = "assets"
+	}
+
+	// DBA-tuned plan, see queryTuning.go
+	hint := r.tuning.Hint(project, QueryStagePivotCount)
+
+	assetsSQL, args := buildAssetTopGroupSQL(
+		dialectOf(r.db), hint, project, root, preferredPhase, assetNameKey,
+		approvalStatuses, workStatuses, submittedUsers, approvalUpdatedUsers, studios,
+		relations, relationMode, tags, overallStatuses, overallRules, requiredPhases, overdueOn, allowedTopGroupNodes, asOf,
+	)
+	sql := `
+SELECT top_group_node, COUNT(*) AS item_count
+FROM (` + assetsSQL + `) AS x
+GROUP BY top_group_node
+ORDER BY (top_group_node = '') ASC, LOWER(top_group_node) ASC
+`
+	defer metrics.ObserveQuery("pivot_group_counts", time.Now())
+	var counts []TopGroupNodeCount
+	if err := r.ReadWithContext(ctx, project).Raw(sql, args...).Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("CountAssetsByTopGroupNode: %w", err)
+	}
+	return counts, nil
+}
+
+/*
+──────────────────────────────────────────────────────────────────────────
+
+	NestBucketsByCategoryPath splits the items of each top group node bucket into child
+	buckets following the items' GroupCategoryPath, down to depth levels counting the
+	top node as level 1 (depth < 0 follows the full path; 0 and 1 leave buckets flat).
+	Children are ordered A→Z (case-insensitive). Items keep their relative order and sit
+	on the deepest node of their path within depth. ItemCount and TotalCount of a child
+	count its whole subtree; the top buckets keep theirs.
+
+───────────────────────────────────────────────────────────────────────────
+*/
+func NestBucketsByCategoryPath(buckets []GroupedAssetBucket, depth int) []GroupedAssetBucket {
+	if depth >= 0 && depth <= 1 {
+		return buckets
+	}
+	out := make([]GroupedAssetBucket, len(buckets))
+	for i, b := range buckets {
+		root := &categoryNode{path: b.TopGroupNode}
+		for _, item := range b.Items {
+			segments := strings.Split(strings.Trim(item.GroupCategoryPath, "/"), "/")
+			// an item whose path does not start at this bucket stays on the top node
+			if len(segments) == 0 || !strings.EqualFold(segments[0], item.TopGroupNode) {
+				segments = segments[:1]
+			}
+			segments = segments[1:]
+			if depth > 0 && len(segments) > depth-1 {
+				segments = segments[:depth-1]
+			}
+			root.add(segments, item)
+		}
+		nested := b
+		nested.Items = root.items
+		nested.Children = root.buckets()
+		out[i] = nested
+	}
+	return out
+}
+
+type categoryNode struct {
+	name     string
+	path     string
+	items    []AssetPivot
+	count    int
+	children map[string]*categoryNode
+	order    []string
+}
+
+func (n *categoryNode) add(segments []string, item AssetPivot) {
+	n.count++
+	if len(segments) == 0 {
+		n.items = append(n.items, item)
+		return
+	}
+	if n.children == nil {
+		n.children = map[string]*categoryNode{}
+	}
+	child, ok := n.children[segments[0]]
+	if !ok {
+		child = &categoryNode{name: segments[0], path: n.path + "/" + segments[0]}
+		n.children[segments[0]] = child
+		n.order = append(n.order, segments[0])
+	}
+	child.add(segments[1:], item)
+}
+
+func (n *categoryNode) buckets() []GroupedAssetBucket {
+	if len(n.order) == 0 {
+		return nil
+	}
+	sort.SliceStable(n.order, func(i, j int) bool {
+		return strings.ToLower(n.order[i]) < strings.ToLower(n.order[j])
+	})
+	out := make([]GroupedAssetBucket, 0, len(n.order))
+	for _, name := range n.order {
+		child := n.children[name]
+		total := child.count
+		items := child.items
+		if items == nil {
+			items = []AssetPivot{}
+		}
+		out = append(out, GroupedAssetBucket{
+			TopGroupNode: child.name,
+			Path:         child.path,
+			ItemCount:    child.count,
+			Items:        items,
+			TotalCount:   &total,
+			Children:     child.buckets(),
+		})
+	}
+	return out
+}
+
+// unassignedAssetsSQL selects the latest row of every asset whose leaf group has no
+// group category; it is the "" bucket of buildAssetTopGroupSQL without the filters.
+const unassignedAssetsSQL = `
+WITH latest AS (
+  SELECT
+    group_1,
+    relation,
+    COALESCE(JSON_UNQUOTE(JSON_EXTRACT(` + "`groups`" + `, '$[0]')), '') AS leaf_group_name,
+    modified_at_utc,
+    ROW_NUMBER() OVER (
+      PARTITION BY group_1, relation
+      ORDER BY modified_at_utc DESC
+    ) AS rn
+  FROM t_review_info
+  WHERE project = ? AND root = 'assets' AND deleted = 0
+)
+SELECT l.group_1, l.relation, l.leaf_group_name, l.modified_at_utc
+FROM latest AS l
+WHERE l.rn = 1
+  AND NOT EXISTS (
+    SELECT 1
+    FROM t_group_category_group AS gcg
+    JOIN t_group_category AS gc
+      ON gc.id = gcg.group_category_id
+     AND gc.deleted = 0
+     AND gc.root = 'assets'
+    WHERE gcg.project = ?
+      AND gcg.deleted = 0
+      AND gcg.path = l.leaf_group_name
+  )
+`
+
+type unassignedAssetRow struct {
+	Group1        string    `gorm:"column:group_1"`
+	Relation      string    `gorm:"column:relation"`
+	LeafGroupName string    `gorm:"column:leaf_group_name"`
+	ModifiedAtUTC time.Time `gorm:"column:modified_at_utc"`
+}
+
+func (r *ReviewInfo) ListUnassignedAssets(
+	db *gorm.DB,
+	params *entity.UnassignedAssetListParams,
+) ([]*entity.UnassignedAsset, int, error) {
+	var total int64
+	if err := db.Raw(
+		"SELECT COUNT(*) FROM ("+unassignedAssetsSQL+") AS u", params.Project, params.Project,
+	).Scan(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []unassignedAssetRow
+	perPage := params.GetPerPage()
+	offset := perPage * (params.GetPage() - 1)
+	if err := db.Raw(
+		unassignedAssetsSQL+"ORDER BY l.group_1, l.relation\nLIMIT ? OFFSET ?",
+		params.Project, params.Project, perPage, offset,
+	).Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	assets := make([]*entity.UnassignedAsset, len(rows))
+	for i, row := range rows {
+		assets[i] = &entity.UnassignedAsset{
+			Name:              row.Group1,
+			Relation:          row.Relation,
+			LeafGroupName:     row.LeafGroupName,
+			LastModifiedAtUtc: row.ModifiedAtUTC,
+		}
+	}
+	return assets, int(total), nil
+}
@@ -0,0 +1,49 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoNaturalSort.go
+
+	Module Description:
+		Natural ordering of asset names for the asset pivot (natural=true).
+
+	Details:
+	- LOWER() orders names character by character, so asset10 sorts before asset2,
+	  and the column collation orders Japanese names by code point.
+	- The natural sort key zero-pads every run of digits to naturalSortWidth in SQL
+	  (asset2 -> asset0000000002), so numbers compare by value, and compares the
+	  result under the Japanese collation (utf8mb4_ja_0900_as_cs) so kana and kanji
+	  follow the Japanese order.
+	- The key is computed in the ORDER BY of the key query, not by re-sorting a page,
+	  so offsets and keyset cursors stay consistent across pages. Runs longer than
+	  naturalSortWidth digits keep their text order.
+	- On PostgreSQL the key uses the ICU Japanese collation ("ja-x-icu"), which the
+	  server must be built with.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Build the key in the SQL dialect of the database.
+
+	Functions:
+	* - naturalSortExpr: Natural sort key expression of a name column.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+// naturalSortWidth is the number of digits runs are padded to; numbers up to that
+// many digits compare by value.
+const naturalSortWidth = 10
+
+// naturalSortExpr returns the natural sort key of column c in dialect d: lower-cased,
+// digit runs zero-padded, under the Japanese collation. The first replacement prefixes
+// every run with naturalSortWidth zeros; the second keeps the last naturalSortWidth
+// digits of each.
+func naturalSortExpr(d reviewquery.Dialect, c string) string {
+	padded := d.ReplaceAll("LOWER("+c+")", "([0-9]+)", strings.Repeat("0", naturalSortWidth)+"$1")
+	return d.Japanese(d.ReplaceAll(padded, fmt.Sprintf("0*([0-9]{%d})", naturalSortWidth), "$1"))
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+// pivotOrderKeys lists every sort key buildOrderKeyClause handles, "" being the default.
+var pivotOrderKeys = []string{
+	"",
+	"submitted_at_utc", "modified_at_utc", "phase",
+	"group1_only", "relation_only", "component", "component_only", "group_rel_submitted",
+	"mdl_submitted", "rig_submitted", "bld_submitted", "dsn_submitted", "ldv_submitted",
+	"mdl_work", "rig_work", "bld_work", "dsn_work", "ldv_work",
+	"work_status",
+	"mdl_appr", "rig_appr", "bld_appr", "dsn_appr", "ldv_appr",
+	"mdl_take", "rig_take", "bld_take", "dsn_take", "ldv_take",
+	"take",
+	AttentionOrderKey,
+	OverallStatusOrderKey,
+	DueDateOrderKey,
+	LatestActivityOrderKey,
+	PriorityFirstOrderKey,
+}
+
+// orderClauseCase is one buildOrderClause call of the tests.
+type orderClauseCase struct {
+	d       reviewquery.Dialect
+	alias   string
+	key     string
+	dir     string
+	nulls   string
+	natural bool
+}
+
+// eachOrderClauseCase calls f for every sort key in every dialect, direction, nulls
+// placement and name order, with and without an alias.
+func eachOrderClauseCase(f func(c orderClauseCase)) {
+	for _, d := range []reviewquery.Dialect{reviewquery.MySQL, reviewquery.PostgreSQL, reviewquery.SQLite} {
+		for _, alias := range []string{"", "p"} {
+			for _, key := range pivotOrderKeys {
+				for _, dir := range []string{"asc", "desc"} {
+					for _, nulls := range []string{"", entity.PivotNullsFirst, entity.PivotNullsLast} {
+						for _, natural := range []bool{false, true} {
+							f(orderClauseCase{d, alias, key, dir, nulls, natural})
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Every order ends in the asset identity, each column of it appearing once, so rows
+// sharing every sort value still have one fixed order.
+func TestBuildOrderClauseTieBreaker(t *testing.T) {
+	eachOrderClauseCase(func(c orderClauseCase) {
+		clause := buildOrderClause(c.d, c.alias, c.key, c.dir, c.nulls, c.natural)
+		terms := splitOrderClause(clause)
+
+		var tail []string
+		for _, col := range []string{"project", "root", "group_1", "group_2", "group_3", "relation"} {
+			if c.alias != "" {
+				col = c.alias + "." + col
+			}
+			tail = append(tail, col)
+		}
+		if len(terms) <= len(tail) {
+			t.Errorf("%+v: %d terms in %q", c, len(terms), clause)
+			return
+		}
+		for i, col := range tail {
+			term := terms[len(terms)-len(tail)+i]
+			if term.expr != col || term.desc {
+				t.Errorf("%+v: term %d of the tail is %+v, want %s ASC", c, i, term, col)
+			}
+			n := 0
+			for _, term := range terms {
+				if term.expr == col {
+					n++
+				}
+			}
+			if n != 1 {
+				t.Errorf("%+v: %s is a term %d times in %q", c, col, n, clause)
+			}
+		}
+	})
+}
+
+// splitOrderClause recovers every term of the clause with its direction, and
+// buildCursorCondition expands that order into one branch per term.
+func TestOrderClauseCursorRoundTrip(t *testing.T) {
+	eachOrderClauseCase(func(c orderClauseCase) {
+		clause := buildOrderClause(c.d, c.alias, c.key, c.dir, c.nulls, c.natural)
+		terms := splitOrderClause(clause)
+
+		parts := make([]string, len(terms))
+		for i, term := range terms {
+			if term.expr == "" {
+				t.Errorf("%+v: empty term %d in %q", c, i, clause)
+			}
+			dir := " ASC"
+			if term.desc {
+				dir = " DESC"
+			}
+			parts[i] = term.expr + dir
+		}
+		if got := strings.Join(parts, ", "); got != clause {
+			t.Errorf("%+v: terms rejoin to\n%s\nwant\n%s", c, got, clause)
+		}
+
+		values := make([]any, len(terms))
+		for i := range values {
+			values[i] = "v"
+		}
+		cond, args, err := buildCursorCondition(terms, values)
+		if err != nil {
+			t.Errorf("%+v: %v", c, err)
+			return
+		}
+		// branch i binds the equalities of terms 0..i-1 and the comparison of term i
+		if want := len(terms) * (len(terms) + 1) / 2; len(args) != want {
+			t.Errorf("%+v: %d args, want %d", c, len(args), want)
+		}
+		if n := strings.Count(cond, "\n    OR "); n != len(terms)-1 {
+			t.Errorf("%+v: %d branches, want %d", c, n+1, len(terms))
+		}
+		if strings.Count(cond, "?") != len(args) {
+			t.Errorf("%+v: %d placeholders for %d args", c, strings.Count(cond, "?"), len(args))
+		}
+		last := terms[len(terms)-1].expr
+		if !strings.HasSuffix(cond, last+" > ?))") {
+			t.Errorf("%+v: condition does not end on %s > ?: %s", c, last, cond)
+		}
+
+		if _, _, err := buildCursorCondition(terms, values[1:]); !errors.Is(err, entity.ErrInvalidPivotCursor) {
+			t.Errorf("%+v: short sort key error = %v, want ErrInvalidPivotCursor", c, err)
+		}
+	})
+}
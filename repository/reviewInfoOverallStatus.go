@@ -0,0 +1,171 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoOverallStatus.go
+
+	Module Description:
+		overall_status of asset pivot rows, the roll-up of the phases of an asset.
+
+	Details:
+	- The roll-up follows entity.OverallStatusRules (per project, see PivotDefaults):
+	  "retake" when any required phase's latest approval status is a retake status,
+	  "approved" when every required phase's is an approved status, "in_progress"
+	  otherwise.
+	- The required phases of an asset come from entity.RequiredPhases by relation or
+	  top group node, then from the rules' RequiredPhases, else every phase it has; a
+	  camera without ldv is thus approved once its required phases are.
+	- It is computed in the asset keys query over the latest row of every phase, before
+	  the status filters drop any row, so a filter on one phase does not change the
+	  roll-up; the list, grouped and count queries filter on it in SQL.
+	- sort=overall_status orders retake, in_progress, approved (ascending).
+	- Conditions are counted with SUM(CASE ...) rather than by summing booleans, which
+	  PostgreSQL does not do.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Only count the required phases of an asset (RequiredPhases).
+	* - 15-10-2026 - Portable condition counts; the top group node in the SQL dialect.
+
+	Functions:
+	* - buildOverallStatusCTE: CTE rolling the latest_phase rows of every asset up.
+	* - buildOverallStatusWhere: Restricts asset_status rows to some overall statuses.
+	* - requiredPhaseExprs: SQL telling which phases an asset requires.
+	* - sortedPhaseMapKeys: Returns the keys of a phase mapping in order.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+// OverallStatusOrderKey sorts the asset pivot by overall_status.
+const OverallStatusOrderKey = "overall_status"
+
+func lowerValues(values []string) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = strings.ToLower(strings.TrimSpace(v))
+	}
+	return out
+}
+
+// buildOverallStatusCTE returns the asset_status CTE (preceded by a comma) with the
+// overall_status of every asset of the latest_phase CTE, which must carry project,
+// root, group_1, group_2, group_3, relation, phase, approval_status, leaf_group_name
+// and rn. Only the phases required of an asset (see requiredPhaseExprs) count.
+func buildOverallStatusCTE(
+	d reviewquery.Dialect,
+	rules entity.OverallStatusRules,
+	required *entity.RequiredPhases,
+) (string, []any) {
+	approval := "LOWER(COALESCE(lp.approval_status, ''))"
+	req, reqArgs, count, countArgs, join := requiredPhaseExprs(d, rules.RequiredPhases, required)
+
+	retakeCond := "FALSE"
+	var retakeArgs []any
+	if len(rules.RetakeStatuses) > 0 {
+		retakeCond = "SUM(CASE WHEN (" + req + ") AND " + approval + " IN ? THEN 1 ELSE 0 END) > 0"
+		retakeArgs = append(append(retakeArgs, reqArgs...), lowerValues(rules.RetakeStatuses))
+	}
+	approved := lowerValues(rules.ApprovedStatuses)
+	// A NULL count requires every phase the asset has.
+	approvedCond := `CASE
+        WHEN MAX(` + count + `) IS NULL THEN SUM(CASE WHEN (` + req + `) AND ` + approval + ` NOT IN ? THEN 1 ELSE 0 END) = 0
+        ELSE COUNT(DISTINCT CASE WHEN (` + req + `) AND ` + approval + ` IN ? THEN LOWER(lp.phase) END) = MAX(` + count + `)
+      END`
+	var approvedArgs []any
+	approvedArgs = append(approvedArgs, countArgs...)
+	approvedArgs = append(approvedArgs, reqArgs...)
+	approvedArgs = append(approvedArgs, approved)
+	approvedArgs = append(approvedArgs, reqArgs...)
+	approvedArgs = append(approvedArgs, approved)
+	approvedArgs = append(approvedArgs, countArgs...)
+
+	return `,
+asset_status AS (
+  SELECT lp.project, lp.root, lp.group_1, lp.group_2, lp.group_3, lp.relation,
+    CASE
+      WHEN ` + retakeCond + ` THEN '` + entity.OverallStatusRetake + `'
+      WHEN ` + approvedCond + ` THEN '` + entity.OverallStatusApproved + `'
+      ELSE '` + entity.OverallStatusInProgress + `'
+    END AS overall_status
+  FROM latest_phase AS lp` + join + `
+  WHERE lp.rn = 1
+  GROUP BY lp.project, lp.root, lp.group_1, lp.group_2, lp.group_3, lp.relation
+)`, append(retakeArgs, approvedArgs...)
+}
+
+// requiredPhaseExprs returns, for a latest_phase row lp, whether its phase is required
+// of its asset (req) and how many phases the asset requires (count, NULL when every
+// phase it has is required), with the join resolving the top group node of lp when
+// required maps top group nodes. Relation mappings win over top group node mappings;
+// unmapped assets require defaults, or every phase when defaults is empty.
+func requiredPhaseExprs(
+	d reviewquery.Dialect,
+	defaults []string,
+	required *entity.RequiredPhases,
+) (req string, reqArgs []any, count string, countArgs []any, join string) {
+	phase := "LOWER(lp.phase)"
+	req, count = "TRUE", "NULL"
+	if len(defaults) > 0 {
+		req, count = phase+" IN ?", "?"
+		reqArgs, countArgs = []any{lowerValues(defaults)}, []any{len(defaults)}
+	}
+	if required.Empty() {
+		return req, reqArgs, count, countArgs, ""
+	}
+
+	var reqSB, countSB strings.Builder
+	var reqWhen, countWhen []any
+	when := func(cond string, key string, phases []string) {
+		reqSB.WriteString(" WHEN " + cond + " THEN " + phase + " IN ?")
+		reqWhen = append(reqWhen, key, lowerValues(phases))
+		countSB.WriteString(" WHEN " + cond + " THEN ?")
+		countWhen = append(countWhen, key, len(phases))
+	}
+	for _, k := range sortedPhaseMapKeys(required.ByRelation) {
+		when("LOWER(lp.relation) = ?", k, required.ByRelation[k])
+	}
+	if len(required.ByTopGroupNode) > 0 {
+		for _, k := range sortedPhaseMapKeys(required.ByTopGroupNode) {
+			when(d.PathHead("rgc.path")+" = ?", k, required.ByTopGroupNode[k])
+		}
+		join = `
+  LEFT JOIN t_group_category_group AS rgcg
+         ON rgcg.project = lp.project
+        AND rgcg.deleted = 0
+        AND rgcg.path = lp.leaf_group_name
+  LEFT JOIN t_group_category AS rgc
+         ON rgc.id = rgcg.group_category_id
+        AND rgc.deleted = 0
+        AND rgc.root = lp.root`
+	}
+	req = "CASE" + reqSB.String() + " ELSE " + req + " END"
+	reqArgs = append(reqWhen, reqArgs...)
+	count = "CASE" + countSB.String() + " ELSE " + count + " END"
+	countArgs = append(countWhen, countArgs...)
+	return req, reqArgs, count, countArgs, join
+}
+
+// sortedPhaseMapKeys returns the keys of m in order, so equal mappings build equal SQL.
+func sortedPhaseMapKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// buildOverallStatusWhere restricts the asset_status rows (referenced as alias) to the
+// given overall statuses; none means unrestricted.
+func buildOverallStatusWhere(alias string, statuses []string) (string, []any) {
+	if len(statuses) == 0 {
+		return "", nil
+	}
+	return " AND " + alias + ".overall_status IN ?", []any{lowerValues(statuses)}
+}
@@ -0,0 +1,86 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoOverview.go
+
+	Module Description:
+		Per-project review statistics for the landing dashboard.
+
+	Details:
+	- Everything is read from t_review_latest, so an overview of every show costs one
+	  grouped scan of the summary instead of a pivot count per show.
+	- asset_count counts the distinct group_1/relation pairs under root "assets".
+	- pending_approval_count counts the latest phase rows in the review queue statuses
+	  (ReviewShotStatuses) under any root.
+	- Category access only restricts asset rows; shot rows are always counted.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) ListReviewProjects: Lists the projects having reviews.
+	* - (ReviewInfo) ProjectOverviews: Computes the overviews of projects.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"gorm.io/gorm"
+)
+
+// ListReviewProjects returns the projects with at least one live review info, limited
+// to projects when not empty, in name order.
+func (r *ReviewInfo) ListReviewProjects(db *gorm.DB, projects []string) ([]string, error) {
+	stmt := db.Table("t_review_latest").Distinct("project").Order("project")
+	if len(projects) > 0 {
+		stmt = stmt.Where("`project` IN ?", projects)
+	}
+	var out []string
+	if err := stmt.Pluck("project", &out).Error; err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectOverviews computes the overviews of projects; allowedTopGroupNodes restricts
+// the counted assets of every one of them (nil means unrestricted). Projects without
+// visible rows are omitted.
+func (r *ReviewInfo) ProjectOverviews(
+	ctx context.Context,
+	projects []string,
+	allowedTopGroupNodes []string,
+) ([]*entity.ReviewProjectOverview, error) {
+	if len(projects) == 0 {
+		return []*entity.ReviewProjectOverview{}, nil
+	}
+	pendingCond, pendingArgs := buildReviewShotStatusWhere(nil)
+	accessCond, accessArgs := buildTopGroupNodeFilter(dialectOf(r.db), "l", allowedTopGroupNodes)
+	if accessCond != "" {
+		accessCond = " AND (l.root <> 'assets' OR (1 = 1" + accessCond + "))"
+	}
+
+	sql := `
+SELECT
+  l.project,
+  COUNT(DISTINCT CASE WHEN l.root = 'assets' THEN l.group_1 END, l.relation) AS asset_count,
+  MAX(l.submitted_at_utc) AS last_submitted_at_utc,
+  SUM(CASE WHEN ` + pendingCond + ` THEN 1 ELSE 0 END) AS pending_approval_count
+FROM t_review_latest AS l
+WHERE l.project IN ?` + accessCond + `
+GROUP BY l.project
+ORDER BY l.project`
+
+	args := append([]any{}, pendingArgs...)
+	args = append(args, projects)
+	args = append(args, accessArgs...)
+
+	var overviews []*entity.ReviewProjectOverview
+	// A dashboard tolerates replica lag, so recent writes do not pin this to the primary.
+	if err := r.ReadWithContext(ctx, "").Raw(sql, args...).Scan(&overviews).Error; err != nil {
+		return nil, fmt.Errorf("ProjectOverviews: %w", err)
+	}
+	return overviews, nil
+}
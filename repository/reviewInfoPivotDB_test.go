@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/schema"
+)
+
+// The pivot tests run the real pivot SQL against a database holding the tables it
+// reads. t_review_info and the group category tables belong to the central database,
+// so they are created here with the columns the pivot reads; the tables of this
+// service are migrated from their models as NewReviewInfo does.
+//
+// openPivotTestDB returns an empty database private to a test: in-memory SQLite by
+// default (reviewInfoPivotSQLite_test.go), MySQL in a container with -tags integration
+// (reviewInfoPivotMySQL_test.go).
+
+// pivotTestGormConfig returns the gorm configuration of the server (t_ tables).
+func pivotTestGormConfig() *gorm.Config {
+	return &gorm.Config{
+		Logger: logger.Discard,
+		NamingStrategy: schema.NamingStrategy{
+			TablePrefix:   "t_",
+			SingularTable: true,
+		},
+	}
+}
+
+// reviewInfoDDL creates t_review_info with the columns the pivot reads.
+func reviewInfoDDL(d reviewquery.Dialect) string {
+	id, text, ts := "INTEGER PRIMARY KEY AUTOINCREMENT", "TEXT", "DATETIME"
+	if d == reviewquery.MySQL {
+		id, text, ts = "INT NOT NULL AUTO_INCREMENT PRIMARY KEY", "VARCHAR(255)", "DATETIME(6)"
+	}
+	return `
+CREATE TABLE t_review_info (
+  id ` + id + `,
+  project ` + text + ` NOT NULL,
+  root ` + text + ` NOT NULL,
+  group_1 ` + text + ` NOT NULL,
+  group_2 ` + text + `,
+  group_3 ` + text + `,
+  relation ` + text + ` NOT NULL,
+  phase ` + text + ` NOT NULL,
+  component ` + text + `,
+  take ` + text + `,
+  ` + d.Ident("groups") + ` JSON,
+  studio ` + text + `,
+  work_status ` + text + `,
+  approval_status ` + text + `,
+  submitted_user ` + text + `,
+  approval_status_updated_user ` + text + `,
+  submitted_at_utc ` + ts + `,
+  modified_at_utc ` + ts + ` NOT NULL,
+  deleted INT NOT NULL DEFAULT 0
+)`
+}
+
+// groupCategoryDDL creates the central group category tables on MySQL; PrepareSQLite
+// creates them on SQLite.
+var groupCategoryDDL = []string{`
+CREATE TABLE t_group_category (
+  id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  root VARCHAR(32) NOT NULL,
+  path VARCHAR(255) NOT NULL,
+  deleted INT NOT NULL DEFAULT 0
+)`, `
+CREATE TABLE t_group_category_group (
+  id INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  project VARCHAR(64) NOT NULL,
+  path VARCHAR(255) NOT NULL,
+  group_category_id INT NOT NULL,
+  deleted INT NOT NULL DEFAULT 0
+)`}
+
+// newPivotTestRepo creates the tables of the pivot in db and returns a ReviewInfo
+// repository reading them.
+func newPivotTestRepo(t *testing.T, db *gorm.DB) *ReviewInfo {
+	t.Helper()
+	d := dialectOf(db)
+	if d == reviewquery.SQLite {
+		if err := PrepareSQLite(db); err != nil {
+			t.Fatal(err)
+		}
+	} else {
+		for _, ddl := range groupCategoryDDL {
+			if err := db.Exec(ddl).Error; err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := db.Exec(reviewInfoDDL(d)).Error; err != nil {
+		t.Fatal(err)
+	}
+	if err := migrateReviewLatest(db); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(
+		&model.ReviewDueDate{},
+		&model.ReviewAssetPriority{},
+		&model.ReviewTag{},
+		&model.ReviewLock{},
+	); err != nil {
+		t.Fatal(err)
+	}
+	return &ReviewInfo{
+		db:     db,
+		counts: newLatestCountCache(),
+	}
+}
+
+// pivotTestRow is one t_review_info row of a fixture.
+type pivotTestRow struct {
+	Group1         string
+	Relation       string
+	Phase          string
+	Take           string
+	Category       string // groups[0], the leaf group resolved through t_group_category_group
+	WorkStatus     string
+	ApprovalStatus string
+	SubmittedAt    time.Time
+}
+
+// pivotTestEpoch is the time the fixture rows are submitted relative to.
+var pivotTestEpoch = time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+// seedGroupCategories maps the leaf groups of the fixtures to category paths of the
+// assets root: leaf -> path, e.g. "chr_main" -> "character/main".
+func seedGroupCategories(t *testing.T, db *gorm.DB, project string, paths map[string]string) {
+	t.Helper()
+	for leaf, path := range paths {
+		if err := db.Exec(
+			"INSERT INTO t_group_category (root, path, deleted) VALUES (?, ?, 0)", "assets", path,
+		).Error; err != nil {
+			t.Fatal(err)
+		}
+		var id int32
+		if err := db.Raw(
+			"SELECT id FROM t_group_category WHERE root = ? AND path = ?", "assets", path,
+		).Scan(&id).Error; err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Exec(
+			"INSERT INTO t_group_category_group (project, path, group_category_id, deleted) VALUES (?, ?, ?, 0)",
+			project, leaf, id,
+		).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// insertPivotRow writes row into project's assets root as a review info does (with its
+// t_review_latest refresh) and returns its ID.
+func insertPivotRow(t *testing.T, r *ReviewInfo, project string, row pivotTestRow) int32 {
+	t.Helper()
+	d := dialectOf(r.db)
+	var id int32
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		groups := fmt.Sprintf(`[%q]`, row.Category)
+		if err := tx.Exec(`
+INSERT INTO t_review_info (project, root, group_1, group_2, group_3, relation, phase, component, take, `+
+			d.Ident("groups")+`, work_status, approval_status, submitted_user, submitted_at_utc, modified_at_utc, deleted)
+VALUES (?, 'assets', ?, '', '', ?, ?, '', ?, ?, ?, ?, 'tester', ?, ?, 0)`,
+			project, row.Group1, row.Relation, row.Phase, row.Take, groups,
+			row.WorkStatus, row.ApprovalStatus, row.SubmittedAt, row.SubmittedAt,
+		).Error; err != nil {
+			return err
+		}
+		if err := tx.Raw("SELECT MAX(id) FROM t_review_info").Scan(&id).Error; err != nil {
+			return err
+		}
+		return refreshReviewLatest(tx, id)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+// updatePivotRow sets the approval status of review info id, as a status update does.
+func updatePivotRow(t *testing.T, r *ReviewInfo, id int32, approvalStatus string, at time.Time) {
+	t.Helper()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(
+			"UPDATE t_review_info SET approval_status = ?, modified_at_utc = ? WHERE id = ?",
+			approvalStatus, at, id,
+		).Error; err != nil {
+			return err
+		}
+		return refreshReviewLatest(tx, id)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// deletePivotRow soft-deletes review info id, as Delete does.
+func deletePivotRow(t *testing.T, r *ReviewInfo, id int32, at time.Time) {
+	t.Helper()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec(
+			"UPDATE t_review_info SET deleted = id, modified_at_utc = ? WHERE id = ?", at, id,
+		).Error; err != nil {
+			return err
+		}
+		return refreshReviewLatest(tx, id)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// pivotPage is the ListAssetsPivot request of the tests; the zero value lists every
+// asset of project rod by name.
+type pivotPage struct {
+	OrderKey         string
+	Direction        string
+	Limit            int
+	Offset           int
+	After            *AssetPivotCursor
+	ApprovalStatuses []string
+	WorkStatuses     []string
+	Relations        []string
+	RelationMode     string
+	AllowedNodes     []string
+}
+
+// queryPivotPage runs ListAssetsPivot for p with the defaults of a project.
+func queryPivotPage(r *ReviewInfo, p pivotPage) ([]AssetPivot, int64, *AssetPivotCursor, error) {
+	rows, total, _, next, err := r.ListAssetsPivot(context.Background(), AssetPivotQuery{
+		Project:              "rod",
+		Root:                 "assets",
+		OrderKey:             p.OrderKey,
+		Direction:            p.Direction,
+		Limit:                p.Limit,
+		Offset:               p.Offset,
+		After:                p.After,
+		ApprovalStatuses:     p.ApprovalStatuses,
+		WorkStatuses:         p.WorkStatuses,
+		Relations:            p.Relations,
+		RelationMode:         p.RelationMode,
+		OverallRules:         entity.DefaultOverallStatusRules,
+		AllowedTopGroupNodes: p.AllowedNodes,
+		Weights:              entity.DefaultAttentionWeights,
+	})
+	return rows, total, next, err
+}
+
+func listPivotPage(t *testing.T, r *ReviewInfo, p pivotPage) ([]AssetPivot, int64, *AssetPivotCursor) {
+	t.Helper()
+	rows, total, next, err := queryPivotPage(r, p)
+	if err != nil {
+		t.Fatalf("ListAssetsPivot(%+v): %v", p, err)
+	}
+	return rows, total, next
+}
+
+// pivotAssetNames returns "group_1/relation" of every row, in order.
+func pivotAssetNames(rows []AssetPivot) []string {
+	names := make([]string, len(rows))
+	for i, row := range rows {
+		names[i] = row.Group1 + "/" + row.Relation
+	}
+	return names
+}
+
+// A relation prefix matches % and _ literally, on SQLite too (no default LIKE escape).
+func TestListAssetsPivotRelationPrefix(t *testing.T) {
+	r := newPivotTestRepo(t, openPivotTestDB(t))
+	for i, rel := range []string{"ch_a", "chXa", "ch%b", "chYb", `ch\c`} {
+		insertPivotRow(t, r, "rod", pivotTestRow{
+			Group1: "hero", Relation: rel, Phase: "MDL", Take: "take01",
+			WorkStatus: "wip", ApprovalStatus: "check",
+			SubmittedAt: pivotTestEpoch.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	for _, tc := range []struct {
+		prefix string
+		want   []string
+	}{
+		{"ch_", []string{"hero/ch_a"}},
+		{"ch%", []string{"hero/ch%b"}},
+		{`ch\`, []string{`hero/ch\c`}},
+		{"ch", []string{"hero/ch%b", "hero/chXa", "hero/chYb", `hero/ch\c`, "hero/ch_a"}},
+	} {
+		rows, _, _ := listPivotPage(t, r, pivotPage{
+			OrderKey: "relation_only", Direction: "asc",
+			Relations: []string{tc.prefix}, RelationMode: entity.PivotRelationModePrefix,
+		})
+		got := pivotAssetNames(rows)
+		sort.Strings(got)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("prefix %q: got %v, want %v", tc.prefix, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,236 @@
+package repository
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// updateGolden rewrites testdata/pivotOrder.golden from the current results:
+//
+//	go test ./repository/ -run TestListAssetsPivotGolden -update
+var updateGolden = flag.Bool("update", false, "rewrite the golden files of testdata")
+
+const pivotGoldenFile = "pivotOrder.golden"
+
+// pivotGoldenCategories maps the leaf groups of the golden fixture to category paths;
+// leaf "misc" has none and lands in the Unassigned bucket.
+var pivotGoldenCategories = map[string]string{
+	"chr_main":  "character/main",
+	"prp_small": "prop/small",
+	"env_ext":   "environment/exterior",
+}
+
+// pivotGoldenRows is the golden fixture: assets of every category and of none,
+// capitalized names among lowercase ones, two relations of one asset, several phases
+// per asset, and takes and submission times shared by several assets. The phases of one asset are submitted
+// at different times, so the row standing for the asset (its latest) is the same on
+// every engine.
+var pivotGoldenRows = []pivotTestRow{
+	{"alice", "main", "MDL", "take0002", "chr_main", "done", "approved", pivotTestEpoch.Add(1 * time.Minute)},
+	{"alice", "main", "RIG", "take0001", "chr_main", "wip", "check", pivotTestEpoch.Add(5 * time.Minute)},
+	{"Bob", "main", "MDL", "take0003", "chr_main", "wip", "retake", pivotTestEpoch.Add(2 * time.Minute)},
+	{"carol", "main", "MDL", "take0001", "prp_small", "wip", "check", pivotTestEpoch.Add(2 * time.Minute)},
+	{"carol", "main", "BLD", "take0012", "prp_small", "hold", "check", pivotTestEpoch.Add(9 * time.Minute)},
+	{"dave", "main", "RIG", "take0010", "prp_small", "done", "approved", pivotTestEpoch.Add(3 * time.Minute)},
+	{"dave", "main", "LDV", "take0004", "prp_small", "wip", "check", pivotTestEpoch.Add(7 * time.Minute)},
+	{"dave", "alt", "RIG", "take0001", "prp_small", "wip", "check", pivotTestEpoch.Add(3 * time.Minute)},
+	{"Erin", "main", "DSN", "take0002", "env_ext", "wip", "retake", pivotTestEpoch.Add(4 * time.Minute)},
+	{"frank", "main", "MDL", "take0001", "misc", "wip", "check", pivotTestEpoch.Add(6 * time.Minute)},
+	{"gina", "main", "MDL", "take0005", "env_ext", "done", "approved", pivotTestEpoch.Add(8 * time.Minute)},
+	{"gina", "main", "RIG", "take0005", "env_ext", "done", "approved", pivotTestEpoch.Add(10 * time.Minute)},
+	{"gina", "main", "BLD", "take0003", "env_ext", "done", "approved", pivotTestEpoch.Add(11 * time.Minute)},
+}
+
+// seedPivotGolden writes the golden fixture into project rod, with due dates on three
+// asset phases and two assets flagged as priority.
+func seedPivotGolden(t *testing.T, r *ReviewInfo) {
+	t.Helper()
+	seedGroupCategories(t, r.db, "rod", pivotGoldenCategories)
+	for _, row := range pivotGoldenRows {
+		insertPivotRow(t, r, "rod", row)
+	}
+	for _, due := range []entity.SetReviewDueDateParams{
+		{Asset: "Bob", Relation: "main", Phase: "MDL", DueDate: "2026-01-20"},
+		{Asset: "Erin", Relation: "main", Phase: "DSN", DueDate: "2026-01-12"},
+		{Asset: "dave", Relation: "main", Phase: "LDV", DueDate: "2026-01-15"},
+	} {
+		due.Project = "rod"
+		if _, err := r.SetDueDate(r.db, &due); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, asset := range []string{"carol", "frank"} {
+		if _, err := r.SetAssetPriority(r.db, &entity.SetAssetPriorityParams{
+			Project: "rod", Asset: asset, Priority: true,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// pivotGoldenFilter is a filter of the tests with the fixture rows it keeps: an asset
+// is listed when one of its latest phase rows matches every condition.
+type pivotGoldenFilter struct {
+	name             string
+	approvalStatuses []string
+	workStatuses     []string
+	allowedNodes     []string
+}
+
+func (f pivotGoldenFilter) keeps(row pivotTestRow) bool {
+	in := func(v string, vals []string) bool {
+		if vals == nil {
+			return true
+		}
+		for _, x := range vals {
+			if strings.EqualFold(x, v) {
+				return true
+			}
+		}
+		return false
+	}
+	node := strings.Split(pivotGoldenCategories[row.Category], "/")[0]
+	return in(row.ApprovalStatus, f.approvalStatuses) &&
+		in(row.WorkStatus, f.workStatuses) &&
+		in(node, f.allowedNodes)
+}
+
+var pivotGoldenFilters = []pivotGoldenFilter{
+	{name: "approval check", approvalStatuses: []string{"check"}},
+	{name: "approval retake or approved", approvalStatuses: []string{"Retake", "approved"}},
+	{name: "work wip", workStatuses: []string{"wip"}},
+	{name: "approval check and work hold", approvalStatuses: []string{"check"}, workStatuses: []string{"hold"}},
+	{name: "categories character and unassigned", allowedNodes: []string{"character", ""}},
+	{name: "category prop, approval check", approvalStatuses: []string{"check"}, allowedNodes: []string{"prop"}},
+	{name: "no category", allowedNodes: []string{}},
+}
+
+// pivotOrderName names a sort key in the golden file.
+func pivotOrderName(key, dir string) string {
+	if key == "" {
+		key = "default"
+	}
+	return key + " " + dir
+}
+
+// For every sort key and direction, the golden fixture lists in the order recorded in
+// testdata/pivotOrder.golden; offset and cursor pages concatenate to that listing with
+// no asset twice or missing; and each filter keeps that order, listing exactly the
+// assets with a matching row.
+func TestListAssetsPivotGolden(t *testing.T) {
+	r := newPivotTestRepo(t, openPivotTestDB(t))
+	seedPivotGolden(t, r)
+	assets := map[string]bool{}
+	for _, row := range pivotGoldenRows {
+		assets[row.Group1+"/"+row.Relation] = true
+	}
+
+	var golden strings.Builder
+	for _, key := range pivotOrderKeys {
+		for _, dir := range []string{"asc", "desc"} {
+			name := pivotOrderName(key, dir)
+			all := pivotPage{OrderKey: key, Direction: dir, Limit: 100}
+			rows, total, _ := listPivotPage(t, r, all)
+			order := pivotAssetNames(rows)
+			fmt.Fprintf(&golden, "%s: %s\n", name, strings.Join(order, " "))
+
+			if len(order) != len(assets) || total != int64(len(assets)) {
+				t.Errorf("%s: %d assets, total %d, want %d", name, len(order), total, len(assets))
+			}
+			checkPivotPages(t, r, name, all, order)
+
+			for _, f := range pivotGoldenFilters {
+				keep := map[string]bool{}
+				for _, row := range pivotGoldenRows {
+					if f.keeps(row) {
+						keep[row.Group1+"/"+row.Relation] = true
+					}
+				}
+				want := []string{}
+				for _, asset := range order {
+					if keep[asset] {
+						want = append(want, asset)
+					}
+				}
+				page := all
+				page.ApprovalStatuses, page.WorkStatuses, page.AllowedNodes =
+					f.approvalStatuses, f.workStatuses, f.allowedNodes
+				rows, total, _ := listPivotPage(t, r, page)
+				got := pivotAssetNames(rows)
+				if !reflect.DeepEqual(got, want) || total != int64(len(want)) {
+					t.Errorf("%s, %s: %v (total %d), want %v", name, f.name, got, total, want)
+				}
+				checkPivotPages(t, r, name+", "+f.name, page, want)
+			}
+		}
+	}
+
+	path := filepath.Join("testdata", pivotGoldenFile)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(golden.String()), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	gotLines, wantLines := strings.Split(golden.String(), "\n"), strings.Split(string(want), "\n")
+	for i := 0; i < len(gotLines) || i < len(wantLines); i++ {
+		var got, want string
+		if i < len(gotLines) {
+			got = gotLines[i]
+		}
+		if i < len(wantLines) {
+			want = wantLines[i]
+		}
+		if got != want {
+			t.Errorf("%s:%d\n got: %s\nwant: %s", path, i+1, got, want)
+		}
+	}
+}
+
+// checkPivotPages lists page three assets at a time, by offset and then by cursor, and
+// checks that both concatenate to want.
+func checkPivotPages(t *testing.T, r *ReviewInfo, name string, page pivotPage, want []string) {
+	t.Helper()
+	page.Limit = 3
+	for _, paging := range []string{"offset", "cursor"} {
+		page.Offset, page.After = 0, nil
+		var got []string
+		for pages := 0; ; pages++ {
+			if pages > len(want) {
+				t.Errorf("%s, %s pages: do not end", name, paging)
+				return
+			}
+			rows, _, next := listPivotPage(t, r, page)
+			got = append(got, pivotAssetNames(rows)...)
+			if paging == "offset" {
+				if len(rows) < page.Limit {
+					break
+				}
+				page.Offset += page.Limit
+			} else {
+				if next == nil {
+					break
+				}
+				page.After = next
+			}
+		}
+		if len(got) == 0 && len(want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s, %s pages: %v, want %v", name, paging, got, want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+//go:build integration
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	sqlmysql "github.com/go-sql-driver/mysql"
+	tcmysql "github.com/testcontainers/testcontainers-go/modules/mysql"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// With -tags integration the pivot tests run against MySQL 8 in a container, the
+// engine of production (Docker required):
+//
+//	go test -tags integration ./repository/
+//
+// One server serves the whole package run; every test gets a database of its own.
+
+// pivotTestServer is the connection of the server, to its default database.
+var pivotTestServer *sqlmysql.Config
+
+// pivotTestDatabases numbers the databases of the tests.
+var pivotTestDatabases atomic.Int32
+
+func TestMain(m *testing.M) {
+	ctx := context.Background()
+	ctr, err := tcmysql.Run(ctx, "mysql:8.0.36",
+		tcmysql.WithUsername("root"),
+		tcmysql.WithPassword("pivot"),
+		tcmysql.WithDatabase("central"),
+	)
+	if err != nil {
+		log.Fatalf("start mysql: %v", err)
+	}
+	dsn, err := ctr.ConnectionString(ctx, "parseTime=true", "loc=UTC")
+	if err == nil {
+		pivotTestServer, err = sqlmysql.ParseDSN(dsn)
+	}
+	if err != nil {
+		ctr.Terminate(ctx)
+		log.Fatalf("mysql dsn: %v", err)
+	}
+
+	code := m.Run()
+	if err := ctr.Terminate(ctx); err != nil {
+		log.Printf("stop mysql: %v", err)
+	}
+	os.Exit(code)
+}
+
+// openPivotTestDB returns an empty MySQL database private to t, dropped when t ends.
+func openPivotTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	server, err := gorm.Open(mysql.Open(pivotTestServer.FormatDSN()), pivotTestGormConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name := fmt.Sprintf("pivot_test_%d", pivotTestDatabases.Add(1))
+	if err := server.Exec("CREATE DATABASE " + name).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := pivotTestServer.Clone()
+	cfg.DBName = name
+	db, err := gorm.Open(mysql.Open(cfg.FormatDSN()), pivotTestGormConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			sqlDB.Close()
+		}
+		server.Exec("DROP DATABASE " + name)
+		if sqlDB, err := server.DB(); err == nil {
+			sqlDB.Close()
+		}
+	})
+	return db
+}
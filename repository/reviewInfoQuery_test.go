@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+// pivotFilters are the filters of the pivot, alone and together, as ListAssetsPivot
+// passes them to buildAssetKeysSQL.
+type pivotFilters struct {
+	assetNameKey         string
+	approvalStatuses     []string
+	workStatuses         []string
+	submittedUsers       []string
+	approvalUpdatedUsers []string
+	studios              []string
+	relations            []string
+	relationMode         string
+	tags                 []string
+	overallStatuses      []string
+	requiredPhases       *entity.RequiredPhases
+	overdueOn            *time.Time
+	allowedTopGroupNodes []string
+	asOf                 *time.Time
+}
+
+func pivotFilterCases() map[string]pivotFilters {
+	day := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	at := time.Date(2026, 1, 8, 12, 0, 0, 0, time.UTC)
+	all := pivotFilters{
+		assetNameKey:         "Chr",
+		approvalStatuses:     []string{"check", "retake"},
+		workStatuses:         []string{"wip"},
+		submittedUsers:       []string{"sam"},
+		approvalUpdatedUsers: []string{"val"},
+		studios:              []string{"ppi"},
+		relations:            []string{"main", "sub"},
+		relationMode:         entity.PivotRelationModePrefix,
+		tags:                 []string{"hero"},
+		overallStatuses:      []string{entity.OverallStatusRetake},
+		requiredPhases:       &entity.RequiredPhases{ByRelation: map[string][]string{"main": {"MDL", "RIG"}}},
+		overdueOn:            &day,
+		allowedTopGroupNodes: []string{"character", ""},
+		asOf:                 &at,
+	}
+	return map[string]pivotFilters{
+		"none":              {},
+		"name":              {assetNameKey: all.assetNameKey},
+		"statuses":          {approvalStatuses: all.approvalStatuses, workStatuses: all.workStatuses},
+		"users":             {submittedUsers: all.submittedUsers, approvalUpdatedUsers: all.approvalUpdatedUsers},
+		"studios":           {studios: all.studios},
+		"relations":         {relations: all.relations},
+		"relation prefixes": {relations: all.relations, relationMode: all.relationMode},
+		"tags":              {tags: all.tags},
+		"overall status":    {overallStatuses: all.overallStatuses, requiredPhases: all.requiredPhases},
+		"overdue":           {overdueOn: all.overdueOn},
+		"categories":        {allowedTopGroupNodes: all.allowedTopGroupNodes},
+		"no category":       {allowedTopGroupNodes: []string{}},
+		"as of":             {asOf: all.asOf},
+		"every filter":      all,
+	}
+}
+
+// sqlPlaceholders counts the ? of sql outside string literals.
+func sqlPlaceholders(sql string) int {
+	n, inQuote := 0, false
+	for _, ch := range sql {
+		switch {
+		case ch == '\'':
+			inQuote = !inQuote
+		case ch == '?' && !inQuote:
+			n++
+		}
+	}
+	return n
+}
+
+// For every filter combination in every dialect, the key query binds one argument per
+// placeholder, starting with the project and root, and binds each filter value.
+func TestBuildAssetKeysSQLFilters(t *testing.T) {
+	for _, d := range []reviewquery.Dialect{reviewquery.MySQL, reviewquery.PostgreSQL, reviewquery.SQLite} {
+		for name, f := range pivotFilterCases() {
+			t.Run(d.Name()+"/"+name, func(t *testing.T) {
+				sql, args := buildAssetKeysSQL(
+					d, QueryHint{}, "rod", "assets", "", f.assetNameKey,
+					f.approvalStatuses, f.workStatuses, f.submittedUsers, f.approvalUpdatedUsers, f.studios,
+					f.relations, f.relationMode, f.tags, f.overallStatuses, entity.DefaultOverallStatusRules,
+					f.requiredPhases, f.overdueOn, f.allowedTopGroupNodes, f.asOf,
+				)
+				if n := sqlPlaceholders(sql); n != len(args) {
+					t.Fatalf("%d placeholders for %d args:\n%s", n, len(args), sql)
+				}
+				if len(args) < 2 || args[0] != "rod" || args[1] != "assets" {
+					t.Errorf("args start with %v, want [rod assets]", args)
+				}
+
+				// "IN ?" binds a slice, which gorm expands.
+				bound := map[string]bool{}
+				for _, a := range args {
+					if vals, ok := a.([]string); ok {
+						for _, v := range vals {
+							bound[strings.ToLower(v)] = true
+						}
+						continue
+					}
+					bound[strings.ToLower(fmt.Sprint(a))] = true
+				}
+				var values []string
+				if f.assetNameKey != "" {
+					values = append(values, strings.ToLower(f.assetNameKey)+"%")
+				}
+				for _, vals := range [][]string{
+					f.approvalStatuses, f.workStatuses, f.submittedUsers, f.approvalUpdatedUsers,
+					f.studios, f.tags, f.overallStatuses,
+				} {
+					values = append(values, vals...)
+				}
+				if f.overdueOn != nil {
+					values = append(values, f.overdueOn.Format(entity.ReviewDueDateLayout))
+				}
+				for _, v := range values {
+					if !bound[strings.ToLower(v)] {
+						t.Errorf("%q not bound: %v", v, args)
+					}
+				}
+				switch {
+				case f.allowedTopGroupNodes == nil:
+				case len(f.allowedTopGroupNodes) == 0 && !strings.Contains(sql, " AND 1 = 0"):
+					t.Errorf("no category allowed, yet rows match:\n%s", sql)
+				case len(f.allowedTopGroupNodes) > 0 && !strings.Contains(sql, "t_group_category"):
+					t.Errorf("category access without a group category join:\n%s", sql)
+				}
+				if f.asOf != nil && !strings.Contains(sql, "t_review_info") {
+					t.Errorf("as-of read not on t_review_info:\n%s", sql)
+				}
+			})
+		}
+	}
+}
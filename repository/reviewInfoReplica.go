@@ -0,0 +1,232 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoReplica.go
+
+	Module Description:
+		Read-replica routing for the heavy review info reads.
+
+	Details:
+	- With SetReader, the pivot (counts, keys, phases, grouped buckets), the status
+	  summary and List read from the replica; every write and the rest of the reads
+	  stay on the primary.
+	- WatchReplica samples the replica lag (SHOW REPLICA STATUS, which needs the
+	  REPLICATION CLIENT privilege). While the lag is unknown or above maxLag, reads go
+	  to the primary.
+	- A project written within the last maxLag is read from the primary, so a submission
+	  shows up in the pivot right away and a stale page cannot be cached after the
+	  invalidation. Writes are noted through InvalidateLatestCounts, which also runs for
+	  writes broadcast by other instances.
+	- A reader that reports no replication status (e.g. the primary itself in
+	  development) counts as caught up.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) SetReader: Routes heavy reads to a read replica.
+	* - (ReviewInfo) ReadWithContext: Returns the connection a read of a project should use.
+	* - (ReviewInfo) WatchReplica: Checks the replica lag every interval.
+	* - (ReviewInfo) CheckReplica: Samples the replica lag once.
+	* - (ReviewInfo) ReplicaStatus: Returns the last replica check.
+	* - replicaLag: Reads the lag from SHOW REPLICA STATUS / SHOW SLAVE STATUS.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ReplicaStatus is the outcome of the last replica lag check.
+type ReplicaStatus struct {
+	Configured    bool      `json:"configured"`
+	Healthy       bool      `json:"healthy"`
+	LagSeconds    *float64  `json:"lag_seconds,omitempty"`
+	MaxLagSeconds float64   `json:"max_lag_seconds,omitempty"`
+	CheckedAt     time.Time `json:"checked_at,omitempty"`
+	Error         string    `json:"error,omitempty"`
+}
+
+type replica struct {
+	db     *gorm.DB
+	maxLag time.Duration
+
+	mu     sync.RWMutex
+	status ReplicaStatus
+	writes map[string]time.Time // project → last write seen by this instance
+}
+
+var errReplicationStopped = errors.New("replication is not running")
+
+// SetReader routes heavy reads to reader while its lag stays within maxLag. Reads use
+// the primary until the first check of WatchReplica or CheckReplica.
+func (r *ReviewInfo) SetReader(reader *gorm.DB, maxLag time.Duration) {
+	r.replica = &replica{
+		db:     reader,
+		maxLag: maxLag,
+		status: ReplicaStatus{Configured: true, MaxLagSeconds: maxLag.Seconds()},
+		writes: map[string]time.Time{},
+	}
+}
+
+// ReadWithContext returns the replica for reads of project when it is healthy and the
+// project was not written recently, the primary otherwise.
+func (r *ReviewInfo) ReadWithContext(ctx context.Context, project string) *gorm.DB {
+	if r.replica == nil || !r.replica.usable(project, time.Now()) {
+		return r.db.WithContext(ctx)
+	}
+	return r.replica.db.WithContext(ctx)
+}
+
+func (p *replica) usable(project string, now time.Time) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if !p.status.Healthy {
+		return false
+	}
+	written, ok := p.writes[project]
+	return !ok || now.Sub(written) > p.maxLag
+}
+
+// noteWrite pins reads of project to the primary for the next maxLag.
+func (p *replica) noteWrite(project string) {
+	if p == nil {
+		return
+	}
+	now := time.Now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.writes[project] = now
+	for prj, t := range p.writes {
+		if now.Sub(t) > p.maxLag {
+			delete(p.writes, prj)
+		}
+	}
+}
+
+// WatchReplica checks the replica lag right away and then every interval until ctx is
+// done. It returns at once when no reader is set.
+func (r *ReviewInfo) WatchReplica(ctx context.Context, interval time.Duration) {
+	if r.replica == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		before := r.ReplicaStatus()
+		after := r.CheckReplica(ctx)
+		if before.Healthy != after.Healthy || before.CheckedAt.IsZero() {
+			if after.Healthy {
+				log.Printf("[REPLICA] reading from the replica (lag %.1fs)", *after.LagSeconds)
+			} else {
+				log.Printf("[REPLICA] reading from the primary: %s", after.Error)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckReplica samples the replica lag once and returns the new status.
+func (r *ReviewInfo) CheckReplica(ctx context.Context) ReplicaStatus {
+	if r.replica == nil {
+		return ReplicaStatus{}
+	}
+	p := r.replica
+	checkCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	lag, err := replicaLag(p.db.WithContext(checkCtx))
+
+	status := ReplicaStatus{
+		Configured:    true,
+		MaxLagSeconds: p.maxLag.Seconds(),
+		CheckedAt:     time.Now().UTC(),
+	}
+	switch {
+	case err != nil:
+		status.Error = err.Error()
+	case lag > p.maxLag:
+		secs := lag.Seconds()
+		status.LagSeconds = &secs
+		status.Error = fmt.Sprintf("lag %v exceeds %v", lag, p.maxLag)
+	default:
+		secs := lag.Seconds()
+		status.LagSeconds = &secs
+		status.Healthy = true
+	}
+	p.mu.Lock()
+	p.status = status
+	p.mu.Unlock()
+	return status
+}
+
+// ReplicaStatus returns the last check; Configured is false without a reader.
+func (r *ReviewInfo) ReplicaStatus() ReplicaStatus {
+	if r.replica == nil {
+		return ReplicaStatus{}
+	}
+	r.replica.mu.RLock()
+	defer r.replica.mu.RUnlock()
+	return r.replica.status
+}
+
+// replicaLag reads Seconds_Behind_Source, falling back to the pre-8.0.22 statement.
+func replicaLag(db *gorm.DB) (time.Duration, error) {
+	var lastErr error
+	for _, stmt := range []string{"SHOW REPLICA STATUS", "SHOW SLAVE STATUS"} {
+		rows, err := db.Raw(stmt).Rows()
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		lag, err := scanReplicaLag(rows)
+		rows.Close()
+		return lag, err
+	}
+	return 0, lastErr
+}
+
+func scanReplicaLag(rows *sql.Rows) (time.Duration, error) {
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+	if !rows.Next() {
+		// Not a replica: as fresh as the primary.
+		return 0, rows.Err()
+	}
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, err
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if values[i] == nil {
+			return 0, errReplicationStopped
+		}
+		secs, err := strconv.ParseFloat(string(values[i]), 64)
+		if err != nil {
+			return 0, err
+		}
+		return time.Duration(secs * float64(time.Second)), nil
+	}
+	return 0, fmt.Errorf("replica status has no Seconds_Behind_Source column")
+}
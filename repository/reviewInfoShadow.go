@@ -0,0 +1,158 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoShadow.go
+
+	Module Description:
+		Shadow comparison of ListAssetsPivot implementations.
+
+	Details:
+	- ListAssetsPivot keeps being rewritten (raw SQL, GORM builder, split queries) and
+	  the rewrites tend to regress the ordering. A rewrite registers itself in
+	  assetPivotImpls under a name; with the shadow enabled (PPI_PIVOT_SHADOW=<name>), a
+	  sample of the ListAssetsPivot calls (PPI_PIVOT_SHADOW_SAMPLE, default 1%) runs it
+	  as well, with the same AssetPivotQuery, and compares its page with the one served.
+	- The shadow runs after the response in the background, at most
+	  maxShadowInFlight at once (further samples are skipped), under shadowTimeout. It
+	  never changes what is served.
+	- Compared are the total (unless estimated or skipped), the order of the rows and
+	  each row's JSON. A mismatch is logged with the query, so it can be replayed, and
+	  counted in central30_pivot_shadow_total{result}. Writes between the two runs show
+	  up as mismatches too.
+	- keys_phases, the current implementation, can shadow itself, which catches
+	  orderings that are not deterministic.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) SetPivotShadow: Enables the shadow comparison of an implementation.
+	* - (ReviewInfo) shadowAssetsPivot: Runs the shadow of a sampled call.
+	* - diffAssetPivot: Describes the first difference of two pivot pages.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/metrics"
+)
+
+const (
+	shadowTimeout     = 30 * time.Second
+	maxShadowInFlight = 2
+)
+
+// AssetPivotImpl is an implementation of ListAssetsPivot.
+type AssetPivotImpl func(
+	r *ReviewInfo,
+	ctx context.Context,
+	q AssetPivotQuery,
+) ([]AssetPivot, int64, bool, *AssetPivotCursor, error)
+
+// assetPivotImpls are the implementations the shadow can run, by name.
+var assetPivotImpls = map[string]AssetPivotImpl{
+	"keys_phases": (*ReviewInfo).listAssetsPivot,
+}
+
+type pivotShadow struct {
+	name     string
+	impl     AssetPivotImpl
+	sample   float64
+	inFlight chan struct{}
+}
+
+// SetPivotShadow runs the implementation name next to ListAssetsPivot on a sample
+// fraction of the calls and logs where their pages differ. An empty name or a
+// sample <= 0 disables the shadow.
+func (r *ReviewInfo) SetPivotShadow(name string, sample float64) error {
+	if name == "" || sample <= 0 {
+		r.shadow = nil
+		return nil
+	}
+	impl, ok := assetPivotImpls[name]
+	if !ok {
+		names := make([]string, 0, len(assetPivotImpls))
+		for n := range assetPivotImpls {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown ListAssetsPivot implementation %q (known: %s)", name, strings.Join(names, ", "))
+	}
+	r.shadow = &pivotShadow{
+		name:     name,
+		impl:     impl,
+		sample:   sample,
+		inFlight: make(chan struct{}, maxShadowInFlight),
+	}
+	return nil
+}
+
+// shadowAssetsPivot runs the shadow implementation on q when the call is sampled and
+// compares its page with rows and total, the page served.
+func (r *ReviewInfo) shadowAssetsPivot(q AssetPivotQuery, rows []AssetPivot, total int64, estimated bool) {
+	sh := r.shadow
+	if sh == nil || rand.Float64() >= sh.sample {
+		return
+	}
+	select {
+	case sh.inFlight <- struct{}{}:
+	default:
+		metrics.PivotShadow.WithLabelValues("skipped").Inc()
+		return
+	}
+	go func() {
+		defer func() { <-sh.inFlight }()
+		ctx, cancel := context.WithTimeout(context.Background(), shadowTimeout)
+		defer cancel()
+		start := time.Now()
+		shadowRows, shadowTotal, _, _, err := sh.impl(r, ctx, q)
+		if err != nil {
+			metrics.PivotShadow.WithLabelValues("error").Inc()
+			log.Printf("[SHADOW] pivot %s failed for %s: %v", sh.name, q.Project, err)
+			return
+		}
+		diff := ""
+		if !estimated && !q.SkipCount && total != shadowTotal {
+			diff = fmt.Sprintf("total %d, shadow %d", total, shadowTotal)
+		} else {
+			diff = diffAssetPivot(rows, shadowRows)
+		}
+		if diff == "" {
+			metrics.PivotShadow.WithLabelValues("match").Inc()
+			return
+		}
+		metrics.PivotShadow.WithLabelValues("mismatch").Inc()
+		b, _ := json.Marshal(q)
+		log.Printf("[SHADOW] pivot %s differs for %s (%v): %s; query %s",
+			sh.name, q.Project, time.Since(start), diff, b)
+	}()
+}
+
+// diffAssetPivot describes the first difference between the pages a and b, or returns
+// "" when they are the same.
+func diffAssetPivot(a, b []AssetPivot) string {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i].Group1 != b[i].Group1 || a[i].Group2 != b[i].Group2 || a[i].Group3 != b[i].Group3 ||
+			a[i].Relation != b[i].Relation || a[i].Component != b[i].Component {
+			return fmt.Sprintf("order at row %d: %s/%s, shadow %s/%s", i, a[i].Group1, a[i].Relation, b[i].Group1, b[i].Relation)
+		}
+		ja, errA := json.Marshal(a[i])
+		jb, errB := json.Marshal(b[i])
+		if errA != nil || errB != nil || !bytes.Equal(ja, jb) {
+			return fmt.Sprintf("cells of row %d (%s/%s): %s, shadow %s", i, a[i].Group1, a[i].Relation, ja, jb)
+		}
+	}
+	if len(a) != len(b) {
+		return fmt.Sprintf("rows %d, shadow %d", len(a), len(b))
+	}
+	return ""
+}
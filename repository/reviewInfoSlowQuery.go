@@ -0,0 +1,149 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoSlowQuery.go
+
+	Module Description:
+		Slow query log with EXPLAIN plans for the pivot queries.
+
+	Details:
+	- With a threshold set (PPI_SLOW_QUERY_THRESHOLD), a pivot count, key or phase query
+	  running longer is logged with its SQL, its bound parameters and the plan the
+	  database picks for it (EXPLAIN FORMAT=TREE, plain EXPLAIN on PostgreSQL, run on
+	  the same connection pool), so a plan regression, e.g. after a buildOrderClause
+	  change, shows up in the logs.
+	- Parameters are redacted: strings (asset names, users, statuses) are logged as their
+	  length, lists as their size; numbers, booleans and times are kept since they shape
+	  the plan.
+	- Each stage is EXPLAINed at most once per slowQueryExplainInterval, so a struggling
+	  database does not get a second query for every slow one.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - EXPLAIN in the SQL dialect of the connection.
+
+	Functions:
+	* - (ReviewInfo) SetSlowQueryLog: Enables the slow query log above a duration.
+	* - (ReviewInfo) scanPivot: Runs a pivot query, logging it when slow.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	slowQueryExplainInterval = time.Minute
+	slowQueryExplainTimeout  = 5 * time.Second
+)
+
+type slowQueryLog struct {
+	threshold time.Duration
+
+	mu        sync.Mutex
+	explained map[string]time.Time // stage -> last EXPLAIN
+}
+
+// SetSlowQueryLog logs pivot queries running longer than threshold with their plan;
+// zero disables the log.
+func (r *ReviewInfo) SetSlowQueryLog(threshold time.Duration) {
+	if threshold <= 0 {
+		r.slow = nil
+		return
+	}
+	r.slow = &slowQueryLog{
+		threshold: threshold,
+		explained: map[string]time.Time{},
+	}
+}
+
+// scanPivot runs sql on db into dest and hands it to the slow query log.
+func (r *ReviewInfo) scanPivot(db *gorm.DB, stage, project string, dest any, sql string, args ...any) error {
+	start := time.Now()
+	err := db.Raw(sql, args...).Scan(dest).Error
+	if r.slow != nil {
+		r.slow.observe(db, stage, project, sql, args, time.Since(start))
+	}
+	return err
+}
+
+func (l *slowQueryLog) observe(db *gorm.DB, stage, project, sql string, args []any, took time.Duration) {
+	if took < l.threshold {
+		return
+	}
+	log.Printf("[SLOW QUERY] %s of %s took %v\nSQL: %s\nParams: %s",
+		stage, project, took, strings.TrimSpace(sql), redactQueryArgs(args))
+	if !l.shouldExplain(stage) {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), slowQueryExplainTimeout)
+	defer cancel()
+	var plan []string
+	if err := db.Session(&gorm.Session{NewDB: true}).WithContext(ctx).Raw(
+		dialectOf(db).Explain()+strings.TrimSuffix(strings.TrimSpace(sql), ";"), args...,
+	).Scan(&plan).Error; err != nil {
+		log.Printf("[SLOW QUERY] EXPLAIN of %s failed: %v", stage, err)
+		return
+	}
+	log.Printf("[SLOW QUERY] plan of %s:\n%s", stage, strings.Join(plan, "\n"))
+}
+
+func (l *slowQueryLog) shouldExplain(stage string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if last, ok := l.explained[stage]; ok && now.Sub(last) < slowQueryExplainInterval {
+		return false
+	}
+	l.explained[stage] = now
+	return true
+}
+
+// redactQueryArgs formats bound parameters without their text values.
+func redactQueryArgs(args []any) string {
+	out := make([]string, len(args))
+	for i, a := range args {
+		out[i] = redactQueryArg(a)
+	}
+	return "[" + strings.Join(out, ", ") + "]"
+}
+
+func redactQueryArg(a any) string {
+	switch v := a.(type) {
+	case nil:
+		return "NULL"
+	case string:
+		return fmt.Sprintf("<string len=%d>", len(v))
+	case *string:
+		if v == nil {
+			return "NULL"
+		}
+		return fmt.Sprintf("<string len=%d>", len(*v))
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return "NULL"
+		}
+		return v.UTC().Format(time.RFC3339)
+	}
+	rv := reflect.ValueOf(a)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return fmt.Sprintf("<list len=%d>", rv.Len())
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(a)
+	}
+	return fmt.Sprintf("<%T>", a)
+}
@@ -0,0 +1,253 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoStats.go
+
+	Module Description:
+		Submission statistics of a project over time.
+
+	Details:
+	- Every live row of t_review_info is one submission; rows are bucketed by the UTC
+	  date of submitted_at_utc in SQL, so only the grouped counts leave the database.
+	- Weeks start on Monday, months on their first day.
+	- Category access only restricts asset rows; shot rows are always counted.
+	- Approval latency is approval_status_updated_at_utc - submitted_at_utc of the rows
+	  whose current approval_status is the requested one. Percentiles are nearest-rank,
+	  picked with window functions so only one row per phase/relation is returned.
+	- A take entered retake as many times as t_review_status_history recorded it, and at
+	  least once when its current approval_status is retake (takes older than the
+	  history log).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added ApprovalLatencies.
+	* - 15-10-2026 - Added AssetRetakes and ArtistRetakes.
+
+	Functions:
+	* - (ReviewInfo) SubmissionCounts: Counts submissions per interval and phase.
+	* - (ReviewInfo) ApprovalLatencies: Computes approval latencies per phase and relation.
+	* - (ReviewInfo) AssetRetakes: Lists the asset phases sent to retake most often.
+	* - (ReviewInfo) ArtistRetakes: Computes the retake record of every submitting user.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+)
+
+// submissionBucketExprs give the start date of the interval holding ri.submitted_at_utc.
+var submissionBucketExprs = map[string]string{
+	entity.ReviewStatsIntervalDay:   "DATE_FORMAT(ri.submitted_at_utc, '%Y-%m-%d')",
+	entity.ReviewStatsIntervalWeek:  "DATE_FORMAT(DATE_SUB(ri.submitted_at_utc, INTERVAL WEEKDAY(ri.submitted_at_utc) DAY), '%Y-%m-%d')",
+	entity.ReviewStatsIntervalMonth: "DATE_FORMAT(ri.submitted_at_utc, '%Y-%m-01')",
+}
+
+// SubmissionCounts counts the submissions of params.Project in [From, To) per interval
+// bucket and phase; allowedTopGroupNodes restricts the counted assets (nil means
+// unrestricted). Buckets without submissions are omitted.
+func (r *ReviewInfo) SubmissionCounts(
+	ctx context.Context,
+	params *entity.ReviewSubmissionStatsParams,
+	allowedTopGroupNodes []string,
+) ([]*entity.ReviewSubmissionCount, error) {
+	bucket, ok := submissionBucketExprs[params.Interval]
+	if !ok {
+		return nil, fmt.Errorf("SubmissionCounts: unknown interval %q", params.Interval)
+	}
+	accessCond, accessArgs := buildTopGroupNodeFilter(dialectOf(r.db), "ri", allowedTopGroupNodes)
+	if accessCond != "" {
+		accessCond = " AND (ri.root <> 'assets' OR (1 = 1" + accessCond + "))"
+	}
+
+	sql := `
+SELECT
+  ` + bucket + ` AS bucket,
+  ri.phase,
+  COUNT(*) AS count
+FROM t_review_info AS ri
+WHERE ri.project = ?
+  AND ri.deleted = 0
+  AND ri.submitted_at_utc >= ?
+  AND ri.submitted_at_utc < ?` + accessCond + `
+GROUP BY bucket, ri.phase
+ORDER BY bucket, ri.phase`
+
+	args := []any{params.Project, params.From, params.To}
+	args = append(args, accessArgs...)
+
+	var counts []*entity.ReviewSubmissionCount
+	if err := r.ReadWithContext(ctx, params.Project).Raw(sql, args...).Scan(&counts).Error; err != nil {
+		return nil, fmt.Errorf("SubmissionCounts: %w", err)
+	}
+	return counts, nil
+}
+
+// ApprovalLatencies computes the submission-to-approval latencies of params.Project per
+// phase and relation; allowedTopGroupNodes restricts the counted assets (nil means
+// unrestricted). Rows without a submission time, or approved before it, are skipped.
+func (r *ReviewInfo) ApprovalLatencies(
+	ctx context.Context,
+	params *entity.ReviewApprovalLatencyParams,
+	allowedTopGroupNodes []string,
+) ([]*entity.ReviewApprovalLatency, error) {
+	rangeCond := ""
+	args := []any{params.Project, strings.ToLower(params.Status)}
+	if params.From != nil {
+		rangeCond += " AND ri.approval_status_updated_at_utc >= ?"
+		args = append(args, *params.From)
+	}
+	if params.To != nil {
+		rangeCond += " AND ri.approval_status_updated_at_utc < ?"
+		args = append(args, *params.To)
+	}
+	accessCond, accessArgs := buildTopGroupNodeFilter(dialectOf(r.db), "ri", allowedTopGroupNodes)
+	if accessCond != "" {
+		accessCond = " AND (ri.root <> 'assets' OR (1 = 1" + accessCond + "))"
+	}
+	args = append(args, accessArgs...)
+
+	sql := `
+WITH latencies AS (
+  SELECT
+    ri.phase,
+    ri.relation,
+    TIMESTAMPDIFF(SECOND, ri.submitted_at_utc, ri.approval_status_updated_at_utc) AS latency
+  FROM t_review_info AS ri
+  WHERE ri.project = ?
+    AND ri.deleted = 0
+    AND LOWER(ri.approval_status) = ?
+    AND ri.submitted_at_utc IS NOT NULL
+    AND ri.approval_status_updated_at_utc >= ri.submitted_at_utc` + rangeCond + accessCond + `
+),
+ranked AS (
+  SELECT
+    phase,
+    relation,
+    latency,
+    ROW_NUMBER() OVER (PARTITION BY phase, relation ORDER BY latency) AS rn,
+    COUNT(*) OVER (PARTITION BY phase, relation) AS cnt
+  FROM latencies
+)
+SELECT
+  phase,
+  relation,
+  MAX(cnt) AS count,
+  AVG(latency) AS avg_seconds,
+  MAX(CASE WHEN rn = CEIL(0.50 * cnt) THEN latency END) AS p50_seconds,
+  MAX(CASE WHEN rn = CEIL(0.90 * cnt) THEN latency END) AS p90_seconds,
+  MAX(CASE WHEN rn = CEIL(0.95 * cnt) THEN latency END) AS p95_seconds
+FROM ranked
+GROUP BY phase, relation
+ORDER BY phase, relation`
+
+	var latencies []*entity.ReviewApprovalLatency
+	if err := r.ReadWithContext(ctx, params.Project).Raw(sql, args...).Scan(&latencies).Error; err != nil {
+		return nil, fmt.Errorf("ApprovalLatencies: %w", err)
+	}
+	return latencies, nil
+}
+
+// buildRetakeTakesCTE returns the retake_takes CTE: one row per take of the request with
+// the number of times it entered params.Status.
+func buildRetakeTakesCTE(
+	params *entity.ReviewRetakeStatsParams,
+	allowedTopGroupNodes []string,
+) (string, []any) {
+	status := strings.ToLower(params.Status)
+	cond := ""
+	args := []any{status, params.Project, entity.ReviewActivityFieldApprovalStatus, status, params.Project}
+	if params.Root != "" {
+		cond += " AND ri.root = ?"
+		args = append(args, params.Root)
+	}
+	if params.From != nil {
+		cond += " AND ri.submitted_at_utc >= ?"
+		args = append(args, *params.From)
+	}
+	if params.To != nil {
+		cond += " AND ri.submitted_at_utc < ?"
+		args = append(args, *params.To)
+	}
+	accessCond, accessArgs := buildTopGroupNodeFilter(reviewquery.MySQL, "ri", allowedTopGroupNodes)
+	if accessCond != "" {
+		cond += " AND (ri.root <> 'assets' OR (1 = 1" + accessCond + "))"
+		args = append(args, accessArgs...)
+	}
+
+	return `
+WITH retake_takes AS (
+  SELECT
+    ri.root,
+    ri.group_1,
+    ri.relation,
+    ri.phase,
+    COALESCE(ri.submitted_user, '') AS submitted_user,
+    GREATEST(
+      COALESCE(h.entered, 0),
+      CASE WHEN LOWER(COALESCE(ri.approval_status, '')) = ? THEN 1 ELSE 0 END
+    ) AS retakes
+  FROM t_review_info AS ri
+  LEFT JOIN (
+    SELECT review_info_id, COUNT(*) AS entered
+    FROM t_review_status_history
+    WHERE project = ? AND field = ? AND LOWER(to_value) = ?
+    GROUP BY review_info_id
+  ) AS h ON h.review_info_id = ri.id
+  WHERE ri.project = ?
+    AND ri.deleted = 0` + cond + `
+)`, args
+}
+
+// AssetRetakes lists the asset phases whose takes entered the retake status at least
+// once, most retakes first, at most params.Limit of them.
+func (r *ReviewInfo) AssetRetakes(
+	ctx context.Context,
+	params *entity.ReviewRetakeStatsParams,
+	allowedTopGroupNodes []string,
+) ([]*entity.ReviewAssetRetakes, error) {
+	cte, args := buildRetakeTakesCTE(params, allowedTopGroupNodes)
+	sql := cte + `
+SELECT root, group_1, relation, phase, COUNT(*) AS takes, SUM(retakes) AS retakes
+FROM retake_takes
+GROUP BY root, group_1, relation, phase
+HAVING SUM(retakes) > 0
+ORDER BY retakes DESC, root, group_1, relation, phase
+LIMIT ?`
+	args = append(args, params.Limit)
+
+	var out []*entity.ReviewAssetRetakes
+	if err := r.ReadWithContext(ctx, params.Project).Raw(sql, args...).Scan(&out).Error; err != nil {
+		return nil, fmt.Errorf("AssetRetakes: %w", err)
+	}
+	return out, nil
+}
+
+// ArtistRetakes computes the retake record of every user who submitted a take of the
+// request, in user order. RetakeRate is left to the caller.
+func (r *ReviewInfo) ArtistRetakes(
+	ctx context.Context,
+	params *entity.ReviewRetakeStatsParams,
+	allowedTopGroupNodes []string,
+) ([]*entity.ReviewArtistRetakes, error) {
+	cte, args := buildRetakeTakesCTE(params, allowedTopGroupNodes)
+	sql := cte + `
+SELECT
+  submitted_user,
+  COUNT(*) AS takes,
+  SUM(CASE WHEN retakes > 0 THEN 1 ELSE 0 END) AS retaken_takes,
+  SUM(retakes) AS retakes
+FROM retake_takes
+GROUP BY submitted_user
+ORDER BY submitted_user`
+
+	var out []*entity.ReviewArtistRetakes
+	if err := r.ReadWithContext(ctx, params.Project).Raw(sql, args...).Scan(&out).Error; err != nil {
+		return nil, fmt.Errorf("ArtistRetakes: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,84 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoStatusCount.go
+
+	Module Description:
+		Counts of the latest review info rows by phase and status.
+
+	Details:
+	- Like the pivot, only the latest row of every asset phase (per component) counts.
+	- Statuses are compared lower-cased; a missing status is counted as "".
+	- The caller maps the raw values to the project's status vocabulary.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Read from the read replica.
+
+	Functions:
+	* - (ReviewInfo) CountLatestByStatus: Counts latest rows per phase and status value.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+)
+
+// StatusCount is the number of latest rows of a phase having a status value.
+type StatusCount struct {
+	Phase  string `gorm:"column:phase"`
+	Status string `gorm:"column:status"`
+	Count  int    `gorm:"column:count"`
+}
+
+// statusCountColumns are the t_review_info columns CountLatestByStatus can count by.
+var statusCountColumns = map[string]bool{
+	"approval_status": true,
+	"work_status":     true,
+}
+
+// CountLatestByStatus counts the latest rows of every asset phase of project under root
+// by the value of column (approval_status or work_status). allowedTopGroupNodes limits
+// the rows like the pivot; nil means unrestricted.
+func (r *ReviewInfo) CountLatestByStatus(
+	ctx context.Context,
+	project, root, column string,
+	allowedTopGroupNodes []string,
+) ([]StatusCount, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+	if !statusCountColumns[column] {
+		return nil, fmt.Errorf("cannot count by column %q", column)
+	}
+	if root == "" {
+		root = "assets"
+	}
+	accessCond, accessArgs := buildTopGroupNodeFilter(dialectOf(r.db), "t_review_info", allowedTopGroupNodes)
+
+	sql := `
+WITH latest_phase AS (
+  SELECT
+    LOWER(phase) AS phase,
+    LOWER(COALESCE(` + column + `, '')) AS status,
+    ROW_NUMBER() OVER (
+      PARTITION BY project, root, group_1, relation, component, phase
+      ORDER BY modified_at_utc DESC
+    ) AS rn
+  FROM t_review_info
+  WHERE project = ? AND root = ? AND deleted = 0` + accessCond + `
+)
+SELECT phase, status, COUNT(*) AS count
+FROM latest_phase
+WHERE rn = 1
+GROUP BY phase, status
+ORDER BY phase, status;
+`
+	args := append([]any{project, root}, accessArgs...)
+	var counts []StatusCount
+	if err := r.ReadWithContext(ctx, project).Raw(sql, args...).Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
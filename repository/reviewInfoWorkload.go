@@ -0,0 +1,87 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewInfoWorkload.go
+
+	Module Description:
+		Per-studio workload of a project for the outsourcing coordination page.
+
+	Details:
+	- One grouped scan of t_review_latest; the studio is read from the t_review_info row
+	  each summary row copies, which is a primary key lookup.
+	- pending uses the review queue statuses (ReviewShotStatuses) of the overview.
+	- Category access only restricts asset rows; shot rows are always counted.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) Workload: Counts the asset phases of a project per studio and phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// Workload counts the latest asset phases of params.Project per studio and phase by
+// state; approvedStatus is the final approval status and allowedTopGroupNodes restricts
+// the counted assets (nil means unrestricted). Rows without a studio get "".
+func (r *ReviewInfo) Workload(
+	ctx context.Context,
+	params *entity.ReviewWorkloadParams,
+	approvedStatus string,
+	allowedTopGroupNodes []string,
+) ([]*entity.ReviewWorkload, error) {
+	pendingCond, pendingArgs := buildReviewShotStatusWhere(nil)
+	approved := strings.ToLower(approvedStatus)
+
+	cond := ""
+	scopeArgs := []any{params.Project}
+	if params.Root != "" {
+		cond += " AND l.root = ?"
+		scopeArgs = append(scopeArgs, params.Root)
+	}
+	accessCond, accessArgs := buildTopGroupNodeFilter(dialectOf(r.db), "l", allowedTopGroupNodes)
+	if accessCond != "" {
+		cond += " AND (l.root <> 'assets' OR (1 = 1" + accessCond + "))"
+		scopeArgs = append(scopeArgs, accessArgs...)
+	}
+
+	sql := `
+SELECT
+  w.studio,
+  w.phase,
+  SUM(CASE WHEN w.approved THEN 1 ELSE 0 END) AS approved,
+  SUM(CASE WHEN NOT w.approved AND ` + pendingCond + ` THEN 1 ELSE 0 END) AS pending,
+  COUNT(*) AS total
+FROM (
+  SELECT
+    l.phase,
+    l.work_status,
+    l.approval_status,
+    LOWER(COALESCE(l.approval_status, '')) = ? AS approved,
+    COALESCE((SELECT ri.studio FROM t_review_info AS ri WHERE ri.id = l.review_info_id), '') AS studio
+  FROM t_review_latest AS l
+  WHERE l.project = ?` + cond + `
+) AS w
+GROUP BY w.studio, w.phase
+ORDER BY w.studio, w.phase`
+
+	args := append([]any{}, pendingArgs...)
+	args = append(args, approved)
+	args = append(args, scopeArgs...)
+
+	var out []*entity.ReviewWorkload
+	if err := r.ReadWithContext(ctx, params.Project).Raw(sql, args...).Scan(&out).Error; err != nil {
+		return nil, fmt.Errorf("Workload: %w", err)
+	}
+	for _, w := range out {
+		w.WIP = w.Total - w.Approved - w.Pending
+	}
+	return out, nil
+}
@@ -0,0 +1,248 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewLatest.go
+
+	Module Description:
+		t_review_latest, the latest review info row of every asset phase maintained on write.
+
+	Details:
+	- The pivot used to find the latest row of every phase with window functions over all
+	  of t_review_info, every take of every asset, on every page; on large shows those
+	  scans are what timed out. t_review_latest holds one row per
+	  project/root/group_1/group_2/group_3/relation/phase with a copy of the latest live
+	  row, so current pivot reads are indexed lookups on a table the size of the pivot.
+	- group_2 and group_3 are part of the key outside the assets root only (shots are
+	  told apart by sequence and shot); they are stored as "" for assets and for NULL, as
+	  pivotGroupColumn reads them from t_review_info.
+	- Every write of a review info row (Create, Update, Delete, Restore, the import
+	  backdating) calls refreshReviewLatest in the same transaction, which recomputes the
+	  row of the touched phase from t_review_info. The summary is therefore never ahead
+	  of or behind the rows it was computed from.
+	- "Latest" is the highest modified_at_utc among live rows, ties broken by ID, as the
+	  window functions did; a take pinned in t_review_take_pin wins over both while its
+	  row is live.
+	- Historical ("as of") reads still go to t_review_info, since the summary only knows
+	  the present; pins do not apply to them.
+	- The DELETE and UPDATE joins and the pinned-take order (<=>) are MySQL syntax; other
+	  databases (PostgreSQL, SQLite) get DELETE ... WHERE EXISTS, UPDATE ... FROM and
+	  IS NOT DISTINCT FROM. The rest of the SQL is common.
+	- The table is backfilled once when it is created, and a column added later is
+	  filled once from t_review_info when it appears (studio). A change of the key
+	  (group_2/group_3) rebuilds the table.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Prefer the pinned take of a phase.
+	* - 15-10-2026 - Copy the studio of the latest row.
+	* - 15-10-2026 - Key rows by group_2 and group_3 outside the assets root.
+	* - 15-10-2026 - Maintain the table on PostgreSQL too (DELETE USING, UPDATE FROM).
+	* - 15-10-2026 - DELETE ... WHERE EXISTS outside MySQL, which SQLite runs too.
+
+	Functions:
+	* - migrateReviewLatest: Creates t_review_latest, backfilling it on creation.
+	* - refreshReviewLatest: Recomputes the summary row of a review info's phase.
+	* - latestPhaseSourceFor: Picks the source of a latest-row-per-phase CTE.
+	* - pivotGroupColumn: Returns group_2/group_3 of a row as the pivot keys assets by.
+	* - pivotPhasePartition: Returns the partition of one latest row per asset phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+	"gorm.io/gorm"
+)
+
+// reviewLatestColumns are copied from t_review_info into t_review_latest as they are.
+var reviewLatestColumns = []string{
+	"project", "root", "group_1", "relation", "phase", "component",
+	"work_status", "approval_status", "submitted_user", "approval_status_updated_user", "studio",
+	"submitted_at_utc", "modified_at_utc", "take", "groups",
+}
+
+// reviewLatestInsertColumns returns the columns of t_review_latest written from
+// t_review_info, in the order of reviewLatestSelect.
+func reviewLatestInsertColumns(d reviewquery.Dialect) string {
+	cols := make([]string, 0, len(reviewLatestColumns)+2)
+	for _, c := range reviewLatestColumns {
+		cols = append(cols, d.Ident(c))
+	}
+	return strings.Join(append(cols, "group_2", "group_3"), ", ")
+}
+
+// reviewLatestSelect lists reviewLatestColumns qualified by alias, followed by the
+// group_2 and group_3 keys.
+func reviewLatestSelect(d reviewquery.Dialect, alias string) string {
+	cols := make([]string, 0, len(reviewLatestColumns)+2)
+	for _, c := range reviewLatestColumns {
+		cols = append(cols, alias+"."+d.Ident(c))
+	}
+	cols = append(cols, pivotGroupColumn(alias, "group_2"), pivotGroupColumn(alias, "group_3"))
+	return strings.Join(cols, ", ")
+}
+
+// pivotGroupColumn returns column col (group_2 or group_3) of the row qualified by ref
+// (unqualified when empty) as the pivot keys assets by: "" in the assets root, where
+// group_1 and relation identify an asset, and "" for NULL elsewhere.
+func pivotGroupColumn(ref, col string) string {
+	if ref != "" {
+		ref += "."
+	}
+	return "CASE WHEN " + ref + "root = 'assets' THEN '' ELSE COALESCE(" + ref + col + ", '') END"
+}
+
+// pivotPhasePartition returns the columns of t_review_info (qualified by ref when set)
+// one latest row per asset phase is kept for.
+func pivotPhasePartition(ref string) string {
+	q := ""
+	if ref != "" {
+		q = ref + "."
+	}
+	return q + "project, " + q + "root, " + q + "group_1, " +
+		pivotGroupColumn(ref, "group_2") + ", " + pivotGroupColumn(ref, "group_3") + ", " +
+		q + "relation, " + q + "phase"
+}
+
+func migrateReviewLatest(db *gorm.DB) error {
+	d := dialectOf(db)
+	if err := db.AutoMigrate(&model.ReviewTakePin{}); err != nil {
+		return err
+	}
+	backfill := !db.Migrator().HasTable(&model.ReviewLatest{})
+	if !backfill && !db.Migrator().HasColumn(&model.ReviewLatest{}, "Group2") {
+		// The key changed; the rows of other roots were merged under the old one.
+		if err := db.Migrator().DropTable(&model.ReviewLatest{}); err != nil {
+			return err
+		}
+		backfill = true
+	}
+	fillStudio := !backfill && !db.Migrator().HasColumn(&model.ReviewLatest{}, "Studio")
+	if err := db.AutoMigrate(&model.ReviewLatest{}); err != nil {
+		return err
+	}
+	if fillStudio {
+		if d != reviewquery.MySQL {
+			return db.Exec(`
+UPDATE t_review_latest AS l
+SET studio = ri.studio
+FROM t_review_info AS ri
+WHERE ri.id = l.review_info_id`).Error
+		}
+		return db.Exec(`
+UPDATE t_review_latest AS l
+JOIN t_review_info AS ri ON ri.id = l.review_info_id
+SET l.studio = ri.studio`).Error
+	}
+	if !backfill {
+		return nil
+	}
+	return db.Exec(`
+INSERT INTO t_review_latest (review_info_id, ` + reviewLatestInsertColumns(d) + `)
+SELECT x.id, ` + reviewLatestSelect(d, "x") + `
+FROM (
+  SELECT ri.*,
+    ROW_NUMBER() OVER (
+      PARTITION BY ` + pivotPhasePartition("ri") + `
+      ORDER BY ri.modified_at_utc DESC, ri.id DESC
+    ) AS rn
+  FROM t_review_info AS ri
+  WHERE ri.deleted = 0
+) AS x
+WHERE x.rn = 1`).Error
+}
+
+// refreshReviewLatest recomputes the t_review_latest row of the phase the review info
+// reviewInfoID belongs to, from its pinned take when that is live and its latest live
+// row otherwise; the row goes away when the phase has no live row left.
+// Call it in the transaction that wrote the review info.
+func refreshReviewLatest(tx *gorm.DB, reviewInfoID int32) error {
+	d := dialectOf(tx)
+	samePhase := `ri.project = l.project
+ AND ri.root = l.root
+ AND ri.group_1 = l.group_1
+ AND ` + pivotGroupColumn("ri", "group_2") + ` = l.group_2
+ AND ` + pivotGroupColumn("ri", "group_3") + ` = l.group_3
+ AND ri.relation = l.relation
+ AND ri.phase = l.phase`
+	deleteSQL := `
+DELETE l FROM t_review_latest AS l
+JOIN t_review_info AS ri
+  ON ` + samePhase + `
+WHERE ri.id = ?`
+	pinned := "ri.id <=> p.review_info_id DESC"
+	if d != reviewquery.MySQL {
+		deleteSQL = `
+DELETE FROM t_review_latest AS l
+WHERE EXISTS (
+  SELECT 1 FROM t_review_info AS ri
+  WHERE ` + samePhase + `
+   AND ri.id = ?
+)`
+		pinned = "(ri.id IS NOT DISTINCT FROM p.review_info_id) DESC"
+	}
+	if err := tx.Exec(deleteSQL, reviewInfoID).Error; err != nil {
+		return err
+	}
+	return tx.Exec(`
+INSERT INTO t_review_latest (review_info_id, `+reviewLatestInsertColumns(d)+`)
+SELECT ri.id, `+reviewLatestSelect(d, "ri")+`
+FROM t_review_info AS ri
+JOIN t_review_info AS src
+  ON src.project = ri.project
+ AND src.root = ri.root
+ AND src.group_1 = ri.group_1
+ AND `+pivotGroupColumn("src", "group_2")+` = `+pivotGroupColumn("ri", "group_2")+`
+ AND `+pivotGroupColumn("src", "group_3")+` = `+pivotGroupColumn("ri", "group_3")+`
+ AND src.relation = ri.relation
+ AND src.phase = ri.phase
+LEFT JOIN t_review_take_pin AS p
+  ON p.project = ri.project
+ AND p.root = ri.root
+ AND p.group_1 = ri.group_1
+ AND p.relation = ri.relation
+ AND p.phase = ri.phase
+WHERE src.id = ? AND ri.deleted = 0
+ORDER BY `+pinned+`, ri.modified_at_utc DESC, ri.id DESC
+LIMIT 1`, reviewInfoID).Error
+}
+
+// latestPhaseSource is what a latest_phase CTE reads: t_review_latest, where every row
+// already is the latest (rank 1), or t_review_info ranked by modified_at_utc.
+type latestPhaseSource struct {
+	from     string // FROM clause
+	ref      string // qualifier of the source's columns in conditions
+	idColumn string // column holding the review info ID
+	rank     string // rn expression, 1 for the latest row of a partition
+	live     string // condition keeping live rows
+}
+
+// latestPhaseSourceFor returns t_review_latest for current reads and t_review_info for
+// as-of reads, aliased as alias when set. partition lists the columns one latest row
+// is kept per; the hint's index hints only apply to t_review_info.
+func latestPhaseSourceFor(hint QueryHint, alias, partition string, asOf *time.Time) latestPhaseSource {
+	table := "t_review_latest"
+	if asOf != nil {
+		table = "t_review_info"
+	}
+	src := latestPhaseSource{from: table, ref: table, idColumn: "id"}
+	if alias != "" {
+		src.from += " AS " + alias
+		src.ref = alias
+	}
+	if asOf == nil {
+		src.idColumn = "review_info_id"
+		src.rank = "1"
+		return src
+	}
+	src.from += hint.indexHint()
+	src.rank = `ROW_NUMBER() OVER (
+      PARTITION BY ` + partition + `
+      ORDER BY ` + src.ref + `.modified_at_utc DESC
+    )`
+	src.live = " AND " + src.ref + ".deleted = 0"
+	return src
+}
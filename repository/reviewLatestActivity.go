@@ -0,0 +1,36 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewLatestActivity.go
+
+	Module Description:
+		The asset pivot's latest_activity sort.
+
+	Details:
+	- An asset's latest_activity_at is the newest modified_at_utc of its rows across
+	  every phase, computed over latest_phase before the status filters, so filtering
+	  to one phase does not hide the activity of the others.
+	- sort=latest_activity orders by latest_activity_at, assets without one last;
+	  the usecase defaults it to descending (most recently touched first).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - latestActivityColumn: Window expression of an asset's latest activity.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+// LatestActivityOrderKey sorts the asset pivot by latest_activity_at.
+const LatestActivityOrderKey = "latest_activity"
+
+// latestActivityColumn returns the newest modified_at_utc of the asset of a
+// latest_phase source row (referenced as ref), over all its phases.
+func latestActivityColumn(ref string) string {
+	q := ""
+	if ref != "" {
+		q = ref + "."
+	}
+	return "MAX(" + q + "modified_at_utc) OVER (PARTITION BY " + q + "project, " + q + "root, " + q + "group_1, " +
+		pivotGroupColumn(ref, "group_2") + ", " + pivotGroupColumn(ref, "group_3") + ", " + q + "relation)"
+}
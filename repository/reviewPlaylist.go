@@ -0,0 +1,228 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewPlaylist.go
+
+	Module Description:
+		Repository for review playlists, ordered lists of takes for review sessions.
+
+	Details:
+	- Items live in t_review_playlist_item, ordered by position (0-based, contiguous).
+	- Playlists are always returned with their items.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Create: Creates a playlist with its items.
+	* - List: Lists the playlists of a project, newest first.
+	* - Get: Returns a playlist.
+	* - Reorder: Rewrites the item positions of a playlist.
+	* - Delete: Removes a playlist and its items.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewPlaylist struct {
+	db *gorm.DB
+}
+
+func NewReviewPlaylist(db *gorm.DB) (*ReviewPlaylist, error) {
+	if err := db.AutoMigrate(&model.ReviewPlaylist{}, &model.ReviewPlaylistItem{}); err != nil {
+		return nil, err
+	}
+	return &ReviewPlaylist{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewPlaylist) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewPlaylist) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewPlaylist) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewPlaylistParams,
+) (*entity.ReviewPlaylist, error) {
+	m := model.NewReviewPlaylist(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	items := make([]*model.ReviewPlaylistItem, len(params.Items))
+	for i, p := range params.Items {
+		items[i] = model.NewReviewPlaylistItem(m.ID, i, p)
+	}
+	if err := tx.Create(&items).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(items), nil
+}
+
+// itemsOf returns the items of the given playlists keyed by playlist ID, in position order.
+func (r *ReviewPlaylist) itemsOf(db *gorm.DB, ids []int32) (map[int32][]*model.ReviewPlaylistItem, error) {
+	byPlaylist := make(map[int32][]*model.ReviewPlaylistItem, len(ids))
+	if len(ids) == 0 {
+		return byPlaylist, nil
+	}
+	var items []*model.ReviewPlaylistItem
+	if err := db.Where(
+		"`playlist_id` IN ?", ids,
+	).Order(
+		"`playlist_id` asc",
+	).Order(
+		"`position` asc",
+	).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		byPlaylist[item.PlaylistID] = append(byPlaylist[item.PlaylistID], item)
+	}
+	return byPlaylist, nil
+}
+
+func (r *ReviewPlaylist) List(
+	db *gorm.DB,
+	params *entity.ReviewPlaylistListParams,
+) ([]*entity.ReviewPlaylist, int, error) {
+	var total int64
+	if err := db.Model(&model.ReviewPlaylist{}).Where(
+		"`project` = ?", params.Project,
+	).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []*model.ReviewPlaylist
+	perPage := params.GetPerPage()
+	offset := perPage * (params.GetPage() - 1)
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Order(
+		"`id` desc",
+	).Limit(perPage).Offset(offset).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	ids := make([]int32, len(models))
+	for i, m := range models {
+		ids[i] = m.ID
+	}
+	items, err := r.itemsOf(db, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	entities := make([]*entity.ReviewPlaylist, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity(items[m.ID])
+	}
+	return entities, int(total), nil
+}
+
+func (r *ReviewPlaylist) get(db *gorm.DB, project string, id int32) (*model.ReviewPlaylist, error) {
+	var m model.ReviewPlaylist
+	if err := db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`id` = ?", id,
+	).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *ReviewPlaylist) Get(
+	db *gorm.DB,
+	params *entity.GetReviewPlaylistParams,
+) (*entity.ReviewPlaylist, error) {
+	m, err := r.get(db, params.Project, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := r.itemsOf(db, []int32{m.ID})
+	if err != nil {
+		return nil, err
+	}
+	return m.Entity(items[m.ID]), nil
+}
+
+// Reorder sets each item's position to its index in params.ItemIDs, which must hold every
+// item of the playlist exactly once (entity.ErrInvalidPlaylistOrder otherwise).
+func (r *ReviewPlaylist) Reorder(
+	tx *gorm.DB,
+	params *entity.ReorderReviewPlaylistParams,
+) (*entity.ReviewPlaylist, error) {
+	m, err := r.get(tx, params.Project, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := r.itemsOf(tx, []int32{m.ID})
+	if err != nil {
+		return nil, err
+	}
+	current := items[m.ID]
+	if len(params.ItemIDs) != len(current) {
+		return nil, entity.ErrInvalidPlaylistOrder
+	}
+	byID := make(map[int32]*model.ReviewPlaylistItem, len(current))
+	for _, item := range current {
+		byID[item.ID] = item
+	}
+	ordered := make([]*model.ReviewPlaylistItem, len(params.ItemIDs))
+	for position, id := range params.ItemIDs {
+		item, ok := byID[id]
+		if !ok {
+			return nil, entity.ErrInvalidPlaylistOrder
+		}
+		delete(byID, id)
+		if item.Position != position {
+			if err := tx.Model(item).Update("position", position).Error; err != nil {
+				return nil, err
+			}
+			item.Position = position
+		}
+		ordered[position] = item
+	}
+
+	m.ModifiedAtUtc = time.Now().UTC()
+	if err := tx.Model(m).Update("modified_at_utc", m.ModifiedAtUtc).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(ordered), nil
+}
+
+func (r *ReviewPlaylist) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteReviewPlaylistParams,
+) error {
+	m, err := r.get(tx, params.Project, params.ID)
+	if err != nil {
+		return err
+	}
+	if err := tx.Where(
+		"`playlist_id` = ?", m.ID,
+	).Delete(&model.ReviewPlaylistItem{}).Error; err != nil {
+		return err
+	}
+	return tx.Delete(m).Error
+}
@@ -0,0 +1,147 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewSession.go
+
+	Module Description:
+		Repository for scheduled review sessions (dailies).
+
+	Details:
+	- A session references an optional playlist by ID and a list of assets kept as JSON;
+	  deleting the playlist leaves the session in place.
+	- List returns the sessions overlapping a time range in start order, which is also
+	  what the calendar feed reads.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Create: Creates a session.
+	* - List: Lists the sessions of a project in a time range.
+	* - Get: Returns a session.
+	* - Delete: Removes a session.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewSession struct {
+	db *gorm.DB
+}
+
+func NewReviewSession(db *gorm.DB) (*ReviewSession, error) {
+	if err := db.AutoMigrate(&model.ReviewSession{}); err != nil {
+		return nil, err
+	}
+	return &ReviewSession{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewSession) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewSession) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewSession) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewSessionParams,
+) (*entity.ReviewSession, error) {
+	m := model.NewReviewSession(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// List returns the sessions of params.Project ending after From and starting before To
+// (either bound is skipped when nil), earliest first.
+func (r *ReviewSession) List(
+	db *gorm.DB,
+	params *entity.ReviewSessionListParams,
+) ([]*entity.ReviewSession, int, error) {
+	stmt := db.Model(&model.ReviewSession{}).Where(
+		"`project` = ?", params.Project,
+	)
+	if params.From != nil {
+		stmt = stmt.Where("`ends_at_utc` > ?", params.From.UTC())
+	}
+	if params.To != nil {
+		stmt = stmt.Where("`starts_at_utc` < ?", params.To.UTC())
+	}
+
+	var total int64
+	if err := stmt.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var models []*model.ReviewSession
+	perPage := params.GetPerPage()
+	offset := perPage * (params.GetPage() - 1)
+	if err := stmt.Order(
+		"`starts_at_utc` asc",
+	).Order(
+		"`id` asc",
+	).Limit(perPage).Offset(offset).Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+
+	entities := make([]*entity.ReviewSession, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, int(total), nil
+}
+
+func (r *ReviewSession) get(db *gorm.DB, project string, id int32) (*model.ReviewSession, error) {
+	var m model.ReviewSession
+	if err := db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`id` = ?", id,
+	).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *ReviewSession) Get(
+	db *gorm.DB,
+	params *entity.GetReviewSessionParams,
+) (*entity.ReviewSession, error) {
+	m, err := r.get(db, params.Project, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewSession) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteReviewSessionParams,
+) error {
+	m, err := r.get(tx, params.Project, params.ID)
+	if err != nil {
+		return err
+	}
+	return tx.Delete(m).Error
+}
@@ -0,0 +1,176 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewStatus.go
+
+	Module Description:
+		Repository for the per-project vocabulary of approval and work statuses.
+
+	Details:
+	- Keys are unique per project and type, ignoring case; Create checks before
+	  inserting so a duplicate fails with entity.ErrDuplicateReviewStatus rather than
+	  a MySQL error.
+	- Statuses are listed by type, then order, then key.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - List: Lists the statuses of a project, optionally of one type.
+	* - Get: Retrieves a status by ID.
+	* - Create: Adds a status.
+	* - Update: Changes the label, color or order of a status.
+	* - Delete: Removes a status.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewStatus struct {
+	db *gorm.DB
+}
+
+func NewReviewStatus(db *gorm.DB) (*ReviewStatus, error) {
+	if err := db.AutoMigrate(&model.ReviewStatus{}); err != nil {
+		return nil, err
+	}
+	return &ReviewStatus{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewStatus) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewStatus) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewStatus) List(
+	db *gorm.DB,
+	params *entity.ListReviewStatusParams,
+) ([]*entity.ReviewStatus, error) {
+	stmt := db.Where("`project` = ?", params.Project)
+	if params.Type != "" {
+		stmt = stmt.Where("`type` = ?", params.Type)
+	}
+	var models []*model.ReviewStatus
+	if err := stmt.Order(
+		"`type` asc, `sort_order` asc, `key` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewStatus, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+func (r *ReviewStatus) get(db *gorm.DB, project string, id int32) (*model.ReviewStatus, error) {
+	var m model.ReviewStatus
+	if err := db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`id` = ?", id,
+	).First(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return &m, nil
+}
+
+func (r *ReviewStatus) Get(
+	db *gorm.DB,
+	params *entity.GetReviewStatusParams,
+) (*entity.ReviewStatus, error) {
+	m, err := r.get(db, params.Project, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewStatus) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewStatusParams,
+) (*entity.ReviewStatus, error) {
+	var count int64
+	if err := tx.Model(&model.ReviewStatus{}).Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`type` = ?", params.Type,
+	).Where(
+		"LOWER(`key`) = ?", strings.ToLower(params.Key),
+	).Count(&count).Error; err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, entity.ErrDuplicateReviewStatus
+	}
+	m := model.NewReviewStatus(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewStatus) Update(
+	tx *gorm.DB,
+	params *entity.UpdateReviewStatusParams,
+) (*entity.ReviewStatus, error) {
+	m, err := r.get(tx, params.Project, params.ID)
+	if err != nil {
+		return nil, err
+	}
+	if params.Label != nil {
+		m.Label = *params.Label
+	}
+	if params.Color != nil {
+		m.Color = *params.Color
+	}
+	if params.Order != nil {
+		m.SortOrder = *params.Order
+	}
+	m.ModifiedAtUtc = time.Now().UTC()
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewStatus) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteReviewStatusParams,
+) error {
+	res := tx.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).Delete(&model.ReviewStatus{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
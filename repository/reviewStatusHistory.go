@@ -0,0 +1,103 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewStatusHistory.go
+
+	Module Description:
+		Repository for the audit log of review status changes.
+
+	Details:
+	- Rows are only ever inserted, in the transaction of the update they describe.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added ListByReviewInfoIDs.
+
+	Functions:
+	* - Create: Stores one status change.
+	* - List: Lists the status changes of a review info, oldest first.
+	* - ListByReviewInfoIDs: Lists the status changes of several review infos, oldest first.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewStatusHistory struct {
+	db *gorm.DB
+}
+
+func NewReviewStatusHistory(db *gorm.DB) (*ReviewStatusHistory, error) {
+	if err := db.AutoMigrate(&model.ReviewStatusHistory{}); err != nil {
+		return nil, err
+	}
+	return &ReviewStatusHistory{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewStatusHistory) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewStatusHistory) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewStatusHistoryParams,
+) error {
+	return tx.Create(model.NewReviewStatusHistory(params)).Error
+}
+
+func (r *ReviewStatusHistory) List(
+	db *gorm.DB,
+	params *entity.ListReviewStatusHistoryParams,
+) ([]*entity.ReviewStatusHistory, error) {
+	stmt := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`review_info_id` = ?", params.ReviewInfoID,
+	)
+	if params.Field != nil {
+		stmt = stmt.Where("`field` = ?", *params.Field)
+	}
+	var models []*model.ReviewStatusHistory
+	if err := stmt.Order(
+		"`changed_at_utc` asc, `id` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewStatusHistory, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+func (r *ReviewStatusHistory) ListByReviewInfoIDs(
+	db *gorm.DB,
+	project string,
+	reviewInfoIDs []int32,
+) ([]*entity.ReviewStatusHistory, error) {
+	if len(reviewInfoIDs) == 0 {
+		return []*entity.ReviewStatusHistory{}, nil
+	}
+	var models []*model.ReviewStatusHistory
+	if err := db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`review_info_id` IN ?", reviewInfoIDs,
+	).Order(
+		"`changed_at_utc` asc, `id` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewStatusHistory, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
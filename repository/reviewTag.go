@@ -0,0 +1,191 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewTag.go
+
+	Module Description:
+		Tags of asset relations and the tag filter of the review, asset and pivot lists.
+
+	Details:
+	- t_review_tag holds one row per tag of an asset relation (project, root, group_1,
+	  relation), so tags and assets are many-to-many. Review infos are tagged through
+	  their asset relation.
+	- Tags are lowercase; the filter matches rows of an asset relation carrying any of
+	  the given tags.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - The pivot fill query uses unquoted columns, which PostgreSQL reads too.
+	* - 15-10-2026 - The pivot fill query selects by group_1, as SQLite has no row value lists.
+
+	Functions:
+	* - (ReviewInfo) ListProjectTags: Lists the tags of a project with their asset counts.
+	* - (ReviewInfo) ListTags: Lists the tags of an asset relation.
+	* - (ReviewInfo) AddTags: Adds tags to an asset relation.
+	* - (ReviewInfo) RemoveTag: Removes a tag from an asset relation.
+	* - buildTagWhere: Restricts rows to the asset relations carrying any of some tags.
+	* - (ReviewInfo) fillPivotTags: Sets the tags of pivot rows.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *ReviewInfo) ListProjectTags(
+	db *gorm.DB,
+	params *entity.ListProjectReviewTagsParams,
+) ([]*entity.ReviewTagCount, error) {
+	var counts []*entity.ReviewTagCount
+	if err := db.Model(
+		&model.ReviewTag{},
+	).Select(
+		"`tag`, COUNT(*) AS asset_count",
+	).Where(
+		"`project` = ?", params.Project,
+	).Group(
+		"`tag`",
+	).Order(
+		"`tag` asc",
+	).Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+func (r *ReviewInfo) ListTags(
+	db *gorm.DB,
+	params *entity.ListReviewTagsParams,
+) ([]*entity.ReviewTag, error) {
+	var models []*model.ReviewTag
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`root` = ?", "assets",
+	).Where(
+		"`group_1` = ?", params.Asset,
+	).Where(
+		"`relation` = ?", params.Relation,
+	).Order(
+		"`tag` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewTag, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+// AddTags adds params.Tags (lowercase, without duplicates) to the asset relation and
+// returns all of its tags.
+func (r *ReviewInfo) AddTags(
+	tx *gorm.DB,
+	params *entity.AddReviewTagsParams,
+) ([]*entity.ReviewTag, error) {
+	now := time.Now().UTC()
+	models := make([]*model.ReviewTag, len(params.Tags))
+	for i, tag := range params.Tags {
+		models[i] = &model.ReviewTag{
+			Project:     params.Project,
+			Root:        "assets",
+			Group1:      params.Asset,
+			Relation:    params.Relation,
+			Tag:         tag,
+			TaggedBy:    params.TaggedBy,
+			TaggedAtUtc: now,
+		}
+	}
+	if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models).Error; err != nil {
+		return nil, err
+	}
+	return r.ListTags(tx, &entity.ListReviewTagsParams{
+		Project:  params.Project,
+		Asset:    params.Asset,
+		Relation: params.Relation,
+	})
+}
+
+// RemoveTag returns entity.ErrRecordNotFound when the asset relation doesn't carry the tag.
+func (r *ReviewInfo) RemoveTag(
+	tx *gorm.DB,
+	params *entity.RemoveReviewTagParams,
+) error {
+	res := tx.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`root` = ?", "assets",
+	).Where(
+		"`group_1` = ?", params.Asset,
+	).Where(
+		"`relation` = ?", params.Relation,
+	).Where(
+		"`tag` = ?", params.Tag,
+	).Delete(&model.ReviewTag{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
+
+// buildTagWhere returns the condition (preceded by AND) restricting rows of project,
+// referenced without an alias, to the asset relations carrying any of tags. No tags
+// means no condition.
+func buildTagWhere(project string, tags []string) (string, []any) {
+	if len(tags) == 0 {
+		return "", nil
+	}
+	return `
+    AND (root, group_1, relation) IN (
+      SELECT tg.root, tg.group_1, tg.relation
+      FROM t_review_tag AS tg
+      WHERE tg.project = ? AND tg.tag IN ?
+    )`, []any{project, lowerValues(tags)}
+}
+
+// fillPivotTags sets Tags on rows from t_review_tag; rows of other roots than assets
+// have none.
+func (r *ReviewInfo) fillPivotTags(ctx context.Context, project, root string, rows []*AssetPivot) error {
+	if len(rows) == 0 || root != "assets" {
+		return nil
+	}
+	// by group_1 only: SQLite takes no row value lists; other relations of the assets
+	// are dropped by the lookup below
+	group1s := make([]string, len(rows))
+	for i, ap := range rows {
+		group1s[i] = ap.Group1
+	}
+	var models []*model.ReviewTag
+	if err := r.ReadWithContext(ctx, project).Where(
+		"project = ?", project,
+	).Where(
+		"root = ?", root,
+	).Where(
+		"group_1 IN ?", group1s,
+	).Order(
+		"tag asc",
+	).Find(&models).Error; err != nil {
+		return err
+	}
+
+	type assetRelation struct{ group1, relation string }
+	byAsset := make(map[assetRelation][]string, len(models))
+	for _, m := range models {
+		k := assetRelation{m.Group1, m.Relation}
+		byAsset[k] = append(byAsset[k], m.Tag)
+	}
+	for _, ap := range rows {
+		ap.Tags = byAsset[assetRelation{ap.Group1, ap.Relation}]
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewTakePin.go
+
+	Module Description:
+		The takes of an asset phase and the take pinned to be surfaced in the pivot.
+
+	Details:
+	- A pin names a review info; refreshReviewLatest copies it into t_review_latest
+	  instead of the newest row, so every current pivot read shows the pinned take
+	  without knowing about pins.
+	- Pinning and unpinning refresh the phase's t_review_latest row in the same
+	  transaction. A pin whose row is deleted stops applying until the row is restored.
+	- A take that was submitted more than once (e.g. per component) is pinned by its
+	  latest live review info.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewInfo) ListTakes: Lists the live review infos of an asset phase, newest first.
+	* - (ReviewInfo) GetTakePin: Returns the pin of an asset phase.
+	* - (ReviewInfo) PinTake: Pins a take of an asset phase.
+	* - (ReviewInfo) UnpinTake: Removes the pin of an asset phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// assetPhase scopes a query on t_review_info or t_review_take_pin to one asset phase.
+func assetPhase(db *gorm.DB, project, asset, relation, phase string) *gorm.DB {
+	return db.Where(
+		"`project` = ?", project,
+	).Where(
+		"`root` = ?", "assets",
+	).Where(
+		"`group_1` = ?", asset,
+	).Where(
+		"`relation` = ?", relation,
+	).Where(
+		"`phase` = ?", phase,
+	)
+}
+
+func (r *ReviewInfo) ListTakes(
+	db *gorm.DB,
+	params *entity.ListReviewTakesParams,
+) ([]*entity.ReviewInfo, error) {
+	var reviews []*model.ReviewInfo
+	if err := assetPhase(
+		db, params.Project, params.Asset, params.Relation, params.Phase,
+	).Where(
+		"`deleted` = ?", 0,
+	).Order(
+		"`modified_at_utc` desc, `id` desc",
+	).Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+
+	reviewInfos := make([]*entity.ReviewInfo, len(reviews))
+	for i, review := range reviews {
+		reviewInfos[i] = review.Entity(false)
+	}
+	return reviewInfos, nil
+}
+
+// GetTakePin returns entity.ErrRecordNotFound when the asset phase has no pin.
+func (r *ReviewInfo) GetTakePin(
+	db *gorm.DB,
+	project, asset, relation, phase string,
+) (*entity.ReviewTakePin, error) {
+	var m model.ReviewTakePin
+	if err := assetPhase(db, project, asset, relation, phase).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// PinTake pins the latest live review info of params.Take, replacing any previous pin of
+// the phase. It returns entity.ErrReviewTakeNotFound when the take has no live row.
+func (r *ReviewInfo) PinTake(
+	tx *gorm.DB,
+	params *entity.PinReviewTakeParams,
+) (*entity.ReviewTakePin, error) {
+	var review model.ReviewInfo
+	if err := assetPhase(
+		tx, params.Project, params.Asset, params.Relation, params.Phase,
+	).Where(
+		"`take` = ?", params.Take,
+	).Where(
+		"`deleted` = ?", 0,
+	).Order(
+		"`modified_at_utc` desc, `id` desc",
+	).Take(&review).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrReviewTakeNotFound
+		}
+		return nil, err
+	}
+
+	m := &model.ReviewTakePin{
+		Project:      params.Project,
+		Root:         "assets",
+		Group1:       params.Asset,
+		Relation:     params.Relation,
+		Phase:        params.Phase,
+		ReviewInfoID: review.ID,
+		Take:         params.Take,
+		PinnedBy:     params.PinnedBy,
+		PinnedAtUtc:  time.Now().UTC(),
+	}
+	if err := tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: "project"}, {Name: "root"}, {Name: "group_1"}, {Name: "relation"}, {Name: "phase"},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{"review_info_id", "take", "pinned_by", "pinned_at_utc"}),
+	}).Create(m).Error; err != nil {
+		return nil, err
+	}
+	if err := refreshReviewLatest(tx, review.ID); err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+// UnpinTake returns entity.ErrRecordNotFound when the asset phase has no pin.
+func (r *ReviewInfo) UnpinTake(
+	tx *gorm.DB,
+	params *entity.UnpinReviewTakeParams,
+) error {
+	pin, err := r.GetTakePin(tx, params.Project, params.Asset, params.Relation, params.Phase)
+	if err != nil {
+		return err
+	}
+	if err := assetPhase(
+		tx, params.Project, params.Asset, params.Relation, params.Phase,
+	).Delete(&model.ReviewTakePin{}).Error; err != nil {
+		return err
+	}
+	return refreshReviewLatest(tx, pin.ReviewInfoID)
+}
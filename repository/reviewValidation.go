@@ -0,0 +1,79 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewValidation.go
+
+	Module Description:
+		Repository for per-project review info validation rules (allowed phases and statuses).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves the rules of a project.
+	* - Put: Creates or replaces the rules of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewValidation struct {
+	db *gorm.DB
+}
+
+func NewReviewValidation(db *gorm.DB) (*ReviewValidation, error) {
+	if err := db.AutoMigrate(&model.ReviewValidationRules{}); err != nil {
+		return nil, err
+	}
+	return &ReviewValidation{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewValidation) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewValidation) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewValidation) Get(
+	db *gorm.DB,
+	params *entity.GetReviewValidationRulesParams,
+) (*entity.ReviewValidationRules, error) {
+	var m model.ReviewValidationRules
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewValidation) Put(
+	tx *gorm.DB,
+	params *entity.PutReviewValidationRulesParams,
+) (*entity.ReviewValidationRules, error) {
+	m := model.NewReviewValidationRules(params)
+	if err := tx.Save(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
@@ -0,0 +1,103 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewWebhook.go
+
+	Module Description:
+		Repository for per-project webhook registrations of review events.
+
+	Details:
+	- Registrations are immutable; changing one means deleting and registering again.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Create: Registers a webhook.
+	* - List: Lists the webhooks of a project.
+	* - Delete: Removes a webhook.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+)
+
+type ReviewWebhook struct {
+	db *gorm.DB
+}
+
+func NewReviewWebhook(db *gorm.DB) (*ReviewWebhook, error) {
+	if err := db.AutoMigrate(&model.ReviewWebhook{}); err != nil {
+		return nil, err
+	}
+	return &ReviewWebhook{
+		db: db,
+	}, nil
+}
+
+func (r *ReviewWebhook) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *ReviewWebhook) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *ReviewWebhook) Create(
+	tx *gorm.DB,
+	params *entity.CreateReviewWebhookParams,
+) (*entity.ReviewWebhook, error) {
+	m := model.NewReviewWebhook(params)
+	if err := tx.Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *ReviewWebhook) List(
+	db *gorm.DB,
+	params *entity.ListReviewWebhookParams,
+) ([]*entity.ReviewWebhook, error) {
+	var models []*model.ReviewWebhook
+	if err := db.Where(
+		"`project` = ?", params.Project,
+	).Order(
+		"`id` asc",
+	).Find(&models).Error; err != nil {
+		return nil, err
+	}
+	entities := make([]*entity.ReviewWebhook, len(models))
+	for i, m := range models {
+		entities[i] = m.Entity()
+	}
+	return entities, nil
+}
+
+func (r *ReviewWebhook) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteReviewWebhookParams,
+) error {
+	res := tx.Where(
+		"`project` = ?", params.Project,
+	).Where(
+		"`id` = ?", params.ID,
+	).Delete(&model.ReviewWebhook{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
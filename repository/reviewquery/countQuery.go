@@ -0,0 +1,39 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewquery/countQuery.go
+
+	Module Description:
+		Builder of the query counting the assets in scope of the pivot filters.
+
+	Details:
+	- Counts asset relations (components are not counted apart), the total of the list
+	  view.
+	- Unlike KeyQuery, the asset_status and asset_due CTEs are only added when a filter
+	  needs them; Filter then refers to them through subqueries.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (CountQuery) Build: Builds the count query.
+	────────────────────────────────────────────────────────────────────────── */
+
+package reviewquery
+
+type CountQuery struct {
+	Scope
+	CTEs   Frag // asset_status / asset_due CTEs used by Filter, if any
+	Filter Frag // conditions on the latest rows, each starting with " AND"
+}
+
+func (q CountQuery) Build() (string, []any) {
+	f := Join(q.Scope.CTE(), q.CTEs, Frag{SQL: `
+SELECT COUNT(*) FROM (
+  SELECT project, root, group_1, group_2, group_3, relation
+  FROM latest_phase
+  WHERE rn = 1`}, q.Filter, Frag{SQL: `
+  GROUP BY project, root, group_1, group_2, group_3, relation
+) AS x;
+`})
+	return f.SQL, f.Args
+}
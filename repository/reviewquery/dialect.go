@@ -0,0 +1,280 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewquery/dialect.go
+
+	Module Description:
+		SQL dialects of the asset pivot queries (MySQL, PostgreSQL, SQLite).
+
+	Details:
+	- The pivot SQL is written once; the few constructs the databases spell differently
+	  (JSON array access, path splitting, the UTC clock, timestamp differences, JSON
+	  arrays, identifier quoting, regular expressions, collations) go through a Dialect.
+	- PostgreSQL sorts NULL last in ascending order, MySQL first. Order spells the MySQL
+	  order out on PostgreSQL, so pages, sort keys and keyset cursors agree on both.
+	- The DBA query hints (optimizer hints, FORCE INDEX, SQL_BIG_RESULT) are MySQL
+	  syntax; Hints reports whether a dialect takes them.
+	- ROUND of a floating point value needs a NUMERIC cast on PostgreSQL, and there is no
+	  CAST AS UNSIGNED; Unsigned reads the leading digits of a take the way MySQL does.
+	- SQLite is meant for running the service locally without a MySQL server; it
+	  lacks regular expressions, so its natural sort key is the plain name.
+	- A LIKE pattern escapes its wildcards with a backslash. That is the default escape
+	  character of MySQL and PostgreSQL; SQLite has none, so Like spells ESCAPE out.
+	- DialectFor maps a gorm dialector name to its Dialect; unknown names get MySQL, the
+	  dialect every query was written for.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added SQLite for local development.
+	* - 15-10-2026 - Added Like, escaping LIKE wildcards with a backslash on every dialect.
+
+	Functions:
+	* - DialectFor: Returns the Dialect of a gorm dialector name.
+	* - OrDefault: Returns a dialect, MySQL when nil.
+	────────────────────────────────────────────────────────────────────────── */
+
+package reviewquery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Dialect spells the database-specific parts of the pivot SQL.
+type Dialect interface {
+	Name() string
+	Ident(name string) string                     // quoted identifier, e.g. groups
+	JSONIndex(col string, i int) string           // text of element i of the JSON array col
+	PathHead(path string) string                  // first node of a "/"-separated path
+	UTCNow() string                               // current UTC time
+	SecondsBetween(from, to string) string        // seconds from timestamp from to to
+	Round(expr string, places int) string         // expr rounded to places decimals
+	Right(expr string, n int) string              // last n characters of expr
+	TrimLeading(expr, char string) string         // expr without leading char
+	Greatest(a, b string) string                  // larger of a and b
+	Unsigned(expr string) string                  // leading digits of a string as a number, else 0
+	JSONArray(exprs []string) string              // JSON array of exprs
+	Order(expr string, desc bool) string          // ORDER BY term, NULL first ascending
+	ReplaceAll(expr, pattern, repl string) string // every match of pattern replaced; $1 in repl
+	Japanese(expr string) string                  // expr compared under the Japanese collation
+	Tables() string                               // names of the tables among "IN ?"
+	Explain() string                              // prefix turning a query into its text plan
+	Hints() bool                                  // takes the MySQL query hints
+	Like(expr string) string                      // expr LIKE ?, \ escaping % and _ in the pattern
+}
+
+var (
+	MySQL      Dialect = mysql{}
+	PostgreSQL Dialect = postgres{}
+	SQLite     Dialect = sqlite{}
+)
+
+// DialectFor returns the dialect of the gorm dialector named name ("mysql",
+// "postgres", "sqlite"); MySQL for any other name.
+func DialectFor(name string) Dialect {
+	for _, d := range []Dialect{PostgreSQL, SQLite} {
+		if name == d.Name() {
+			return d
+		}
+	}
+	return MySQL
+}
+
+// OrDefault returns d, or MySQL when d is nil, so zero value builders keep the
+// MySQL SQL.
+func OrDefault(d Dialect) Dialect {
+	if d == nil {
+		return MySQL
+	}
+	return d
+}
+
+type mysql struct{}
+
+func (mysql) Name() string { return "mysql" }
+
+func (mysql) Ident(name string) string { return "`" + name + "`" }
+
+func (mysql) JSONIndex(col string, i int) string {
+	return fmt.Sprintf("JSON_UNQUOTE(JSON_EXTRACT(%s, '$[%d]'))", col, i)
+}
+
+func (mysql) PathHead(path string) string { return "SUBSTRING_INDEX(" + path + ", '/', 1)" }
+
+func (mysql) UTCNow() string { return "UTC_TIMESTAMP()" }
+
+func (mysql) SecondsBetween(from, to string) string {
+	return "TIMESTAMPDIFF(SECOND, " + from + ", " + to + ")"
+}
+
+func (mysql) Round(expr string, places int) string {
+	return fmt.Sprintf("ROUND(%s, %d)", expr, places)
+}
+
+func (mysql) Right(expr string, n int) string { return fmt.Sprintf("RIGHT(%s, %d)", expr, n) }
+
+func (mysql) TrimLeading(expr, char string) string {
+	return "TRIM(LEADING '" + char + "' FROM " + expr + ")"
+}
+
+func (mysql) Greatest(a, b string) string { return "GREATEST(" + a + ", " + b + ")" }
+
+func (mysql) Unsigned(expr string) string { return "CAST(" + expr + " AS UNSIGNED)" }
+
+func (mysql) JSONArray(exprs []string) string {
+	return "JSON_ARRAY(" + strings.Join(exprs, ", ") + ")"
+}
+
+func (mysql) Order(expr string, desc bool) string {
+	if desc {
+		return expr + " DESC"
+	}
+	return expr + " ASC"
+}
+
+func (mysql) ReplaceAll(expr, pattern, repl string) string {
+	return "REGEXP_REPLACE(" + expr + ", '" + pattern + "', '" + repl + "')"
+}
+
+func (mysql) Japanese(expr string) string {
+	return "(CONVERT(" + expr + " USING utf8mb4) COLLATE utf8mb4_ja_0900_as_cs)"
+}
+
+func (mysql) Tables() string {
+	return "SELECT table_name FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name IN ?"
+}
+
+func (mysql) Explain() string { return "EXPLAIN FORMAT=TREE " }
+
+func (mysql) Hints() bool { return true }
+
+// Like leaves the escape character at its default, the backslash: an ESCAPE '\\'
+// literal would read differently under NO_BACKSLASH_ESCAPES.
+func (mysql) Like(expr string) string { return expr + " LIKE ?" }
+
+type postgres struct{}
+
+func (postgres) Name() string { return "postgres" }
+
+func (postgres) Ident(name string) string { return `"` + name + `"` }
+
+func (postgres) JSONIndex(col string, i int) string {
+	return fmt.Sprintf("(CAST(%s AS JSONB) ->> %d)", col, i)
+}
+
+func (postgres) PathHead(path string) string { return "SPLIT_PART(" + path + ", '/', 1)" }
+
+func (postgres) UTCNow() string { return "(NOW() AT TIME ZONE 'UTC')" }
+
+func (postgres) SecondsBetween(from, to string) string {
+	return "EXTRACT(EPOCH FROM (CAST(" + to + " AS TIMESTAMP) - CAST(" + from + " AS TIMESTAMP)))"
+}
+
+func (postgres) Round(expr string, places int) string {
+	return fmt.Sprintf("ROUND(CAST(%s AS NUMERIC), %d)", expr, places)
+}
+
+func (postgres) Right(expr string, n int) string { return fmt.Sprintf("RIGHT(%s, %d)", expr, n) }
+
+func (postgres) TrimLeading(expr, char string) string { return "LTRIM(" + expr + ", '" + char + "')" }
+
+func (postgres) Greatest(a, b string) string { return "GREATEST(" + a + ", " + b + ")" }
+
+// Unsigned reads the leading digits like MySQL's CAST AS UNSIGNED, which PostgreSQL
+// would reject on any other character.
+func (postgres) Unsigned(expr string) string {
+	return "CAST(COALESCE(SUBSTRING(" + expr + " FROM '^[0-9]+'), '0') AS BIGINT)"
+}
+
+func (postgres) JSONArray(exprs []string) string {
+	return "JSON_BUILD_ARRAY(" + strings.Join(exprs, ", ") + ")"
+}
+
+func (postgres) Order(expr string, desc bool) string {
+	if desc {
+		return expr + " DESC NULLS LAST"
+	}
+	return expr + " ASC NULLS FIRST"
+}
+
+// backReference matches the $1 group references of a MySQL replacement.
+var backReference = regexp.MustCompile(`\$([0-9])`)
+
+func (postgres) ReplaceAll(expr, pattern, repl string) string {
+	return "REGEXP_REPLACE(" + expr + ", '" + pattern + "', '" + backReference.ReplaceAllString(repl, `\${1}`) + "', 'g')"
+}
+
+func (postgres) Japanese(expr string) string { return "(" + expr + ` COLLATE "ja-x-icu")` }
+
+func (postgres) Tables() string {
+	return "SELECT table_name FROM information_schema.tables WHERE table_schema = CURRENT_SCHEMA() AND table_name IN ?"
+}
+
+func (postgres) Explain() string { return "EXPLAIN " }
+
+func (postgres) Hints() bool { return false }
+
+func (postgres) Like(expr string) string { return expr + " LIKE ?" }
+
+// sqlite is for local development on a file or in-memory database. SQLite has no
+// regular expressions or collations beyond BINARY and NOCASE, so natural=true sorts
+// like the default name order there.
+type sqlite struct{}
+
+func (sqlite) Name() string { return "sqlite" }
+
+func (sqlite) Ident(name string) string { return `"` + name + `"` }
+
+func (sqlite) JSONIndex(col string, i int) string {
+	return fmt.Sprintf("JSON_EXTRACT(%s, '$[%d]')", col, i)
+}
+
+func (sqlite) PathHead(path string) string {
+	return "(CASE WHEN INSTR(" + path + ", '/') > 0 THEN SUBSTR(" + path + ", 1, INSTR(" + path + ", '/') - 1) ELSE " + path + " END)"
+}
+
+func (sqlite) UTCNow() string { return "CURRENT_TIMESTAMP" }
+
+func (sqlite) SecondsBetween(from, to string) string {
+	return "((JULIANDAY(" + to + ") - JULIANDAY(" + from + ")) * 86400)"
+}
+
+func (sqlite) Round(expr string, places int) string {
+	return fmt.Sprintf("ROUND(%s, %d)", expr, places)
+}
+
+func (sqlite) Right(expr string, n int) string { return fmt.Sprintf("SUBSTR(%s, -%d)", expr, n) }
+
+func (sqlite) TrimLeading(expr, char string) string { return "LTRIM(" + expr + ", '" + char + "')" }
+
+func (sqlite) Greatest(a, b string) string { return "MAX(" + a + ", " + b + ")" }
+
+// Unsigned relies on CAST AS INTEGER reading the leading digits, as MySQL does.
+func (sqlite) Unsigned(expr string) string { return "CAST(" + expr + " AS INTEGER)" }
+
+func (sqlite) JSONArray(exprs []string) string {
+	return "JSON_ARRAY(" + strings.Join(exprs, ", ") + ")"
+}
+
+// Order needs no NULLS clause: SQLite sorts NULL first ascending, like MySQL.
+func (sqlite) Order(expr string, desc bool) string {
+	if desc {
+		return expr + " DESC"
+	}
+	return expr + " ASC"
+}
+
+func (sqlite) ReplaceAll(expr, _, _ string) string { return expr }
+
+func (sqlite) Japanese(expr string) string { return expr }
+
+func (sqlite) Tables() string {
+	return "SELECT name FROM sqlite_master WHERE type = 'table' AND name IN ?"
+}
+
+func (sqlite) Explain() string { return "EXPLAIN QUERY PLAN " }
+
+func (sqlite) Hints() bool { return false }
+
+// Like needs the ESCAPE clause: SQLite has no default escape character.
+func (sqlite) Like(expr string) string { return expr + ` LIKE ? ESCAPE '\'` }
@@ -0,0 +1,57 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewquery/keyQuery.go
+
+	Module Description:
+		Builder of the query selecting the assets in scope of the pivot filters.
+
+	Details:
+	- One row per asset relation and component, with its overall_status, next_due_date,
+	  priority and latest_activity_at. The list and grouped pivot queries use it as a
+	  derived table (the aliases lp, s and ad are visible to Filter).
+	- Overall and Due are the asset_status and asset_due CTEs; both are always joined,
+	  since their columns are selected.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (KeyQuery) Build: Builds the key query.
+	────────────────────────────────────────────────────────────────────────── */
+
+package reviewquery
+
+type KeyQuery struct {
+	Scope
+	Overall Frag // ", asset_status AS (...)": overall status of every asset
+	Due     Frag // ", asset_due AS (...)": next due date of every asset
+	Filter  Frag // conditions on lp, s and ad, each starting with " AND"
+}
+
+func (q KeyQuery) Build() (string, []any) {
+	f := Join(q.Scope.CTE(), q.Overall, q.Due, Frag{SQL: `
+SELECT lp.project, lp.root, lp.group_1, lp.group_2, lp.group_3, lp.relation, lp.component, s.overall_status,
+  ad.next_due_date, (pa.id IS NOT NULL) AS priority, MAX(lp.latest_activity_at) AS latest_activity_at
+FROM latest_phase AS lp
+JOIN asset_status AS s
+  ON s.project = lp.project
+ AND s.root = lp.root
+ AND s.group_1 = lp.group_1
+ AND s.group_2 = lp.group_2
+ AND s.group_3 = lp.group_3
+ AND s.relation = lp.relation
+LEFT JOIN asset_due AS ad
+  ON ad.project = lp.project
+ AND ad.root = lp.root
+ AND ad.group_1 = lp.group_1
+ AND ad.relation = lp.relation
+LEFT JOIN t_review_asset_priority AS pa
+  ON pa.project = lp.project
+ AND pa.root = lp.root
+ AND pa.group_1 = lp.group_1
+WHERE lp.rn = 1`}, q.Filter, Frag{SQL: `
+GROUP BY lp.project, lp.root, lp.group_1, lp.group_2, lp.group_3, lp.relation, lp.component, s.overall_status,
+  ad.next_due_date, pa.id
+`})
+	return f.SQL, f.Args
+}
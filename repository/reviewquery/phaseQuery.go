@@ -0,0 +1,124 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewquery/phaseQuery.go
+
+	Module Description:
+		Builder of the query reading the latest row of every phase of a page of assets.
+
+	Details:
+	- Reads the rows of Keys only (a key without Component matches every component),
+	  with their category path and live review lock. The columns of From are qualified
+	  by Scope.Ref; Scope.Columns is not used.
+	- NoLocks leaves the locks out, e.g. for as-of reads.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (PhaseQuery) Build: Builds the phase query.
+	────────────────────────────────────────────────────────────────────────── */
+
+package reviewquery
+
+import (
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+type PhaseQuery struct {
+	Scope
+	IDColumn string // column of From holding the review info ID
+	Keys     []entity.PivotAssetKey
+	NoLocks  bool
+}
+
+func (q PhaseQuery) Build() (string, []any) {
+	d := OrDefault(q.Dialect)
+	ref := q.Ref
+	leaf := d.JSONIndex(ref+"."+d.Ident("groups"), 0)
+	var sb strings.Builder
+	sb.WriteString(`
+WITH latest_phase AS (
+  SELECT` + q.Modifiers + `
+    ` + ref + `.` + q.IDColumn + ` AS review_info_id,
+    ` + ref + `.project,
+    ` + ref + `.root,
+    ` + ref + `.group_1,
+    ` + q.Group2 + ` AS group_2,
+    ` + q.Group3 + ` AS group_3,
+    ` + ref + `.relation,
+    COALESCE(` + ref + `.component, '') AS component,
+    ` + ref + `.phase,
+    ` + ref + `.work_status,
+    ` + ref + `.approval_status,
+    ` + ref + `.studio,
+    ` + ref + `.submitted_at_utc,
+    ` + ref + `.modified_at_utc,
+    ` + d.Right(ref+".take", 4) + ` AS take,
+    ` + leaf + ` AS leaf_group_name,
+    gc.path AS group_category_path,
+    ` + d.PathHead("gc.path") + ` AS top_group_node,
+    ` + q.Rank + ` AS rn
+  FROM ` + q.From + `
+  LEFT JOIN t_group_category_group AS gcg
+         ON gcg.project = ` + ref + `.project
+        AND gcg.deleted = 0
+        AND gcg.path = ` + leaf + `
+  LEFT JOIN t_group_category AS gc
+         ON gc.id = gcg.group_category_id
+        AND gc.deleted = 0
+        AND gc.root = ` + ref + `.root
+  WHERE ` + ref + `.project = ? AND ` + ref + `.root = ?` + q.Live + q.Rows.SQL + `
+    AND (
+`)
+	args := []any{q.Project, q.Root}
+	args = append(args, q.Rows.Args...)
+
+	for i, k := range q.Keys {
+		if i > 0 {
+			sb.WriteString("      OR ")
+		}
+		sb.WriteString("(" + ref + ".group_1 = ? AND " + q.Group2 + " = ? AND " + q.Group3 + " = ? AND " + ref + ".relation = ?")
+		args = append(args, k.Group1, k.Group2, k.Group3, k.Relation)
+		if k.Component != nil {
+			sb.WriteString(" AND " + ref + ".component = ?")
+			args = append(args, *k.Component)
+		}
+		sb.WriteString(")\n")
+	}
+
+	lockCond := ""
+	if q.NoLocks {
+		lockCond = "\n      AND 1 = 0"
+	}
+	sb.WriteString(`    )
+)
+SELECT
+  lp.review_info_id,
+  lp.project,
+  lp.root,
+  lp.group_1,
+  lp.group_2,
+  lp.group_3,
+  lp.relation,
+  lp.component,
+  lp.phase,
+  lp.work_status,
+  lp.approval_status,
+  lp.studio,
+  lp.submitted_at_utc,
+  lp.take,
+  lp.leaf_group_name,
+  lp.group_category_path,
+  lp.top_group_node,
+  rl.holder         AS lock_holder,
+  rl.expires_at_utc AS lock_expires_at_utc
+FROM latest_phase AS lp
+LEFT JOIN t_review_lock AS rl
+       ON rl.review_info_id = lp.review_info_id
+      AND rl.expires_at_utc > ` + d.UTCNow() + lockCond + `
+WHERE lp.rn = 1;
+`)
+	return sb.String(), args
+}
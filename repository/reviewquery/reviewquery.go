@@ -0,0 +1,96 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/reviewquery/reviewquery.go
+
+	Module Description:
+		Typed builders of the asset pivot SQL, shared by the repository queries.
+
+	Details:
+	- The pivot SQL used to be assembled by hand in every query: each copy of the
+	  latest_phase CTE drifted a little (columns, conditions), and the arguments had to
+	  be appended in exactly the order of their placeholders.
+	- A Frag keeps a piece of SQL together with its arguments, so a builder can place it
+	  anywhere without the caller tracking argument order.
+	- Scope is the latest_phase CTE (the latest row of every asset phase); KeyQuery,
+	  CountQuery and PhaseQuery build the key, count and phase queries over it.
+	- The builders only assemble SQL. The fragments (filters, hints, sources) are made by
+	  the repository, which also runs the queries.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Scope carries the SQL dialect (dialect.go).
+
+	Functions:
+	* - Join: Concatenates fragments in placeholder order.
+	* - (Scope) CTE: Builds the latest_phase CTE.
+	────────────────────────────────────────────────────────────────────────── */
+
+package reviewquery
+
+// Frag is a piece of SQL with the arguments of its placeholders, in order.
+type Frag struct {
+	SQL  string
+	Args []any
+}
+
+// Join concatenates frags, keeping their arguments in placeholder order.
+func Join(frags ...Frag) Frag {
+	var out Frag
+	for _, f := range frags {
+		out.SQL += f.SQL
+		out.Args = append(out.Args, f.Args...)
+	}
+	return out
+}
+
+// Scope is the latest_phase CTE: the latest row of every phase of the assets of Project
+// under Root, restricted by Rows.
+type Scope struct {
+	Dialect   Dialect // nil is MySQL
+	Project   string
+	Root      string
+	Modifiers string   // right after SELECT: optimizer hints, SQL_BIG_RESULT
+	From      string   // t_review_latest, or t_review_info for as-of reads
+	Ref       string   // qualifier of the columns of From
+	Rank      string   // rank of a row within its phase; 1 is the latest row
+	Live      string   // condition keeping live rows, starting with " AND"
+	Group2    string   // group_2 expression
+	Group3    string   // group_3 expression
+	Columns   []string // extra "<expr> AS <name>" columns
+	Rows      Frag     // conditions on the rows (name, relation, tags, access, as-of)
+}
+
+// CTE returns "WITH latest_phase AS (...)".
+func (s Scope) CTE() Frag {
+	d := OrDefault(s.Dialect)
+	columns := ""
+	for _, c := range s.Columns {
+		columns += "\n    " + c + ","
+	}
+	return Join(Frag{
+		SQL: `
+WITH latest_phase AS (
+  SELECT` + s.Modifiers + `
+    project,
+    root,
+    group_1,
+    ` + s.Group2 + ` AS group_2,
+    ` + s.Group3 + ` AS group_3,
+    relation,
+    component,
+    phase,
+    work_status,
+    approval_status,
+    submitted_user,
+    approval_status_updated_user,
+    studio,
+    submitted_at_utc,
+    modified_at_utc,` + columns + `
+    ` + d.JSONIndex(s.Ref+"."+d.Ident("groups"), 0) + ` AS leaf_group_name,
+    ` + s.Rank + ` AS rn
+  FROM ` + s.From + `
+  WHERE project = ? AND root = ?` + s.Live,
+		Args: []any{s.Project, s.Root},
+	}, s.Rows, Frag{SQL: `
+)`})
+}
@@ -0,0 +1,287 @@
+package reviewquery
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+// placeholders counts the ? of sql outside string literals ('$[0]' and the like).
+func placeholders(sql string) int {
+	n, inQuote := 0, false
+	for _, ch := range sql {
+		switch {
+		case ch == '\'':
+			inQuote = !inQuote
+		case ch == '?' && !inQuote:
+			n++
+		}
+	}
+	return n
+}
+
+// testScope returns the latest_phase scope of project rod under assets, reading
+// t_review_latest as the repository does, restricted by rows.
+func testScope(d Dialect, rows Frag) Scope {
+	return Scope{
+		Dialect: d,
+		Project: "rod",
+		Root:    "assets",
+		From:    "t_review_latest AS rl0",
+		Ref:     "rl0",
+		Rank:    "1",
+		Live:    "",
+		Group2:  "rl0.group_2",
+		Group3:  "rl0.group_3",
+		Rows:    rows,
+	}
+}
+
+// The filter fragments of the tests, as the repository builds them.
+var (
+	nameFrag     = Frag{SQL: " AND LOWER(group_1) LIKE ?", Args: []any{"chr%"}}
+	relationFrag = Frag{SQL: " AND relation IN (?,?)", Args: []any{"main", "sub"}}
+	statusFrag   = Frag{SQL: " AND (LOWER(approval_status) IN (?)) AND (LOWER(work_status) IN (?,?))", Args: []any{"check", "wip", "hold"}}
+	overallFrag  = Frag{SQL: ", asset_status AS (SELECT project, root, group_1, group_2, group_3, relation, 'retake' AS overall_status FROM latest_phase WHERE phase <> ?)", Args: []any{"LDV"}}
+	dueFrag      = Frag{SQL: ", asset_due AS (SELECT project, root, group_1, relation, MIN(due_date) AS next_due_date FROM t_review_due_date WHERE project = ? AND root = ? GROUP BY project, root, group_1, relation)", Args: []any{"rod", "assets"}}
+	overdueFrag  = Frag{SQL: " AND ad.next_due_date < ?", Args: []any{"2026-01-10"}}
+)
+
+// dialectCases names the dialects; nil builds MySQL.
+var dialectCases = []struct {
+	name string
+	d    Dialect
+	want Dialect
+}{
+	{"mysql", MySQL, MySQL},
+	{"postgres", PostgreSQL, PostgreSQL},
+	{"sqlite", SQLite, SQLite},
+	{"default", nil, MySQL},
+}
+
+func TestKeyQuery(t *testing.T) {
+	for _, dc := range dialectCases {
+		for _, tc := range []struct {
+			name     string
+			rows     Frag
+			filter   Frag
+			wantArgs []any
+		}{
+			{
+				name:     "no filter",
+				wantArgs: []any{"rod", "assets", "LDV", "rod", "assets"},
+			},
+			{
+				name:     "name and relations",
+				rows:     Join(nameFrag, relationFrag),
+				wantArgs: []any{"rod", "assets", "chr%", "main", "sub", "LDV", "rod", "assets"},
+			},
+			{
+				name:     "statuses and overdue",
+				filter:   Join(statusFrag, overdueFrag),
+				wantArgs: []any{"rod", "assets", "LDV", "rod", "assets", "check", "wip", "hold", "2026-01-10"},
+			},
+			{
+				name:   "every filter",
+				rows:   Join(nameFrag, relationFrag),
+				filter: Join(statusFrag, overdueFrag),
+				wantArgs: []any{
+					"rod", "assets", "chr%", "main", "sub", "LDV", "rod", "assets",
+					"check", "wip", "hold", "2026-01-10",
+				},
+			},
+		} {
+			t.Run(dc.name+"/"+tc.name, func(t *testing.T) {
+				sql, args := KeyQuery{
+					Scope:   testScope(dc.d, tc.rows),
+					Overall: overallFrag,
+					Due:     dueFrag,
+					Filter:  tc.filter,
+				}.Build()
+
+				if !reflect.DeepEqual(args, tc.wantArgs) {
+					t.Errorf("args = %v, want %v", args, tc.wantArgs)
+				}
+				if n := placeholders(sql); n != len(args) {
+					t.Errorf("%d placeholders for %d args:\n%s", n, len(args), sql)
+				}
+				leaf := dc.want.JSONIndex("rl0."+dc.want.Ident("groups"), 0) + " AS leaf_group_name"
+				checkOrder(t, sql,
+					"WITH latest_phase AS (",
+					leaf,
+					"WHERE project = ? AND root = ?"+tc.rows.SQL+"\n)",
+					overallFrag.SQL,
+					dueFrag.SQL,
+					"FROM latest_phase AS lp",
+					"WHERE lp.rn = 1"+tc.filter.SQL+"\nGROUP BY",
+				)
+			})
+		}
+	}
+}
+
+func TestPhaseQuery(t *testing.T) {
+	component := "body"
+	for _, dc := range dialectCases {
+		for _, tc := range []struct {
+			name      string
+			rows      Frag
+			keys      []entity.PivotAssetKey
+			noLocks   bool
+			wantArgs  []any
+			wantConds []string
+		}{
+			{
+				name:      "one key",
+				keys:      []entity.PivotAssetKey{{Group1: "chr", Relation: "main"}},
+				wantArgs:  []any{"rod", "assets", "chr", "", "", "main"},
+				wantConds: []string{"(rl0.group_1 = ? AND rl0.group_2 = ? AND rl0.group_3 = ? AND rl0.relation = ?)\n"},
+			},
+			{
+				name: "keys with and without component",
+				keys: []entity.PivotAssetKey{
+					{Group1: "chr", Relation: "main", Component: &component},
+					{Group1: "sq01", Group2: "sh010", Group3: "cut", Relation: "sub"},
+				},
+				wantArgs: []any{"rod", "assets", "chr", "", "", "main", "body", "sq01", "sh010", "cut", "sub"},
+				wantConds: []string{
+					"(rl0.group_1 = ? AND rl0.group_2 = ? AND rl0.group_3 = ? AND rl0.relation = ? AND rl0.component = ?)\n",
+					"      OR (rl0.group_1 = ? AND rl0.group_2 = ? AND rl0.group_3 = ? AND rl0.relation = ?)\n",
+				},
+			},
+			{
+				name:      "rows filter, as of",
+				rows:      Frag{SQL: " AND rl0.modified_at_utc <= ?", Args: []any{"2026-01-10 00:00:00"}},
+				keys:      []entity.PivotAssetKey{{Group1: "chr", Relation: "main"}},
+				noLocks:   true,
+				wantArgs:  []any{"rod", "assets", "2026-01-10 00:00:00", "chr", "", "", "main"},
+				wantConds: []string{"(rl0.group_1 = ? AND rl0.group_2 = ? AND rl0.group_3 = ? AND rl0.relation = ?)\n"},
+			},
+		} {
+			t.Run(dc.name+"/"+tc.name, func(t *testing.T) {
+				sql, args := PhaseQuery{
+					Scope:    testScope(dc.d, tc.rows),
+					IDColumn: "review_info_id",
+					Keys:     tc.keys,
+					NoLocks:  tc.noLocks,
+				}.Build()
+
+				if !reflect.DeepEqual(args, tc.wantArgs) {
+					t.Errorf("args = %v, want %v", args, tc.wantArgs)
+				}
+				if n := placeholders(sql); n != len(args) {
+					t.Errorf("%d placeholders for %d args:\n%s", n, len(args), sql)
+				}
+				d := dc.want
+				leaf := d.JSONIndex("rl0."+d.Ident("groups"), 0)
+				checkOrder(t, sql, append(append([]string{
+					"rl0.review_info_id AS review_info_id",
+					d.Right("rl0.take", 4) + " AS take",
+					leaf + " AS leaf_group_name",
+					d.PathHead("gc.path") + " AS top_group_node",
+					"AND gcg.path = " + leaf,
+					"WHERE rl0.project = ? AND rl0.root = ?" + tc.rows.SQL + "\n    AND (\n",
+				}, tc.wantConds...),
+					"AND rl.expires_at_utc > "+d.UTCNow(),
+					"WHERE lp.rn = 1;",
+				)...)
+				if got := strings.Contains(sql, "AND 1 = 0"); got != tc.noLocks {
+					t.Errorf("locks left out: %v, want %v", got, tc.noLocks)
+				}
+			})
+		}
+	}
+}
+
+func TestCountQuery(t *testing.T) {
+	for _, dc := range dialectCases {
+		for _, tc := range []struct {
+			name     string
+			rows     Frag
+			ctes     Frag
+			filter   Frag
+			wantArgs []any
+		}{
+			{
+				name:     "no filter",
+				wantArgs: []any{"rod", "assets"},
+			},
+			{
+				name:     "name and statuses",
+				rows:     nameFrag,
+				filter:   statusFrag,
+				wantArgs: []any{"rod", "assets", "chr%", "check", "wip", "hold"},
+			},
+			{
+				name: "overall status and overdue",
+				ctes: Join(overallFrag, dueFrag),
+				filter: Join(
+					Frag{SQL: " AND relation IN (SELECT relation FROM asset_status)"},
+					Frag{SQL: " AND group_1 IN (SELECT group_1 FROM asset_due WHERE next_due_date < ?)", Args: []any{"2026-01-10"}},
+				),
+				wantArgs: []any{"rod", "assets", "LDV", "rod", "assets", "2026-01-10"},
+			},
+			{
+				name:     "every filter",
+				rows:     Join(nameFrag, relationFrag),
+				ctes:     Join(overallFrag, dueFrag),
+				filter:   Join(statusFrag, Frag{SQL: " AND group_1 IN (SELECT group_1 FROM asset_due WHERE next_due_date < ?)", Args: []any{"2026-01-10"}}),
+				wantArgs: []any{"rod", "assets", "chr%", "main", "sub", "LDV", "rod", "assets", "check", "wip", "hold", "2026-01-10"},
+			},
+		} {
+			t.Run(dc.name+"/"+tc.name, func(t *testing.T) {
+				sql, args := CountQuery{
+					Scope:  testScope(dc.d, tc.rows),
+					CTEs:   tc.ctes,
+					Filter: tc.filter,
+				}.Build()
+
+				if !reflect.DeepEqual(args, tc.wantArgs) {
+					t.Errorf("args = %v, want %v", args, tc.wantArgs)
+				}
+				if n := placeholders(sql); n != len(args) {
+					t.Errorf("%d placeholders for %d args:\n%s", n, len(args), sql)
+				}
+				checkOrder(t, sql,
+					"WITH latest_phase AS (",
+					dc.want.JSONIndex("rl0."+dc.want.Ident("groups"), 0)+" AS leaf_group_name",
+					"WHERE project = ? AND root = ?"+tc.rows.SQL+"\n)"+tc.ctes.SQL+"\nSELECT COUNT(*) FROM (",
+					"WHERE rn = 1"+tc.filter.SQL+"\n  GROUP BY project, root, group_1, group_2, group_3, relation",
+				)
+			})
+		}
+	}
+}
+
+// The builders write the fragments where the scope puts them, in placeholder order,
+// whatever the dialect: only the dialect's own spellings differ.
+func TestScopeDialects(t *testing.T) {
+	var sqls []string
+	for _, dc := range dialectCases[:3] {
+		sql, _ := CountQuery{Scope: testScope(dc.d, nameFrag), Filter: statusFrag}.Build()
+		d := dc.want
+		sqls = append(sqls, strings.Replace(sql, d.JSONIndex("rl0."+d.Ident("groups"), 0), "<leaf>", 1))
+	}
+	for i := 1; i < len(sqls); i++ {
+		if sqls[i] != sqls[0] {
+			t.Errorf("%s count query differs from mysql beyond the dialect:\n%s\nvs\n%s",
+				dialectCases[i].name, sqls[i], sqls[0])
+		}
+	}
+}
+
+// checkOrder reports each of parts missing from sql or found before the previous one.
+func checkOrder(t *testing.T, sql string, parts ...string) {
+	t.Helper()
+	at := 0
+	for _, p := range parts {
+		i := strings.Index(sql[at:], p)
+		if i < 0 {
+			t.Errorf("%q missing after offset %d of\n%s", p, at, sql)
+			return
+		}
+		at += i + len(p)
+	}
+}
@@ -0,0 +1,85 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/slackAssetNotifier.go
+
+	Module Description:
+		Slack backed entity.AssetNotifier messaging the watchers of an asset.
+
+	Details:
+	- Messages are posted with chat.postMessage as the bot owning the token; a member
+	  ID as channel sends a direct message from the bot.
+	- Slack answers 200 with "ok": false on most failures, so the body is checked too.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewSlackAssetNotifier: Creates a notifier posting with a bot token.
+	* - Notify: entity.AssetNotifier implementation.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+const slackPostMessageURL = "https://slack.com/api/chat.postMessage"
+
+type SlackAssetNotifier struct {
+	token  string
+	client *http.Client
+}
+
+func NewSlackAssetNotifier(token string) *SlackAssetNotifier {
+	return &SlackAssetNotifier{
+		token:  token,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (n *SlackAssetNotifier) Notify(ctx context.Context, an *entity.AssetNotification) error {
+	if an.Watch.SlackID == "" {
+		return nil
+	}
+	body, err := json.Marshal(map[string]string{
+		"channel": an.Watch.SlackID,
+		"text":    "*" + an.Subject + "*\n" + an.Text,
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, slackPostMessageURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+n.token)
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("slack responded %s", res.Status)
+	}
+	var out struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return err
+	}
+	if !out.OK {
+		return fmt.Errorf("slack: %s", out.Error)
+	}
+	return nil
+}
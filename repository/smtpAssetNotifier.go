@@ -0,0 +1,74 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/smtpAssetNotifier.go
+
+	Module Description:
+		SMTP backed entity.AssetNotifier emailing the watchers of an asset.
+
+	Details:
+	- Mails are plain text from From through the relay at Addr (host:port); PLAIN auth
+	  is used when Username is set.
+	- net/smtp does not take a context, so a slow relay holds the worker up to its own
+	  timeouts.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewSMTPAssetNotifier: Creates a notifier sending through a relay.
+	* - Notify: entity.AssetNotifier implementation.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+type SMTPConfig struct {
+	Addr     string // host:port of the relay
+	From     string
+	Username string
+	Password string
+}
+
+type SMTPAssetNotifier struct {
+	cfg  SMTPConfig
+	auth smtp.Auth
+}
+
+func NewSMTPAssetNotifier(cfg SMTPConfig) *SMTPAssetNotifier {
+	n := &SMTPAssetNotifier{cfg: cfg}
+	if cfg.Username != "" {
+		host, _, _ := net.SplitHostPort(cfg.Addr)
+		n.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+	}
+	return n
+}
+
+func (n *SMTPAssetNotifier) Notify(ctx context.Context, an *entity.AssetNotification) error {
+	if an.Watch.Email == "" {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", n.cfg.From)
+	fmt.Fprintf(&msg, "To: %s\r\n", an.Watch.Email)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", an.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", time.Now().UTC().Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(an.Text, "\n", "\r\n"))
+	msg.WriteString("\r\n")
+	return smtp.SendMail(n.cfg.Addr, n.auth, n.cfg.From, []string{an.Watch.Email}, []byte(msg.String()))
+}
@@ -0,0 +1,146 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/sqliteDevelopment.go
+
+	Module Description:
+		Preparation of an SQLite database for local development (PPI_SQLITE_PATH).
+
+	Details:
+	- The repositories migrate their own tables, but the pivot also reads the group
+	  category tables, which belong to the central database and are not migrated here.
+	  PrepareSQLite creates empty ones with the columns the pivot reads, so assets fall
+	  into "Unassigned" until rows are inserted.
+	- Only for SQLite; a MySQL or PostgreSQL database has the real tables.
+	- The pure Go SQLite driver fails a query with anything after its closing semicolon
+	  ("not an error (21)"), and most pivot queries end in ";\n". PrepareSQLite registers
+	  a callback trimming the whitespace around every statement.
+	- SQLite returns the MAX or MIN of a DATETIME column as text without a column type,
+	  which the driver does not convert; the pivot scans those columns (next_due_date,
+	  latest_activity_at) into nullTime, which parses the text.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Trim the whitespace after the closing semicolon of a statement.
+	* - 15-10-2026 - Added nullTime for the aggregated timestamps of the pivot.
+
+	Functions:
+	* - PrepareSQLite: Creates the external tables the pivot reads.
+	* - trimStatement: Trims the whitespace around the SQL of a statement.
+	* - (nullTime) Scan: Scans a nullable time, also from the text SQLite returns.
+	* - (nullTime) Value: Returns the time, or nil when it is NULL.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/repository/reviewquery"
+	"gorm.io/gorm"
+)
+
+// sqliteExternalTables are the tables of the central database read by the pivot, with
+// the columns it reads.
+var sqliteExternalTables = []string{`
+CREATE TABLE IF NOT EXISTS t_group_category (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  root TEXT NOT NULL,
+  path TEXT NOT NULL,
+  deleted INTEGER NOT NULL DEFAULT 0
+)`, `
+CREATE TABLE IF NOT EXISTS t_group_category_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  project TEXT NOT NULL,
+  path TEXT NOT NULL,
+  group_category_id INTEGER NOT NULL,
+  deleted INTEGER NOT NULL DEFAULT 0
+)`}
+
+// PrepareSQLite creates the central database tables the pivot reads in the SQLite
+// database db. Call it before constructing the repositories.
+func PrepareSQLite(db *gorm.DB) error {
+	if dialectOf(db) != reviewquery.SQLite {
+		return fmt.Errorf("PrepareSQLite: %s is not an SQLite database", db.Dialector.Name())
+	}
+	if err := db.Callback().Row().Before("gorm:row").Register("sqlite:trim", trimStatement); err != nil {
+		return err
+	}
+	if err := db.Callback().Raw().Before("gorm:raw").Register("sqlite:trim", trimStatement); err != nil {
+		return err
+	}
+	for _, ddl := range sqliteExternalTables {
+		if err := db.Exec(ddl).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// trimStatement trims the whitespace around a statement built with Raw (scanned) or Exec.
+func trimStatement(db *gorm.DB) {
+	sql := db.Statement.SQL.String()
+	if trimmed := strings.TrimSpace(sql); trimmed != sql {
+		db.Statement.SQL.Reset()
+		db.Statement.SQL.WriteString(trimmed)
+	}
+}
+
+// sqliteTimeLayouts are the layouts SQLite drivers write a time.Time in, and the date
+// and timestamp literals of the SQL.
+var sqliteTimeLayouts = []string{
+	"2006-01-02 15:04:05.999999999-07:00",
+	"2006-01-02T15:04:05.999999999-07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// nullTime is a nullable time column of a pivot query. MySQL and PostgreSQL return a
+// time.Time; SQLite returns the text of an aggregated one.
+type nullTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+func (t *nullTime) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*t = nullTime{}
+		return nil
+	case time.Time:
+		*t = nullTime{Time: v, Valid: true}
+		return nil
+	case []byte:
+		src = string(v)
+	}
+	text, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("nullTime: cannot scan %T", src)
+	}
+	for _, layout := range sqliteTimeLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			*t = nullTime{Time: parsed, Valid: true}
+			return nil
+		}
+	}
+	return fmt.Errorf("nullTime: cannot parse %q", text)
+}
+
+func (t nullTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// ptr returns the time, or nil when it is NULL.
+func (t nullTime) ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time
+	return &v
+}
@@ -0,0 +1,107 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		repository/userPreference.go
+
+	Module Description:
+		Repository for per-user preference documents.
+
+	Details:
+	- One row per (user, key); Put replaces the whole document.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves one preference of a user.
+	* - Put: Creates or replaces one preference of a user.
+	* - Delete: Removes one preference of a user.
+	────────────────────────────────────────────────────────────────────────── */
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type UserPreference struct {
+	db *gorm.DB
+}
+
+func NewUserPreference(db *gorm.DB) (*UserPreference, error) {
+	if err := db.AutoMigrate(&model.UserPreference{}); err != nil {
+		return nil, err
+	}
+	return &UserPreference{
+		db: db,
+	}, nil
+}
+
+func (r *UserPreference) WithContext(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx)
+}
+
+func (r *UserPreference) TransactionWithContext(
+	ctx context.Context,
+	fc func(tx *gorm.DB) error,
+	opts ...*sql.TxOptions,
+) error {
+	db := r.WithContext(ctx)
+	return db.Transaction(fc, opts...)
+}
+
+func (r *UserPreference) Get(
+	db *gorm.DB,
+	params *entity.GetUserPreferenceParams,
+) (*entity.UserPreference, error) {
+	var m model.UserPreference
+	if err := db.Where(
+		"`user` = ?", params.User,
+	).Where(
+		"`key` = ?", params.Key,
+	).Take(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, entity.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *UserPreference) Put(
+	tx *gorm.DB,
+	params *entity.PutUserPreferenceParams,
+) (*entity.UserPreference, error) {
+	m := model.NewUserPreference(params)
+	if err := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"value", "updated_at_utc"}),
+	}).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return m.Entity(), nil
+}
+
+func (r *UserPreference) Delete(
+	tx *gorm.DB,
+	params *entity.DeleteUserPreferenceParams,
+) error {
+	res := tx.Where(
+		"`user` = ?", params.User,
+	).Where(
+		"`key` = ?", params.Key,
+	).Delete(&model.UserPreference{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRecordNotFound
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		tracing/tracing.go
+
+	Module Description:
+		OpenTelemetry tracing of requests across delivery → usecase → repository.
+
+	Details:
+	- Setup installs the global tracer provider. Spans are exported over OTLP/HTTP when
+	  OTEL_EXPORTER_OTLP_ENDPOINT is set (the exporter reads the standard OTEL_* env);
+	  otherwise spans are only created, so trace IDs still reach logs and X-Trace-Id.
+	- Incoming W3C traceparent headers are honoured, so a client's trace continues here.
+	- Spans follow the context: handlers pass c.Request.Context() down and every layer
+	  starts its child spans from the ctx it received.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Setup: Installs the tracer provider and propagators.
+	* - Start: Starts a span.
+	* - End: Records an error on a span and ends it.
+	* - TraceID: Returns the trace ID of a context.
+	────────────────────────────────────────────────────────────────────────── */
+
+package tracing
+
+import (
+	"context"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/PolygonPictures/central30-web/front"
+
+// Setup installs the global tracer provider for serviceName. Call the returned function
+// on shutdown to flush pending spans.
+func Setup(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", serviceName),
+		)),
+	}
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" {
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+	return tp.Shutdown, nil
+}
+
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// End marks span failed when err is set, then ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// TraceID returns the trace ID of the span in ctx, or "" when there is none.
+func TraceID(ctx context.Context) string {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return ""
+	}
+	return sc.TraceID().String()
+}
@@ -0,0 +1,126 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/assetNote.go
+
+	Module Description:
+		Usecase layer for the free-form notes of asset relations.
+
+	Details:
+	- Anyone who can read the project may write notes; only its author edits or deletes
+	  a note.
+	- The note count and newest note of pivot rows are read on every request asking for
+	  them (include=notes) rather than cached with the page, so notes never lag behind
+	  and writing one doesn't drop the pivot caches.
+	- A failing note lookup leaves the pivot without notes rather than failing it.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - ListNotes: Lists the notes of an asset relation, newest first.
+	* - CreateNote: Adds a note to an asset relation.
+	* - UpdateNote: Replaces the body of the caller's note.
+	* - DeleteNote: Deletes the caller's note.
+	* - applyPivotNotes: Fills the note count and newest note of a pivot result.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+func (uc *ReviewInfo) ListNotes(
+	ctx context.Context,
+	params *entity.ListAssetNotesParams,
+) ([]*entity.AssetNote, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListNotes(db, params)
+}
+
+func (uc *ReviewInfo) CreateNote(
+	ctx context.Context,
+	params *entity.CreateAssetNoteParams,
+) (*entity.AssetNote, error) {
+	params.Body = strings.TrimSpace(params.Body)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.AssetNote
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.CreateNote(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *ReviewInfo) UpdateNote(
+	ctx context.Context,
+	params *entity.UpdateAssetNoteParams,
+) (*entity.AssetNote, error) {
+	params.Body = strings.TrimSpace(params.Body)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.AssetNote
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		var err error
+		e, err = uc.repo.UpdateNote(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *ReviewInfo) DeleteNote(
+	ctx context.Context,
+	params *entity.DeleteAssetNoteParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	return uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		return uc.repo.DeleteNote(tx, params)
+	})
+}
+
+// applyPivotNotes sets NoteCount and LatestNote on every row of res when p asks for them.
+func (uc *ReviewInfo) applyPivotNotes(ctx context.Context, p ListAssetsPivotParams, res *ListAssetsPivotResult) {
+	if !p.Notes {
+		return
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	if err := uc.repo.FillPivotNotes(uc.repo.ReadWithContext(timeoutCtx, p.Project), p.Project, pivotRows(res)); err != nil {
+		log.Printf("[NOTES] notes of %s failed: %v", p.Project, err)
+	}
+}
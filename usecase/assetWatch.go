@@ -0,0 +1,259 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/assetWatch.go
+
+	Module Description:
+		Usecase layer for asset watches and the notification fan-out to their watchers.
+
+	Details:
+	- ReviewInfo Create/Update call notify after their transaction commits, for a new
+	  submission or a changed approval / work status of an asset phase. notify only
+	  queues the change, so slow mail or Slack never delays a write.
+	- Workers started by Run list the asset's watchers and hand every one of them to
+	  each notifier (email, Slack); the user who made the change is not notified.
+	- Failed notifications are logged and not retried. Like the webhook queue, the
+	  queue lives in memory and a full queue drops changes.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Watch: Creates or replaces the caller's watch on an asset.
+	* - Get: Returns the caller's watch on an asset.
+	* - Unwatch: Removes the caller's watch on an asset.
+	* - ListWatchers: Lists the watches of an asset.
+	* - Run: Sends queued notifications until ctx is done.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const assetWatchQueueSize = 1024
+
+// assetChange is one queued status change; before is nil for a new submission.
+type assetChange struct {
+	before *entity.ReviewInfo
+	after  *entity.ReviewInfo
+}
+
+type AssetWatch struct {
+	repo         *repository.AssetWatch
+	prjRepo      *repository.ProjectInfo
+	notifiers    []entity.AssetNotifier
+	queue        chan assetChange
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewAssetWatch(
+	repo *repository.AssetWatch,
+	pr *repository.ProjectInfo,
+	notifiers []entity.AssetNotifier,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *AssetWatch {
+	return &AssetWatch{
+		repo:         repo,
+		prjRepo:      pr,
+		notifiers:    notifiers,
+		queue:        make(chan assetChange, assetWatchQueueSize),
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *AssetWatch) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *AssetWatch) Watch(
+	ctx context.Context,
+	params *entity.WatchAssetParams,
+) (*entity.AssetWatch, error) {
+	params.Email = strings.TrimSpace(params.Email)
+	params.SlackID = strings.TrimSpace(params.SlackID)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if params.Email == "" && params.SlackID == "" {
+		return nil, entity.ErrAssetWatchNoChannel
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.AssetWatch
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Watch(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *AssetWatch) Get(
+	ctx context.Context,
+	params *entity.GetAssetWatchParams,
+) (*entity.AssetWatch, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	return uc.repo.Get(db, params)
+}
+
+func (uc *AssetWatch) Unwatch(
+	ctx context.Context,
+	params *entity.UnwatchAssetParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	return uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		return uc.repo.Unwatch(tx, params)
+	})
+}
+
+func (uc *AssetWatch) ListWatchers(
+	ctx context.Context,
+	params *entity.ListAssetWatchersParams,
+) ([]*entity.AssetWatch, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListWatchers(db, params)
+}
+
+// notify queues the change of an asset phase from before (nil for a new submission) to
+// after. Only asset review infos are watched. It never blocks; a nil receiver (watch
+// notifications disabled) does nothing.
+func (uc *AssetWatch) notify(before, after *entity.ReviewInfo) {
+	if uc == nil || after == nil || after.Root != "assets" {
+		return
+	}
+	if before != nil && before.ApprovalStatus == after.ApprovalStatus && before.WorkStatus == after.WorkStatus {
+		return
+	}
+	select {
+	case uc.queue <- assetChange{before: before, after: after}:
+	default:
+		log.Printf("[WATCH] queue full, dropping change of %s/%s/%s %s",
+			after.Project, after.Group1, after.Relation, after.Phase)
+	}
+}
+
+// Run sends queued notifications with the given number of workers until ctx is done.
+func (uc *AssetWatch) Run(ctx context.Context, workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case change := <-uc.queue:
+					uc.process(ctx, change)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (uc *AssetWatch) process(ctx context.Context, change assetChange) {
+	review := change.after
+	listCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	watches, err := uc.repo.ListWatchers(uc.repo.WithContext(listCtx), &entity.ListAssetWatchersParams{
+		Project:  review.Project,
+		Asset:    review.Group1,
+		Relation: review.Relation,
+	})
+	cancel()
+	if err != nil {
+		log.Printf("[WATCH] listing watchers of %s/%s/%s failed: %v",
+			review.Project, review.Group1, review.Relation, err)
+		return
+	}
+	if len(watches) == 0 {
+		return
+	}
+
+	subject, text, actor := describeAssetChange(change)
+	for _, w := range watches {
+		if w.User == actor {
+			continue
+		}
+		n := &entity.AssetNotification{Watch: w, Subject: subject, Text: text, Review: review}
+		for _, notifier := range uc.notifiers {
+			sendCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+			if err := notifier.Notify(sendCtx, n); err != nil {
+				log.Printf("[WATCH] notifying %s of %s/%s/%s failed: %v",
+					w.User, review.Project, review.Group1, review.Relation, err)
+			}
+			cancel()
+		}
+	}
+}
+
+// describeAssetChange returns the subject and text of the notification of change and
+// the user who made it.
+func describeAssetChange(change assetChange) (subject, text, actor string) {
+	r := change.after
+	asset := fmt.Sprintf("%s %s (%s)", r.Group1, r.Phase, r.Relation)
+	var lines []string
+	switch b := change.before; {
+	case b == nil:
+		actor = r.SubmittedUser
+		subject = fmt.Sprintf("[%s] %s: new submission %s", r.Project, asset, r.Take)
+		lines = append(lines, fmt.Sprintf("Take %s was submitted by %s.", r.Take, r.SubmittedUser))
+	case b.ApprovalStatus != r.ApprovalStatus:
+		actor = r.ApprovalStatusUpdatedUser
+		subject = fmt.Sprintf("[%s] %s: %s", r.Project, asset, r.ApprovalStatus)
+		lines = append(lines, fmt.Sprintf("Approval status: %s → %s (by %s)",
+			b.ApprovalStatus, r.ApprovalStatus, r.ApprovalStatusUpdatedUser))
+	default:
+		actor = r.WorkStatusUpdatedUser
+		subject = fmt.Sprintf("[%s] %s: %s", r.Project, asset, r.WorkStatus)
+	}
+	if b := change.before; b != nil && b.WorkStatus != r.WorkStatus {
+		lines = append(lines, fmt.Sprintf("Work status: %s → %s (by %s)",
+			b.WorkStatus, r.WorkStatus, r.WorkStatusUpdatedUser))
+	}
+	lines = append(lines, fmt.Sprintf("Take: %s", r.Take))
+	return subject, strings.Join(lines, "\n"), actor
+}
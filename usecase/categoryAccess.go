@@ -0,0 +1,173 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/categoryAccess.go
+
+	Module Description:
+		Usecase layer for restricting which top group nodes a role can see in the asset pivot.
+
+	Details:
+	- A role with no rule in a project sees every category (nil allowed list).
+	- Supervisors manage the project and always see every category, whatever the rules.
+	- A role with rules sees only the listed top group nodes; the list is applied to the
+	  pivot keys query and its count, so totals and pages always agree.
+	- Create and Delete need configure_project (supervisor), or a restricted role could
+	  drop its own rules, and drop the project's cached pivot pages, which were built
+	  under the previous rules.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Supervisors are never restricted.
+	* - 15-10-2026 - Rule writes are supervisor-only and drop the cached pivot pages.
+
+	Functions:
+	* - List: Lists the rules of a project.
+	* - Create: Adds a rule (supervisor only once enforcement is on).
+	* - Delete: Removes a rule (supervisor only once enforcement is on).
+	* - AllowedTopGroupNodes: Resolves the allowed list for a role (nil = unrestricted).
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type CategoryAccess struct {
+	repo         *repository.CategoryAccess
+	prjRepo      *repository.ProjectInfo
+	memberUc     *ProjectMember
+	cache        entity.Cache
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewCategoryAccess(
+	repo *repository.CategoryAccess,
+	pr *repository.ProjectInfo,
+	mu *ProjectMember,
+	c entity.Cache,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *CategoryAccess {
+	return &CategoryAccess{
+		repo:         repo,
+		prjRepo:      pr,
+		memberUc:     mu,
+		cache:        c,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *CategoryAccess) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *CategoryAccess) List(
+	ctx context.Context,
+	params *entity.ListCategoryAccessParams,
+) ([]*entity.CategoryAccess, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.List(db, params)
+}
+
+func (uc *CategoryAccess) Create(
+	ctx context.Context,
+	params *entity.CreateCategoryAccessParams,
+) (*entity.CategoryAccess, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.CategoryAccess
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Create(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return e, nil
+}
+
+func (uc *CategoryAccess) Delete(
+	ctx context.Context,
+	params *entity.DeleteCategoryAccessParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		return uc.repo.Delete(tx, params)
+	})
+	if err != nil {
+		return err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return nil
+}
+
+// AllowedTopGroupNodes returns the top group nodes role may see in project, or nil when
+// the role is unrestricted. An empty role and a supervisor are treated as unrestricted.
+func (uc *CategoryAccess) AllowedTopGroupNodes(
+	db *gorm.DB,
+	project string,
+	role string,
+) ([]string, error) {
+	if role == "" || role == entity.ReviewRoleSupervisor {
+		return nil, nil
+	}
+	rules, err := uc.repo.List(db, &entity.ListCategoryAccessParams{
+		Project: project,
+		Role:    &role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	nodes := make([]string, len(rules))
+	for i, rule := range rules {
+		nodes[i] = rule.TopGroupNode
+	}
+	return nodes, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+func TestAllowedTopGroupNodes(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, err := repository.NewCategoryAccess(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rule := range []entity.CreateCategoryAccessParams{
+		{Project: "rod", Role: entity.ReviewRoleViewer, TopGroupNode: "prop"},
+		{Project: "rod", Role: entity.ReviewRoleViewer, TopGroupNode: "character"},
+		{Project: "rod", Role: entity.ReviewRoleSupervisor, TopGroupNode: "prop"},
+	} {
+		if _, err := repo.Create(db, &rule); err != nil {
+			t.Fatal(err)
+		}
+	}
+	uc := NewCategoryAccess(repo, nil, nil, nil, time.Second, time.Second)
+
+	for _, tc := range []struct {
+		project string
+		role    string
+		want    []string
+	}{
+		{"rod", entity.ReviewRoleViewer, []string{"character", "prop"}},
+		{"rod", entity.ReviewRoleSupervisor, nil}, // never restricted, whatever the rules
+		{"rod", entity.ReviewRoleLead, nil},       // no rule
+		{"rod", "", nil},                          // project without members
+		{"ext", entity.ReviewRoleViewer, nil},
+	} {
+		got, err := uc.AllowedTopGroupNodes(db, tc.project, tc.role)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("AllowedTopGroupNodes(%s, %q) = %v, want %v", tc.project, tc.role, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/pivotDefaults.go
+
+	Module Description:
+		Usecase layer for per-project asset pivot defaults.
+
+	Details:
+	- ReviewInfo.ListAssetsPivot applies these to every parameter the client left empty,
+	  so every new user of a show sees the production-preferred ordering.
+	- Put drops the project's cached pivot pages, since a cached page keyed by empty
+	  parameters was built with the previous defaults.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Store attention score weights; attention sorts default to desc.
+	* - 15-10-2026 - Store the overall_status roll-up rules.
+	* - 15-10-2026 - Latest activity sorts default to desc.
+	* - 15-10-2026 - Bound per_page by the project's page limits (PageLimits).
+	* - 15-10-2026 - Writes need configure_project (supervisor).
+
+	Functions:
+	* - Get: Retrieves the defaults of a project (ErrRecordNotFound when unset).
+	* - Put: Validates and stores the defaults of a project (supervisor only once enforcement is on).
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type PivotDefaults struct {
+	repo         *repository.PivotDefaults
+	prjRepo      *repository.ProjectInfo
+	memberUc     *ProjectMember
+	cache        entity.Cache
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+
+	// PageLimits bounds the stored per_page like the requests; nil uses
+	// entity.DefaultPageLimits.
+	PageLimits *entity.PageLimitsConfig
+}
+
+func NewPivotDefaults(
+	repo *repository.PivotDefaults,
+	pr *repository.ProjectInfo,
+	mu *ProjectMember,
+	c entity.Cache,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *PivotDefaults {
+	return &PivotDefaults{
+		repo:         repo,
+		prjRepo:      pr,
+		memberUc:     mu,
+		cache:        c,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *PivotDefaults) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *PivotDefaults) Get(
+	ctx context.Context,
+	params *entity.GetPivotDefaultsParams,
+) (*entity.PivotDefaults, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	return uc.repo.Get(db, params)
+}
+
+func (uc *PivotDefaults) Put(
+	ctx context.Context,
+	params *entity.PutPivotDefaultsParams,
+) (*entity.PivotDefaults, error) {
+	params.OrderKey = strings.TrimSpace(params.OrderKey)
+	params.Direction = strings.ToLower(strings.TrimSpace(params.Direction))
+	params.View = strings.ToLower(strings.TrimSpace(params.View))
+	if (params.OrderKey == repository.AttentionOrderKey || params.OrderKey == repository.LatestActivityOrderKey) &&
+		params.Direction == "" {
+		params.Direction = "desc"
+	}
+	if rules := params.OverallStatusRules; rules != nil {
+		rules.RequiredPhases = normalizeRuleValues(rules.RequiredPhases)
+		rules.ApprovedStatuses = normalizeRuleValues(rules.ApprovedStatuses)
+		rules.RetakeStatuses = normalizeRuleValues(rules.RetakeStatuses)
+	}
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	// Grouped view is much heavier than the list view; keep its default pages small.
+	limits := uc.PageLimits.For(params.Project)
+	maxPerPage, view := limits.MaxPerPage, "list"
+	if params.View == "grouped" {
+		maxPerPage, view = limits.MaxGroupedPerPage, "grouped"
+	}
+	if params.PerPage > maxPerPage {
+		return nil, fmt.Errorf("per_page for the %s view must be at most %d", view, maxPerPage)
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.PivotDefaults
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Put(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return e, nil
+}
@@ -0,0 +1,238 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/projectMember.go
+
+	Module Description:
+		Usecase layer for per-project review roles and their permission checks.
+
+	Details:
+	- Roles, lowest to highest: viewer, artist, lead, supervisor. Each action needs a
+	  minimum role (see reviewActionMinRole); higher roles inherit lower permissions.
+	- A project without members is unrestricted, so existing shows keep working until
+	  someone adds the first member.
+	- authorize is called by ReviewInfo.Update/Delete/PinTake/SetDueDate/SetAssetPriority/
+	  AddTags/RemoveTag, ReviewImport, the forced ReviewLock.Release and the project
+	  settings writers (CategoryAccess, PivotDefaults, RequiredPhases, ReviewValidation,
+	  ReviewStatus) inside their transaction; the user comes from the request context (entity.KeyUser).
+	- Role resolves the caller's role for category access: none while the project has no
+	  members, the member's role, or viewer for anyone else once enforcement is on.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added pin_take for leads.
+	* - 15-10-2026 - Added set_due_date for leads.
+	* - 15-10-2026 - Added set_priority for leads.
+	* - 15-10-2026 - Added tag for artists.
+	* - 15-10-2026 - Added update_metadata for leads.
+	* - 15-10-2026 - Added Role, which feeds category access with the caller's role.
+	* - 15-10-2026 - Added configure_project for supervisors (category access rules).
+	* - 15-10-2026 - Added force_unlock for leads.
+	* - 15-10-2026 - configure_project also guards the other project review settings.
+
+	Functions:
+	* - List: Lists the members of a project.
+	* - Put: Sets the role of a user (supervisor only once enforcement is on).
+	* - Delete: Removes a user (supervisor only once enforcement is on).
+	* - Role: Returns the role the caller holds in a project.
+	* - authorize: Checks that the caller may perform every given action.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+var reviewRoleRank = map[string]int{
+	entity.ReviewRoleViewer:     1,
+	entity.ReviewRoleArtist:     2,
+	entity.ReviewRoleLead:       3,
+	entity.ReviewRoleSupervisor: 4,
+}
+
+var reviewActionMinRole = map[entity.ReviewAction]string{
+	entity.ReviewActionUpdate:               entity.ReviewRoleArtist,
+	entity.ReviewActionUpdateWorkStatus:     entity.ReviewRoleArtist,
+	entity.ReviewActionUpdateApprovalStatus: entity.ReviewRoleLead,
+	entity.ReviewActionBulk:                 entity.ReviewRoleLead,
+	entity.ReviewActionDelete:               entity.ReviewRoleSupervisor,
+	entity.ReviewActionManageMembers:        entity.ReviewRoleSupervisor,
+	entity.ReviewActionPinTake:              entity.ReviewRoleLead,
+	entity.ReviewActionSetDueDate:           entity.ReviewRoleLead,
+	entity.ReviewActionSetPriority:          entity.ReviewRoleLead,
+	entity.ReviewActionTag:                  entity.ReviewRoleArtist,
+	entity.ReviewActionUpdateMetadata:       entity.ReviewRoleLead,
+	entity.ReviewActionConfigureProject:     entity.ReviewRoleSupervisor,
+	entity.ReviewActionForceUnlock:          entity.ReviewRoleLead,
+}
+
+type ProjectMember struct {
+	repo         *repository.ProjectMember
+	prjRepo      *repository.ProjectInfo
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewProjectMember(
+	repo *repository.ProjectMember,
+	pr *repository.ProjectInfo,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ProjectMember {
+	return &ProjectMember{
+		repo:         repo,
+		prjRepo:      pr,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ProjectMember) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+// authorize returns *entity.PermissionDeniedError unless user may perform every action
+// in project.
+func (uc *ProjectMember) authorize(
+	db *gorm.DB,
+	project string,
+	user string,
+	actions ...entity.ReviewAction,
+) error {
+	if len(actions) == 0 {
+		return nil
+	}
+	n, err := uc.repo.Count(db, project)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return nil
+	}
+	denied := &entity.PermissionDeniedError{Project: project, User: user, Action: actions[0]}
+	if user == "" {
+		return denied
+	}
+	m, err := uc.repo.Get(db, project, user)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			return denied
+		}
+		return err
+	}
+	denied.Role = m.Role
+	for _, a := range actions {
+		if reviewRoleRank[m.Role] < reviewRoleRank[reviewActionMinRole[a]] {
+			denied.Action = a
+			return denied
+		}
+	}
+	return nil
+}
+
+// Role returns the role user holds in project: "" while the project has no members,
+// the member's role, or viewer for a user who is not a member once enforcement is on.
+func (uc *ProjectMember) Role(
+	ctx context.Context,
+	project string,
+	user string,
+) (string, error) {
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	n, err := uc.repo.Count(db, project)
+	if err != nil {
+		return "", err
+	}
+	if n == 0 {
+		return "", nil
+	}
+	if user == "" {
+		return entity.ReviewRoleViewer, nil
+	}
+	m, err := uc.repo.Get(db, project, user)
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			return entity.ReviewRoleViewer, nil
+		}
+		return "", err
+	}
+	return m.Role, nil
+}
+
+func (uc *ProjectMember) List(
+	ctx context.Context,
+	params *entity.ListProjectMemberParams,
+) ([]*entity.ProjectMember, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.List(db, params)
+}
+
+func (uc *ProjectMember) Put(
+	ctx context.Context,
+	params *entity.PutProjectMemberParams,
+) (*entity.ProjectMember, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ProjectMember
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionManageMembers,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Put(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *ProjectMember) Delete(
+	ctx context.Context,
+	params *entity.DeleteProjectMemberParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	return uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionManageMembers,
+		); err != nil {
+			return err
+		}
+		return uc.repo.Delete(tx, params)
+	})
+}
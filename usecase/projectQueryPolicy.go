@@ -0,0 +1,124 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/projectQueryPolicy.go
+
+	Module Description:
+		Usecase layer for per-project query policies of the asset pivot.
+
+	Details:
+	- A policy lets operators tune a heavy show without a code change: max_per_page
+	  caps the pages of both views, timeout_seconds replaces the pivot query timeout
+	  and allowed_views disables the list or grouped view.
+	- ReviewInfo.ListAssetsPivot loads the policy on every uncached request, so a Put
+	  applies to the next request; Put also drops the project's cached pivot pages.
+	- Put lower-cases and dedups the allowed views.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves the query policy of a project (ErrRecordNotFound when unset).
+	* - Put: Validates and stores the query policy of a project.
+	* - policy: Returns the query policy of a project, nil when unset.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type ProjectQueryPolicy struct {
+	repo         *repository.ProjectQueryPolicy
+	prjRepo      *repository.ProjectInfo
+	cache        entity.Cache
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewProjectQueryPolicy(
+	repo *repository.ProjectQueryPolicy,
+	pr *repository.ProjectInfo,
+	c entity.Cache,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ProjectQueryPolicy {
+	return &ProjectQueryPolicy{
+		repo:         repo,
+		prjRepo:      pr,
+		cache:        c,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ProjectQueryPolicy) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ProjectQueryPolicy) Get(
+	ctx context.Context,
+	params *entity.GetProjectQueryPolicyParams,
+) (*entity.ProjectQueryPolicy, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	return uc.repo.Get(db, params)
+}
+
+func (uc *ProjectQueryPolicy) Put(
+	ctx context.Context,
+	params *entity.PutProjectQueryPolicyParams,
+) (*entity.ProjectQueryPolicy, error) {
+	views := make([]string, 0, len(params.AllowedViews))
+	for _, v := range params.AllowedViews {
+		views = append(views, strings.ToLower(strings.TrimSpace(v)))
+	}
+	params.AllowedViews = normalizeRuleValues(views)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ProjectQueryPolicy
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Put(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return e, nil
+}
+
+// policy returns the query policy of project, nil when the project has none.
+func (uc *ProjectQueryPolicy) policy(db *gorm.DB, project string) (*entity.ProjectQueryPolicy, error) {
+	e, err := uc.repo.Get(db, &entity.GetProjectQueryPolicyParams{Project: project})
+	if errors.Is(err, entity.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
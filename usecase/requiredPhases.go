@@ -0,0 +1,156 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/requiredPhases.go
+
+	Module Description:
+		Usecase layer for the per-project required phases of assets.
+
+	Details:
+	- The overall_status roll-up and the completion matrix only count the phases an
+	  asset requires; pivot rows list them in required_phases so the client can show
+	  the other phases as not applicable instead of empty.
+	- Put lower-cases relation keys and phases, trims top group node keys and dedups
+	  the phase lists, then drops the project's cached pivot pages.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Writes need configure_project (supervisor).
+
+	Functions:
+	* - Get: Retrieves the required phases of a project (ErrRecordNotFound when unset).
+	* - Put: Validates and stores the required phases of a project (supervisor only once enforcement is on).
+	* - requiredPhases: Returns the required phases of a project, nil when unset.
+	* - normalizePhaseMap: Normalizes the keys and phases of a mapping.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type RequiredPhases struct {
+	repo         *repository.RequiredPhases
+	prjRepo      *repository.ProjectInfo
+	memberUc     *ProjectMember
+	cache        entity.Cache
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewRequiredPhases(
+	repo *repository.RequiredPhases,
+	pr *repository.ProjectInfo,
+	mu *ProjectMember,
+	c entity.Cache,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *RequiredPhases {
+	return &RequiredPhases{
+		repo:         repo,
+		prjRepo:      pr,
+		memberUc:     mu,
+		cache:        c,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *RequiredPhases) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *RequiredPhases) Get(
+	ctx context.Context,
+	params *entity.GetRequiredPhasesParams,
+) (*entity.RequiredPhases, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	return uc.repo.Get(db, params)
+}
+
+// normalizePhaseMap trims the keys (lower-casing them with lowerKeys) and lower-cases
+// and dedups the phases. Blank keys are dropped, so validation rejects nothing a
+// client could not see.
+func normalizePhaseMap(m map[string][]string, lowerKeys bool) map[string][]string {
+	out := make(map[string][]string, len(m))
+	for k, phases := range m {
+		k = strings.TrimSpace(k)
+		if lowerKeys {
+			k = strings.ToLower(k)
+		}
+		if k == "" {
+			continue
+		}
+		phases = normalizeRuleValues(append(out[k], phases...))
+		for i, p := range phases {
+			phases[i] = strings.ToLower(p)
+		}
+		out[k] = phases
+	}
+	return out
+}
+
+func (uc *RequiredPhases) Put(
+	ctx context.Context,
+	params *entity.PutRequiredPhasesParams,
+) (*entity.RequiredPhases, error) {
+	params.ByRelation = normalizePhaseMap(params.ByRelation, true)
+	params.ByTopGroupNode = normalizePhaseMap(params.ByTopGroupNode, false)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.RequiredPhases
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Put(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return e, nil
+}
+
+// requiredPhases returns the required phases of project, nil when the project has
+// none configured.
+func (uc *RequiredPhases) requiredPhases(db *gorm.DB, project string) (*entity.RequiredPhases, error) {
+	e, err := uc.repo.Get(db, &entity.GetRequiredPhasesParams{Project: project})
+	if errors.Is(err, entity.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if e.Empty() {
+		return nil, nil
+	}
+	return e, nil
+}
@@ -0,0 +1,275 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewActivity.go
+
+	Module Description:
+		Usecase layer for the review activity feed.
+
+	Details:
+	- Record is called by ReviewInfo.Create/Update inside their transaction.
+	- Feed collapses rapid repeated events (e.g. a script toggling work_status ten times)
+	  into one entry with a count. Events are merged when they share review info, field
+	  and actor and each is at most Window apart from the next one in the run.
+	- Changes made inside a review session carry its session ID (from the request context),
+	  so SessionDecisions can produce the dailies decision log without manual notes.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Tag events with the review session and add SessionDecisions.
+
+	Functions:
+	* - Record: Stores a raw activity event.
+	* - Feed: Lists the collapsed activity feed of a project.
+	* - CollapseReviewActivities: Collapses raw events (newest first) into feed entries.
+	* - SessionDecisions: Builds the decision log of one review session.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultReviewActivityWindow = 2 * time.Minute
+	defaultReviewActivityLimit  = 100
+	// Raw events fetched per feed entry requested; collapsed runs consume several.
+	reviewActivityFetchFactor = 20
+	maxReviewActivityFetch    = 10000
+)
+
+type ReviewActivity struct {
+	repo         *repository.ReviewActivity
+	prjRepo      *repository.ProjectInfo
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewActivity(
+	repo *repository.ReviewActivity,
+	pr *repository.ProjectInfo,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewActivity {
+	return &ReviewActivity{
+		repo:         repo,
+		prjRepo:      pr,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewActivity) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+// Record stores one event for e. It must be called with the transaction that changed e;
+// sessionID is "" for changes made outside a review session.
+func (uc *ReviewActivity) Record(
+	tx *gorm.DB,
+	e *entity.ReviewInfo,
+	field string,
+	value string,
+	actor string,
+	sessionID string,
+	at time.Time,
+) error {
+	return uc.repo.Create(tx, &entity.CreateReviewActivityParams{
+		Project:       e.Project,
+		ReviewInfoID:  e.ID,
+		Root:          e.Root,
+		Groups:        e.Groups,
+		Relation:      e.Relation,
+		Phase:         e.Phase,
+		Field:         field,
+		Value:         value,
+		Actor:         actor,
+		SessionID:     sessionID,
+		OccurredAtUtc: at,
+	})
+}
+
+func (uc *ReviewActivity) Feed(
+	ctx context.Context,
+	params *entity.ListReviewActivityParams,
+) ([]*entity.ReviewActivityFeedEntry, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	limit := params.Limit
+	if limit <= 0 {
+		limit = defaultReviewActivityLimit
+	}
+	fetch := limit
+	if params.Window > 0 {
+		fetch = limit * reviewActivityFetchFactor
+		if fetch > maxReviewActivityFetch {
+			fetch = maxReviewActivityFetch
+		}
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	events, err := uc.repo.List(db, &entity.ListReviewActivityParams{
+		Project: params.Project,
+		Since:   params.Since,
+		Limit:   fetch,
+	})
+	if err != nil {
+		return nil, err
+	}
+	entries := CollapseReviewActivities(events, params.Window)
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
+
+// CollapseReviewActivities turns raw events, ordered newest first, into feed entries.
+// It is exported so notification senders can apply the same collapsing to a batch.
+func CollapseReviewActivities(
+	events []*entity.ReviewActivity,
+	window time.Duration,
+) []*entity.ReviewActivityFeedEntry {
+	type runKey struct {
+		reviewInfoID int32
+		field        string
+		actor        string
+	}
+	entries := make([]*entity.ReviewActivityFeedEntry, 0, len(events))
+	open := map[runKey]*entity.ReviewActivityFeedEntry{}
+	for _, ev := range events {
+		key := runKey{ev.ReviewInfoID, ev.Field, ev.Actor}
+		if run, ok := open[key]; ok && window > 0 && run.FirstAtUtc.Sub(ev.OccurredAtUtc) <= window {
+			// Walking backwards in time: ev becomes the new start of the run.
+			run.Count++
+			run.FirstValue = ev.Value
+			run.FirstAtUtc = ev.OccurredAtUtc
+			run.FirstEventID = ev.ID
+			continue
+		}
+		run := &entity.ReviewActivityFeedEntry{
+			ReviewInfoID: ev.ReviewInfoID,
+			Root:         ev.Root,
+			Groups:       ev.Groups,
+			Relation:     ev.Relation,
+			Phase:        ev.Phase,
+			Field:        ev.Field,
+			Actor:        ev.Actor,
+			FirstValue:   ev.Value,
+			Value:        ev.Value,
+			Count:        1,
+			FirstAtUtc:   ev.OccurredAtUtc,
+			LastAtUtc:    ev.OccurredAtUtc,
+			FirstEventID: ev.ID,
+			LastEventID:  ev.ID,
+		}
+		open[key] = run
+		entries = append(entries, run)
+	}
+	for _, run := range entries {
+		run.Summary = reviewActivitySummary(run)
+	}
+	return entries
+}
+
+func reviewActivitySummary(run *entity.ReviewActivityFeedEntry) string {
+	actor := run.Actor
+	if actor == "" {
+		actor = "someone"
+	}
+	target := strings.Join(run.Groups, "/")
+	if run.Relation != "" {
+		target += " " + run.Relation
+	}
+	if run.Phase != "" {
+		target += " [" + run.Phase + "]"
+	}
+	if run.Field == entity.ReviewActivityFieldSubmission {
+		if run.Count > 1 {
+			return fmt.Sprintf("%s submitted %s %d times", actor, target, run.Count)
+		}
+		return fmt.Sprintf("%s submitted %s", actor, target)
+	}
+	if run.Count > 1 {
+		return fmt.Sprintf(
+			"%s changed %s of %s %d times (%s → %s)",
+			actor, run.Field, target, run.Count, run.FirstValue, run.Value,
+		)
+	}
+	return fmt.Sprintf("%s set %s of %s to %s", actor, run.Field, target, run.Value)
+}
+
+func (uc *ReviewActivity) SessionDecisions(
+	ctx context.Context,
+	params *entity.ListReviewSessionDecisionsParams,
+) (*entity.ReviewSessionDecisionLog, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	events, err := uc.repo.ListBySession(db, params)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &entity.ReviewSessionDecisionLog{
+		SessionID: params.SessionID,
+		Decisions: []*entity.ReviewSessionDecision{},
+	}
+	byReview := map[int32]*entity.ReviewSessionDecision{}
+	for _, ev := range events {
+		if ev.Field == entity.ReviewActivityFieldSubmission {
+			continue
+		}
+		at := ev.OccurredAtUtc
+		if out.StartedAtUtc == nil {
+			out.StartedAtUtc = &at
+		}
+		out.EndedAtUtc = &at
+
+		d, ok := byReview[ev.ReviewInfoID]
+		if !ok {
+			d = &entity.ReviewSessionDecision{
+				ReviewInfoID: ev.ReviewInfoID,
+				Root:         ev.Root,
+				Groups:       ev.Groups,
+				Relation:     ev.Relation,
+				Phase:        ev.Phase,
+			}
+			byReview[ev.ReviewInfoID] = d
+			out.Decisions = append(out.Decisions, d)
+		}
+		value := ev.Value
+		switch ev.Field {
+		case entity.ReviewActivityFieldApprovalStatus:
+			d.ApprovalStatus = &value
+		case entity.ReviewActivityFieldWorkStatus:
+			d.WorkStatus = &value
+		}
+		d.DecidedBy = ev.Actor
+		d.DecidedAtUtc = ev.OccurredAtUtc
+		d.History = append(d.History, ev)
+	}
+	return out, nil
+}
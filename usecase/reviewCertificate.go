@@ -0,0 +1,238 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewCertificate.go
+
+	Module Description:
+		Usecase layer for approval certificates (signed records of final approval).
+
+	Details:
+	- A certificate is issued inside the same transaction as the review info update that
+	  sets approval_status to the final approval value.
+	- Hash = SHA-256 over the canonical JSON of {manifest, approver, approved_at_utc}.
+	- Signature = HMAC-SHA256(secret, Hash). The secret never leaves the server.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Issue: Builds, signs and stores a certificate for an approved review info.
+	* - List: Lists certificates issued for a review info.
+	* - Get: Fetches a single certificate.
+	* - Verify: Re-checks hash, signature and the live take manifest of a certificate.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+// FinalApprovalStatus is the approval_status value that triggers certificate issuance.
+const FinalApprovalStatus = "approved"
+
+type ReviewCertificate struct {
+	repo         *repository.ReviewCertificate
+	reviewRepo   *repository.ReviewInfo
+	prjRepo      *repository.ProjectInfo
+	secret       []byte
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewCertificate(
+	repo *repository.ReviewCertificate,
+	reviewRepo *repository.ReviewInfo,
+	pr *repository.ProjectInfo,
+	secret string,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewCertificate {
+	return &ReviewCertificate{
+		repo:         repo,
+		reviewRepo:   reviewRepo,
+		prjRepo:      pr,
+		secret:       []byte(secret),
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewCertificate) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+// isFinalApproval reports whether status is the final approval value (case-insensitive).
+func isFinalApproval(status string) bool {
+	return strings.EqualFold(strings.TrimSpace(status), FinalApprovalStatus)
+}
+
+func newReviewCertificateManifest(e *entity.ReviewInfo) *entity.ReviewCertificateManifest {
+	return &entity.ReviewCertificateManifest{
+		ReviewInfoID:   e.ID,
+		Project:        e.Project,
+		Root:           e.Root,
+		Groups:         e.Groups,
+		Relation:       e.Relation,
+		Phase:          e.Phase,
+		Component:      e.Component,
+		Take:           e.Take,
+		TakePath:       e.TakePath,
+		SubmittedUser:  e.SubmittedUser,
+		SubmittedAtUtc: e.SubmittedAtUtc.UTC(),
+		AllFiles:       e.AllFiles,
+		NumAllFiles:    e.NumAllFiles,
+		SizeAllFiles:   e.SizeAllFiles,
+	}
+}
+
+// certificateHash returns the hex SHA-256 of the canonical certificate payload.
+// The manifest is passed as raw JSON so a stored manifest is hashed byte-for-byte.
+func certificateHash(manifest json.RawMessage, approver string, approvedAt time.Time) (string, error) {
+	payload, err := json.Marshal(struct {
+		Manifest      json.RawMessage `json:"manifest"`
+		Approver      string          `json:"approver"`
+		ApprovedAtUtc string          `json:"approved_at_utc"`
+	}{
+		Manifest:      manifest,
+		Approver:      approver,
+		ApprovedAtUtc: approvedAt.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (uc *ReviewCertificate) sign(hash string) string {
+	mac := hmac.New(sha256.New, uc.secret)
+	mac.Write([]byte(hash))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Issue builds and stores a certificate for e. It must be called with the transaction
+// that performed the approval so the certificate and the status change commit together.
+func (uc *ReviewCertificate) Issue(
+	tx *gorm.DB,
+	e *entity.ReviewInfo,
+	approver string,
+	approvedAt time.Time,
+) (*entity.ReviewCertificate, error) {
+	manifest, err := json.Marshal(newReviewCertificateManifest(e))
+	if err != nil {
+		return nil, err
+	}
+	hash, err := certificateHash(manifest, approver, approvedAt)
+	if err != nil {
+		return nil, err
+	}
+	params := &entity.CreateReviewCertificateParams{
+		Project:       e.Project,
+		ReviewInfoID:  e.ID,
+		Approver:      approver,
+		ApprovedAtUtc: approvedAt.UTC(),
+		Manifest:      manifest,
+		Hash:          hash,
+		Signature:     uc.sign(hash),
+	}
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	return uc.repo.Create(tx, params)
+}
+
+func (uc *ReviewCertificate) List(
+	ctx context.Context,
+	params *entity.ListReviewCertificateParams,
+) ([]*entity.ReviewCertificate, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListByReviewInfo(db, params)
+}
+
+func (uc *ReviewCertificate) Get(
+	ctx context.Context,
+	params *entity.GetReviewCertificateParams,
+) (*entity.ReviewCertificate, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.Get(db, params)
+}
+
+func (uc *ReviewCertificate) Verify(
+	ctx context.Context,
+	params *entity.GetReviewCertificateParams,
+) (*entity.ReviewCertificateVerification, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	cert, err := uc.repo.Get(db, params)
+	if err != nil {
+		return nil, err
+	}
+
+	hash, err := certificateHash(cert.Manifest, cert.Approver, cert.ApprovedAtUtc)
+	if err != nil {
+		return nil, err
+	}
+	res := &entity.ReviewCertificateVerification{
+		Certificate:    cert,
+		HashValid:      hmac.Equal([]byte(hash), []byte(cert.Hash)),
+		SignatureValid: hmac.Equal([]byte(uc.sign(cert.Hash)), []byte(cert.Signature)),
+	}
+
+	// The review info may have been soft-deleted since approval; that is reported as a
+	// manifest mismatch rather than an error.
+	current, err := uc.reviewRepo.Get(db, &entity.GetReviewParams{
+		Project: params.Project,
+		ID:      cert.ReviewInfoID,
+	})
+	switch {
+	case err == nil:
+		manifest, err := json.Marshal(newReviewCertificateManifest(current))
+		if err != nil {
+			return nil, err
+		}
+		res.ManifestMatchesCurrent = string(manifest) == string(cert.Manifest)
+	case !errors.Is(err, entity.ErrRecordNotFound):
+		return nil, err
+	}
+
+	res.Valid = res.HashValid && res.SignatureValid
+	return res, nil
+}
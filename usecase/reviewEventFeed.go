@@ -0,0 +1,85 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewEventFeed.go
+
+	Module Description:
+		In-process fan-out of review events to streaming subscribers (gRPC WatchReviews).
+
+	Details:
+	- ReviewWebhook.notify publishes every event to the feed as well as queueing it for
+	  the webhooks, so a subscriber sees what the webhooks receive, right after commit.
+	- A subscriber gets the events of one project on a buffered channel. A subscriber
+	  that falls reviewFeedBuffer events behind loses the newer ones (logged) rather
+	  than holding up the writes.
+	- Only the events of this process are seen; with several instances a client has to
+	  watch every instance, or use webhooks.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ReviewWebhook) Subscribe: Subscribes to the review events of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"log"
+	"sync"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+const reviewFeedBuffer = 256
+
+type reviewFeedSub struct {
+	project string
+	ch      chan *entity.ReviewEvent
+}
+
+// reviewEventFeed is ready to use as its zero value.
+type reviewEventFeed struct {
+	mu   sync.RWMutex
+	subs map[*reviewFeedSub]struct{}
+}
+
+func (f *reviewEventFeed) subscribe(project string) (<-chan *entity.ReviewEvent, func()) {
+	sub := &reviewFeedSub{project: project, ch: make(chan *entity.ReviewEvent, reviewFeedBuffer)}
+	f.mu.Lock()
+	if f.subs == nil {
+		f.subs = map[*reviewFeedSub]struct{}{}
+	}
+	f.subs[sub] = struct{}{}
+	f.mu.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			f.mu.Lock()
+			delete(f.subs, sub)
+			f.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+func (f *reviewEventFeed) publish(event *entity.ReviewEvent) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for sub := range f.subs {
+		if sub.project != event.Project {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			log.Printf("[FEED] subscriber of %s is behind, dropping %s event %s", sub.project, event.Type, event.ID)
+		}
+	}
+}
+
+// Subscribe returns the review events of project as they are committed, and the
+// function ending the subscription, which closes the channel.
+func (uc *ReviewWebhook) Subscribe(project string) (<-chan *entity.ReviewEvent, func()) {
+	return uc.feed.subscribe(project)
+}
@@ -0,0 +1,376 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewExport.go
+
+	Module Description:
+		Usecase layer for asynchronous CSV exports of the asset pivot.
+
+	Details:
+	- Create only records a queued job; workers started by Run write the file, so exports
+	  of 50k-asset shows are not bound by the request timeouts.
+	- A worker walks the list view with keyset cursors, exportPageSize assets per query,
+	  and streams the CSV straight into the export storage; ExportTimeout bounds the
+	  whole job, pages and upload included.
+	- The job's filters and the creator's role are stored with it, so the file holds what
+	  the creator would see in the pivot at that time (bypassing the page cache).
+	- Run requeues the jobs still queued in the database, so a restart only loses the
+	  jobs that were running.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Skip the pivot total while walking the pages.
+	* - 15-10-2026 - Export ExportHeader/ExportRecord for the reviewctl CLI.
+	* - 15-10-2026 - One ExportTimeout deadline per job instead of one per page.
+	* - 15-10-2026 - Read the project's query policy once per job, not per page.
+
+	Functions:
+	* - Create: Queues an export job.
+	* - Get: Returns an export job.
+	* - Open: Opens the file of a finished export job.
+	* - Run: Runs queued export jobs until ctx is done.
+	* - ExportHeader / ExportRecord: The CSV columns and record of a pivot row.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	exportQueueSize   = 256
+	exportPageSize    = 500
+	exportContentType = "text/csv; charset=utf-8"
+)
+
+// exportPhases are the phase column groups of the export, in column order.
+var exportPhases = []string{"mdl", "rig", "bld", "dsn", "ldv"}
+
+type ReviewExport struct {
+	repo         *repository.ReviewExport
+	reviewUc     *ReviewInfo
+	prjRepo      *repository.ProjectInfo
+	storage      entity.ExportStorage
+	queue        chan *entity.ReviewExport
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+
+	// ExportTimeout is the deadline of one export job.
+	ExportTimeout time.Duration
+}
+
+func NewReviewExport(
+	repo *repository.ReviewExport,
+	ru *ReviewInfo,
+	pr *repository.ProjectInfo,
+	storage entity.ExportStorage,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewExport {
+	return &ReviewExport{
+		repo:          repo,
+		reviewUc:      ru,
+		prjRepo:       pr,
+		storage:       storage,
+		queue:         make(chan *entity.ReviewExport, exportQueueSize),
+		ReadTimeout:   readTimeout,
+		WriteTimeout:  writeTimeout,
+		ExportTimeout: DefaultTimeoutConfig().Export,
+	}
+}
+
+func (uc *ReviewExport) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewExport) Create(
+	ctx context.Context,
+	params *entity.CreateReviewExportParams,
+) (*entity.ReviewExport, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	var e *entity.ReviewExport
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		var err error
+		e, err = uc.repo.Create(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	uc.enqueue(e)
+	return e, nil
+}
+
+func (uc *ReviewExport) Get(
+	ctx context.Context,
+	params *entity.GetReviewExportParams,
+) (*entity.ReviewExport, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.Get(db, params)
+}
+
+// Open returns the job and its file, or entity.ErrReviewExportNotDone while the job is
+// queued, running or failed. The caller closes the file.
+func (uc *ReviewExport) Open(
+	ctx context.Context,
+	params *entity.GetReviewExportParams,
+) (*entity.ReviewExport, io.ReadCloser, error) {
+	e, err := uc.Get(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	if e.Status != entity.ReviewExportStatusDone {
+		return e, nil, entity.ErrReviewExportNotDone
+	}
+	rc, err := uc.storage.Open(ctx, e.ObjectName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return e, rc, nil
+}
+
+// enqueue never blocks; a job that does not fit stays queued in the database and is
+// picked up by the next Run.
+func (uc *ReviewExport) enqueue(e *entity.ReviewExport) {
+	select {
+	case uc.queue <- e:
+	default:
+		log.Printf("[EXPORT] queue full, job %d waits for the next restart", e.ID)
+	}
+}
+
+// Run runs export jobs with the given number of workers until ctx is done.
+func (uc *ReviewExport) Run(ctx context.Context, workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	listCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	queued, err := uc.repo.ListQueued(uc.repo.WithContext(listCtx))
+	cancel()
+	if err != nil {
+		log.Printf("[EXPORT] listing queued jobs failed: %v", err)
+	}
+	for _, e := range queued {
+		uc.enqueue(e)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case e := <-uc.queue:
+					uc.process(ctx, e)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (uc *ReviewExport) process(ctx context.Context, e *entity.ReviewExport) {
+	claimCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	var claimed bool
+	err := uc.repo.TransactionWithContext(claimCtx, func(tx *gorm.DB) error {
+		var err error
+		claimed, err = uc.repo.Claim(tx, e.ID)
+		return err
+	})
+	cancel()
+	if err != nil {
+		log.Printf("[EXPORT] claiming job %d failed: %v", e.ID, err)
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	start := time.Now()
+	objectName := fmt.Sprintf("%s/review-export-%d.csv", e.Project, e.ID)
+	jobCtx, cancel := withTimeout(ctx, uc.ExportTimeout)
+	rows, err := uc.export(jobCtx, e, objectName)
+	cancel()
+	finish := &entity.FinishReviewExportParams{
+		ID:         e.ID,
+		Status:     entity.ReviewExportStatusDone,
+		Rows:       rows,
+		ObjectName: objectName,
+	}
+	if err != nil {
+		log.Printf("[EXPORT] job %d of %s failed after %v: %v", e.ID, e.Project, time.Since(start), err)
+		finish.Status = entity.ReviewExportStatusFailed
+		finish.ObjectName = ""
+		finish.Error = err.Error()
+	} else {
+		log.Printf("[EXPORT] job %d of %s wrote %d rows in %v", e.ID, e.Project, rows, time.Since(start))
+	}
+
+	// Record the outcome even when ctx was cancelled mid-export.
+	finishCtx, cancel := withTimeout(context.Background(), uc.WriteTimeout)
+	defer cancel()
+	if err := uc.repo.TransactionWithContext(finishCtx, func(tx *gorm.DB) error {
+		return uc.repo.Finish(tx, finish)
+	}); err != nil {
+		log.Printf("[EXPORT] recording the outcome of job %d failed: %v", e.ID, err)
+	}
+}
+
+// export streams the CSV of e into the storage under objectName.
+func (uc *ReviewExport) export(ctx context.Context, e *entity.ReviewExport, objectName string) (int, error) {
+	pr, pw := io.Pipe()
+	type written struct {
+		rows int
+		err  error
+	}
+	done := make(chan written, 1)
+	go func() {
+		rows, err := uc.writeCSV(ctx, e, pw)
+		pw.CloseWithError(err)
+		done <- written{rows, err}
+	}()
+
+	putErr := uc.storage.Put(ctx, objectName, exportContentType, pr)
+	// Unblock the writer if the storage stopped reading early.
+	pr.CloseWithError(fmt.Errorf("export storage closed"))
+	w := <-done
+	if w.err != nil {
+		return 0, w.err
+	}
+	if putErr != nil {
+		return 0, putErr
+	}
+	return w.rows, nil
+}
+
+func (uc *ReviewExport) writeCSV(ctx context.Context, e *entity.ReviewExport, w io.Writer) (int, error) {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(ExportHeader()); err != nil {
+		return 0, err
+	}
+
+	f := e.Filters
+	params := ListAssetsPivotParams{
+		Project:              e.Project,
+		Root:                 f.Root,
+		PreferredPhase:       f.PreferredPhase,
+		OrderKey:             f.OrderKey,
+		Direction:            f.Direction,
+		Page:                 1,
+		PerPage:              exportPageSize,
+		AssetNameKey:         f.AssetNameKey,
+		ApprovalStatuses:     f.ApprovalStatuses,
+		WorkStatuses:         f.WorkStatuses,
+		SubmittedUsers:       f.SubmittedUsers,
+		ApprovalUpdatedUsers: f.ApprovalUpdatedUsers,
+		View:                 "list",
+		Role:                 e.Role,
+		AsOf:                 f.AsOf,
+		SkipCount:            true, // pages are walked by cursor until it runs out
+	}
+	// The pages run under the job's deadline only.
+	policy, err := uc.reviewUc.policyUc.policy(uc.reviewUc.repo.WithContext(ctx), e.Project)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load query policy: %w", err)
+	}
+	rows := 0
+	for {
+		res, err := uc.reviewUc.listAssetsPivot(ctx, params, policy)
+		if err != nil {
+			return rows, fmt.Errorf("page after %d rows: %w", rows, err)
+		}
+		for i := range res.Assets {
+			if err := cw.Write(ExportRecord(&res.Assets[i])); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			return rows, err
+		}
+		if res.NextCursor == "" || len(res.Assets) == 0 {
+			return rows, nil
+		}
+		params.Cursor = res.NextCursor
+	}
+}
+
+// ExportHeader returns the CSV header of the export; a row of the export is
+// ExportRecord of an asset pivot row. The reviewctl CLI writes the same columns.
+func ExportHeader() []string {
+	header := []string{
+		"root", "project", "group_1", "relation", "component",
+		"leaf_group_name", "group_category_path", "top_group_node",
+	}
+	for _, phase := range exportPhases {
+		header = append(header,
+			phase+"_work_status", phase+"_approval_status", phase+"_submitted_at_utc", phase+"_take",
+		)
+	}
+	return append(header, "attention_score")
+}
+
+// ExportRecord returns the CSV record of a, in the columns of ExportHeader.
+func ExportRecord(a *repository.AssetPivot) []string {
+	str := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	ts := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.UTC().Format(time.RFC3339)
+	}
+	phases := map[string][4]string{
+		"mdl": {str(a.MDLWorkStatus), str(a.MDLApprovalStatus), ts(a.MDLSubmittedAtUTC), str(a.MDLTake)},
+		"rig": {str(a.RIGWorkStatus), str(a.RIGApprovalStatus), ts(a.RIGSubmittedAtUTC), str(a.RIGTake)},
+		"bld": {str(a.BLDWorkStatus), str(a.BLDApprovalStatus), ts(a.BLDSubmittedAtUTC), str(a.BLDTake)},
+		"dsn": {str(a.DSNWorkStatus), str(a.DSNApprovalStatus), ts(a.DSNSubmittedAtUTC), str(a.DSNTake)},
+		"ldv": {str(a.LDVWorkStatus), str(a.LDVApprovalStatus), ts(a.LDVSubmittedAtUTC), str(a.LDVTake)},
+	}
+	record := []string{
+		a.Root, a.Project, a.Group1, a.Relation, a.Component,
+		a.LeafGroupName, a.GroupCategoryPath, a.TopGroupNode,
+	}
+	for _, phase := range exportPhases {
+		cells := phases[phase]
+		record = append(record, cells[:]...)
+	}
+	return append(record, fmt.Sprintf("%g", a.AttentionScore))
+}
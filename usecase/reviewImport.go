@@ -0,0 +1,342 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewImport.go
+
+	Module Description:
+		Usecase layer for backfilling historical statuses from legacy tracking spreadsheets.
+
+	Details:
+	- Each CSV line becomes one review info row (root = assets, take = "legacy") carrying
+	  the mapped status, submitted_at_utc = the spreadsheet date, and a t_review_import
+	  marker. modified_at_utc is backdated to the same date.
+	- A line is skipped when the asset/phase already has a record on or after that date,
+	  or when its latest status already equals the imported one.
+	- dry_run evaluates everything and returns the same diff without writing.
+	- Lines breaking the project's validation rules fail like any other bad line.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Require the bulk permission for non-dry-run imports.
+	* - 15-10-2026 - Check lines against the project's validation rules.
+
+	Functions:
+	* - Import: Applies (or previews) a legacy CSV import.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	legacyImportRoot       = "assets"
+	legacyImportTake       = "legacy"
+	legacyImportDateLayout = "2006-01-02"
+)
+
+type ReviewImport struct {
+	repo         *repository.ReviewImport
+	reviewRepo   *repository.ReviewInfo
+	prjRepo      *repository.ProjectInfo
+	memberUc     *ProjectMember
+	validateUc   *ReviewValidation
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewImport(
+	repo *repository.ReviewImport,
+	reviewRepo *repository.ReviewInfo,
+	pr *repository.ProjectInfo,
+	mu *ProjectMember,
+	vu *ReviewValidation,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewImport {
+	return &ReviewImport{
+		repo:         repo,
+		reviewRepo:   reviewRepo,
+		prjRepo:      pr,
+		memberUc:     mu,
+		validateUc:   vu,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewImport) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+// importColumns resolves the mapped column names to header indexes.
+type importColumns struct {
+	asset, relation, phase, status, date int
+}
+
+func resolveImportColumns(header []string, m *entity.ReviewImportMapping) (*importColumns, error) {
+	index := make(map[string]int, len(header))
+	for i, h := range header {
+		index[strings.ToLower(strings.TrimSpace(h))] = i
+	}
+	find := func(name string, required bool) (int, error) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			if required {
+				return -1, fmt.Errorf("column mapping is incomplete")
+			}
+			return -1, nil
+		}
+		i, ok := index[name]
+		if !ok {
+			return -1, fmt.Errorf("column %q not found in header", name)
+		}
+		return i, nil
+	}
+
+	cols := &importColumns{}
+	var err error
+	if cols.asset, err = find(m.AssetColumn, true); err != nil {
+		return nil, fmt.Errorf("asset_column: %w", err)
+	}
+	if cols.phase, err = find(m.PhaseColumn, true); err != nil {
+		return nil, fmt.Errorf("phase_column: %w", err)
+	}
+	if cols.status, err = find(m.StatusColumn, true); err != nil {
+		return nil, fmt.Errorf("status_column: %w", err)
+	}
+	if cols.date, err = find(m.DateColumn, true); err != nil {
+		return nil, fmt.Errorf("date_column: %w", err)
+	}
+	if cols.relation, err = find(m.RelationColumn, false); err != nil {
+		return nil, fmt.Errorf("relation_column: %w", err)
+	}
+	if cols.relation < 0 && strings.TrimSpace(m.DefaultRelation) == "" {
+		return nil, fmt.Errorf("either relation_column or default_relation is required")
+	}
+	return cols, nil
+}
+
+func mapImportValue(values map[string]string, raw string) string {
+	raw = strings.TrimSpace(raw)
+	for k, v := range values {
+		if strings.EqualFold(strings.TrimSpace(k), raw) {
+			return strings.TrimSpace(v)
+		}
+	}
+	return strings.ToLower(raw)
+}
+
+func importStatusOf(e *entity.ReviewInfo, field string) string {
+	if field == "work" {
+		return e.WorkStatus
+	}
+	return e.ApprovalStatus
+}
+
+func (uc *ReviewImport) Import(
+	ctx context.Context,
+	params *entity.ReviewImportParams,
+) (*entity.ReviewImportResult, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	m := params.Mapping
+	statusField := strings.ToLower(strings.TrimSpace(m.StatusField))
+	if statusField == "" {
+		statusField = "approval"
+	}
+	if statusField != "approval" && statusField != "work" {
+		return nil, fmt.Errorf("status_field must be approval or work")
+	}
+	layout := m.DateLayout
+	if layout == "" {
+		layout = legacyImportDateLayout
+	}
+	cols, err := resolveImportColumns(params.Header, m)
+	if err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.reviewRepo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	// A dry run writes nothing, so only a real import needs the bulk permission.
+	if !params.DryRun {
+		if err := uc.memberUc.authorize(
+			db, params.Project, entity.UserFromContext(ctx), entity.ReviewActionBulk,
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &entity.ReviewImportResult{
+		BatchID: fmt.Sprintf("%d", time.Now().UnixNano()),
+		DryRun:  params.DryRun,
+		Rows:    make([]*entity.ReviewImportRow, 0, len(params.Records)),
+	}
+
+	// latest[asset/relation][phase] holds the current latest row, updated as lines are
+	// planned so repeated lines for the same asset/phase diff against each other.
+	type latestState struct {
+		status string
+		at     time.Time
+	}
+	latest := map[string]map[string]*latestState{}
+	lookup := func(tx *gorm.DB, asset, relation string) (map[string]*latestState, error) {
+		key := asset + "/" + relation
+		if phases, ok := latest[key]; ok {
+			return phases, nil
+		}
+		infos, err := uc.reviewRepo.ListAssetReviewInfos(tx, &entity.AssetReviewInfoListParams{
+			Project:  params.Project,
+			Asset:    asset,
+			Relation: relation,
+		})
+		if err != nil {
+			return nil, err
+		}
+		phases := make(map[string]*latestState, len(infos))
+		for _, info := range infos {
+			phases[strings.ToLower(info.Phase)] = &latestState{
+				status: importStatusOf(info, statusField),
+				at:     info.SubmittedAtUtc,
+			}
+		}
+		latest[key] = phases
+		return phases, nil
+	}
+
+	cell := func(rec []string, i int) string {
+		if i < 0 || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+
+	apply := func(tx *gorm.DB) error {
+		rules, err := uc.validateUc.rules(tx, params.Project)
+		if err != nil {
+			return err
+		}
+		for i, rec := range params.Records {
+			row := &entity.ReviewImportRow{
+				Line:     i + 2, // header is line 1
+				Asset:    cell(rec, cols.asset),
+				Relation: cell(rec, cols.relation),
+				Phase:    mapImportValue(m.PhaseValues, cell(rec, cols.phase)),
+			}
+			result.Rows = append(result.Rows, row)
+			if row.Relation == "" {
+				row.Relation = strings.TrimSpace(m.DefaultRelation)
+			}
+			row.NewStatus = mapImportValue(m.StatusValues, cell(rec, cols.status))
+
+			fail := func(format string, a ...any) {
+				row.Action = entity.ReviewImportActionError
+				row.Error = fmt.Sprintf(format, a...)
+				result.Failed++
+			}
+			if row.Asset == "" || row.Phase == "" || row.NewStatus == "" {
+				fail("asset, phase and status are required")
+				continue
+			}
+			approvalStatus, workStatus := &row.NewStatus, (*string)(nil)
+			if statusField == "work" {
+				approvalStatus, workStatus = nil, &row.NewStatus
+			}
+			if err := rules.Validate(&row.Phase, approvalStatus, workStatus); err != nil {
+				fail("%v", err)
+				continue
+			}
+			at, err := time.ParseInLocation(layout, cell(rec, cols.date), time.UTC)
+			if err != nil {
+				fail("invalid date %q (layout %q)", cell(rec, cols.date), layout)
+				continue
+			}
+			row.Date = &at
+
+			phases, err := lookup(tx, row.Asset, row.Relation)
+			if err != nil {
+				return err
+			}
+			cur := phases[row.Phase]
+			if cur != nil {
+				status := cur.status
+				row.CurrentStatus = &status
+				if !cur.at.Before(at) || strings.EqualFold(cur.status, row.NewStatus) {
+					row.Action = entity.ReviewImportActionSkip
+					result.Skipped++
+					continue
+				}
+			}
+			row.Action = entity.ReviewImportActionCreate
+			result.Created++
+			phases[row.Phase] = &latestState{status: row.NewStatus, at: at}
+
+			if params.DryRun {
+				continue
+			}
+			createParams := &entity.CreateReviewInfoParams{
+				Project:        params.Project,
+				CreatedBy:      &params.ImportedBy,
+				TakePath:       fmt.Sprintf("legacy-import/%s/%d", result.BatchID, row.Line),
+				Root:           legacyImportRoot,
+				Groups:         []string{row.Asset},
+				Relation:       row.Relation,
+				Phase:          row.Phase,
+				Take:           legacyImportTake,
+				SubmittedAtUtc: at,
+				SubmittedUser:  params.ImportedBy,
+			}
+			if statusField == "work" {
+				createParams.WorkStatus = row.NewStatus
+				createParams.WorkStatusUpdatedUser = params.ImportedBy
+			} else {
+				createParams.ApprovalStatus = row.NewStatus
+				createParams.ApprovalStatusUpdatedUser = params.ImportedBy
+			}
+			e, err := uc.reviewRepo.Create(tx, createParams)
+			if err != nil {
+				return err
+			}
+			if err := uc.repo.Create(tx, &entity.CreateReviewImportParams{
+				Project:        params.Project,
+				BatchID:        result.BatchID,
+				ReviewInfoID:   e.ID,
+				SourceLine:     row.Line,
+				ImportedBy:     params.ImportedBy,
+				BackdatedAtUtc: at,
+			}); err != nil {
+				return err
+			}
+			row.ReviewInfoID = &e.ID
+		}
+		return nil
+	}
+
+	if params.DryRun {
+		if err := apply(db); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+	if err := uc.reviewRepo.TransactionWithContext(timeoutCtx, apply); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -1,166 +1,1313 @@
-package usecase
-
-import (
-	"context"
-	"fmt"
-	"strings"
-
-	"github.com/PolygonPictures/central30-web/front/repository"
-)
-
-type ListAssetsPivotParams struct {
-	Project          string
-	Root             string
-	PreferredPhase   string
-	OrderKey         string
-	Direction        string
-	Page             int
-	PerPage          int
-	AssetNameKey     string
-	ApprovalStatuses []string
-	WorkStatuses     []string
-	View             string // "list" or "grouped"
-}
-
-type ListAssetsPivotResult struct {
-	Assets   []repository.AssetPivot
-	Groups   []repository.GroupedAssetBucket
-	Total    int64
-	Page     int
-	PerPage  int
-	PageLast int
-	HasNext  bool
-	HasPrev  bool
-	Sort     string
-	Dir      string
-}
-
-// Add this method on your existing usecase.ReviewInfo
-func (u *ReviewInfo) ListAssetsPivot(ctx context.Context, p ListAssetsPivotParams) (*ListAssetsPivotResult, error) {
-	if p.Project == "" {
-		return nil, fmt.Errorf("project is required")
-	}
-	if p.Root == "" {
-		p.Root = "assets"
-	}
-	if p.PerPage <= 0 {
-		p.PerPage = 15
-	}
-	if p.Page <= 0 {
-		p.Page = 1
-	}
-	limit := p.PerPage
-	offset := (p.Page - 1) * p.PerPage
-
-	// normalize dir
-	dir := strings.ToUpper(strings.TrimSpace(p.Direction))
-	if dir != "ASC" && dir != "DESC" {
-		dir = "ASC"
-	}
-
-	isGroupedView := p.View == "group" || p.View == "grouped" || p.View == "category"
-
-	// ---------- LIST VIEW ----------
-	if !isGroupedView {
-		assets, total, err := u.reviewInfoRepo.ListAssetsPivot( // <-- rename field if needed
-			ctx,
-			p.Project,
-			p.Root,
-			p.PreferredPhase,
-			p.OrderKey,
-			strings.ToLower(dir),
-			limit,
-			offset,
-			p.AssetNameKey,
-			p.ApprovalStatuses,
-			p.WorkStatuses,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		pageLast := int((total + int64(p.PerPage) - 1) / int64(p.PerPage))
-
-		return &ListAssetsPivotResult{
-			Assets:   assets,
-			Groups:   nil,
-			Total:    total,
-			Page:     p.Page,
-			PerPage:  p.PerPage,
-			PageLast: pageLast,
-			HasNext:  offset+limit < int(total),
-			HasPrev:  p.Page > 1,
-			Sort:     p.OrderKey,
-			Dir:      strings.ToLower(dir),
-		}, nil
-	}
-
-	// ---------- GROUPED VIEW (group-first order, then paginate) ----------
-	const allLimit = 1_000_000
-	assetsAll, total, err := u.reviewInfoRepo.ListAssetsPivot( // <-- rename field if needed
-		ctx,
-		p.Project,
-		p.Root,
-		p.PreferredPhase,
-		"group_1",
-		"asc",
-		allLimit,
-		0,
-		p.AssetNameKey,
-		p.ApprovalStatuses,
-		p.WorkStatuses,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	groupedAll := repository.GroupAndSortByTopNode(assetsAll, repository.SortDirection(dir))
-
-	flat := make([]repository.AssetPivot, 0, len(assetsAll))
-	for _, g := range groupedAll {
-		flat = append(flat, g.Items...)
-	}
-
-	totalAssets := len(flat)
-	if totalAssets == 0 {
-		return &ListAssetsPivotResult{
-			Assets:   []repository.AssetPivot{},
-			Groups:   []repository.GroupedAssetBucket{},
-			Total:    0,
-			Page:     p.Page,
-			PerPage:  p.PerPage,
-			PageLast: 0,
-			HasNext:  false,
-			HasPrev:  false,
-			Sort:     "group_1",
-			Dir:      strings.ToLower(dir),
-		}, nil
-	}
-
-	start := offset
-	if start > totalAssets {
-		start = totalAssets
-	}
-	end := start + limit
-	if end > totalAssets {
-		end = totalAssets
-	}
-
-	pageSlice := flat[start:end]
-	pageGroups := repository.GroupAndSortByTopNode(pageSlice, repository.SortDirection(dir))
-
-	pageLast := (totalAssets + p.PerPage - 1) / p.PerPage
-
-	return &ListAssetsPivotResult{
-		Assets:   pageSlice,
-		Groups:   pageGroups,
-		Total:    total,
-		Page:     p.Page,
-		PerPage:  p.PerPage,
-		PageLast: pageLast,
-		HasNext:  offset+limit < totalAssets,
-		HasPrev:  p.Page > 1,
-		Sort:     "group_1",
-		Dir:      strings.ToLower(dir),
-	}, nil
-}
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+    	usecase/reviewInfo.go
+
+	Module Description:
+		Usecase layer for managing review information.
+
+	Details:
+
+	Update and Modification History:
+	* - 29-10-2025 - SanjayK PSI - Implemented dynamic filtering and sorting for latest submissions.
+	* - 17-11-2025 - SanjayK PSI - Added phase-aware status filtering and sorting.
+	* - 22-11-2025 - SanjayK PSI - Fixed bugs related to phase-specific filtering and sorting.
+	* - 16-01-2026 - SanjayK PSI - Added asset pivot listing with grouped view and sorting.
+	* - 15-10-2026 - Issue an approval certificate on final approval in Update.
+	* - 15-10-2026 - Record submissions and status changes for the activity feed.
+	* - 15-10-2026 - Apply per-role category access to ListAssetsPivot.
+	* - 15-10-2026 - Cache ListAssetsPivot pages; invalidate on Create/Update/Delete.
+	* - 15-10-2026 - Reject Update while another user holds a live review lock.
+	* - 15-10-2026 - Tag recorded status changes with the review session from the context.
+	* - 15-10-2026 - Drop cached pivot totals on Create/Update/Delete.
+	* - 15-10-2026 - Accept and return keyset page cursors in ListAssetsPivot.
+	* - 15-10-2026 - Historical "as of" reads in ListAssetsPivot.
+	* - 15-10-2026 - Apply per-project pivot defaults to empty ListAssetsPivot parameters.
+	* - 15-10-2026 - Enforce project review roles on Update and Delete.
+	* - 15-10-2026 - Write the status history of Update in its transaction.
+	* - 15-10-2026 - Pass project attention weights to ListAssetsPivot; sort=attention defaults to desc.
+	* - 15-10-2026 - Added AssetTimeline.
+	* - 15-10-2026 - Added BatchAssetDetails.
+	* - 15-10-2026 - Paginate the grouped view by complete top group node buckets.
+	* - 15-10-2026 - Optional nested category tree (GroupDepth) in the grouped view.
+	* - 15-10-2026 - Added ListUnassignedAssets.
+	* - 15-10-2026 - Notify review event webhooks after Create/Update/Delete commit.
+	* - 15-10-2026 - Trace ListAssetsPivot and its category access lookup.
+	* - 15-10-2026 - Filter List, ListAssets and ListAssetsPivot by submitted / approval user.
+	* - 15-10-2026 - Sparse phase fieldsets (Fields) on ListAssetsPivot.
+	* - 15-10-2026 - Check Create/Update payloads against the project's validation rules.
+	* - 15-10-2026 - Reject ListAssetsPivot status filters outside the status vocabulary.
+	* - 15-10-2026 - Idempotent Create keyed by the Idempotency-Key of the context.
+	* - 15-10-2026 - Moved the comment document of Create to createReviewComment for CreateBatch.
+	* - 15-10-2026 - Added TrashRetention for the trash purge and Sync.
+	* - 15-10-2026 - Serve List from the read replica.
+	* - 15-10-2026 - SkipCount lists the pivot without computing its total.
+	* - 15-10-2026 - Report estimated totals of very large projects (TotalIsEstimate).
+	* - 15-10-2026 - Added Comments, the comment counter of Compare.
+	* - 15-10-2026 - Optional per-cell comment counts on ListAssetsPivot (CommentCounts).
+	* - 15-10-2026 - Optional per-cell thumbnails on ListAssetsPivot (Thumbnails, ThumbnailURL).
+	* - 15-10-2026 - Filter ListAssetsPivot by studio; optional per-cell studios (Studio).
+	* - 15-10-2026 - Filter ListAssetsPivot by relation (Relations, RelationMode).
+	* - 15-10-2026 - Filter ListAssetsPivot by overall status with the project's roll-up rules.
+	* - 15-10-2026 - Roll up and mark the required phases of every asset (RequiredPhases).
+	* - 15-10-2026 - Notify asset watchers of new submissions and status changes (Watches).
+	* - 15-10-2026 - Filter ListAssetsPivot by overdue assets and sort it by due date (reviewInfoDueDates.go).
+	* - 15-10-2026 - Filter List, ListAssets and ListAssetsPivot by tag (reviewTag.go).
+	* - 15-10-2026 - Optional note count and newest note on ListAssetsPivot (Notes, assetNote.go).
+	* - 15-10-2026 - Reject Update when the row moved past the expected version of the context.
+	* - 15-10-2026 - Added UpdateWithMetadata for take path / components / duration / comment corrections.
+	* - 15-10-2026 - Bucket headers only (GroupsOnly) and top group node filter (Groups) on ListAssetsPivot.
+	* - 15-10-2026 - sort=latest_activity defaults to desc.
+	* - 15-10-2026 - Pass the nulls placement of ListAssetsPivot to the repository.
+	* - 15-10-2026 - Natural name ordering on ListAssetsPivot (Natural).
+	* - 15-10-2026 - Page sizes of ListAssetsPivot come from PageLimits (per project).
+	* - 15-10-2026 - Apply the project's query policy (max per_page, timeout, views) to ListAssetsPivot.
+	* - 15-10-2026 - Remember first page requests of ListAssetsPivot for the pre-warmer.
+	* - 15-10-2026 - ListAssetsPivotResult.Pagination for the Link header and pagination block.
+	* - 15-10-2026 - Depend on the ReviewInfoRepository interface rather than *repository.ReviewInfo.
+	* - 15-10-2026 - PivotTimeout bounds the whole ListAssetsPivot request; timeouts go through withTimeout.
+	* - 15-10-2026 - view=category is an alias of the grouped view again.
+	* - 15-10-2026 - ListAssetsPivot reads its query policy once, under the request deadline (pivotContext).
+	* - 15-10-2026 - Lock ownership and metadata audits use the request's user, not the body's.
+	* - 15-10-2026 - Update reads the row it checks If-Match against FOR UPDATE.
+	* - 15-10-2026 - Pass the pivot queries to the repository as AssetPivotQuery / AssetPivotGroupedQuery.
+
+	Functions:
+	* - List: Retrieves a list of review information based on parameters.
+	* - Get: Fetches a specific review information entry.
+	* - Create: Creates a new review information entry.
+	* - Update: Updates an existing review information entry.
+	* - UpdateWithMetadata: Updates an entry and corrects its submission metadata.
+	* - Delete: Deletes a review information entry.
+	* - ListAssets: Lists assets for a project.
+	* - ListUnassignedAssets: Lists assets whose leaf group has no group category.
+	* - ListAssetReviewInfos: Lists review information for a specific asset.
+	* - AssetTimeline: Lists every submission of an asset by phase with its status changes.
+	* - ListShotReviewInfos: Lists review information for a specific shot.
+	* - ListAssetsPivot: Provides filtered, phase-aware pivoted asset data with grouping.
+	* - BatchAssetDetails: Returns the per-phase pivot rows of several assets at once.
+	* - (ListAssetsPivotResult) Pagination: Returns the pagination of a pivot page.
+
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/tracing"
+	"github.com/gin-gonic/gin/binding"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+)
+
+type ReviewInfo struct {
+	repo         ReviewInfoRepository
+	prjRepo      *repository.ProjectInfo
+	stuRepo      *repository.StudioInfo
+	docRepo      entity.DocumentRepository
+	certUc       *ReviewCertificate
+	actUc        *ReviewActivity
+	accessUc     *CategoryAccess
+	lockUc       *ReviewLock
+	defaultsUc   *PivotDefaults
+	requiredUc   *RequiredPhases
+	policyUc     *ProjectQueryPolicy
+	memberUc     *ProjectMember
+	historyUc    *ReviewStatusHistory
+	webhookUc    *ReviewWebhook
+	validateUc   *ReviewValidation
+	idemRepo     *repository.ReviewIdempotency
+	cache        entity.Cache
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+
+	// PivotTimeout is the deadline of one ListAssetsPivot request unless the project's
+	// query policy sets one.
+	PivotTimeout time.Duration
+
+	// PivotCacheTTL bounds how long a ListAssetsPivot page may be served from cache.
+	// Change it through SetCacheTTLs once the server runs.
+	PivotCacheTTL time.Duration
+	ttlMu         sync.Mutex
+
+	// prewarm holds the first pivot pages kept warm by RunPivotPrewarm.
+	prewarm *pivotPrewarm
+
+	// TrashRetention is how long deleted review infos stay restorable; 0 keeps them.
+	TrashRetention time.Duration
+
+	// PageLimits bounds the asset pivot pages, per project; nil uses
+	// entity.DefaultPageLimits.
+	PageLimits *entity.PageLimitsConfig
+
+	// Comments reads review comments; nil leaves comment counts out.
+	Comments entity.ReviewCommentRepository
+
+	// ThumbnailURL turns a content path into the URL the grid loads; nil serves the path.
+	ThumbnailURL func(project, path string) string
+
+	// Watches notifies the watchers of an asset of its status changes; nil disables
+	// watch notifications.
+	Watches *AssetWatch
+}
+
+func NewReviewInfo(
+	repo ReviewInfoRepository,
+	pr *repository.ProjectInfo,
+	sr *repository.StudioInfo,
+	dr entity.DocumentRepository,
+	cu *ReviewCertificate,
+	au *ReviewActivity,
+	ac *CategoryAccess,
+	lu *ReviewLock,
+	du *PivotDefaults,
+	rpu *RequiredPhases,
+	qpu *ProjectQueryPolicy,
+	mu *ProjectMember,
+	hu *ReviewStatusHistory,
+	wu *ReviewWebhook,
+	vu *ReviewValidation,
+	ir *repository.ReviewIdempotency,
+	c entity.Cache,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewInfo {
+	return &ReviewInfo{
+		repo:          repo,
+		prjRepo:       pr,
+		stuRepo:       sr,
+		docRepo:       dr,
+		certUc:        cu,
+		actUc:         au,
+		accessUc:      ac,
+		lockUc:        lu,
+		defaultsUc:    du,
+		requiredUc:    rpu,
+		policyUc:      qpu,
+		memberUc:      mu,
+		historyUc:     hu,
+		webhookUc:     wu,
+		validateUc:    vu,
+		idemRepo:      ir,
+		cache:         c,
+		ReadTimeout:   readTimeout,
+		WriteTimeout:  writeTimeout,
+		PivotTimeout:  DefaultTimeoutConfig().Pivot,
+		PivotCacheTTL: DefaultPivotCacheTTL,
+		prewarm:       &pivotPrewarm{entries: map[string]*pivotPrewarmEntry{}},
+	}
+}
+
+func (uc *ReviewInfo) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewInfo) checkForStudio(db *gorm.DB, studio string) error {
+	_, err := uc.stuRepo.Get(db, &entity.GetStudioInfoParams{
+		KeyName: studio,
+	})
+	return err
+}
+
+/* =========================
+   EXISTING CRUD METHODS (KEEP THESE)
+========================= */
+
+// List returns the reviews matching params, narrowed to those submitted by one of
+// submittedUsers and approved by one of approvalUpdatedUsers when given.
+func (uc *ReviewInfo) List(
+	ctx context.Context,
+	params *entity.ListReviewInfoParams,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	tags []string,
+) ([]*entity.ReviewInfo, int, error) {
+
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, 0, err
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+
+	// Check context before proceeding
+	select {
+	case <-timeoutCtx.Done():
+		return nil, 0, timeoutCtx.Err()
+	default:
+		// Continue
+	}
+
+	// Heavy read: served by the read replica when one is configured.
+	db := uc.repo.ReadWithContext(timeoutCtx, params.Project)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, 0, err
+	}
+
+	if params.Studio != nil {
+		if err := uc.checkForStudio(db, *params.Studio); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return uc.repo.List(db, params, submittedUsers, approvalUpdatedUsers, tags)
+}
+
+func (uc *ReviewInfo) Get(
+	ctx context.Context,
+	params *entity.GetReviewParams,
+) (*entity.ReviewInfo, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.Get(db, params)
+}
+
+func (uc *ReviewInfo) Create(
+	ctx context.Context,
+	params *entity.CreateReviewInfoParams,
+) (*entity.ReviewInfo, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	if err := uc.checkForStudio(db, params.Studio); err != nil {
+		return nil, err
+	}
+	if err := uc.validateUc.validate(
+		db, params.Project, &params.Phase, &params.ApprovalStatus, &params.WorkStatus,
+	); err != nil {
+		return nil, err
+	}
+	idemKey := entity.IdempotencyKeyFrom(ctx)
+	var fingerprint string
+	if idemKey != "" {
+		var err error
+		if fingerprint, err = idempotencyFingerprint(params); err != nil {
+			return nil, err
+		}
+		if e, err := uc.replayCreate(db, params.Project, idemKey, fingerprint); e != nil || err != nil {
+			return e, err
+		}
+	}
+	var e *entity.ReviewInfo
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		var err error
+		e, err = uc.repo.Create(tx, params)
+		if err != nil {
+			return err
+		}
+		if idemKey != "" {
+			if err := uc.idemRepo.Create(tx, &entity.ReviewIdempotencyKey{
+				Project:      params.Project,
+				Key:          idemKey,
+				Fingerprint:  fingerprint,
+				ReviewInfoID: e.ID,
+			}, time.Now().UTC().Add(-idempotencyKeyTTL)); err != nil {
+				return err
+			}
+		}
+		return uc.actUc.Record(
+			tx, e, entity.ReviewActivityFieldSubmission, e.Take, e.SubmittedUser,
+			entity.ReviewSessionIDFrom(ctx), e.SubmittedAtUtc,
+		)
+	}); err != nil {
+		if idemKey != "" {
+			// A concurrent create with the same key may have won the race.
+			replayed, replayErr := uc.replayCreate(db, params.Project, idemKey, fingerprint)
+			if replayed != nil || errors.Is(replayErr, entity.ErrIdempotencyConflict) {
+				return replayed, replayErr
+			}
+		}
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	uc.webhookUc.notify(entity.ReviewEventCreated, e, "")
+	uc.Watches.notify(nil, e)
+
+	if err := uc.createReviewComment(timeoutCtx, params); err != nil {
+		return nil, err
+	}
+
+	return e, nil
+}
+
+// createReviewComment creates the comment document of a new review.
+// https://docs.google.com/spreadsheets/d/14VSOi7h_zh5TP0JK3nBXjVoAQhrete3XahPZ96h30Wo/edit#gid=734852926
+func (uc *ReviewInfo) createReviewComment(
+	ctx context.Context,
+	params *entity.CreateReviewInfoParams,
+) error {
+	var user string
+	if params.CreatedBy != nil {
+		user = *params.CreatedBy
+	}
+	commentdata := []map[string]interface{}{}
+	defaultrole := "artist"
+	for _, commentinfo := range params.ReviewComments {
+		role := commentinfo.ResponsiblePersonRole
+		if role == nil {
+			role = &defaultrole
+		}
+		comment := map[string]interface{}{
+			"language":                commentinfo.Language,
+			"text":                    commentinfo.Text,
+			"attachments":             commentinfo.Attachments,
+			"need_translation":        commentinfo.NeedTranslation,
+			"is_translated":           commentinfo.IsTranslated,
+			"responsible_person_role": role,
+		}
+		commentdata = append(commentdata, comment)
+	}
+
+	_, err := uc.docRepo.CreateDocument(
+		context.WithValue(ctx, entity.KeyUser, user),
+		params.Project,
+		"comment",
+		map[string]interface{}{
+			"root":                 params.Root,
+			"groups":               params.Groups,
+			"relation":             params.Relation,
+			"phase":                params.Phase,
+			"original_comment_id":  nil,
+			"task_id":              params.TaskID,
+			"subtask_id":           params.SubtaskID,
+			"path":                 params.TakePath,
+			"take":                 params.Take,
+			"comment_data":         commentdata,
+			"studio":               params.Studio,
+			"project":              params.Project,
+			"submitted_at_utc":     params.SubmittedAtUtc.Format(time.RFC3339Nano),
+			"submitted_user":       params.SubmittedUser,
+			"submitted_computer":   params.SubmittedComputer,
+			"submitted_os":         params.SubmittedOS,
+			"submitted_os_version": params.SubmittedOSVersion,
+			"component":            params.Component,
+			"type":                 "review",
+			"tool":                 "ppiCentralWeb",
+		},
+	)
+	return err
+}
+
+func (uc *ReviewInfo) Update(
+	ctx context.Context,
+	params *entity.UpdateReviewInfoParams,
+) (*entity.ReviewInfo, error) {
+	return uc.UpdateWithMetadata(ctx, params, nil)
+}
+
+// UpdateWithMetadata is Update that also corrects the submission metadata of meta (nil
+// for none) in the same transaction; each corrected field is logged in the status
+// history. Correcting metadata needs the update_metadata action.
+func (uc *ReviewInfo) UpdateWithMetadata(
+	ctx context.Context,
+	params *entity.UpdateReviewInfoParams,
+	meta *entity.ReviewInfoMetadataPatch,
+) (*entity.ReviewInfo, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if !meta.Empty() {
+		if err := binding.Validator.ValidateStruct(meta); err != nil {
+			return nil, err
+		}
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	if err := uc.validateUc.validate(
+		db, params.Project, nil, params.ApprovalStatus, params.WorkStatus,
+	); err != nil {
+		return nil, err
+	}
+	sessionID := entity.ReviewSessionIDFrom(ctx)
+	var before, e *entity.ReviewInfo
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		actions := updateActions(params)
+		if !meta.Empty() {
+			actions = append(actions, entity.ReviewActionUpdateMetadata)
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), actions...,
+		); err != nil {
+			return err
+		}
+		// A live-session lock held by someone else blocks the change.
+		if err := uc.lockUc.checkUnlocked(tx, params.Project, params.ID, entity.UserFromContext(ctx)); err != nil {
+			return err
+		}
+		// The row stays locked until the update commits, so two updates expecting the
+		// same version cannot both pass the check below.
+		var err error
+		before, err = uc.repo.GetForUpdate(tx, &entity.GetReviewParams{
+			Project: params.Project,
+			ID:      params.ID,
+		})
+		if err != nil {
+			return err
+		}
+		// An If-Match version the row has moved past means someone else changed it.
+		if want := entity.ExpectedReviewVersionFrom(ctx); want != "" && want != entity.ReviewInfoVersion(before) {
+			return &entity.ReviewVersionConflictError{Current: before}
+		}
+		// A metadata-only correction leaves the statuses alone; an update changing
+		// nothing at all still fails in repo.Update.
+		if hasStatusChange(params) || meta.Empty() {
+			e, err = uc.repo.Update(tx, params)
+			if err != nil {
+				return err
+			}
+		}
+		if !meta.Empty() {
+			actor := entity.UserFromContext(ctx)
+			e, err = uc.repo.UpdateMetadata(tx, params.Project, params.ID, meta, actor)
+			if err != nil {
+				return err
+			}
+			if err := uc.historyUc.recordMetadata(tx, before, e, meta, actor, sessionID); err != nil {
+				return err
+			}
+		}
+		if err := uc.historyUc.record(tx, before, e, sessionID); err != nil {
+			return err
+		}
+		if params.ApprovalStatus != nil {
+			if err := uc.actUc.Record(
+				tx, e, entity.ReviewActivityFieldApprovalStatus,
+				e.ApprovalStatus, e.ApprovalStatusUpdatedUser, sessionID, e.ModifiedAtUTC,
+			); err != nil {
+				return err
+			}
+		}
+		if params.WorkStatus != nil {
+			if err := uc.actUc.Record(
+				tx, e, entity.ReviewActivityFieldWorkStatus,
+				e.WorkStatus, e.WorkStatusUpdatedUser, sessionID, e.ModifiedAtUTC,
+			); err != nil {
+				return err
+			}
+		}
+		// Final approval issues a signed certificate in the same transaction, so an
+		// approval can never be committed without its record.
+		if params.ApprovalStatus != nil && isFinalApproval(*params.ApprovalStatus) {
+			_, err = uc.certUc.Issue(tx, e, e.ApprovalStatusUpdatedUser, e.ModifiedAtUTC)
+		}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	uc.webhookUc.notify(entity.ReviewEventUpdated, e, "")
+	if e.ApprovalStatus != before.ApprovalStatus {
+		uc.webhookUc.notify(entity.ReviewEventApprovalStatusChanged, e, before.ApprovalStatus)
+	}
+	uc.Watches.notify(before, e)
+	return e, nil
+}
+
+// updateActions are the permission-checked actions an update performs.
+func updateActions(params *entity.UpdateReviewInfoParams) []entity.ReviewAction {
+	actions := []entity.ReviewAction{entity.ReviewActionUpdate}
+	if params.ApprovalStatus != nil {
+		actions = append(actions, entity.ReviewActionUpdateApprovalStatus)
+	}
+	if params.WorkStatus != nil {
+		actions = append(actions, entity.ReviewActionUpdateWorkStatus)
+	}
+	return actions
+}
+
+// hasStatusChange reports whether params sets a status or a status user.
+func hasStatusChange(params *entity.UpdateReviewInfoParams) bool {
+	return params.ApprovalStatus != nil || params.ApprovalStatusUpdatedUser != nil ||
+		params.WorkStatus != nil || params.WorkStatusUpdatedUser != nil
+}
+
+func (uc *ReviewInfo) Delete(
+	ctx context.Context,
+	params *entity.DeleteReviewInfoParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var deleted *entity.ReviewInfo
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionDelete,
+		); err != nil {
+			return err
+		}
+		// Kept for the review.deleted event payload.
+		var err error
+		deleted, err = uc.repo.Get(tx, &entity.GetReviewParams{
+			Project: params.Project,
+			ID:      params.ID,
+		})
+		if err != nil {
+			return err
+		}
+		return uc.repo.Delete(tx, params)
+	}); err != nil {
+		return err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	uc.webhookUc.notify(entity.ReviewEventDeleted, deleted, "")
+	return nil
+}
+
+// ListAssets returns the assets with at least one review matching the user filters
+// (see List).
+func (uc *ReviewInfo) ListAssets(
+	ctx context.Context,
+	params *entity.AssetListParams,
+	submittedUsers []string,
+	approvalUpdatedUsers []string,
+	tags []string,
+) ([]*entity.Asset, int, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, 0, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, 0, err
+	}
+	if params.Studio != nil {
+		if err := uc.checkForStudio(db, *params.Studio); err != nil {
+			return nil, 0, err
+		}
+	}
+	return uc.repo.ListAssets(db, params, submittedUsers, approvalUpdatedUsers, tags)
+}
+
+func (uc *ReviewInfo) ListUnassignedAssets(
+	ctx context.Context,
+	params *entity.UnassignedAssetListParams,
+) ([]*entity.UnassignedAsset, int, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, 0, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.ListUnassignedAssets(db, params)
+}
+
+func (uc *ReviewInfo) ListAssetReviewInfos(
+	ctx context.Context,
+	params *entity.AssetReviewInfoListParams,
+) ([]*entity.ReviewInfo, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	if params.Studio != nil {
+		if err := uc.checkForStudio(db, *params.Studio); err != nil {
+			return nil, err
+		}
+	}
+	return uc.repo.ListAssetReviewInfos(db, params)
+}
+
+func (uc *ReviewInfo) AssetTimeline(
+	ctx context.Context,
+	params *entity.AssetTimelineParams,
+) (*entity.AssetTimeline, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	reviews, err := uc.repo.ListAssetSubmissions(db, params)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int32, len(reviews))
+	for i, e := range reviews {
+		ids[i] = e.ID
+	}
+	transitions, err := uc.historyUc.byReviewInfo(db, params.Project, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := &entity.AssetTimeline{
+		Project:  params.Project,
+		Asset:    params.Asset,
+		Relation: params.Relation,
+		Phases:   []*entity.AssetTimelinePhase{},
+	}
+	byPhase := map[string]*entity.AssetTimelinePhase{}
+	for _, e := range reviews {
+		phase, ok := byPhase[e.Phase]
+		if !ok {
+			phase = &entity.AssetTimelinePhase{Phase: e.Phase}
+			byPhase[e.Phase] = phase
+			timeline.Phases = append(timeline.Phases, phase)
+		}
+		sub := &entity.AssetTimelineSubmission{
+			ReviewInfoID:   e.ID,
+			Component:      e.Component,
+			Take:           e.Take,
+			SubmittedUser:  e.SubmittedUser,
+			SubmittedAtUtc: e.SubmittedAtUtc,
+			ApprovalStatus: e.ApprovalStatus,
+			WorkStatus:     e.WorkStatus,
+			Transitions:    transitions[e.ID],
+		}
+		if sub.Transitions == nil {
+			sub.Transitions = []*entity.ReviewStatusHistory{}
+		}
+		phase.Submissions = append(phase.Submissions, sub)
+	}
+	return timeline, nil
+}
+
+func (uc *ReviewInfo) ListShotReviewInfos(
+	ctx context.Context,
+	params *entity.ShotReviewInfoListParams,
+) ([]*entity.ReviewInfo, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	if params.Studio != nil {
+		if err := uc.checkForStudio(db, *params.Studio); err != nil {
+			return nil, err
+		}
+	}
+	return uc.repo.ListShotReviewInfos(db, params)
+}
+
+/* =========================
+   NEW ASSET PIVOT METHODS
+========================= */
+
+type ListAssetsPivotParams struct {
+	Project              string
+	Root                 string
+	PreferredPhase       string
+	OrderKey             string
+	Direction            string
+	Nulls                string // first | last: where missing sort values go; empty = the sort key's default
+	Natural              bool   // order names naturally (asset2 before asset10) under the Japanese collation
+	Page                 int
+	PerPage              int
+	Cursor               string // keyset token from a previous NextCursor; overrides Page
+	AssetNameKey         string
+	ApprovalStatuses     []string
+	WorkStatuses         []string
+	SubmittedUsers       []string   // latest row of a phase submitted by one of them
+	ApprovalUpdatedUsers []string   // approval status of a phase last set by one of them
+	Studios              []string   // latest row of a phase submitted from one of them
+	Relations            []string   // asset relation is one of them
+	RelationMode         string     // exact (default) | prefix: relation starts with one of Relations
+	Tags                 []string   // asset relation carries one of them
+	OverallStatuses      []string   // overall_status of the asset is one of them
+	Overdue              bool       // only assets with an open phase past its due date
+	View                 string     // list | grouped (aliases group, category)
+	Role                 string     // caller's role from the auth context; drives category access
+	AsOf                 *time.Time // optional: reconstruct the pivot as it was at this time
+	GroupPage            int        // grouped view: 1-based page of top group node buckets
+	GroupPerPage         int        // grouped view: buckets per page
+	GroupDepth           int        // grouped view: category levels to nest; < 0 = full path, 0/1 = flat
+	GroupsOnly           bool       // grouped view: every bucket with its count, no items
+	Groups               []string   // top group nodes to keep, e.g. to load one bucket; within category access
+	Fields               []string   // phases to fetch and serialise; empty = all
+	SkipCount            bool       // list view: no Total/PageLast, HasNext from one extra row
+
+	// CommentCounts and Thumbnails fill the comment counts and thumbnails of the rows.
+	// They are read on every request, so the flags stay out of the page cache key.
+	CommentCounts bool `json:"-"`
+	Thumbnails    bool `json:"-"`
+	// Studio keeps the per-cell studios, which the cached page always holds.
+	Studio bool `json:"-"`
+	// Notes fills the note count and newest note of the rows, read on every request.
+	Notes bool `json:"-"`
+}
+
+type ListAssetsPivotResult struct {
+	Assets   []repository.AssetPivot
+	Groups   []repository.GroupedAssetBucket
+	Total    int64
+	Page     int
+	PerPage  int
+	PageLast int
+	HasNext  bool
+	HasPrev  bool
+	Sort     string
+	Dir      string
+	View     string // resolved view: list | grouped
+	// CountSkipped is set when SkipCount left Total and PageLast uncomputed.
+	CountSkipped bool
+	// TotalIsEstimate is set when Total is a recent count rather than an exact one
+	// (very large projects).
+	TotalIsEstimate bool
+	// NextCursor fetches the following page by sort key rather than offset, so rows
+	// changing in between neither repeat nor go missing. Empty on the last page.
+	NextCursor string
+	// Grouped view only: pagination over complete top group node buckets.
+	GroupPage     int
+	GroupPerPage  int
+	GroupTotal    int64
+	GroupPageLast int
+}
+
+// Pagination returns the pagination of the page: over assets in the list view, over
+// top group node buckets (group_page, group_per_page) in the grouped view.
+func (r *ListAssetsPivotResult) Pagination() entity.Pagination {
+	if r.View == "grouped" {
+		return entity.Pagination{
+			Page:         r.GroupPage,
+			PerPage:      r.GroupPerPage,
+			Total:        r.GroupTotal,
+			PageLast:     r.GroupPageLast,
+			HasNext:      r.HasNext,
+			HasPrev:      r.HasPrev,
+			PageParam:    "group_page",
+			PerPageParam: "group_per_page",
+		}
+	}
+	return entity.Pagination{
+		Page:     r.Page,
+		PerPage:  r.PerPage,
+		Total:    r.Total,
+		PageLast: r.PageLast,
+		HasNext:  r.HasNext,
+		HasPrev:  r.HasPrev,
+	}
+}
+
+// PageLimitsFor returns the page limits of the asset pivot of project.
+func (u *ReviewInfo) PageLimitsFor(project string) entity.PageLimits {
+	return u.PageLimits.For(project)
+}
+
+// PivotQueryLimits returns the page limits and query timeout of the asset pivot of
+// project once its query policy applies. ListAssetsPivot applies the timeout itself.
+func (u *ReviewInfo) PivotQueryLimits(
+	ctx context.Context,
+	project string,
+) (entity.PageLimits, time.Duration, error) {
+	timeoutCtx, cancel := withTimeout(ctx, u.ReadTimeout)
+	defer cancel()
+	policy, err := u.policyUc.policy(u.repo.WithContext(timeoutCtx), project)
+	if err != nil {
+		return entity.PageLimits{}, 0, err
+	}
+	return policy.Limits(u.PageLimitsFor(project)), policy.Timeout(u.PivotTimeout), nil
+}
+
+// pivotContext bounds ctx by the pivot timeout of project and reads the project's
+// query policy under that deadline. A policy timeout replaces PivotTimeout, counted
+// from the same start.
+func (u *ReviewInfo) pivotContext(
+	ctx context.Context,
+	project string,
+) (context.Context, context.CancelFunc, *entity.ProjectQueryPolicy, error) {
+	start := time.Now()
+	pivotCtx, cancel := withTimeout(ctx, u.PivotTimeout)
+	policy, err := u.policyUc.policy(u.repo.WithContext(pivotCtx), project)
+	if err != nil {
+		cancel()
+		return nil, nil, nil, fmt.Errorf("failed to load query policy: %w", err)
+	}
+	if timeout := policy.Timeout(u.PivotTimeout); timeout != u.PivotTimeout {
+		cancel()
+		pivotCtx, cancel = context.WithDeadline(ctx, start.Add(timeout))
+	}
+	return pivotCtx, cancel, policy, nil
+}
+
+func (u *ReviewInfo) ListAssetsPivot(
+	ctx context.Context,
+	p ListAssetsPivotParams,
+) (*ListAssetsPivotResult, error) {
+	fields, err := repository.NormalizePivotFields(p.Fields)
+	if err != nil {
+		return nil, err
+	}
+	p.Fields = fields
+	u.notePivotPrewarm(p)
+
+	// One deadline covers the whole request, the query policy, cached pages and their
+	// per-cell extras included.
+	ctx, cancel, policy, err := u.pivotContext(ctx, p.Project)
+	if err != nil {
+		return nil, err
+	}
+	defer cancel()
+
+	ctx, span := tracing.Start(ctx, "ReviewInfo.ListAssetsPivot",
+		attribute.String("project", p.Project),
+		attribute.String("view", p.View),
+	)
+	key, cached := u.loadPivotCache(ctx, p)
+	span.SetAttributes(attribute.Bool("cache_hit", cached != nil))
+	if cached != nil {
+		tracing.End(span, nil)
+		// The selection is not part of the cached JSON; reapply it.
+		repository.LimitPivotFields(cached.Assets, p.Fields)
+		repository.LimitGroupedPivotFields(cached.Groups, p.Fields)
+		u.applyCommentCounts(ctx, p, cached)
+		u.applyThumbnails(ctx, p, cached)
+		applyPivotStudios(p, cached)
+		applyPivotOverdue(p, cached)
+		u.applyPivotNotes(ctx, p, cached)
+		return cached, nil
+	}
+	res, err := u.listAssetsPivot(ctx, p, policy)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, err
+	}
+	u.storePivotCache(ctx, key, res)
+	u.applyCommentCounts(ctx, p, res)
+	u.applyThumbnails(ctx, p, res)
+	applyPivotStudios(p, res)
+	applyPivotOverdue(p, res)
+	u.applyPivotNotes(ctx, p, res)
+	return res, nil
+}
+
+// applyPivotStudios drops the per-cell studios of res unless p asks for them.
+func applyPivotStudios(p ListAssetsPivotParams, res *ListAssetsPivotResult) {
+	if p.Studio {
+		return
+	}
+	for _, row := range pivotRows(res) {
+		row.Studios = nil
+	}
+}
+
+// listAssetsPivot lists one pivot page under ctx, whose deadline the caller sets, with
+// the query policy of the project the caller read.
+func (u *ReviewInfo) listAssetsPivot(
+	ctx context.Context,
+	p ListAssetsPivotParams,
+	policy *entity.ProjectQueryPolicy,
+) (*ListAssetsPivotResult, error) {
+
+	// Validate required parameters
+	if p.Project == "" {
+		return nil, fmt.Errorf("project is required")
+	}
+
+	// Attention and latest activity sorts put the most urgent / most recently touched
+	// asset first unless told otherwise.
+	if (p.OrderKey == repository.AttentionOrderKey || p.OrderKey == repository.LatestActivityOrderKey) && p.Direction == "" {
+		p.Direction = "desc"
+	}
+
+	// Project defaults fill whatever the client left empty and carry the attention
+	// weights and the overall status rules.
+	weights := entity.DefaultAttentionWeights
+	overallRules := entity.DefaultOverallStatusRules
+	d, err := u.defaultsUc.Get(ctx, &entity.GetPivotDefaultsParams{Project: p.Project})
+	switch {
+	case err == nil:
+		if d.AttentionWeights != nil {
+			weights = *d.AttentionWeights
+		}
+		if d.OverallStatusRules != nil {
+			overallRules = *d.OverallStatusRules
+		}
+		if p.OrderKey == "" {
+			p.OrderKey = d.OrderKey
+		}
+		if p.Direction == "" {
+			p.Direction = d.Direction
+		}
+		if p.View == "" {
+			p.View = d.View
+		}
+		if p.PerPage <= 0 {
+			p.PerPage = d.PerPage
+		}
+	case errors.Is(err, entity.ErrRecordNotFound):
+	default:
+		return nil, fmt.Errorf("failed to load pivot defaults: %w", err)
+	}
+	if p.Root == "" {
+		p.Root = "assets"
+	}
+	// The project's query policy narrows the page limits.
+	limits := policy.Limits(u.PageLimitsFor(p.Project))
+	if p.PerPage <= 0 {
+		p.PerPage = limits.PerPage
+	}
+	if p.Page <= 0 {
+		p.Page = 1
+	}
+
+	// Process sort parameters
+	actualSortKey := p.OrderKey
+	if actualSortKey == "" {
+		actualSortKey = "group_1" // Default sort by asset name
+	}
+
+	dir := strings.ToUpper(strings.TrimSpace(p.Direction))
+	if dir != "ASC" && dir != "DESC" {
+		dir = "ASC" // Default ascending
+	}
+
+	// Determine view mode
+	isGrouped := isGroupedPivotView(p.View)
+
+	// For grouped view, always sort by group_1 for consistent grouping
+	if isGrouped {
+		actualSortKey = "group_1"
+	}
+
+	view := entity.PivotViewList
+	if isGrouped {
+		view = entity.PivotViewGrouped
+	}
+	if !policy.AllowsView(view) {
+		return nil, entity.ErrPivotViewNotAllowed
+	}
+
+	// Grouped pages are much heavier than list pages; both have their own ceiling.
+	p.PerPage = limits.ClampPerPage(p.PerPage, isGrouped)
+
+	limit := p.PerPage
+	offset := (p.Page - 1) * p.PerPage
+
+	var after *repository.AssetPivotCursor
+	if p.Cursor != "" {
+		c, err := repository.DecodeAssetPivotCursor(p.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		after = c
+	}
+
+	var overdueOn *time.Time
+	if p.Overdue {
+		day := pivotDueDay(p)
+		overdueOn = &day
+	}
+
+	// CRITICAL: Check context before any operations
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// Continue
+	}
+
+	// Validate project exists
+	db := u.repo.WithContext(ctx)
+	if err := u.checkForProject(db, p.Project); err != nil {
+		return nil, fmt.Errorf("project validation failed: %w", err)
+	}
+	if err := u.validateUc.checkStatusFilters(db, p.Project, p.ApprovalStatuses, p.WorkStatuses); err != nil {
+		return nil, err
+	}
+
+	requiredPhases, err := u.requiredUc.requiredPhases(db, p.Project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load required phases: %w", err)
+	}
+
+	// Resolve category access once so keys and counts use the same allowed list.
+	accessCtx, span := tracing.Start(ctx, "pivot.category_access", attribute.String("role", p.Role))
+	allowedTopGroupNodes, err := u.accessUc.AllowedTopGroupNodes(db.WithContext(accessCtx), p.Project, p.Role)
+	tracing.End(span, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category access: %w", err)
+	}
+
+	// Requested buckets only narrow what the role may see.
+	allowedTopGroupNodes = intersectTopGroupNodes(p.Groups, allowedTopGroupNodes)
+
+	// Check context again before DB call
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	default:
+		// Continue
+	}
+
+	// ---------- LIST VIEW ----------
+	if !isGrouped {
+		assets, total, estimated, next, err := u.repo.ListAssetsPivot(ctx, repository.AssetPivotQuery{
+			Project:              p.Project,
+			Root:                 p.Root,
+			PreferredPhase:       p.PreferredPhase,
+			OrderKey:             actualSortKey,
+			Direction:            strings.ToLower(dir),
+			Nulls:                p.Nulls,
+			Natural:              p.Natural,
+			Limit:                limit,
+			Offset:               offset,
+			After:                after,
+			AssetNameKey:         p.AssetNameKey,
+			ApprovalStatuses:     p.ApprovalStatuses,
+			WorkStatuses:         p.WorkStatuses,
+			SubmittedUsers:       p.SubmittedUsers,
+			ApprovalUpdatedUsers: p.ApprovalUpdatedUsers,
+			Studios:              p.Studios,
+			Relations:            p.Relations,
+			RelationMode:         p.RelationMode,
+			Tags:                 p.Tags,
+			OverallStatuses:      p.OverallStatuses,
+			OverallRules:         overallRules,
+			RequiredPhases:       requiredPhases,
+			OverdueOn:            overdueOn,
+			AllowedTopGroupNodes: allowedTopGroupNodes,
+			AsOf:                 p.AsOf,
+			Fields:               p.Fields,
+			Weights:              weights,
+			SkipCount:            p.SkipCount,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list asset pivot: %w", err)
+		}
+		markRequiredPhases(assets, requiredPhases)
+
+		// Calculate pagination metadata
+		pageLast := 0
+		if !p.SkipCount {
+			pageLast = u.calculatePageLast(total, p.PerPage)
+		}
+
+		return &ListAssetsPivotResult{
+			Assets:          assets,
+			Total:           total,
+			TotalIsEstimate: estimated,
+			Page:            p.Page,
+			PerPage:         p.PerPage,
+			PageLast:        pageLast,
+			HasNext:         u.hasNextPivotPage(p, pageLast, next),
+			HasPrev:         p.Page > 1,
+			Sort:            actualSortKey,
+			Dir:             strings.ToLower(dir),
+			View:            "list",
+			NextCursor:      encodePivotCursor(next),
+			CountSkipped:    p.SkipCount,
+		}, nil
+	}
+
+	// ---------- GROUPED VIEW ----------
+	// Pages hold complete top group node buckets, so no bucket is split across pages.
+	if p.GroupPage <= 0 {
+		p.GroupPage = 1
+	}
+	if p.GroupPerPage <= 0 {
+		p.GroupPerPage = limits.GroupPerPage
+	}
+	if p.GroupPerPage > limits.MaxGroupPerPage {
+		p.GroupPerPage = limits.MaxGroupPerPage
+	}
+	grouped, groupTotal, total, err := u.repo.ListAssetsPivotGrouped(ctx, repository.AssetPivotGroupedQuery{
+		Project:              p.Project,
+		Root:                 p.Root,
+		PreferredPhase:       p.PreferredPhase,
+		Direction:            strings.ToLower(dir),
+		GroupPage:            p.GroupPage,
+		GroupsPerPage:        p.GroupPerPage,
+		AssetNameKey:         p.AssetNameKey,
+		ApprovalStatuses:     p.ApprovalStatuses,
+		WorkStatuses:         p.WorkStatuses,
+		SubmittedUsers:       p.SubmittedUsers,
+		ApprovalUpdatedUsers: p.ApprovalUpdatedUsers,
+		Studios:              p.Studios,
+		Relations:            p.Relations,
+		RelationMode:         p.RelationMode,
+		Tags:                 p.Tags,
+		OverallStatuses:      p.OverallStatuses,
+		OverallRules:         overallRules,
+		RequiredPhases:       requiredPhases,
+		OverdueOn:            overdueOn,
+		AllowedTopGroupNodes: allowedTopGroupNodes,
+		AsOf:                 p.AsOf,
+		Fields:               p.Fields,
+		GroupsOnly:           p.GroupsOnly,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list grouped asset pivot: %w", err)
+	}
+	if p.GroupsOnly {
+		// Every bucket in one page; the nested tree needs the items' paths.
+		return &ListAssetsPivotResult{
+			Groups:        grouped,
+			Total:         total,
+			Page:          1,
+			PerPage:       len(grouped),
+			PageLast:      1,
+			Sort:          "group_1",
+			Dir:           strings.ToLower(dir),
+			View:          "grouped",
+			GroupPage:     1,
+			GroupPerPage:  len(grouped),
+			GroupTotal:    groupTotal,
+			GroupPageLast: 1,
+		}, nil
+	}
+
+	assetsPage := []repository.AssetPivot{}
+	for _, g := range grouped {
+		markRequiredPhases(g.Items, requiredPhases)
+		assetsPage = append(assetsPage, g.Items...)
+	}
+	grouped = repository.NestBucketsByCategoryPath(grouped, p.GroupDepth)
+
+	groupPageLast := u.calculatePageLast(groupTotal, p.GroupPerPage)
+
+	return &ListAssetsPivotResult{
+		Assets:        assetsPage,
+		Groups:        grouped,
+		Total:         total,
+		Page:          p.GroupPage,
+		PerPage:       p.GroupPerPage,
+		PageLast:      groupPageLast,
+		HasNext:       p.GroupPage < groupPageLast,
+		HasPrev:       p.GroupPage > 1,
+		Sort:          "group_1", // Always group_1 for grouped view
+		Dir:           strings.ToLower(dir),
+		View:          "grouped",
+		GroupPage:     p.GroupPage,
+		GroupPerPage:  p.GroupPerPage,
+		GroupTotal:    groupTotal,
+		GroupPageLast: groupPageLast,
+	}, nil
+}
+
+// markRequiredPhases sets the RequiredPhases of the rows whose relation or top group
+// node required maps.
+func markRequiredPhases(rows []repository.AssetPivot, required *entity.RequiredPhases) {
+	for i := range rows {
+		if phases, ok := required.Phases(rows[i].Relation, rows[i].TopGroupNode); ok {
+			rows[i].RequiredPhases = phases
+		}
+	}
+}
+
+func (u *ReviewInfo) BatchAssetDetails(
+	ctx context.Context,
+	params *entity.BatchAssetDetailParams,
+) ([]repository.AssetPivot, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, u.ReadTimeout)
+	defer cancel()
+	db := u.repo.WithContext(timeoutCtx)
+	if err := u.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	allowedTopGroupNodes, err := u.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category access: %w", err)
+	}
+	assets, err := u.repo.LatestPerPhaseForAssets(timeoutCtx, params.Project, params.Root, params.Assets, nil)
+	if err != nil {
+		return nil, err
+	}
+	if allowedTopGroupNodes == nil {
+		return assets, nil
+	}
+	allowed := make(map[string]bool, len(allowedTopGroupNodes))
+	for _, node := range allowedTopGroupNodes {
+		allowed[node] = true
+	}
+	visible := assets[:0]
+	for _, a := range assets {
+		if allowed[a.TopGroupNode] {
+			visible = append(visible, a)
+		}
+	}
+	return visible, nil
+}
+
+// isGroupedPivotView reports whether view selects the grouped view; "group" and
+// "category" are aliases of "grouped".
+func isGroupedPivotView(view string) bool {
+	switch strings.ToLower(strings.TrimSpace(view)) {
+	case "group", "grouped", "category":
+		return true
+	}
+	return false
+}
+
+// With a cursor the page number is meaningless, so only the cursor tells if more follow.
+// Without a count there is no last page either; the cursor is only set when a row follows.
+func (u *ReviewInfo) hasNextPivotPage(p ListAssetsPivotParams, pageLast int, next *repository.AssetPivotCursor) bool {
+	if p.Cursor != "" || p.SkipCount {
+		return next != nil
+	}
+	return p.Page < pageLast
+}
+
+func encodePivotCursor(c *repository.AssetPivotCursor) string {
+	if c == nil {
+		return ""
+	}
+	return c.Encode()
+}
+
+// Helper method to calculate last page number
+func (u *ReviewInfo) calculatePageLast(total int64, perPage int) int {
+	if perPage <= 0 || total == 0 {
+		return 1
+	}
+
+	lastPage := (total + int64(perPage) - 1) / int64(perPage)
+	if lastPage < 1 {
+		return 1
+	}
+
+	return int(lastPage)
+}
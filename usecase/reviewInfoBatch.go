@@ -0,0 +1,110 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoBatch.go
+
+	Module Description:
+		Batch create of review infos for multi-component publishes.
+
+	Details:
+	- All items are checked (binding, studio, validation rules) before anything is
+	  written; the first failing item fails the batch with *entity.BatchItemError.
+	- The rows and their submission activity are written in one transaction, so a batch
+	  is created completely or not at all.
+	- The pivot caches are dropped once per batch and the review.created webhooks are
+	  queued together after the commit.
+	- Comment documents are created after the commit like in Create; a failing comment is
+	  logged and does not fail the batch, whose rows already exist.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Notify the watchers of the created assets.
+
+	Functions:
+	* - CreateBatch: Creates several review infos of a project in one transaction.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+// CreateBatch creates items, all of project, and returns them in request order.
+func (uc *ReviewInfo) CreateBatch(
+	ctx context.Context,
+	project string,
+	items []*entity.CreateReviewInfoParams,
+) ([]*entity.ReviewInfo, error) {
+	if len(items) == 0 || len(items) > entity.MaxReviewBatchSize {
+		return nil, fmt.Errorf("a batch holds 1 to %d items, got %d", entity.MaxReviewBatchSize, len(items))
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, project); err != nil {
+		return nil, err
+	}
+	rules, err := uc.validateUc.rules(db, project)
+	if err != nil {
+		return nil, err
+	}
+	studios := map[string]bool{}
+	for i, params := range items {
+		params.Project = project
+		if err := binding.Validator.ValidateStruct(params); err != nil {
+			return nil, &entity.BatchItemError{Index: i, Err: err}
+		}
+		if !studios[params.Studio] {
+			if err := uc.checkForStudio(db, params.Studio); err != nil {
+				return nil, &entity.BatchItemError{Index: i, Err: err}
+			}
+			studios[params.Studio] = true
+		}
+		if err := rules.Validate(&params.Phase, &params.ApprovalStatus, &params.WorkStatus); err != nil {
+			return nil, &entity.BatchItemError{Index: i, Err: err}
+		}
+	}
+
+	created := make([]*entity.ReviewInfo, len(items))
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		sessionID := entity.ReviewSessionIDFrom(ctx)
+		for i, params := range items {
+			e, err := uc.repo.Create(tx, params)
+			if err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+			if err := uc.actUc.Record(
+				tx, e, entity.ReviewActivityFieldSubmission, e.Take, e.SubmittedUser,
+				sessionID, e.SubmittedAtUtc,
+			); err != nil {
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+			created[i] = e
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, project)
+	uc.repo.InvalidateLatestCounts(project)
+	for _, e := range created {
+		uc.webhookUc.notify(entity.ReviewEventCreated, e, "")
+		uc.Watches.notify(nil, e)
+	}
+
+	commentCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	for i, params := range items {
+		if err := uc.createReviewComment(commentCtx, params); err != nil {
+			log.Printf("[BATCH] comment of item %d (review info %d) of %s failed: %v",
+				i, created[i].ID, project, err)
+		}
+	}
+	return created, nil
+}
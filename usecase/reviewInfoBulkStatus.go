@@ -0,0 +1,220 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoBulkStatus.go
+
+	Module Description:
+		Usecase layer for setting the work status of every asset matching the pivot
+		filters.
+
+	Details:
+	- Needs the bulk and update_work_status actions (lead and above). The target
+	  status and the status filters are checked against the project's vocabulary.
+	- Groups are intersected with the categories the caller's role may see.
+	- The matching submissions are changed in transactions of bulkStatusBatchSize,
+	  each row like Update: lock check, status history and activity. Rows locked by
+	  another user are skipped and counted. A failed batch stops the run; earlier
+	  batches stay committed and are reported in the result.
+	- At most bulkStatusMaxRows submissions per request (ErrBulkStatusTooMany).
+	- DryRun lists every matching submission with its current and new status and
+	  whether a lock would skip it, and writes nothing. Like a dry-run import it
+	  needs no bulk permission.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added DryRun.
+
+	Functions:
+	* - BulkSetWorkStatus: Sets the work status of every submission matching the filters.
+	* - afterBulkStatus: Drops the pivot caches and notifies the committed changes.
+	* - intersectTopGroupNodes: Restricts requested top group nodes to the allowed ones.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	bulkStatusBatchSize = 200
+	bulkStatusMaxRows   = 5000
+)
+
+func (uc *ReviewInfo) BulkSetWorkStatus(
+	ctx context.Context,
+	params *entity.BulkSetWorkStatusParams,
+) (*entity.BulkSetWorkStatusResult, error) {
+	params.WorkStatus = strings.TrimSpace(params.WorkStatus)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	readCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(readCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	// A dry run writes nothing, so only a real run needs the bulk permission.
+	if !params.DryRun {
+		if err := uc.memberUc.authorize(
+			db, params.Project, entity.UserFromContext(ctx),
+			entity.ReviewActionBulk, entity.ReviewActionUpdateWorkStatus,
+		); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.validateUc.checkStatusFilters(
+		db, params.Project, params.ApprovalStatuses, params.WorkStatuses,
+	); err != nil {
+		return nil, err
+	}
+	if err := uc.validateUc.validate(db, params.Project, nil, nil, &params.WorkStatus); err != nil {
+		return nil, err
+	}
+	allowed, err := uc.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := uc.repo.BulkWorkStatusTargets(
+		db, params, intersectTopGroupNodes(params.Groups, allowed), bulkStatusMaxRows,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) > bulkStatusMaxRows {
+		return nil, entity.ErrBulkStatusTooMany
+	}
+
+	res := &entity.BulkSetWorkStatusResult{DryRun: params.DryRun, Matched: len(targets)}
+	if params.DryRun {
+		for _, t := range targets {
+			t.NewStatus = params.WorkStatus
+			if err := uc.lockUc.checkUnlocked(db, params.Project, t.ReviewInfoID, params.User); err != nil {
+				var lockedErr *entity.ReviewLockedError
+				if !errors.As(err, &lockedErr) {
+					return nil, err
+				}
+				t.Locked = true
+				res.Locked++
+			}
+		}
+		res.Changes = targets
+		return res, nil
+	}
+
+	ids := make([]int32, len(targets))
+	for i, t := range targets {
+		ids[i] = t.ReviewInfoID
+	}
+	sessionID := entity.ReviewSessionIDFrom(ctx)
+	var changes []assetChange
+	for start := 0; start < len(ids); start += bulkStatusBatchSize {
+		end := start + bulkStatusBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		var batch []assetChange
+		locked := 0
+		err := func() error {
+			writeCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+			defer cancel()
+			return uc.repo.TransactionWithContext(writeCtx, func(tx *gorm.DB) error {
+				batch, locked = batch[:0], 0
+				for _, id := range ids[start:end] {
+					if err := uc.lockUc.checkUnlocked(tx, params.Project, id, params.User); err != nil {
+						var lockedErr *entity.ReviewLockedError
+						if errors.As(err, &lockedErr) {
+							locked++
+							continue
+						}
+						return err
+					}
+					before, err := uc.repo.Get(tx, &entity.GetReviewParams{
+						Project: params.Project,
+						ID:      id,
+					})
+					if errors.Is(err, entity.ErrRecordNotFound) {
+						// Deleted since the lookup.
+						continue
+					}
+					if err != nil {
+						return err
+					}
+					e, err := uc.repo.Update(tx, &entity.UpdateReviewInfoParams{
+						Project:               params.Project,
+						ID:                    id,
+						WorkStatus:            &params.WorkStatus,
+						WorkStatusUpdatedUser: &params.User,
+						ModifiedBy:            &params.User,
+					})
+					if err != nil {
+						return err
+					}
+					if err := uc.historyUc.record(tx, before, e, sessionID); err != nil {
+						return err
+					}
+					if err := uc.actUc.Record(
+						tx, e, entity.ReviewActivityFieldWorkStatus,
+						e.WorkStatus, e.WorkStatusUpdatedUser, sessionID, e.ModifiedAtUTC,
+					); err != nil {
+						return err
+					}
+					batch = append(batch, assetChange{before: before, after: e})
+				}
+				return nil
+			})
+		}()
+		if err != nil {
+			uc.afterBulkStatus(ctx, params.Project, changes)
+			return res, err
+		}
+		res.Updated += len(batch)
+		res.Locked += locked
+		changes = append(changes, batch...)
+	}
+	uc.afterBulkStatus(ctx, params.Project, changes)
+	return res, nil
+}
+
+// afterBulkStatus drops the pivot caches and notifies webhooks and watchers of the
+// committed changes of a bulk status change.
+func (uc *ReviewInfo) afterBulkStatus(ctx context.Context, project string, changes []assetChange) {
+	if len(changes) == 0 {
+		return
+	}
+	cacheCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	invalidatePivotCache(cacheCtx, uc.cache, project)
+	uc.repo.InvalidateLatestCounts(project)
+	for _, change := range changes {
+		uc.webhookUc.notify(entity.ReviewEventUpdated, change.after, "")
+		uc.Watches.notify(change.before, change.after)
+	}
+}
+
+// intersectTopGroupNodes returns the requested top group nodes the caller may see. No
+// request means allowed as is (nil = unrestricted); an empty result matches nothing.
+func intersectTopGroupNodes(requested, allowed []string) []string {
+	if len(requested) == 0 {
+		return allowed
+	}
+	if allowed == nil {
+		return requested
+	}
+	out := []string{}
+	for _, r := range requested {
+		for _, a := range allowed {
+			if r == a {
+				out = append(out, r)
+				break
+			}
+		}
+	}
+	return out
+}
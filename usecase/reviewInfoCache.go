@@ -0,0 +1,191 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoCache.go
+
+	Module Description:
+		Page cache for ListAssetsPivot on top of a pluggable entity.Cache.
+
+	Details:
+	- Keys embed a per-project generation. Create/Update/Delete of a review info bumps the
+	  generation, which orphans every cached page of that project at once (orphans age out
+	  through their TTL / the LRU bound) without needing key scans.
+	- Cache failures never fail a request; the pivot is recomputed from MySQL instead.
+	- Behind a repository.BroadcastCache the generation delete reaches every instance;
+	  PivotCacheProject lets an instance map it back to the project for its other caches.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added PivotCacheProject for cross-instance invalidation.
+	* - 15-10-2026 - Record pivot page cache hits and misses as metrics.
+	* - 15-10-2026 - Added CacheTTLs / SetCacheTTLs and FlushPivotCache for the admin API.
+
+	Functions:
+	* - pivotCacheKey: Builds the cache key of a pivot request.
+	* - loadPivotCache / storePivotCache: Reads and writes a cached pivot result.
+	* - invalidatePivotCache: Drops every cached pivot page of a project.
+	* - PivotCacheProject: Returns the project invalidated by a deleted cache key.
+	* - CacheTTLs / SetCacheTTLs: Read and change the pivot page and total lifetimes.
+	* - FlushPivotCache: Drops every cached pivot page and total of a project.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/metrics"
+)
+
+const DefaultPivotCacheTTL = 2 * time.Minute
+
+func pivotGenerationKey(project string) string {
+	return "reviewinfo:pivot:gen:" + project
+}
+
+// pivotGeneration returns the current generation of project, starting a new one when
+// none is stored (first use, or evicted) so pages of a forgotten generation never match.
+func (u *ReviewInfo) pivotGeneration(ctx context.Context, project string) (string, error) {
+	b, ok, err := u.cache.Get(ctx, pivotGenerationKey(project))
+	if err != nil {
+		return "", err
+	}
+	if ok {
+		return string(b), nil
+	}
+	gen := strconv.FormatInt(time.Now().UnixNano(), 36)
+	if err := u.cache.Set(ctx, pivotGenerationKey(project), []byte(gen), 0); err != nil {
+		return "", err
+	}
+	return gen, nil
+}
+
+func (u *ReviewInfo) pivotCacheKey(ctx context.Context, p ListAssetsPivotParams) (string, error) {
+	gen, err := u.pivotGeneration(ctx, p.Project)
+	if err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return "reviewinfo:pivot:" + p.Project + ":" + gen + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+func (u *ReviewInfo) loadPivotCache(ctx context.Context, p ListAssetsPivotParams) (string, *ListAssetsPivotResult) {
+	if u.cache == nil {
+		return "", nil
+	}
+	key, err := u.pivotCacheKey(ctx, p)
+	if err != nil {
+		log.Printf("[CACHE] pivot key for project %s: %v", p.Project, err)
+		return "", nil
+	}
+	b, ok, err := u.cache.Get(ctx, key)
+	if err != nil {
+		log.Printf("[CACHE] pivot get %s: %v", key, err)
+		metrics.ObserveCache("pivot_page", false)
+		return key, nil
+	}
+	if !ok {
+		metrics.ObserveCache("pivot_page", false)
+		return key, nil
+	}
+	var res ListAssetsPivotResult
+	if err := json.Unmarshal(b, &res); err != nil {
+		log.Printf("[CACHE] pivot decode %s: %v", key, err)
+		metrics.ObserveCache("pivot_page", false)
+		return key, nil
+	}
+	metrics.ObserveCache("pivot_page", true)
+	return key, &res
+}
+
+func (u *ReviewInfo) storePivotCache(ctx context.Context, key string, res *ListAssetsPivotResult) {
+	if u.cache == nil || key == "" {
+		return
+	}
+	b, err := json.Marshal(res)
+	if err != nil {
+		log.Printf("[CACHE] pivot encode %s: %v", key, err)
+		return
+	}
+	if err := u.cache.Set(ctx, key, b, u.CacheTTLs().PivotPage); err != nil {
+		log.Printf("[CACHE] pivot set %s: %v", key, err)
+	}
+}
+
+// invalidatePivotCache is shared by every usecase whose writes change pivot cells.
+func invalidatePivotCache(ctx context.Context, c entity.Cache, project string) {
+	if c == nil {
+		return
+	}
+	if err := c.Delete(ctx, pivotGenerationKey(project)); err != nil {
+		log.Printf("[CACHE] pivot invalidate project %s: %v", project, err)
+	}
+}
+
+// PivotCacheProject returns the project whose pivot pages are invalidated by deleting
+// key, and false when key is not a pivot generation key.
+func PivotCacheProject(key string) (string, bool) {
+	prefix := pivotGenerationKey("")
+	if !strings.HasPrefix(key, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(key, prefix), true
+}
+
+// CacheTTLs returns the current lifetimes of the pivot pages and the pivot totals.
+func (u *ReviewInfo) CacheTTLs() entity.CacheTTLs {
+	u.ttlMu.Lock()
+	pivotPage := u.PivotCacheTTL
+	u.ttlMu.Unlock()
+	fresh, stale := u.repo.CountCacheTTLs()
+	return entity.CacheTTLs{PivotPage: pivotPage, CountFresh: fresh, CountStale: stale}
+}
+
+// SetCacheTTLs changes the lifetimes of this instance's pivot caches; zero fields keep
+// the current value. Entries already cached keep the TTL they were stored with.
+func (u *ReviewInfo) SetCacheTTLs(t entity.CacheTTLs) (entity.CacheTTLs, error) {
+	cur := u.CacheTTLs()
+	fresh, stale := cur.CountFresh, cur.CountStale
+	if t.CountFresh > 0 {
+		fresh = t.CountFresh
+	}
+	if t.CountStale > 0 {
+		stale = t.CountStale
+	}
+	if stale < fresh {
+		return cur, entity.ErrInvalidCacheTTLs
+	}
+	if t.PivotPage > 0 {
+		u.ttlMu.Lock()
+		u.PivotCacheTTL = t.PivotPage
+		u.ttlMu.Unlock()
+	}
+	u.repo.SetCountCacheTTLs(fresh, stale)
+	log.Printf("[CACHE] pivot TTLs set to %+v", u.CacheTTLs())
+	return u.CacheTTLs(), nil
+}
+
+// FlushPivotCache drops every cached pivot page and total of project. The pages go on
+// every instance through the shared generation key; the other instances invalidate
+// their totals on the broadcast of its delete, as after a write.
+func (u *ReviewInfo) FlushPivotCache(ctx context.Context, project string) error {
+	timeoutCtx, cancel := withTimeout(ctx, u.ReadTimeout)
+	defer cancel()
+	if err := u.checkForProject(u.repo.WithContext(timeoutCtx), project); err != nil {
+		return err
+	}
+	invalidatePivotCache(timeoutCtx, u.cache, project)
+	u.repo.FlushLatestCounts(project)
+	return nil
+}
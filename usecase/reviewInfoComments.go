@@ -0,0 +1,132 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoComments.go
+
+	Module Description:
+		Usecase layer reading the review comments created with review infos.
+
+	Details:
+	- Comments live in the document store (Comments) and belong to a take path, so a
+	  review info's comments are those of its take path.
+	- The pivot's comment counts are added after the page cache, so a badge never lags
+	  behind a new comment by the cache TTL. Counting costs one query for the take paths
+	  and one aggregation, whatever the page size.
+	- A failing comment store leaves the pivot without counts rather than failing it.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - ListComments: Lists the comments of a review info.
+	* - applyCommentCounts: Fills the per-cell comment counts of a pivot result.
+	* - pivotRows: Returns every row of a pivot result, grouped rows included.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"log"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// maxReviewComments bounds the comments listed for one review info.
+const maxReviewComments = 500
+
+func (uc *ReviewInfo) ListComments(
+	ctx context.Context,
+	params *entity.ListReviewCommentsParams,
+) ([]*entity.ReviewComment, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if uc.Comments == nil {
+		return nil, entity.ErrReviewCommentsUnavailable
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	e, err := uc.repo.Get(db, &entity.GetReviewParams{Project: params.Project, ID: params.ID})
+	if err != nil {
+		return nil, err
+	}
+	return uc.Comments.ListByTakePath(timeoutCtx, params.Project, e.TakePath, maxReviewComments)
+}
+
+// applyCommentCounts sets CommentCounts on every row of res when p asks for them.
+func (uc *ReviewInfo) applyCommentCounts(ctx context.Context, p ListAssetsPivotParams, res *ListAssetsPivotResult) {
+	if !p.CommentCounts || uc.Comments == nil {
+		return
+	}
+	rows := pivotRows(res)
+	ids := pivotReviewInfoIDs(rows)
+	if len(ids) == 0 {
+		return
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	paths, err := uc.repo.TakePaths(uc.repo.ReadWithContext(timeoutCtx, p.Project), p.Project, ids)
+	if err != nil {
+		log.Printf("[COMMENTS] take paths of %s failed: %v", p.Project, err)
+		return
+	}
+	unique := make([]string, 0, len(paths))
+	seen := map[string]bool{}
+	for _, path := range paths {
+		if !seen[path] {
+			seen[path] = true
+			unique = append(unique, path)
+		}
+	}
+	counts, err := uc.Comments.CountByTakePath(timeoutCtx, p.Project, unique)
+	if err != nil {
+		log.Printf("[COMMENTS] counting comments of %s failed: %v", p.Project, err)
+		return
+	}
+	for _, row := range rows {
+		if len(row.ReviewInfoIDs) == 0 {
+			continue
+		}
+		row.CommentCounts = make(map[string]int64, len(row.ReviewInfoIDs))
+		for phase, id := range row.ReviewInfoIDs {
+			row.CommentCounts[phase] = counts[paths[id]]
+		}
+	}
+}
+
+// pivotRows returns pointers to every row of res, list and grouped (nested) alike.
+func pivotRows(res *ListAssetsPivotResult) []*repository.AssetPivot {
+	var rows []*repository.AssetPivot
+	for i := range res.Assets {
+		rows = append(rows, &res.Assets[i])
+	}
+	var walk func(buckets []repository.GroupedAssetBucket)
+	walk = func(buckets []repository.GroupedAssetBucket) {
+		for i := range buckets {
+			for j := range buckets[i].Items {
+				rows = append(rows, &buckets[i].Items[j])
+			}
+			walk(buckets[i].Children)
+		}
+	}
+	walk(res.Groups)
+	return rows
+}
+
+// pivotReviewInfoIDs returns the review info IDs of every cell of rows.
+func pivotReviewInfoIDs(rows []*repository.AssetPivot) []int32 {
+	var ids []int32
+	for _, row := range rows {
+		for _, id := range row.ReviewInfoIDs {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
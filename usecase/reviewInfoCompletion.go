@@ -0,0 +1,139 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoCompletion.go
+
+	Module Description:
+		Usecase layer for the phase completion matrix of a project.
+
+	Details:
+	- Approved means the final approval status (FinalApprovalStatus) on any live take
+	  of the phase, not only the latest one.
+	- Percentages only count the phases an asset has a live take of, so an asset
+	  without a rig does not hold the rig column down.
+	- Assets whose relation or top group node the project maps (RequiredPhases) count
+	  exactly their required phases instead: a required phase without a take counts as
+	  not approved, other phases are left out even when they have takes.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Count the required phases of mapped assets.
+
+	Functions:
+	* - Completion: Returns the phase completion matrix of a project.
+	* - completionPercent: Returns a ratio in percent.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+)
+
+func (uc *ReviewInfo) Completion(
+	ctx context.Context,
+	params *entity.ReviewCompletionParams,
+) (*entity.ReviewCompletion, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	allowed, err := uc.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category access: %w", err)
+	}
+	required, err := uc.requiredUc.requiredPhases(db, params.Project)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load required phases: %w", err)
+	}
+	rows, err := uc.repo.PhaseApprovals(timeoutCtx, params, FinalApprovalStatus, allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	bits := make(map[string]uint32, len(entity.PivotPhases))
+	out := &entity.ReviewCompletion{
+		Phases:  entity.PivotPhases,
+		Assets:  []*entity.ReviewAssetCompletion{},
+		ByPhase: make([]*entity.ReviewPhaseCompletion, len(entity.PivotPhases)),
+	}
+	for i, phase := range entity.PivotPhases {
+		bits[phase] = 1 << i
+		out.ByPhase[i] = &entity.ReviewPhaseCompletion{Phase: phase}
+	}
+
+	// Rows come ordered by asset, so an asset's phases are adjacent.
+	// A mapped asset starts with the bits of its required phases.
+	var asset *entity.ReviewAssetCompletion
+	var mapped bool
+	for _, row := range rows {
+		if asset == nil || asset.Group1 != row.Group1 || asset.Group2 != row.Group2 ||
+			asset.Group3 != row.Group3 || asset.Relation != row.Relation {
+			asset = &entity.ReviewAssetCompletion{
+				Group1:   row.Group1,
+				Group2:   row.Group2,
+				Group3:   row.Group3,
+				Relation: row.Relation,
+			}
+			var phases []string
+			phases, mapped = required.Phases(row.Relation, row.TopGroupNode)
+			for _, phase := range phases {
+				asset.Phases |= bits[phase]
+			}
+			out.Assets = append(out.Assets, asset)
+		}
+		bit, ok := bits[row.Phase]
+		if !ok {
+			continue
+		}
+		if mapped {
+			if asset.Phases&bit == 0 {
+				continue
+			}
+		} else {
+			if asset.Phases&bit != 0 {
+				continue
+			}
+			asset.Phases |= bit
+		}
+		if row.Approved {
+			asset.Approved |= bit
+		}
+	}
+
+	for _, a := range out.Assets {
+		for i, p := range out.ByPhase {
+			bit := uint32(1) << i
+			if a.Phases&bit == 0 {
+				continue
+			}
+			p.Assets++
+			out.Cells++
+			if a.Approved&bit != 0 {
+				p.Approved++
+				out.Approved++
+			}
+		}
+	}
+	for _, p := range out.ByPhase {
+		p.Percent = completionPercent(p.Approved, p.Assets)
+	}
+	out.Percent = completionPercent(out.Approved, out.Cells)
+	return out, nil
+}
+
+// completionPercent returns part / whole in percent, 0 for an empty whole.
+func completionPercent(part, whole int64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return float64(part) * 100 / float64(whole)
+}
@@ -0,0 +1,131 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoDueDates.go
+
+	Module Description:
+		Usecase layer for the due dates of asset phases.
+
+	Details:
+	- Setting and clearing a due date needs the set_due_date action (lead and above)
+	  and drops the pivot caches, since pivot rows carry their due dates.
+	- Phases are stored lowercased, matching the phase keys of the pivot rows.
+	- The overdue flag of pivot rows depends on the day, so it is set on every read
+	  (applyPivotOverdue) rather than kept in the cached page.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - ListDueDates: Lists the due dates of an asset relation.
+	* - SetDueDate: Sets the due date of an asset phase.
+	* - ClearDueDate: Removes the due date of an asset phase.
+	* - pivotDueDay: Returns the day pivot rows are overdue on.
+	* - applyPivotOverdue: Sets the overdue flag of the rows of a pivot result.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+func (uc *ReviewInfo) ListDueDates(
+	ctx context.Context,
+	params *entity.ListReviewDueDatesParams,
+) ([]*entity.ReviewDueDate, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListDueDates(db, params)
+}
+
+func (uc *ReviewInfo) SetDueDate(
+	ctx context.Context,
+	params *entity.SetReviewDueDateParams,
+) (*entity.ReviewDueDate, error) {
+	params.Phase = strings.ToLower(strings.TrimSpace(params.Phase))
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ReviewDueDate
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionSetDueDate,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.SetDueDate(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	return e, nil
+}
+
+func (uc *ReviewInfo) ClearDueDate(
+	ctx context.Context,
+	params *entity.ClearReviewDueDateParams,
+) error {
+	params.Phase = strings.ToLower(strings.TrimSpace(params.Phase))
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionSetDueDate,
+		); err != nil {
+			return err
+		}
+		return uc.repo.ClearDueDate(tx, params)
+	}); err != nil {
+		return err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	return nil
+}
+
+// pivotDueDay returns the UTC day the rows of p are overdue on: the day of AsOf for a
+// historical view, today otherwise.
+func pivotDueDay(p ListAssetsPivotParams) time.Time {
+	t := time.Now().UTC()
+	if p.AsOf != nil {
+		t = p.AsOf.UTC()
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// applyPivotOverdue sets Overdue on the rows of res whose next due date is before the
+// due day of p.
+func applyPivotOverdue(p ListAssetsPivotParams, res *ListAssetsPivotResult) {
+	day := pivotDueDay(p).Format(entity.ReviewDueDateLayout)
+	for _, row := range pivotRows(res) {
+		// YYYY-MM-DD strings sort like the days they name.
+		row.Overdue = row.NextDueDate != nil && *row.NextDueDate < day
+	}
+}
@@ -0,0 +1,105 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoIdempotency.go
+
+	Module Description:
+		Idempotent review info creates keyed by the client's Idempotency-Key.
+
+	Details:
+	- Create records the key with the new row in the same transaction. A retry with the
+	  same key within idempotencyKeyTTL returns the recorded row without inserting,
+	  commenting or notifying again.
+	- Every key is bound to a fingerprint of its payload; reusing a key for a different
+	  payload fails with entity.ErrIdempotencyConflict, as does replaying a key whose
+	  row has been deleted since.
+	- Two concurrent creates with one key race on the unique key; the loser rolls back
+	  and replays the winner's row.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - idempotencyFingerprint: Hashes a create payload.
+	* - replayCreate: Returns the row an idempotency key already created, if any.
+	* - RunIdempotencyKeyPurge: Removes expired idempotency keys every interval.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"gorm.io/gorm"
+)
+
+// idempotencyKeyTTL is how long a retry with the same key returns the original row.
+const idempotencyKeyTTL = 24 * time.Hour
+
+func idempotencyFingerprint(params *entity.CreateReviewInfoParams) (string, error) {
+	b, err := json.Marshal(params)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// replayCreate returns the review info key created in project, or nil when the key is
+// unused or expired.
+func (uc *ReviewInfo) replayCreate(
+	db *gorm.DB,
+	project, key, fingerprint string,
+) (*entity.ReviewInfo, error) {
+	record, err := uc.idemRepo.Get(db, project, key, time.Now().UTC().Add(-idempotencyKeyTTL))
+	if errors.Is(err, entity.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if record.Fingerprint != fingerprint {
+		return nil, fmt.Errorf("%w: key %q was used for a different review", entity.ErrIdempotencyConflict, key)
+	}
+	e, err := uc.repo.Get(db, &entity.GetReviewParams{Project: project, ID: record.ReviewInfoID})
+	if errors.Is(err, entity.ErrRecordNotFound) {
+		return nil, fmt.Errorf(
+			"%w: review info %d created with key %q has been deleted",
+			entity.ErrIdempotencyConflict, record.ReviewInfoID, key,
+		)
+	}
+	return e, err
+}
+
+// RunIdempotencyKeyPurge removes expired idempotency keys every interval until ctx is done.
+func (uc *ReviewInfo) RunIdempotencyKeyPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			purgeCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+			var purged int64
+			err := uc.idemRepo.TransactionWithContext(purgeCtx, func(tx *gorm.DB) error {
+				var err error
+				purged, err = uc.idemRepo.PurgeBefore(tx, time.Now().UTC().Add(-idempotencyKeyTTL))
+				return err
+			})
+			cancel()
+			if err != nil {
+				log.Printf("[IDEMPOTENCY] purging expired keys failed: %v", err)
+			} else if purged > 0 {
+				log.Printf("[IDEMPOTENCY] purged %d expired keys", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoOverview.go
+
+	Module Description:
+		Usecase layer for the per-project review overview of the landing dashboard.
+
+	Details:
+	- Projects without category access rules for the caller's role share one query;
+	  every project restricting the role gets its own, with its allowed top group nodes.
+	- Every listed project is returned, with zero counts when nothing is visible to the
+	  caller, ordered by project.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Overview: Returns the review overview of projects.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+)
+
+func (uc *ReviewInfo) Overview(
+	ctx context.Context,
+	params *entity.ReviewOverviewParams,
+) ([]*entity.ReviewProjectOverview, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+
+	projects, err := uc.repo.ListReviewProjects(db, params.Projects)
+	if err != nil {
+		return nil, err
+	}
+	var unrestricted []string
+	restricted := map[string][]string{}
+	for _, project := range projects {
+		allowed, err := uc.accessUc.AllowedTopGroupNodes(db, project, params.Role)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve category access of %s: %w", project, err)
+		}
+		if allowed == nil {
+			unrestricted = append(unrestricted, project)
+			continue
+		}
+		restricted[project] = allowed
+	}
+
+	byProject := make(map[string]*entity.ReviewProjectOverview, len(projects))
+	overviews, err := uc.repo.ProjectOverviews(timeoutCtx, unrestricted, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range overviews {
+		byProject[o.Project] = o
+	}
+	for project, allowed := range restricted {
+		overviews, err := uc.repo.ProjectOverviews(timeoutCtx, []string{project}, allowed)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range overviews {
+			byProject[o.Project] = o
+		}
+	}
+
+	out := make([]*entity.ReviewProjectOverview, len(projects))
+	for i, project := range projects {
+		o, ok := byProject[project]
+		if !ok {
+			o = &entity.ReviewProjectOverview{Project: project}
+		}
+		out[i] = o
+	}
+	return out, nil
+}
@@ -0,0 +1,163 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoPrewarm.go
+
+	Module Description:
+		Background pre-warming of the first asset pivot page of active projects.
+
+	Details:
+	- Once a cached page expires, the next visitor of a project waits for the full pivot
+	  query. For the page almost every visit starts on, page 1 with the default sort and
+	  no filters, RunPivotPrewarm recomputes the page every interval and stores it in the
+	  shared page cache, so it never expires while the project is in use.
+	- A project is active while its first page has been requested within
+	  pivotPrewarmActiveFor. The exact params of those requests are remembered (the role
+	  decides the category access, so each role of a project is its own page) and
+	  replayed; at most maxPivotPrewarmPages pages are kept warm, the least recently
+	  requested are dropped first.
+	- Writes still invalidate the page at once; the next round warms the new generation.
+	- Every instance warms the pages requested from it, so with a shared cache a page
+	  may be refreshed by several instances.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Each page runs under its pivot deadline with the policy read once.
+
+	Functions:
+	* - isPivotFirstPage: Reports whether params ask for the default first page.
+	* - notePivotPrewarm: Remembers a first page request of a project.
+	* - RunPivotPrewarm: Re-computes the remembered first pages every interval.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"sync"
+	"time"
+)
+
+const (
+	// pivotPrewarmActiveFor is how long after its last request a page is kept warm.
+	pivotPrewarmActiveFor = 30 * time.Minute
+	maxPivotPrewarmPages  = 500
+)
+
+type pivotPrewarmEntry struct {
+	params   ListAssetsPivotParams
+	lastSeen time.Time
+}
+
+type pivotPrewarm struct {
+	mu      sync.Mutex
+	entries map[string]*pivotPrewarmEntry
+}
+
+// isPivotFirstPage reports whether p asks for page 1 of a project with the default
+// sort and no filters, selections or cursors.
+func isPivotFirstPage(p ListAssetsPivotParams) bool {
+	return p.Page <= 1 && p.GroupPage <= 1 && p.Cursor == "" &&
+		p.OrderKey == "" && p.Direction == "" && p.Nulls == "" && !p.Natural &&
+		p.PerPage == 0 && p.GroupPerPage == 0 && !p.GroupsOnly && len(p.Groups) == 0 &&
+		p.AssetNameKey == "" && len(p.ApprovalStatuses) == 0 && len(p.WorkStatuses) == 0 &&
+		len(p.SubmittedUsers) == 0 && len(p.ApprovalUpdatedUsers) == 0 &&
+		len(p.Studios) == 0 && len(p.Relations) == 0 && len(p.Tags) == 0 &&
+		len(p.OverallStatuses) == 0 && !p.Overdue && p.AsOf == nil &&
+		len(p.Fields) == 0 && !p.SkipCount
+}
+
+// notePivotPrewarm remembers p when it asks for the first page, so RunPivotPrewarm
+// keeps that page warm.
+func (u *ReviewInfo) notePivotPrewarm(p ListAssetsPivotParams) {
+	if u.prewarm == nil || !isPivotFirstPage(p) {
+		return
+	}
+	b, err := json.Marshal(p)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	u.prewarm.mu.Lock()
+	defer u.prewarm.mu.Unlock()
+	if e, ok := u.prewarm.entries[string(b)]; ok {
+		e.lastSeen = now
+		return
+	}
+	u.prewarm.entries[string(b)] = &pivotPrewarmEntry{params: p, lastSeen: now}
+	if len(u.prewarm.entries) <= maxPivotPrewarmPages {
+		return
+	}
+	var oldestKey string
+	var oldestAt time.Time
+	for k, e := range u.prewarm.entries {
+		if oldestKey == "" || e.lastSeen.Before(oldestAt) {
+			oldestKey, oldestAt = k, e.lastSeen
+		}
+	}
+	delete(u.prewarm.entries, oldestKey)
+}
+
+// activePivotPrewarm returns the params of the pages to warm, dropping the pages not
+// requested within pivotPrewarmActiveFor.
+func (u *ReviewInfo) activePivotPrewarm(now time.Time) []ListAssetsPivotParams {
+	u.prewarm.mu.Lock()
+	defer u.prewarm.mu.Unlock()
+	active := make([]ListAssetsPivotParams, 0, len(u.prewarm.entries))
+	for k, e := range u.prewarm.entries {
+		if now.Sub(e.lastSeen) > pivotPrewarmActiveFor {
+			delete(u.prewarm.entries, k)
+			continue
+		}
+		active = append(active, e.params)
+	}
+	return active
+}
+
+// RunPivotPrewarm re-computes the first pivot page of the active projects every
+// interval until ctx is done. Without a page cache it returns at once.
+func (u *ReviewInfo) RunPivotPrewarm(ctx context.Context, interval time.Duration) {
+	if u.cache == nil || u.prewarm == nil {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		start := time.Now()
+		warmed := 0
+		for _, p := range u.activePivotPrewarm(start) {
+			if ctx.Err() != nil {
+				return
+			}
+			// The key is taken before the query, as in ListAssetsPivot, so a write
+			// during the query leaves the page in the orphaned generation.
+			key, err := u.pivotCacheKey(ctx, p)
+			if err != nil {
+				log.Printf("[PREWARM] pivot key %s: %v", p.Project, err)
+				continue
+			}
+			pageCtx, cancel, policy, err := u.pivotContext(ctx, p.Project)
+			if err != nil {
+				log.Printf("[PREWARM] pivot page %s: %v", p.Project, err)
+				continue
+			}
+			res, err := u.listAssetsPivot(pageCtx, p, policy)
+			cancel()
+			if err != nil {
+				log.Printf("[PREWARM] pivot page %s: %v", p.Project, err)
+				continue
+			}
+			u.storePivotCache(ctx, key, res)
+			warmed++
+		}
+		if warmed > 0 {
+			log.Printf("[PREWARM] warmed %d pivot pages in %v", warmed, time.Since(start))
+		}
+	}
+}
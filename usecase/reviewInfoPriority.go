@@ -0,0 +1,57 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoPriority.go
+
+	Module Description:
+		Usecase layer for the priority flags of assets.
+
+	Details:
+	- Flagging needs the set_priority action (lead and above) and drops the pivot
+	  caches, since pivot rows carry the flag and sort=priority_first orders by it.
+	- Totals don't depend on the flag, so the cached counts are kept.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - SetAssetPriority: Flags or unflags an asset.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+func (uc *ReviewInfo) SetAssetPriority(
+	ctx context.Context,
+	params *entity.SetAssetPriorityParams,
+) (*entity.AssetPriority, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.AssetPriority
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionSetPriority,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.SetAssetPriority(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return e, nil
+}
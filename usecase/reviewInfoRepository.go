@@ -0,0 +1,184 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoRepository.go
+
+	Module Description:
+		The repository interface of the ReviewInfo usecase.
+
+	Details:
+	- ReviewInfo used to hold a *repository.ReviewInfo, so nothing above it could run
+	  without a database. It now depends on ReviewInfoRepository, the methods it calls;
+	  *repository.ReviewInfo implements it and main wires it as before.
+	- The interface lists only what the usecase uses. A new repository call from the
+	  usecase needs its method added here (the build fails until it is), and the mock
+	  regenerated: go generate ./usecase/...
+	- mocks.ReviewInfoRepository (usecase/mocks, go.uber.org/mock) stands in for the
+	  database in tests. WithContext, ReadWithContext and TransactionWithContext still
+	  hand a *gorm.DB to the other methods; a mock may return nil and ignore it.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added GetForUpdate.
+	* - 15-10-2026 - ListAssetsPivot and ListAssetsPivotGrouped take a query struct.
+
+	Functions:
+	* - ReviewInfoRepository: The repository methods the ReviewInfo usecase uses.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+//go:generate mockgen -source=reviewInfoRepository.go -destination=mocks/reviewInfoRepository.go -package=mocks
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"gorm.io/gorm"
+)
+
+// ReviewInfoRepository is the storage of review infos and the asset pivot.
+type ReviewInfoRepository interface {
+	// Connections: the primary, the read replica of project, a transaction.
+	WithContext(ctx context.Context) *gorm.DB
+	ReadWithContext(ctx context.Context, project string) *gorm.DB
+	TransactionWithContext(ctx context.Context, fc func(tx *gorm.DB) error, opts ...*sql.TxOptions) error
+
+	// Review infos
+	Get(db *gorm.DB, params *entity.GetReviewParams) (*entity.ReviewInfo, error)
+	GetForUpdate(tx *gorm.DB, params *entity.GetReviewParams) (*entity.ReviewInfo, error)
+	List(
+		db *gorm.DB,
+		params *entity.ListReviewInfoParams,
+		submittedUsers []string,
+		approvalUpdatedUsers []string,
+		tags []string,
+	) ([]*entity.ReviewInfo, int, error)
+	Create(tx *gorm.DB, params *entity.CreateReviewInfoParams) (*entity.ReviewInfo, error)
+	Update(tx *gorm.DB, params *entity.UpdateReviewInfoParams) (*entity.ReviewInfo, error)
+	UpdateMetadata(
+		tx *gorm.DB,
+		project string,
+		id int32,
+		patch *entity.ReviewInfoMetadataPatch,
+		modifiedBy string,
+	) (*entity.ReviewInfo, error)
+	Delete(tx *gorm.DB, params *entity.DeleteReviewInfoParams) error
+	ListDeleted(db *gorm.DB, params *entity.ReviewTrashListParams) ([]*entity.ReviewInfo, int, error)
+	Restore(tx *gorm.DB, params *entity.RestoreReviewInfoParams) (*entity.ReviewInfo, error)
+	PurgeDeleted(tx *gorm.DB, cutoff time.Time, limit int) (int64, error)
+	ListChanges(
+		db *gorm.DB,
+		project string,
+		after *repository.ReviewSyncToken,
+		horizon time.Time,
+		limit int,
+	) ([]*entity.ReviewChange, *repository.ReviewSyncToken, error)
+	BulkWorkStatusTargets(
+		db *gorm.DB,
+		params *entity.BulkSetWorkStatusParams,
+		topGroupNodes []string,
+		limit int,
+	) ([]*entity.BulkWorkStatusChange, error)
+	TakePaths(db *gorm.DB, project string, ids []int32) (map[int32]string, error)
+	ContentPaths(db *gorm.DB, project string, ids []int32) (map[int32][]string, error)
+
+	// Assets and shots
+	ListAssets(
+		db *gorm.DB,
+		params *entity.AssetListParams,
+		submittedUsers []string,
+		approvalUpdatedUsers []string,
+		tags []string,
+	) ([]*entity.Asset, int, error)
+	ListUnassignedAssets(db *gorm.DB, params *entity.UnassignedAssetListParams) ([]*entity.UnassignedAsset, int, error)
+	ListAssetReviewInfos(db *gorm.DB, params *entity.AssetReviewInfoListParams) ([]*entity.ReviewInfo, error)
+	ListShotReviewInfos(db *gorm.DB, params *entity.ShotReviewInfoListParams) ([]*entity.ReviewInfo, error)
+	ListAssetSubmissions(db *gorm.DB, params *entity.AssetTimelineParams) ([]*entity.ReviewInfo, error)
+
+	// Asset pivot
+	ListAssetsPivot(
+		ctx context.Context,
+		q repository.AssetPivotQuery,
+	) ([]repository.AssetPivot, int64, bool, *repository.AssetPivotCursor, error)
+	ListAssetsPivotGrouped(
+		ctx context.Context,
+		q repository.AssetPivotGroupedQuery,
+	) ([]repository.GroupedAssetBucket, int64, int64, error)
+	LatestPerPhaseForAssets(
+		ctx context.Context,
+		project string,
+		root string,
+		keys []entity.PivotAssetKey,
+		asOf *time.Time,
+	) ([]repository.AssetPivot, error)
+	FillPivotNotes(db *gorm.DB, project string, rows []*repository.AssetPivot) error
+
+	// Cached pivot counts
+	InvalidateLatestCounts(project string)
+	FlushLatestCounts(project string)
+	CountCacheTTLs() (fresh time.Duration, stale time.Duration)
+	SetCountCacheTTLs(fresh time.Duration, stale time.Duration)
+
+	// Takes, due dates, priorities, tags and notes
+	ListTakes(db *gorm.DB, params *entity.ListReviewTakesParams) ([]*entity.ReviewInfo, error)
+	GetTakePin(db *gorm.DB, project string, asset string, relation string, phase string) (*entity.ReviewTakePin, error)
+	PinTake(tx *gorm.DB, params *entity.PinReviewTakeParams) (*entity.ReviewTakePin, error)
+	UnpinTake(tx *gorm.DB, params *entity.UnpinReviewTakeParams) error
+	ListDueDates(db *gorm.DB, params *entity.ListReviewDueDatesParams) ([]*entity.ReviewDueDate, error)
+	SetDueDate(tx *gorm.DB, params *entity.SetReviewDueDateParams) (*entity.ReviewDueDate, error)
+	ClearDueDate(tx *gorm.DB, params *entity.ClearReviewDueDateParams) error
+	SetAssetPriority(tx *gorm.DB, params *entity.SetAssetPriorityParams) (*entity.AssetPriority, error)
+	ListTags(db *gorm.DB, params *entity.ListReviewTagsParams) ([]*entity.ReviewTag, error)
+	ListProjectTags(db *gorm.DB, params *entity.ListProjectReviewTagsParams) ([]*entity.ReviewTagCount, error)
+	AddTags(tx *gorm.DB, params *entity.AddReviewTagsParams) ([]*entity.ReviewTag, error)
+	RemoveTag(tx *gorm.DB, params *entity.RemoveReviewTagParams) error
+	ListNotes(db *gorm.DB, params *entity.ListAssetNotesParams) ([]*entity.AssetNote, error)
+	CreateNote(tx *gorm.DB, params *entity.CreateAssetNoteParams) (*entity.AssetNote, error)
+	UpdateNote(tx *gorm.DB, params *entity.UpdateAssetNoteParams) (*entity.AssetNote, error)
+	DeleteNote(tx *gorm.DB, params *entity.DeleteAssetNoteParams) error
+
+	// Statistics and overviews
+	ListReviewProjects(db *gorm.DB, projects []string) ([]string, error)
+	ProjectOverviews(
+		ctx context.Context,
+		projects []string,
+		allowedTopGroupNodes []string,
+	) ([]*entity.ReviewProjectOverview, error)
+	SubmissionCounts(
+		ctx context.Context,
+		params *entity.ReviewSubmissionStatsParams,
+		allowedTopGroupNodes []string,
+	) ([]*entity.ReviewSubmissionCount, error)
+	ApprovalLatencies(
+		ctx context.Context,
+		params *entity.ReviewApprovalLatencyParams,
+		allowedTopGroupNodes []string,
+	) ([]*entity.ReviewApprovalLatency, error)
+	AssetRetakes(
+		ctx context.Context,
+		params *entity.ReviewRetakeStatsParams,
+		allowedTopGroupNodes []string,
+	) ([]*entity.ReviewAssetRetakes, error)
+	ArtistRetakes(
+		ctx context.Context,
+		params *entity.ReviewRetakeStatsParams,
+		allowedTopGroupNodes []string,
+	) ([]*entity.ReviewArtistRetakes, error)
+	PhaseApprovals(
+		ctx context.Context,
+		params *entity.ReviewCompletionParams,
+		approvedStatus string,
+		allowedTopGroupNodes []string,
+	) ([]*entity.ReviewPhaseApproval, error)
+	Workload(
+		ctx context.Context,
+		params *entity.ReviewWorkloadParams,
+		approvedStatus string,
+		allowedTopGroupNodes []string,
+	) ([]*entity.ReviewWorkload, error)
+}
+
+var _ ReviewInfoRepository = (*repository.ReviewInfo)(nil)
@@ -0,0 +1,204 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoStats.go
+
+	Module Description:
+		Usecase layer for the submission statistics of a project.
+
+	Details:
+	- From is moved back to the start of its interval, so the first bucket is complete;
+	  To is exclusive.
+	- A range may hold at most maxStatsBuckets intervals (about 3 years of days).
+	- Intervals without submissions are filled with empty buckets, so charts need not
+	  know the bucketing rules.
+	- Approval latency defaults to the final approval status (FinalApprovalStatus).
+	- Retake statistics default to the "retake" status and the defaultRetakeAssetLimit
+	  most retaken asset phases; artists are ranked by retake rate, then by takes.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added ApprovalLatency.
+	* - 15-10-2026 - Added RetakeStats.
+
+	Functions:
+	* - SubmissionStats: Counts the submissions of a project per interval and phase.
+	* - ApprovalLatency: Computes the approval latencies of a project per phase and relation.
+	* - RetakeStats: Aggregates retakes per asset phase and per submitting user.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+)
+
+const (
+	maxStatsBuckets         = 1100
+	defaultRetakeStatus     = "retake"
+	defaultRetakeAssetLimit = 100
+)
+
+func (uc *ReviewInfo) SubmissionStats(
+	ctx context.Context,
+	params *entity.ReviewSubmissionStatsParams,
+) (*entity.ReviewSubmissionStats, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	params.From = statsBucketStart(params.From.UTC(), params.Interval)
+	params.To = params.To.UTC()
+	if !params.From.Before(params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", entity.ErrInvalidStatsRange)
+	}
+	var starts []time.Time
+	for t := params.From; t.Before(params.To); t = statsNextBucket(t, params.Interval) {
+		if len(starts) == maxStatsBuckets {
+			return nil, fmt.Errorf("%w: more than %d %s intervals", entity.ErrInvalidStatsRange, maxStatsBuckets, params.Interval)
+		}
+		starts = append(starts, t)
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	allowed, err := uc.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category access: %w", err)
+	}
+	counts, err := uc.repo.SubmissionCounts(timeoutCtx, params, allowed)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]*entity.ReviewSubmissionBucket, len(starts))
+	byStart := make(map[string]*entity.ReviewSubmissionBucket, len(starts))
+	for i, start := range starts {
+		buckets[i] = &entity.ReviewSubmissionBucket{Start: start, Phases: map[string]int64{}}
+		byStart[start.Format(time.DateOnly)] = buckets[i]
+	}
+	for _, c := range counts {
+		b, ok := byStart[c.Bucket]
+		if !ok {
+			continue
+		}
+		b.Phases[c.Phase] += c.Count
+		b.Total += c.Count
+	}
+	return &entity.ReviewSubmissionStats{
+		Project:  params.Project,
+		Interval: params.Interval,
+		From:     params.From,
+		To:       params.To,
+		Buckets:  buckets,
+	}, nil
+}
+
+func (uc *ReviewInfo) ApprovalLatency(
+	ctx context.Context,
+	params *entity.ReviewApprovalLatencyParams,
+) ([]*entity.ReviewApprovalLatency, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if params.Status == "" {
+		params.Status = FinalApprovalStatus
+	}
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", entity.ErrInvalidStatsRange)
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	allowed, err := uc.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category access: %w", err)
+	}
+	return uc.repo.ApprovalLatencies(timeoutCtx, params, allowed)
+}
+
+func (uc *ReviewInfo) RetakeStats(
+	ctx context.Context,
+	params *entity.ReviewRetakeStatsParams,
+) (*entity.ReviewRetakeStats, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if params.Status == "" {
+		params.Status = defaultRetakeStatus
+	}
+	if params.Limit == 0 {
+		params.Limit = defaultRetakeAssetLimit
+	}
+	if params.From != nil && params.To != nil && !params.From.Before(*params.To) {
+		return nil, fmt.Errorf("%w: from must be before to", entity.ErrInvalidStatsRange)
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	allowed, err := uc.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category access: %w", err)
+	}
+	assets, err := uc.repo.AssetRetakes(timeoutCtx, params, allowed)
+	if err != nil {
+		return nil, err
+	}
+	artists, err := uc.repo.ArtistRetakes(timeoutCtx, params, allowed)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range artists {
+		if a.Takes > 0 {
+			a.RetakeRate = float64(a.RetakenTakes) / float64(a.Takes)
+		}
+	}
+	sort.SliceStable(artists, func(i, j int) bool {
+		if artists[i].RetakeRate != artists[j].RetakeRate {
+			return artists[i].RetakeRate > artists[j].RetakeRate
+		}
+		return artists[i].Takes > artists[j].Takes
+	})
+	return &entity.ReviewRetakeStats{
+		Project: params.Project,
+		Status:  params.Status,
+		Assets:  assets,
+		Artists: artists,
+	}, nil
+}
+
+// statsBucketStart returns the start of the interval holding t (UTC).
+func statsBucketStart(t time.Time, interval string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch interval {
+	case entity.ReviewStatsIntervalWeek:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case entity.ReviewStatsIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+func statsNextBucket(start time.Time, interval string) time.Time {
+	switch interval {
+	case entity.ReviewStatsIntervalWeek:
+		return start.AddDate(0, 0, 7)
+	case entity.ReviewStatsIntervalMonth:
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
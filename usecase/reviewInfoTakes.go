@@ -0,0 +1,138 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoTakes.go
+
+	Module Description:
+		Usecase layer for the takes of an asset phase and take pinning.
+
+	Details:
+	- The pivot shows the latest take of every phase; pinning a take makes the pivot
+	  show it instead, even when newer takes are submitted afterwards.
+	- Pinning and unpinning need the pin_take action (lead and above) and drop the
+	  pivot caches, since they change what the pivot shows.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - ListTakes: Lists the takes of an asset phase, newest first, marking the pinned one.
+	* - PinTake: Pins a take of an asset phase.
+	* - UnpinTake: Removes the pin of an asset phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+func (uc *ReviewInfo) ListTakes(
+	ctx context.Context,
+	params *entity.ListReviewTakesParams,
+) (*entity.ReviewTakeList, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	reviews, err := uc.repo.ListTakes(db, params)
+	if err != nil {
+		return nil, err
+	}
+	pin, err := uc.repo.GetTakePin(db, params.Project, params.Asset, params.Relation, params.Phase)
+	if err != nil && !errors.Is(err, entity.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	list := &entity.ReviewTakeList{
+		Project:  params.Project,
+		Asset:    params.Asset,
+		Relation: params.Relation,
+		Phase:    params.Phase,
+		Takes:    make([]*entity.ReviewTake, len(reviews)),
+	}
+	for i, e := range reviews {
+		take := &entity.ReviewTake{
+			ReviewInfoID:   e.ID,
+			Take:           e.Take,
+			Component:      e.Component,
+			SubmittedUser:  e.SubmittedUser,
+			SubmittedAtUtc: e.SubmittedAtUtc,
+			ApprovalStatus: e.ApprovalStatus,
+			WorkStatus:     e.WorkStatus,
+		}
+		// A pin on a deleted row does not apply, so it is only reported with its row.
+		if pin != nil && pin.ReviewInfoID == e.ID {
+			take.Pinned = true
+			list.PinnedReviewInfoID = &pin.ReviewInfoID
+		}
+		list.Takes[i] = take
+	}
+	return list, nil
+}
+
+func (uc *ReviewInfo) PinTake(
+	ctx context.Context,
+	params *entity.PinReviewTakeParams,
+) (*entity.ReviewTakePin, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var pin *entity.ReviewTakePin
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionPinTake,
+		); err != nil {
+			return err
+		}
+		var err error
+		pin, err = uc.repo.PinTake(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	return pin, nil
+}
+
+func (uc *ReviewInfo) UnpinTake(
+	ctx context.Context,
+	params *entity.UnpinReviewTakeParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionPinTake,
+		); err != nil {
+			return err
+		}
+		return uc.repo.UnpinTake(tx, params)
+	}); err != nil {
+		return err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	return nil
+}
@@ -0,0 +1,103 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoThumbnails.go
+
+	Module Description:
+		Per-cell thumbnails and previews of the asset pivot.
+
+	Details:
+	- The thumbnail of a cell is the first image among its review's contents, the
+	  preview the first video; review data comes before output contents. A cell whose
+	  review has neither gets no entry.
+	- URLs come from ThumbnailURL, which main builds from PPI_THUMBNAIL_URL_TEMPLATE;
+	  without it the content path is returned as is.
+	- Like comment counts, thumbnails are added after the page cache and a failure leaves
+	  them out rather than failing the pivot.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - applyThumbnails: Fills the per-cell thumbnails of a pivot result.
+	* - ThumbnailURLTemplate: Returns a ThumbnailURL filling a URL template.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/repository"
+)
+
+var (
+	thumbnailImageExts = map[string]bool{".png": true, ".jpg": true, ".jpeg": true, ".webp": true, ".gif": true}
+	thumbnailVideoExts = map[string]bool{".mp4": true, ".mov": true, ".webm": true}
+)
+
+// applyThumbnails sets Thumbnails on every row of res when p asks for them.
+func (uc *ReviewInfo) applyThumbnails(ctx context.Context, p ListAssetsPivotParams, res *ListAssetsPivotResult) {
+	if !p.Thumbnails {
+		return
+	}
+	rows := pivotRows(res)
+	ids := pivotReviewInfoIDs(rows)
+	if len(ids) == 0 {
+		return
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	contents, err := uc.repo.ContentPaths(uc.repo.ReadWithContext(timeoutCtx, p.Project), p.Project, ids)
+	if err != nil {
+		log.Printf("[THUMBNAILS] content paths of %s failed: %v", p.Project, err)
+		return
+	}
+	resolve := uc.ThumbnailURL
+	if resolve == nil {
+		resolve = func(_, contentPath string) string { return contentPath }
+	}
+	for _, row := range rows {
+		for phase, id := range row.ReviewInfoIDs {
+			var thumb *repository.PivotThumbnail
+			for _, c := range contents[id] {
+				ext := strings.ToLower(path.Ext(c))
+				switch {
+				case thumbnailImageExts[ext] && (thumb == nil || thumb.ThumbnailURL == ""):
+					if thumb == nil {
+						thumb = &repository.PivotThumbnail{ReviewInfoID: id}
+					}
+					thumb.ThumbnailURL = resolve(p.Project, c)
+				case thumbnailVideoExts[ext] && (thumb == nil || thumb.PreviewURL == ""):
+					if thumb == nil {
+						thumb = &repository.PivotThumbnail{ReviewInfoID: id}
+					}
+					thumb.PreviewURL = resolve(p.Project, c)
+				}
+			}
+			if thumb == nil {
+				continue
+			}
+			if row.Thumbnails == nil {
+				row.Thumbnails = map[string]*repository.PivotThumbnail{}
+			}
+			row.Thumbnails[phase] = thumb
+		}
+	}
+}
+
+// ThumbnailURLTemplate returns a ThumbnailURL replacing {project} and {path} in tmpl
+// with the query-escaped project and content path, e.g.
+// "https://files.example.com/preview?project={project}&path={path}".
+func ThumbnailURLTemplate(tmpl string) func(project, path string) string {
+	return func(project, contentPath string) string {
+		return strings.NewReplacer(
+			"{project}", url.QueryEscape(project),
+			"{path}", url.QueryEscape(contentPath),
+		).Replace(tmpl)
+	}
+}
@@ -0,0 +1,132 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoTrash.go
+
+	Module Description:
+		Usecase layer for the trash of deleted review infos.
+
+	Details:
+	- Restoring needs the same project role as deleting; it drops the pivot caches and
+	  sends review.restored to the project's webhooks.
+	- RunTrashPurge hard-deletes review infos that have been in the trash longer than
+	  TrashRetention, purgeBatchSize rows per transaction. Purged rows are gone for good.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Take the retention from TrashRetention, which Sync checks tokens against.
+
+	Functions:
+	* - ListTrash: Lists the deleted review infos of a project.
+	* - Restore: Restores a deleted review info.
+	* - PurgeTrash: Hard-deletes review infos deleted longer than the retention ago.
+	* - RunTrashPurge: Runs PurgeTrash every interval until ctx is done.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const purgeBatchSize = 1000
+
+func (uc *ReviewInfo) ListTrash(
+	ctx context.Context,
+	params *entity.ReviewTrashListParams,
+) ([]*entity.ReviewInfo, int, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, 0, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.ListDeleted(db, params)
+}
+
+func (uc *ReviewInfo) Restore(
+	ctx context.Context,
+	params *entity.RestoreReviewInfoParams,
+) (*entity.ReviewInfo, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ReviewInfo
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionDelete,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Restore(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	uc.webhookUc.notify(entity.ReviewEventRestored, e, "")
+	return e, nil
+}
+
+// PurgeTrash hard-deletes the review infos of every project deleted more than retention
+// ago and returns how many it removed.
+func (uc *ReviewInfo) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	cutoff := time.Now().UTC().Add(-retention)
+	var purged int64
+	for {
+		batchCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+		var n int64
+		err := uc.repo.TransactionWithContext(batchCtx, func(tx *gorm.DB) error {
+			var err error
+			n, err = uc.repo.PurgeDeleted(tx, cutoff, purgeBatchSize)
+			return err
+		})
+		cancel()
+		if err != nil {
+			return purged, err
+		}
+		purged += n
+		if n < purgeBatchSize {
+			return purged, nil
+		}
+	}
+}
+
+// RunTrashPurge purges the trash right away and then every interval until ctx is done.
+// A TrashRetention of 0 or less keeps deleted review infos forever.
+func (uc *ReviewInfo) RunTrashPurge(ctx context.Context, interval time.Duration) {
+	retention := uc.TrashRetention
+	if retention <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		purged, err := uc.PurgeTrash(ctx, retention)
+		if err != nil {
+			log.Printf("[TRASH] purge failed after %d rows: %v", purged, err)
+		} else if purged > 0 {
+			log.Printf("[TRASH] purged %d review infos deleted more than %v ago", purged, retention)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
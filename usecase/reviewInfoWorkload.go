@@ -0,0 +1,47 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewInfoWorkload.go
+
+	Module Description:
+		Usecase layer for the per-studio workload of a project.
+
+	Details:
+	- Approved means the final approval status (FinalApprovalStatus), as for review
+	  certificates.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Workload: Returns the workload of a project per studio and phase.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+)
+
+func (uc *ReviewInfo) Workload(
+	ctx context.Context,
+	params *entity.ReviewWorkloadParams,
+) ([]*entity.ReviewWorkload, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	allowed, err := uc.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve category access: %w", err)
+	}
+	return uc.repo.Workload(timeoutCtx, params, FinalApprovalStatus, allowed)
+}
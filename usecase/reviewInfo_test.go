@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/PolygonPictures/central30-web/front/usecase/mocks"
+	"github.com/glebarez/sqlite"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+)
+
+func TestIsGroupedPivotView(t *testing.T) {
+	for view, want := range map[string]bool{
+		"":          false,
+		"list":      false,
+		"grouped":   true,
+		"group":     true,
+		"category":  true,
+		" Grouped ": true,
+		"CATEGORY":  true,
+		"tree":      false,
+	} {
+		if got := isGroupedPivotView(view); got != want {
+			t.Errorf("isGroupedPivotView(%q) = %v, want %v", view, got, want)
+		}
+	}
+}
+
+func TestCalculatePageLast(t *testing.T) {
+	u := &ReviewInfo{}
+	for _, tc := range []struct {
+		total   int64
+		perPage int
+		want    int
+	}{
+		{0, 30, 1},
+		{1, 30, 1},
+		{30, 30, 1},
+		{31, 30, 2},
+		{60, 30, 2},
+		{61, 30, 3},
+		{10, 0, 1},
+	} {
+		if got := u.calculatePageLast(tc.total, tc.perPage); got != tc.want {
+			t.Errorf("calculatePageLast(%d, %d) = %d, want %d", tc.total, tc.perPage, got, tc.want)
+		}
+	}
+}
+
+// The list view answers HasNext as Page < PageLast; for offset pages it has to agree
+// with the offset+limit < total of the original fragment on every page, including
+// pages past the last one and empty projects.
+func TestHasNextPivotPageOffset(t *testing.T) {
+	u := &ReviewInfo{}
+	for total := int64(0); total <= 25; total++ {
+		for perPage := 1; perPage <= 7; perPage++ {
+			pageLast := u.calculatePageLast(total, perPage)
+			for page := 1; page <= pageLast+2; page++ {
+				p := ListAssetsPivotParams{Page: page, PerPage: perPage}
+				offset := (page - 1) * perPage
+				want := offset+perPage < int(total)
+				if got := u.hasNextPivotPage(p, pageLast, nil); got != want {
+					t.Errorf("total=%d per_page=%d page=%d: HasNext = %v, want %v",
+						total, perPage, page, got, want)
+				}
+			}
+		}
+	}
+}
+
+// With a cursor or without a count only the next cursor tells whether a page follows.
+func TestHasNextPivotPageCursor(t *testing.T) {
+	u := &ReviewInfo{}
+	next := &repository.AssetPivotCursor{}
+	for _, p := range []ListAssetsPivotParams{
+		{Page: 1, PerPage: 10, Cursor: "abc"},
+		{Page: 1, PerPage: 10, SkipCount: true},
+	} {
+		if !u.hasNextPivotPage(p, 0, next) {
+			t.Errorf("%+v with a next cursor: HasNext = false", p)
+		}
+		if u.hasNextPivotPage(p, 5, nil) {
+			t.Errorf("%+v without a next cursor: HasNext = true", p)
+		}
+	}
+}
+
+// The grouped view pages complete top group node buckets: its pagination counts
+// buckets under group_page / group_per_page, not assets.
+func TestListAssetsPivotResultPagination(t *testing.T) {
+	grouped := &ListAssetsPivotResult{
+		View:          "grouped",
+		Total:         120,
+		Page:          2,
+		PerPage:       30,
+		PageLast:      4,
+		HasNext:       true,
+		HasPrev:       true,
+		GroupPage:     2,
+		GroupPerPage:  5,
+		GroupTotal:    12,
+		GroupPageLast: 3,
+	}
+	got := grouped.Pagination()
+	if got.Page != 2 || got.PerPage != 5 || got.Total != 12 || got.PageLast != 3 {
+		t.Errorf("grouped pagination = %+v, want page 2 of 3, 5 of 12 buckets", got)
+	}
+	if got.PageParam != "group_page" || got.PerPageParam != "group_per_page" {
+		t.Errorf("grouped pagination params = %q, %q", got.PageParam, got.PerPageParam)
+	}
+	if !got.HasNext || !got.HasPrev {
+		t.Errorf("grouped pagination = %+v, want HasNext and HasPrev", got)
+	}
+
+	list := &ListAssetsPivotResult{View: "list", Total: 31, Page: 1, PerPage: 30, PageLast: 2, HasNext: true}
+	got = list.Pagination()
+	if got.Page != 1 || got.PerPage != 30 || got.Total != 31 || got.PageLast != 2 || !got.HasNext {
+		t.Errorf("list pagination = %+v", got)
+	}
+	if got.PageParam != "" || got.PerPageParam != "" {
+		t.Errorf("list pagination params = %q, %q, want the defaults", got.PageParam, got.PerPageParam)
+	}
+}
+
+// The pivot deadline starts before the query policy is read, the policy is read once
+// under it, and a policy timeout replaces PivotTimeout from the same start.
+func TestPivotContext(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file:"+t.Name()+"?mode=memory&cache=shared"), &gorm.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	policies, err := repository.NewProjectQueryPolicy(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := policies.Put(db, &entity.PutProjectQueryPolicyParams{Project: "slow", TimeoutSeconds: 30}); err != nil {
+		t.Fatal(err)
+	}
+
+	var reads []bool // whether each query ran under a deadline
+	if err := db.Callback().Query().Before("gorm:query").Register("test:deadline", func(tx *gorm.DB) {
+		_, ok := tx.Statement.Context.Deadline()
+		reads = append(reads, ok)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	repo := mocks.NewMockReviewInfoRepository(gomock.NewController(t))
+	repo.EXPECT().WithContext(gomock.Any()).DoAndReturn(func(ctx context.Context) *gorm.DB {
+		return db.WithContext(ctx)
+	}).AnyTimes()
+	u := &ReviewInfo{
+		repo:         repo,
+		policyUc:     NewProjectQueryPolicy(policies, nil, nil, time.Second, time.Second),
+		PivotTimeout: 10 * time.Second,
+	}
+
+	for _, tc := range []struct {
+		project string
+		want    time.Duration
+	}{
+		{"rod", 10 * time.Second},  // no policy
+		{"slow", 30 * time.Second}, // policy timeout
+	} {
+		reads = nil
+		start := time.Now()
+		ctx, cancel, _, err := u.pivotContext(context.Background(), tc.project)
+		end := time.Now()
+		if err != nil {
+			t.Fatal(err)
+		}
+		deadline, ok := ctx.Deadline()
+		cancel()
+		if !ok || deadline.Before(start.Add(tc.want)) || deadline.After(end.Add(tc.want)) {
+			t.Errorf("%s: deadline in %v, want %v", tc.project, deadline.Sub(start), tc.want)
+		}
+		if len(reads) != 1 || !reads[0] {
+			t.Errorf("%s: policy reads under a deadline = %v, want [true]", tc.project, reads)
+		}
+	}
+}
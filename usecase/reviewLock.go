@@ -0,0 +1,172 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewLock.go
+
+	Module Description:
+		Usecase layer for short-lived review locks held during live review sessions.
+
+	Details:
+	- A lock blocks status changes on one review info by anyone but its holder until it
+	  is released or expires (default 5 minutes, at most 30; holders renew by acquiring
+	  again).
+	- ReviewInfo.Update calls checkUnlocked inside its transaction, with the user of the
+	  request context as the actor; the delivery takes the holder from there as well.
+	- A forced release drops someone else's lock and needs force_unlock (lead).
+	- Lock changes invalidate the pivot cache so lock info in pivot cells stays current.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Forced releases need the force_unlock action.
+
+	Functions:
+	* - Get: Returns the active lock of a review info.
+	* - Acquire: Takes or renews a lock.
+	* - Release: Drops a lock (someone else's with Force, leads only).
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	DefaultReviewLockTTL = 5 * time.Minute
+	MaxReviewLockTTL     = 30 * time.Minute
+)
+
+type ReviewLock struct {
+	repo         *repository.ReviewLock
+	prjRepo      *repository.ProjectInfo
+	memberUc     *ProjectMember
+	cache        entity.Cache
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewLock(
+	repo *repository.ReviewLock,
+	pr *repository.ProjectInfo,
+	mu *ProjectMember,
+	c entity.Cache,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewLock {
+	return &ReviewLock{
+		repo:         repo,
+		prjRepo:      pr,
+		memberUc:     mu,
+		cache:        c,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewLock) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewLock) Get(
+	ctx context.Context,
+	params *entity.GetReviewLockParams,
+) (*entity.ReviewLock, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.Get(db, params)
+}
+
+func (uc *ReviewLock) Acquire(
+	ctx context.Context,
+	params *entity.AcquireReviewLockParams,
+) (*entity.ReviewLock, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if params.TTL <= 0 {
+		params.TTL = DefaultReviewLockTTL
+	}
+	if params.TTL > MaxReviewLockTTL {
+		params.TTL = MaxReviewLockTTL
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	var e *entity.ReviewLock
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		var err error
+		e, err = uc.repo.Acquire(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return e, nil
+}
+
+func (uc *ReviewLock) Release(
+	ctx context.Context,
+	params *entity.ReleaseReviewLockParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return err
+	}
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if params.Force {
+			if err := uc.memberUc.authorize(
+				tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionForceUnlock,
+			); err != nil {
+				return err
+			}
+		}
+		return uc.repo.Release(tx, params)
+	}); err != nil {
+		return err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	return nil
+}
+
+// checkUnlocked returns a *entity.ReviewLockedError when the review info is locked by
+// someone other than actor.
+func (uc *ReviewLock) checkUnlocked(tx *gorm.DB, project string, id int32, actor string) error {
+	lock, err := uc.repo.Get(tx, &entity.GetReviewLockParams{
+		Project:      project,
+		ReviewInfoID: id,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if lock.Holder == actor {
+		return nil
+	}
+	return &entity.ReviewLockedError{Lock: lock}
+}
@@ -0,0 +1,170 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewMedia.go
+
+	Module Description:
+		Usecase layer issuing short-lived signed URLs for review media files.
+
+	Details:
+	- Clients ask for the URL of a file of a review info by its index in all_files or
+	  review_target, instead of building storage paths themselves.
+	- File paths are studio paths; the part below root is the key in the media store
+	  (GCS, S3 or a local directory, see repository/mediaSigner.go). Files outside root
+	  are not served.
+	- URLs expire after TTL (DefaultMediaURLTTL unless set).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - NewReviewMedia: Creates a new ReviewMedia usecase.
+	* - FileURL: Issues a signed URL for a file of a review info.
+	* - ResolveLocal: Checks a signed local media URL and returns its file.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const DefaultMediaURLTTL = 15 * time.Minute
+
+type ReviewMedia struct {
+	repo        *repository.ReviewInfo
+	prjRepo     *repository.ProjectInfo
+	signer      entity.MediaSigner
+	root        string
+	ReadTimeout time.Duration
+
+	// TTL is how long an issued URL stays valid.
+	TTL time.Duration
+}
+
+func NewReviewMedia(
+	repo *repository.ReviewInfo,
+	pr *repository.ProjectInfo,
+	signer entity.MediaSigner,
+	root string,
+	readTimeout time.Duration,
+) *ReviewMedia {
+	return &ReviewMedia{
+		repo:        repo,
+		prjRepo:     pr,
+		signer:      signer,
+		root:        root,
+		ReadTimeout: readTimeout,
+		TTL:         DefaultMediaURLTTL,
+	}
+}
+
+func (uc *ReviewMedia) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewMedia) FileURL(
+	ctx context.Context,
+	params *entity.GetReviewMediaURLParams,
+) (*entity.ReviewMediaURL, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	e, err := uc.repo.Get(db, &entity.GetReviewParams{Project: params.Project, ID: params.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	var file any
+	switch params.Kind {
+	case entity.ReviewMediaKindAllFiles:
+		if params.Index < len(e.AllFiles) {
+			file = e.AllFiles[params.Index]
+		}
+	case entity.ReviewMediaKindReviewTarget:
+		if params.Index < len(e.ReviewTarget) {
+			file = e.ReviewTarget[params.Index]
+		}
+	}
+	filePath := mediaPath(file)
+	if filePath == "" {
+		return nil, entity.ErrReviewMediaNotFound
+	}
+	key, ok := mediaKey(uc.root, filePath)
+	if !ok {
+		return nil, entity.ErrReviewMediaNotServable
+	}
+	expires := time.Now().Add(uc.TTL).UTC().Truncate(time.Second)
+	u, err := uc.signer.SignURL(timeoutCtx, key, expires)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.ReviewMediaURL{
+		ReviewInfoID: e.ID,
+		Kind:         params.Kind,
+		Index:        params.Index,
+		Path:         filePath,
+		URL:          u,
+		ExpiresAtUtc: expires,
+	}, nil
+}
+
+// ResolveLocal returns the file of a URL issued by a local media store, or
+// entity.ErrInvalidMediaSignature when the URL is not valid (or the store is remote).
+func (uc *ReviewMedia) ResolveLocal(key string, expires time.Time, signature string) (string, error) {
+	local, ok := uc.signer.(entity.LocalMediaStore)
+	if !ok {
+		return "", entity.ErrInvalidMediaSignature
+	}
+	return local.Resolve(key, expires, signature)
+}
+
+// mediaPath returns the path of a file or content entry from its JSON form, "" for nil
+// or an entry without a path.
+func mediaPath(file any) string {
+	if file == nil {
+		return ""
+	}
+	raw, err := json.Marshal(file)
+	if err != nil {
+		return ""
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return ""
+	}
+	p, _ := fields["path"].(string)
+	return p
+}
+
+// mediaKey returns the key of filePath below root, with forward slashes, and false when
+// the file is not below root. An empty root serves every absolute path.
+func mediaKey(root, filePath string) (string, bool) {
+	clean := path.Clean(strings.ReplaceAll(filePath, `\`, "/"))
+	if root == "" {
+		key := strings.TrimPrefix(clean, "/")
+		return key, key != "" && key != "." && !strings.HasPrefix(key, "../")
+	}
+	prefix := strings.TrimRight(path.Clean(strings.ReplaceAll(root, `\`, "/")), "/") + "/"
+	if !strings.HasPrefix(clean, prefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(clean, prefix), true
+}
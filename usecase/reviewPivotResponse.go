@@ -0,0 +1,128 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewPivotResponse.go
+
+	Module Description:
+		JSON responses of the asset pivot (list and grouped views).
+
+	Details:
+	- The pivot handler used to answer gin.H maps built separately for each view, and
+	  their field names had started to drift. The views now share ListResponse, the
+	  paging fields, and the structs live here so the export code and other callers
+	  can build the same responses without a gin context.
+	- Total and PageLast are null when the count was skipped (count=false), in both
+	  views.
+	- RequestID and QueryTime are per request; they are left empty while the ETag is
+	  taken, so the tag follows the data only.
+	- The links of the pagination block depend on the request URL; the handler fills
+	  Pagination in (delivery/pagination.NewBlock).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - (ListAssetsPivotResult) PivotResponse: Returns the list view response of a page.
+	* - (ListAssetsPivotResult) GroupedResponse: Returns the grouped view response of a page.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+)
+
+// ListResponse is the paging part shared by the pivot responses.
+type ListResponse struct {
+	Total      *int64                 `json:"total"` // null when not counted
+	Page       int                    `json:"page"`
+	PerPage    int                    `json:"per_page"`
+	PageLast   *int                   `json:"page_last"` // null when not counted
+	HasNext    bool                   `json:"has_next"`
+	HasPrev    bool                   `json:"has_prev"`
+	NextCursor string                 `json:"next_cursor"`
+	Pagination entity.PaginationBlock `json:"pagination"`
+	RequestID  string                 `json:"request_id,omitempty"`
+}
+
+// PivotResponse is the list view of the asset pivot.
+type PivotResponse struct {
+	Assets []repository.AssetPivot `json:"assets"`
+	ListResponse
+	TotalIsEstimate bool                            `json:"total_is_estimate"`
+	Sort            string                          `json:"sort"`
+	Dir             string                          `json:"dir"`
+	Project         string                          `json:"project"`
+	Root            string                          `json:"root"`
+	View            string                          `json:"view"`
+	TopGroupNode    string                          `json:"top_group_node,omitempty"`
+	AsOf            *time.Time                      `json:"as_of,omitempty"`
+	Groups          []repository.GroupedAssetBucket `json:"groups,omitempty"`
+	QueryTime       *float64                        `json:"query_time,omitempty"` // seconds
+}
+
+// GroupedPivotResponse is the grouped view of the asset pivot; its pagination block
+// pages over top group node buckets.
+type GroupedPivotResponse struct {
+	Groups []repository.GroupedAssetBucket `json:"groups"`
+	ListResponse
+	ItemCount     int   `json:"item_count"`
+	GroupPage     int   `json:"group_page"`
+	GroupPerPage  int   `json:"group_per_page"`
+	GroupTotal    int64 `json:"group_total"`
+	GroupPageLast int   `json:"group_page_last"`
+}
+
+func (r *ListAssetsPivotResult) listResponse() ListResponse {
+	res := ListResponse{
+		Page:       r.Page,
+		PerPage:    r.PerPage,
+		HasNext:    r.HasNext,
+		HasPrev:    r.HasPrev,
+		NextCursor: r.NextCursor,
+		Pagination: entity.PaginationBlock{Pagination: r.Pagination()},
+	}
+	if !r.CountSkipped {
+		total, pageLast := r.Total, r.PageLast
+		res.Total, res.PageLast = &total, &pageLast
+	}
+	return res
+}
+
+// PivotResponse returns the list view response of the page of project and root; a
+// non-empty topNode is the bucket of /pivot/groups/:topNode, asOf the time of a
+// historical read.
+func (r *ListAssetsPivotResult) PivotResponse(project, root, topNode string, asOf *time.Time) PivotResponse {
+	res := PivotResponse{
+		Assets:          r.Assets,
+		ListResponse:    r.listResponse(),
+		TotalIsEstimate: r.TotalIsEstimate,
+		Sort:            r.Sort,
+		Dir:             r.Dir,
+		Project:         project,
+		Root:            root,
+		View:            r.View,
+		TopGroupNode:    topNode,
+		Groups:          r.Groups,
+	}
+	if asOf != nil {
+		t := asOf.UTC()
+		res.AsOf = &t
+	}
+	return res
+}
+
+// GroupedResponse returns the grouped view response of the page.
+func (r *ListAssetsPivotResult) GroupedResponse() GroupedPivotResponse {
+	return GroupedPivotResponse{
+		Groups:        r.Groups,
+		ListResponse:  r.listResponse(),
+		ItemCount:     len(r.Assets),
+		GroupPage:     r.GroupPage,
+		GroupPerPage:  r.GroupPerPage,
+		GroupTotal:    r.GroupTotal,
+		GroupPageLast: r.GroupPageLast,
+	}
+}
@@ -0,0 +1,234 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewSession.go
+
+	Module Description:
+		Usecase layer for scheduled review sessions and their calendar feed.
+
+	Details:
+	- A session may reference a playlist of the same project, which must exist when the
+	  session is created, and / or a list of assets.
+	- List defaults to upcoming sessions (From = now).
+	- Calendar renders the project's sessions as an iCalendar feed (see
+	  reviewSessionCalendar.go). Calendar apps cannot send the auth header, so the feed
+	  can also be read with a per-project token, HMAC-SHA256(secret, project). Without a
+	  secret the token feed is disabled.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - List: Lists the sessions of a project.
+	* - Get: Returns a session.
+	* - Create: Schedules a session.
+	* - Delete: Removes a session.
+	* - Calendar: Renders the sessions of a project as iCalendar.
+	* - FeedToken: Returns the calendar feed token of a project.
+	* - CheckFeedToken: Checks a calendar feed token.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	// reviewSessionCalendarPast is how far back the calendar feed goes.
+	reviewSessionCalendarPast = 30 * 24 * time.Hour
+	// reviewSessionCalendarLimit caps the number of sessions in the calendar feed.
+	reviewSessionCalendarLimit = 500
+)
+
+type ReviewSession struct {
+	repo         *repository.ReviewSession
+	playlistRepo *repository.ReviewPlaylist
+	prjRepo      *repository.ProjectInfo
+	feedSecret   []byte
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewSession(
+	repo *repository.ReviewSession,
+	playlistRepo *repository.ReviewPlaylist,
+	pr *repository.ProjectInfo,
+	feedSecret string,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewSession {
+	return &ReviewSession{
+		repo:         repo,
+		playlistRepo: playlistRepo,
+		prjRepo:      pr,
+		feedSecret:   []byte(feedSecret),
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewSession) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewSession) List(
+	ctx context.Context,
+	params *entity.ReviewSessionListParams,
+) ([]*entity.ReviewSession, int, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, 0, err
+	}
+	if params.From == nil {
+		now := time.Now().UTC()
+		params.From = &now
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, 0, err
+	}
+	return uc.repo.List(db, params)
+}
+
+func (uc *ReviewSession) Get(
+	ctx context.Context,
+	params *entity.GetReviewSessionParams,
+) (*entity.ReviewSession, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.Get(db, params)
+}
+
+func (uc *ReviewSession) Create(
+	ctx context.Context,
+	params *entity.CreateReviewSessionParams,
+) (*entity.ReviewSession, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ReviewSession
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if params.PlaylistID != nil {
+			if _, err := uc.playlistRepo.Get(tx, &entity.GetReviewPlaylistParams{
+				Project: params.Project,
+				ID:      *params.PlaylistID,
+			}); err != nil {
+				if errors.Is(err, entity.ErrRecordNotFound) {
+					return fmt.Errorf("playlist with ID %d: %w", *params.PlaylistID, err)
+				}
+				return err
+			}
+		}
+		var err error
+		e, err = uc.repo.Create(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *ReviewSession) Delete(
+	ctx context.Context,
+	params *entity.DeleteReviewSessionParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	return uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		return uc.repo.Delete(tx, params)
+	})
+}
+
+// Calendar renders the sessions of project from 30 days ago on as an iCalendar feed.
+func (uc *ReviewSession) Calendar(ctx context.Context, project string) ([]byte, error) {
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, project); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	from := now.Add(-reviewSessionCalendarPast)
+	perPage := reviewSessionCalendarLimit
+	sessions, _, err := uc.repo.List(db, &entity.ReviewSessionListParams{
+		Project:        project,
+		From:           &from,
+		BaseListParams: &entity.BaseListParams{PerPage: &perPage},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	playlists := make(map[int32]*entity.ReviewPlaylist)
+	for _, s := range sessions {
+		if s.PlaylistID == nil {
+			continue
+		}
+		if _, ok := playlists[*s.PlaylistID]; ok {
+			continue
+		}
+		p, err := uc.playlistRepo.Get(db, &entity.GetReviewPlaylistParams{
+			Project: project,
+			ID:      *s.PlaylistID,
+		})
+		if err != nil && !errors.Is(err, entity.ErrRecordNotFound) {
+			return nil, err
+		}
+		// A deleted playlist is remembered as nil so it is looked up once.
+		playlists[*s.PlaylistID] = p
+	}
+	return renderReviewSessionCalendar(project, sessions, playlists, now), nil
+}
+
+// FeedToken returns the token of the calendar feed of project, or
+// entity.ErrReviewSessionFeedDisabled when no secret is configured.
+func (uc *ReviewSession) FeedToken(project string) (string, error) {
+	if len(uc.feedSecret) == 0 {
+		return "", entity.ErrReviewSessionFeedDisabled
+	}
+	mac := hmac.New(sha256.New, uc.feedSecret)
+	mac.Write([]byte(project))
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// CheckFeedToken returns nil when token is the calendar feed token of project.
+func (uc *ReviewSession) CheckFeedToken(project, token string) error {
+	want, err := uc.FeedToken(project)
+	if err != nil {
+		return err
+	}
+	if !hmac.Equal([]byte(want), []byte(token)) {
+		return entity.ErrReviewSessionFeedToken
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewSessionCalendar.go
+
+	Module Description:
+		iCalendar (RFC 5545) rendering of review sessions.
+
+	Details:
+	- One VEVENT per session with a stable UID, so calendar apps update events in place
+	  when the feed is refreshed; deleted sessions disappear from the feed.
+	- Times are written in UTC. The description lists the playlist takes and assets of
+	  the session, with the API path of the playlist.
+	- Lines end with CRLF and are folded at 75 octets, never inside a UTF-8 sequence.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - renderReviewSessionCalendar: Renders sessions as a VCALENDAR.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+)
+
+const icsTimeFormat = "20060102T150405Z"
+
+var icsTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", `\n`,
+)
+
+// renderReviewSessionCalendar renders sessions as a VCALENDAR. playlists holds the
+// referenced playlists by ID; a nil entry is a playlist that no longer exists.
+func renderReviewSessionCalendar(
+	project string,
+	sessions []*entity.ReviewSession,
+	playlists map[int32]*entity.ReviewPlaylist,
+	now time.Time,
+) []byte {
+	var buf bytes.Buffer
+	writeICSLine(&buf, "BEGIN:VCALENDAR")
+	writeICSLine(&buf, "VERSION:2.0")
+	writeICSLine(&buf, "PRODID:-//Polygon Pictures//central30 review sessions//EN")
+	writeICSLine(&buf, "CALSCALE:GREGORIAN")
+	writeICSLine(&buf, "METHOD:PUBLISH")
+	writeICSLine(&buf, "X-WR-CALNAME:"+icsText(project+" review sessions"))
+	stamp := now.UTC().Format(icsTimeFormat)
+	for _, s := range sessions {
+		writeICSLine(&buf, "BEGIN:VEVENT")
+		writeICSLine(&buf, fmt.Sprintf("UID:review-session-%d@%s", s.ID, icsText(project)))
+		writeICSLine(&buf, "DTSTAMP:"+stamp)
+		writeICSLine(&buf, "DTSTART:"+s.StartsAtUtc.UTC().Format(icsTimeFormat))
+		writeICSLine(&buf, "DTEND:"+s.EndsAtUtc.UTC().Format(icsTimeFormat))
+		writeICSLine(&buf, "LAST-MODIFIED:"+s.ModifiedAtUtc.UTC().Format(icsTimeFormat))
+		writeICSLine(&buf, "SUMMARY:"+icsText(s.Title))
+		if s.Location != "" {
+			writeICSLine(&buf, "LOCATION:"+icsText(s.Location))
+		}
+		if d := reviewSessionDescription(s, playlists); d != "" {
+			writeICSLine(&buf, "DESCRIPTION:"+icsText(d))
+		}
+		writeICSLine(&buf, "END:VEVENT")
+	}
+	writeICSLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// reviewSessionDescription returns the plain text description of s: its own
+// description followed by the takes of its playlist and its assets.
+func reviewSessionDescription(s *entity.ReviewSession, playlists map[int32]*entity.ReviewPlaylist) string {
+	var lines []string
+	if s.Description != "" {
+		lines = append(lines, s.Description, "")
+	}
+	if s.PlaylistID != nil {
+		if p := playlists[*s.PlaylistID]; p != nil {
+			lines = append(lines, fmt.Sprintf("Playlist: %s (%d takes)", p.Name, len(p.Items)))
+			lines = append(lines, fmt.Sprintf("/api/projects/%s/playlists/%d", s.Project, p.ID))
+			for _, item := range p.Items {
+				lines = append(lines, fmt.Sprintf("- %s %s %s %s", item.Asset, item.Relation, item.Phase, item.Take))
+			}
+		} else {
+			lines = append(lines, fmt.Sprintf("Playlist: #%d (deleted)", *s.PlaylistID))
+		}
+	}
+	if len(s.Assets) > 0 {
+		if s.PlaylistID != nil {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "Assets:")
+		for _, a := range s.Assets {
+			lines = append(lines, fmt.Sprintf("- %s %s", a.Asset, a.Relation))
+		}
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func icsText(s string) string {
+	return icsTextEscaper.Replace(s)
+}
+
+// writeICSLine writes line followed by CRLF, folding it so that no physical line is
+// longer than 75 octets.
+func writeICSLine(buf *bytes.Buffer, line string) {
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines start with a space, which counts toward the limit.
+		limit = 74
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,281 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewStatus.go
+
+	Module Description:
+		Usecase layer for the per-project approval and work status vocabulary.
+
+	Details:
+	- The vocabulary names the values review infos may carry, with a label, color and
+	  display order for each. Keys are compared case-insensitively everywhere.
+	- Once a project has statuses of a type, the pivot's status filters of that type only
+	  accept those keys, and the validation rules fall back to them (see
+	  ReviewValidation).
+	- Summary counts the latest review info of every asset phase by status, grouped by
+	  the vocabulary in its order. Values outside the vocabulary are counted under
+	  "other"; rows without a status under "".
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Writes need configure_project (supervisor).
+
+	Functions:
+	* - List: Lists the statuses of a project.
+	* - Create: Adds a status (supervisor only once enforcement is on).
+	* - Update: Changes the label, color or order of a status (likewise).
+	* - Delete: Removes a status (likewise).
+	* - Summary: Counts latest review infos per status of the vocabulary.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	summaryOtherKey   = "other"
+	summaryOtherLabel = "Other"
+	summaryNoneLabel  = "No status"
+)
+
+type ReviewStatus struct {
+	repo         *repository.ReviewStatus
+	reviewRepo   *repository.ReviewInfo
+	prjRepo      *repository.ProjectInfo
+	memberUc     *ProjectMember
+	accessUc     *CategoryAccess
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewStatus(
+	repo *repository.ReviewStatus,
+	rr *repository.ReviewInfo,
+	pr *repository.ProjectInfo,
+	mu *ProjectMember,
+	ac *CategoryAccess,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewStatus {
+	return &ReviewStatus{
+		repo:         repo,
+		reviewRepo:   rr,
+		prjRepo:      pr,
+		memberUc:     mu,
+		accessUc:     ac,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewStatus) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewStatus) List(
+	ctx context.Context,
+	params *entity.ListReviewStatusParams,
+) ([]*entity.ReviewStatus, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.List(db, params)
+}
+
+func (uc *ReviewStatus) Create(
+	ctx context.Context,
+	params *entity.CreateReviewStatusParams,
+) (*entity.ReviewStatus, error) {
+	params.Key = strings.TrimSpace(params.Key)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ReviewStatus
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Create(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *ReviewStatus) Update(
+	ctx context.Context,
+	params *entity.UpdateReviewStatusParams,
+) (*entity.ReviewStatus, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ReviewStatus
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Update(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *ReviewStatus) Delete(
+	ctx context.Context,
+	params *entity.DeleteReviewStatusParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	return uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		return uc.repo.Delete(tx, params)
+	})
+}
+
+func (uc *ReviewStatus) Summary(
+	ctx context.Context,
+	params *entity.ReviewStatusSummaryParams,
+) (*entity.ReviewStatusSummary, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if params.Root == "" {
+		params.Root = "assets"
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	statuses, err := uc.repo.List(db, &entity.ListReviewStatusParams{Project: params.Project})
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := uc.accessUc.AllowedTopGroupNodes(db, params.Project, params.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &entity.ReviewStatusSummary{Project: params.Project, Root: params.Root}
+	for _, t := range []struct {
+		typ    string
+		column string
+		out    *[]*entity.ReviewStatusCount
+	}{
+		{entity.ReviewStatusTypeApproval, "approval_status", &summary.Approval},
+		{entity.ReviewStatusTypeWork, "work_status", &summary.Work},
+	} {
+		counts, err := uc.reviewRepo.CountLatestByStatus(timeoutCtx, params.Project, params.Root, t.column, allowed)
+		if err != nil {
+			return nil, err
+		}
+		*t.out = groupStatusCounts(statusesOfType(statuses, t.typ), counts)
+	}
+	return summary, nil
+}
+
+func statusesOfType(statuses []*entity.ReviewStatus, typ string) []*entity.ReviewStatus {
+	var out []*entity.ReviewStatus
+	for _, s := range statuses {
+		if s.Type == typ {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// groupStatusCounts folds raw counts into one entry per vocabulary status, in vocabulary
+// order, followed by "other" and "" when any row falls there. Without a vocabulary every
+// value gets its own entry, in the order of counts.
+func groupStatusCounts(
+	vocabulary []*entity.ReviewStatus,
+	counts []repository.StatusCount,
+) []*entity.ReviewStatusCount {
+	groups := make([]*entity.ReviewStatusCount, 0, len(vocabulary)+2)
+	byKey := make(map[string]*entity.ReviewStatusCount, len(vocabulary))
+	for _, s := range vocabulary {
+		g := &entity.ReviewStatusCount{Key: s.Key, Label: s.Label, Color: s.Color, ByPhase: map[string]int{}}
+		groups = append(groups, g)
+		byKey[strings.ToLower(s.Key)] = g
+	}
+	other := &entity.ReviewStatusCount{Key: summaryOtherKey, Label: summaryOtherLabel, ByPhase: map[string]int{}}
+	none := &entity.ReviewStatusCount{Key: "", Label: summaryNoneLabel, ByPhase: map[string]int{}}
+	for _, c := range counts {
+		g, ok := byKey[c.Status]
+		switch {
+		case ok:
+		case c.Status == "":
+			g = none
+		case len(vocabulary) == 0:
+			g = &entity.ReviewStatusCount{Key: c.Status, Label: c.Status, ByPhase: map[string]int{}}
+			groups = append(groups, g)
+			byKey[c.Status] = g
+		default:
+			g = other
+		}
+		g.Total += c.Count
+		g.ByPhase[c.Phase] += c.Count
+	}
+	for _, g := range []*entity.ReviewStatusCount{other, none} {
+		if g.Total > 0 {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+// statusKeys returns the lower-cased keys of the statuses of typ, nil when the project
+// has none of that type.
+func statusKeys(statuses []*entity.ReviewStatus, typ string) map[string]bool {
+	var keys map[string]bool
+	for _, s := range statusesOfType(statuses, typ) {
+		if keys == nil {
+			keys = map[string]bool{}
+		}
+		keys[strings.ToLower(s.Key)] = true
+	}
+	return keys
+}
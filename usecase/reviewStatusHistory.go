@@ -0,0 +1,214 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewStatusHistory.go
+
+	Module Description:
+		Usecase layer for the audit log of approval_status / work_status changes.
+
+	Details:
+	- ReviewInfo.Update reads the row before changing it and calls record inside its
+	  transaction, so a status change and its history row commit or roll back together.
+	- Updates that leave a status unchanged are not logged.
+	- Corrected submission metadata (take path, target components, duration, review
+	  comments) is logged by recordMetadata, one row per changed field. List values
+	  are stored as JSON, cut to the 255 characters of the value columns.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Added byReviewInfo for the asset timeline.
+	* - 15-10-2026 - Added recordMetadata.
+	* - 15-10-2026 - recordMetadata stamps the correction time; metadata edits keep modified_at_utc.
+
+	Functions:
+	* - record: Stores the changes between the row before and after an update.
+	* - recordMetadata: Stores the submission metadata changes of an update.
+	* - History: Returns the change timeline of a review info.
+	* - byReviewInfo: Returns the changes of several review infos keyed by review info ID.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type ReviewStatusHistory struct {
+	repo         *repository.ReviewStatusHistory
+	prjRepo      *repository.ProjectInfo
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewStatusHistory(
+	repo *repository.ReviewStatusHistory,
+	pr *repository.ProjectInfo,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewStatusHistory {
+	return &ReviewStatusHistory{
+		repo:         repo,
+		prjRepo:      pr,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewStatusHistory) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+// record logs every status that differs between before and after. It must be called
+// with the transaction that performed the update.
+func (uc *ReviewStatusHistory) record(
+	tx *gorm.DB,
+	before *entity.ReviewInfo,
+	after *entity.ReviewInfo,
+	sessionID string,
+) error {
+	changes := []struct {
+		field, from, to, by string
+	}{
+		{
+			entity.ReviewActivityFieldApprovalStatus,
+			before.ApprovalStatus, after.ApprovalStatus, after.ApprovalStatusUpdatedUser,
+		},
+		{
+			entity.ReviewActivityFieldWorkStatus,
+			before.WorkStatus, after.WorkStatus, after.WorkStatusUpdatedUser,
+		},
+	}
+	for _, ch := range changes {
+		if ch.from == ch.to {
+			continue
+		}
+		if err := uc.repo.Create(tx, &entity.CreateReviewStatusHistoryParams{
+			Project:      after.Project,
+			ReviewInfoID: after.ID,
+			Field:        ch.field,
+			FromValue:    ch.from,
+			ToValue:      ch.to,
+			ChangedBy:    ch.by,
+			SessionID:    sessionID,
+			ChangedAtUtc: after.ModifiedAtUTC,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordMetadata logs every field of patch whose value differs between before and
+// after, as changed by by now. It must be called with the transaction that performed
+// the update. The row's modified_at_utc orders its takes, so a correction does not move
+// it and the history row is where the correction time is kept.
+func (uc *ReviewStatusHistory) recordMetadata(
+	tx *gorm.DB,
+	before *entity.ReviewInfo,
+	after *entity.ReviewInfo,
+	patch *entity.ReviewInfoMetadataPatch,
+	by string,
+	sessionID string,
+) error {
+	type change struct{ field, from, to string }
+	var changes []change
+	now := time.Now().UTC().Truncate(time.Second)
+	if patch.TakePath != nil {
+		changes = append(changes, change{entity.ReviewFieldTakePath, before.TakePath, after.TakePath})
+	}
+	if patch.TargetComponents != nil {
+		changes = append(changes, change{entity.ReviewFieldTargetComponents,
+			historyJSON(before.TargetComponents), historyJSON(after.TargetComponents)})
+	}
+	if patch.Duration != nil {
+		changes = append(changes, change{entity.ReviewFieldDuration,
+			historyDuration(before.Duration), historyDuration(after.Duration)})
+	}
+	if patch.ReviewComments != nil {
+		changes = append(changes, change{entity.ReviewFieldReviewComments,
+			historyJSON(before.ReviewComments), historyJSON(after.ReviewComments)})
+	}
+	for _, ch := range changes {
+		if ch.from == ch.to {
+			continue
+		}
+		if err := uc.repo.Create(tx, &entity.CreateReviewStatusHistoryParams{
+			Project:      after.Project,
+			ReviewInfoID: after.ID,
+			Field:        ch.field,
+			FromValue:    historyValue(ch.from),
+			ToValue:      historyValue(ch.to),
+			ChangedBy:    by,
+			SessionID:    sessionID,
+			ChangedAtUtc: now,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func historyJSON(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func historyDuration(d *int32) string {
+	if d == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*d))
+}
+
+// historyValue cuts v to the 255 characters of the history value columns.
+func historyValue(v string) string {
+	if r := []rune(v); len(r) > 255 {
+		return string(r[:254]) + "…"
+	}
+	return v
+}
+
+func (uc *ReviewStatusHistory) History(
+	ctx context.Context,
+	params *entity.ListReviewStatusHistoryParams,
+) ([]*entity.ReviewStatusHistory, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.List(db, params)
+}
+
+func (uc *ReviewStatusHistory) byReviewInfo(
+	db *gorm.DB,
+	project string,
+	reviewInfoIDs []int32,
+) (map[int32][]*entity.ReviewStatusHistory, error) {
+	changes, err := uc.repo.ListByReviewInfoIDs(db, project, reviewInfoIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make(map[int32][]*entity.ReviewStatusHistory, len(reviewInfoIDs))
+	for _, ch := range changes {
+		out[ch.ReviewInfoID] = append(out[ch.ReviewInfoID], ch)
+	}
+	return out, nil
+}
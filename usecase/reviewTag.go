@@ -0,0 +1,139 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewTag.go
+
+	Module Description:
+		Usecase layer for the tags of asset relations.
+
+	Details:
+	- Tags are trimmed and lowercased, so hero and Hero are one tag.
+	- Adding and removing tags needs the tag action (artist and above) and drops the
+	  pivot caches and totals, since the pivot filters by tag.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - ListProjectTags: Lists the tags of a project with their asset counts.
+	* - ListTags: Lists the tags of an asset relation.
+	* - AddTags: Adds tags to an asset relation.
+	* - RemoveTag: Removes a tag from an asset relation.
+	* - normaliseTags: Trims, lowercases and de-duplicates tags.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+func (uc *ReviewInfo) ListProjectTags(
+	ctx context.Context,
+	params *entity.ListProjectReviewTagsParams,
+) ([]*entity.ReviewTagCount, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListProjectTags(db, params)
+}
+
+func (uc *ReviewInfo) ListTags(
+	ctx context.Context,
+	params *entity.ListReviewTagsParams,
+) ([]*entity.ReviewTag, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.ListTags(db, params)
+}
+
+func (uc *ReviewInfo) AddTags(
+	ctx context.Context,
+	params *entity.AddReviewTagsParams,
+) ([]*entity.ReviewTag, error) {
+	params.Tags = normaliseTags(params.Tags)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var tags []*entity.ReviewTag
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionTag,
+		); err != nil {
+			return err
+		}
+		var err error
+		tags, err = uc.repo.AddTags(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	return tags, nil
+}
+
+func (uc *ReviewInfo) RemoveTag(
+	ctx context.Context,
+	params *entity.RemoveReviewTagParams,
+) error {
+	params.Tag = strings.ToLower(strings.TrimSpace(params.Tag))
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionTag,
+		); err != nil {
+			return err
+		}
+		return uc.repo.RemoveTag(tx, params)
+	}); err != nil {
+		return err
+	}
+	invalidatePivotCache(timeoutCtx, uc.cache, params.Project)
+	uc.repo.InvalidateLatestCounts(params.Project)
+	return nil
+}
+
+// normaliseTags trims and lowercases tags, dropping empty ones and duplicates.
+func normaliseTags(tags []string) []string {
+	out := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	return out
+}
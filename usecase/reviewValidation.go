@@ -0,0 +1,223 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewValidation.go
+
+	Module Description:
+		Usecase layer for per-project review info validation rules.
+
+	Details:
+	- ReviewInfo.Create/Update and ReviewImport check phase, approval_status and
+	  work_status against the rules of the project before writing anything; a rejected
+	  payload fails with *entity.ValidationError.
+	- A project without rules accepts any value, as before the rules existed.
+	- Empty status lists fall back to the project's status vocabulary (ReviewStatus), so
+	  defining the vocabulary is enough to restrict the values.
+	- Put trims and deduplicates the lists, keeping the first spelling of each value.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - Fall back to the status vocabulary; added checkStatusFilters.
+	* - 15-10-2026 - Writes need configure_project (supervisor).
+
+	Functions:
+	* - Get: Retrieves the rules of a project (ErrRecordNotFound when unset).
+	* - Put: Validates and stores the rules of a project (supervisor only once enforcement is on).
+	* - rules: Returns the rules of a project, empty when unset.
+	* - validate: Checks review info values against the rules of a project.
+	* - checkStatusFilters: Checks status filters against the status vocabulary.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+type ReviewValidation struct {
+	repo         *repository.ReviewValidation
+	statusRepo   *repository.ReviewStatus
+	prjRepo      *repository.ProjectInfo
+	memberUc     *ProjectMember
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewValidation(
+	repo *repository.ReviewValidation,
+	sr *repository.ReviewStatus,
+	pr *repository.ProjectInfo,
+	mu *ProjectMember,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewValidation {
+	return &ReviewValidation{
+		repo:         repo,
+		statusRepo:   sr,
+		prjRepo:      pr,
+		memberUc:     mu,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewValidation) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewValidation) Get(
+	ctx context.Context,
+	params *entity.GetReviewValidationRulesParams,
+) (*entity.ReviewValidationRules, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	return uc.repo.Get(db, params)
+}
+
+// normalizeRuleValues trims values and drops blanks and case-insensitive duplicates.
+func normalizeRuleValues(values []string) []string {
+	out := make([]string, 0, len(values))
+	seen := map[string]bool{}
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		key := strings.ToLower(v)
+		if v == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, v)
+	}
+	return out
+}
+
+func (uc *ReviewValidation) Put(
+	ctx context.Context,
+	params *entity.PutReviewValidationRulesParams,
+) (*entity.ReviewValidationRules, error) {
+	params.Phases = normalizeRuleValues(params.Phases)
+	params.ApprovalStatuses = normalizeRuleValues(params.ApprovalStatuses)
+	params.WorkStatuses = normalizeRuleValues(params.WorkStatuses)
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.ReviewValidationRules
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		if err := uc.checkForProject(tx, params.Project); err != nil {
+			return err
+		}
+		if err := uc.memberUc.authorize(
+			tx, params.Project, entity.UserFromContext(ctx), entity.ReviewActionConfigureProject,
+		); err != nil {
+			return err
+		}
+		var err error
+		e, err = uc.repo.Put(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// rules returns the rules of project; a project without rules gets empty ones, which
+// allow any value. Empty status lists are filled from the status vocabulary.
+func (uc *ReviewValidation) rules(db *gorm.DB, project string) (*entity.ReviewValidationRules, error) {
+	rules, err := uc.repo.Get(db, &entity.GetReviewValidationRulesParams{Project: project})
+	if errors.Is(err, entity.ErrRecordNotFound) {
+		rules, err = &entity.ReviewValidationRules{Project: project}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(rules.ApprovalStatuses) > 0 && len(rules.WorkStatuses) > 0 {
+		return rules, nil
+	}
+	statuses, err := uc.statusRepo.List(db, &entity.ListReviewStatusParams{Project: project})
+	if err != nil {
+		return nil, err
+	}
+	var approval, work []string
+	for _, s := range statuses {
+		switch s.Type {
+		case entity.ReviewStatusTypeApproval:
+			approval = append(approval, s.Key)
+		case entity.ReviewStatusTypeWork:
+			work = append(work, s.Key)
+		}
+	}
+	if len(rules.ApprovalStatuses) == 0 {
+		rules.ApprovalStatuses = approval
+	}
+	if len(rules.WorkStatuses) == 0 {
+		rules.WorkStatuses = work
+	}
+	return rules, nil
+}
+
+// validate checks the given values against the rules of project; nil values are not
+// checked. It returns a *entity.ValidationError when a value is not allowed.
+func (uc *ReviewValidation) validate(
+	db *gorm.DB,
+	project string,
+	phase, approvalStatus, workStatus *string,
+) error {
+	rules, err := uc.rules(db, project)
+	if err != nil {
+		return err
+	}
+	return rules.Validate(phase, approvalStatus, workStatus)
+}
+
+// checkStatusFilters rejects status filter values that are not in the project's status
+// vocabulary with entity.ErrUnknownReviewStatus. A type without vocabulary accepts any
+// value.
+func (uc *ReviewValidation) checkStatusFilters(
+	db *gorm.DB,
+	project string,
+	approvalStatuses, workStatuses []string,
+) error {
+	if len(approvalStatuses) == 0 && len(workStatuses) == 0 {
+		return nil
+	}
+	statuses, err := uc.statusRepo.List(db, &entity.ListReviewStatusParams{Project: project})
+	if err != nil {
+		return err
+	}
+	for _, f := range []struct {
+		typ    string
+		values []string
+	}{
+		{entity.ReviewStatusTypeApproval, approvalStatuses},
+		{entity.ReviewStatusTypeWork, workStatuses},
+	} {
+		keys := statusKeys(statuses, f.typ)
+		if keys == nil {
+			continue
+		}
+		for _, v := range f.values {
+			if !keys[strings.ToLower(strings.TrimSpace(v))] {
+				return fmt.Errorf("%w: %s status %q", entity.ErrUnknownReviewStatus, f.typ, v)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/reviewWebhook.go
+
+	Module Description:
+		Usecase layer for review event webhooks and their asynchronous dispatcher.
+
+	Details:
+	- ReviewInfo Create/Update/Delete call notify after their transaction commits; notify
+	  only queues the event, so a slow or dead receiver never delays a write.
+	- Workers started by Run resolve the project's subscribed webhooks and POST the event
+	  as JSON. Each request carries:
+	    X-Review-Event:     event type
+	    X-Review-Delivery:  event ID (the same on every retry)
+	    X-Review-Signature: sha256=<hex HMAC-SHA256 of the body keyed by the secret>
+	- Network errors, 429 and 5xx are retried with exponential backoff, up to
+	  webhookMaxAttempts attempts; other responses are final.
+	- The queue lives in memory: events still queued when the process stops are lost,
+	  and a full queue drops new events (both are logged).
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - notify also publishes to the in-process event feed (reviewEventFeed.go).
+
+	Functions:
+	* - List: Lists the webhooks of a project.
+	* - Create: Registers a webhook.
+	* - Delete: Removes a webhook.
+	* - Run: Delivers queued events until ctx is done.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	webhookQueueSize      = 1024
+	webhookMaxAttempts    = 5
+	webhookRetryBaseDelay = 2 * time.Second
+	webhookRequestTimeout = 10 * time.Second
+)
+
+// webhookJob is one queued delivery. A nil hook fans the event out to every subscribed
+// webhook of its project.
+type webhookJob struct {
+	event   *entity.ReviewEvent
+	hook    *entity.ReviewWebhook
+	attempt int
+}
+
+type ReviewWebhook struct {
+	repo         *repository.ReviewWebhook
+	prjRepo      *repository.ProjectInfo
+	client       *http.Client
+	queue        chan webhookJob
+	feed         reviewEventFeed
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewReviewWebhook(
+	repo *repository.ReviewWebhook,
+	pr *repository.ProjectInfo,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *ReviewWebhook {
+	return &ReviewWebhook{
+		repo:         repo,
+		prjRepo:      pr,
+		client:       &http.Client{Timeout: webhookRequestTimeout},
+		queue:        make(chan webhookJob, webhookQueueSize),
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *ReviewWebhook) checkForProject(db *gorm.DB, project string) error {
+	_, err := uc.prjRepo.Get(db, &entity.GetProjectInfoParams{
+		KeyName: project,
+	})
+	return err
+}
+
+func (uc *ReviewWebhook) List(
+	ctx context.Context,
+	params *entity.ListReviewWebhookParams,
+) ([]*entity.ReviewWebhook, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	return uc.repo.List(db, params)
+}
+
+func (uc *ReviewWebhook) Create(
+	ctx context.Context,
+	params *entity.CreateReviewWebhookParams,
+) (*entity.ReviewWebhook, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return nil, err
+	}
+	var e *entity.ReviewWebhook
+	if err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		var err error
+		e, err = uc.repo.Create(tx, params)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *ReviewWebhook) Delete(
+	ctx context.Context,
+	params *entity.DeleteReviewWebhookParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	if err := uc.checkForProject(db, params.Project); err != nil {
+		return err
+	}
+	return uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		return uc.repo.Delete(tx, params)
+	})
+}
+
+// notify queues an event of review for the project's webhooks. It never blocks; a nil
+// receiver (webhooks disabled) does nothing.
+func (uc *ReviewWebhook) notify(eventType string, review *entity.ReviewInfo, previousApprovalStatus string) {
+	if uc == nil || review == nil {
+		return
+	}
+	event := &entity.ReviewEvent{
+		ID:                     newWebhookEventID(),
+		Type:                   eventType,
+		Project:                review.Project,
+		OccurredAtUtc:          time.Now().UTC(),
+		Review:                 review,
+		PreviousApprovalStatus: previousApprovalStatus,
+	}
+	uc.feed.publish(event)
+	uc.enqueue(webhookJob{event: event})
+}
+
+func (uc *ReviewWebhook) enqueue(job webhookJob) {
+	select {
+	case uc.queue <- job:
+	default:
+		log.Printf("[WEBHOOK] queue full, dropping %s event %s", job.event.Type, job.event.ID)
+	}
+}
+
+// Run delivers queued events with the given number of workers until ctx is done.
+func (uc *ReviewWebhook) Run(ctx context.Context, workers int) {
+	if workers < 1 {
+		workers = 1
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-uc.queue:
+					uc.process(ctx, job)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (uc *ReviewWebhook) process(ctx context.Context, job webhookJob) {
+	if job.hook != nil {
+		uc.attempt(ctx, job)
+		return
+	}
+	listCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	hooks, err := uc.repo.List(uc.repo.WithContext(listCtx), &entity.ListReviewWebhookParams{
+		Project: job.event.Project,
+	})
+	cancel()
+	if err != nil {
+		log.Printf("[WEBHOOK] listing webhooks of %s failed, dropping event %s: %v",
+			job.event.Project, job.event.ID, err)
+		return
+	}
+	for _, hook := range hooks {
+		if hook.Subscribes(job.event.Type) {
+			uc.attempt(ctx, webhookJob{event: job.event, hook: hook, attempt: 1})
+		}
+	}
+}
+
+// attempt makes one delivery and schedules the next attempt when it may succeed later.
+func (uc *ReviewWebhook) attempt(ctx context.Context, job webhookJob) {
+	retry, err := uc.deliver(ctx, job.hook, job.event)
+	if err == nil {
+		return
+	}
+	if !retry || job.attempt >= webhookMaxAttempts {
+		log.Printf("[WEBHOOK] giving up on event %s for webhook %d after %d attempt(s): %v",
+			job.event.ID, job.hook.ID, job.attempt, err)
+		return
+	}
+	delay := webhookRetryBaseDelay << (job.attempt - 1)
+	log.Printf("[WEBHOOK] event %s for webhook %d failed (attempt %d), retrying in %v: %v",
+		job.event.ID, job.hook.ID, job.attempt, delay, err)
+	next := webhookJob{event: job.event, hook: job.hook, attempt: job.attempt + 1}
+	time.AfterFunc(delay, func() {
+		if ctx.Err() == nil {
+			uc.enqueue(next)
+		}
+	})
+}
+
+// deliver POSTs event to hook. retry reports whether a failure is worth retrying.
+func (uc *ReviewWebhook) deliver(
+	ctx context.Context,
+	hook *entity.ReviewWebhook,
+	event *entity.ReviewEvent,
+) (retry bool, err error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return false, err
+	}
+	mac := hmac.New(sha256.New, []byte(hook.Secret))
+	mac.Write(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Review-Event", event.Type)
+	req.Header.Set("X-Review-Delivery", event.ID)
+	req.Header.Set("X-Review-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	res, err := uc.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return false, nil
+	}
+	retry = res.StatusCode == http.StatusTooManyRequests || res.StatusCode >= 500
+	return retry, fmt.Errorf("webhook responded %s", res.Status)
+}
+
+func newWebhookEventID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,59 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/timeouts.go
+
+	Module Description:
+		Query timeout policy of the review usecases.
+
+	Details:
+	- The usecases own the query timeouts; delivery (HTTP, gRPC) passes the request
+	  context through without a deadline of its own.
+	- Read bounds lookups and lists, Write bounds creates, updates and deletes with their
+	  transactions, Pivot bounds a whole asset pivot request (a project's query policy
+	  may replace it) and Export bounds a whole export job.
+	- withTimeout always applies its timeout. A deadline the context already carries
+	  still holds when it is earlier, so a usecase called under a caller's deadline (a
+	  gRPC client's, an export job's) is bounded by both.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+	* - 15-10-2026 - withTimeout applies its timeout under an existing deadline too.
+
+	Functions:
+	* - DefaultTimeoutConfig: Returns the default timeouts.
+	* - withTimeout: Applies a timeout to a context.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"time"
+)
+
+// TimeoutConfig holds the query timeouts of the review usecases.
+type TimeoutConfig struct {
+	Read   time.Duration // lookups and lists
+	Write  time.Duration // creates, updates and deletes
+	Pivot  time.Duration // one asset pivot request, unless the project's query policy sets one
+	Export time.Duration // one export job, every page included
+}
+
+// DefaultTimeoutConfig returns the timeouts used when the server configures none.
+func DefaultTimeoutConfig() TimeoutConfig {
+	return TimeoutConfig{
+		Read:   60 * time.Second,
+		Write:  60 * time.Second,
+		Pivot:  10 * time.Second,
+		Export: 30 * time.Minute,
+	}
+}
+
+// withTimeout returns ctx with a deadline d from now, kept earlier by a deadline ctx
+// already has; d not positive adds none. The returned cancel must be called either way.
+func withTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d)
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithTimeout(t *testing.T) {
+	now := time.Now()
+	for _, tc := range []struct {
+		name   string
+		parent time.Duration // deadline of the parent from now; 0 for none
+		d      time.Duration
+		want   time.Duration // deadline of the result from now; 0 for none
+	}{
+		{"no parent deadline", 0, time.Second, time.Second},
+		{"later parent deadline", time.Hour, time.Second, time.Second},
+		{"earlier parent deadline", time.Second, time.Hour, time.Second},
+		{"no timeout", 0, 0, 0},
+		{"no timeout, parent deadline", time.Minute, 0, time.Minute},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			parent := context.Background()
+			if tc.parent > 0 {
+				var cancel context.CancelFunc
+				parent, cancel = context.WithDeadline(parent, now.Add(tc.parent))
+				defer cancel()
+			}
+			ctx, cancel := withTimeout(parent, tc.d)
+			defer cancel()
+
+			deadline, ok := ctx.Deadline()
+			if ok != (tc.want > 0) {
+				t.Fatalf("has deadline = %v, want %v", ok, tc.want > 0)
+			}
+			// withTimeout reads the clock after now; allow for that.
+			if got := deadline.Sub(now); ok && (got < tc.want || got > tc.want+time.Second/2) {
+				t.Errorf("deadline in %v, want %v", got, tc.want)
+			}
+			cancel()
+			if ctx.Err() == nil {
+				t.Error("cancel did not cancel the context")
+			}
+		})
+	}
+}
@@ -0,0 +1,178 @@
+/* ──────────────────────────────────────────────────────────────────────────
+	Module Name:
+		usecase/userPreference.go
+
+	Module Description:
+		Usecase layer for per-user preferences (e.g. review pivot column layout).
+
+	Details:
+	- Preferences are stored as JSON documents keyed by (user, key); the typed helpers
+	  below validate a document before it is written.
+	- Preferences are not project scoped, so there is no project check.
+
+	Update and Modification History:
+	* - 15-10-2026 - Initial creation.
+
+	Functions:
+	* - Get: Retrieves one preference of a user.
+	* - Put: Creates or replaces one preference of a user.
+	* - Delete: Removes one preference of a user.
+	* - GetReviewColumns: Returns the review column layout of a user (nil if unset).
+	* - PutReviewColumns: Validates and stores the review column layout of a user.
+	────────────────────────────────────────────────────────────────────────── */
+
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PolygonPictures/central30-web/front/entity"
+	"github.com/PolygonPictures/central30-web/front/repository"
+	"github.com/gin-gonic/gin/binding"
+	"gorm.io/gorm"
+)
+
+const (
+	maxReviewColumns     = 200
+	maxReviewColumnWidth = 4000
+)
+
+type UserPreference struct {
+	repo         *repository.UserPreference
+	ReadTimeout  time.Duration
+	WriteTimeout time.Duration
+}
+
+func NewUserPreference(
+	repo *repository.UserPreference,
+	readTimeout time.Duration,
+	writeTimeout time.Duration,
+) *UserPreference {
+	return &UserPreference{
+		repo:         repo,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+	}
+}
+
+func (uc *UserPreference) Get(
+	ctx context.Context,
+	params *entity.GetUserPreferenceParams,
+) (*entity.UserPreference, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.ReadTimeout)
+	defer cancel()
+	db := uc.repo.WithContext(timeoutCtx)
+	return uc.repo.Get(db, params)
+}
+
+func (uc *UserPreference) Put(
+	ctx context.Context,
+	params *entity.PutUserPreferenceParams,
+) (*entity.UserPreference, error) {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return nil, err
+	}
+	if !json.Valid(params.Value) {
+		return nil, fmt.Errorf("preference %s is not valid JSON", params.Key)
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	var e *entity.UserPreference
+	err := uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		var err error
+		e, err = uc.repo.Put(tx, params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (uc *UserPreference) Delete(
+	ctx context.Context,
+	params *entity.DeleteUserPreferenceParams,
+) error {
+	if err := binding.Validator.ValidateStruct(params); err != nil {
+		return err
+	}
+	timeoutCtx, cancel := withTimeout(ctx, uc.WriteTimeout)
+	defer cancel()
+	return uc.repo.TransactionWithContext(timeoutCtx, func(tx *gorm.DB) error {
+		return uc.repo.Delete(tx, params)
+	})
+}
+
+// GetReviewColumns returns nil, nil when the user has not saved a layout yet.
+func (uc *UserPreference) GetReviewColumns(
+	ctx context.Context,
+	user string,
+) (*entity.ReviewColumnsPreference, error) {
+	e, err := uc.Get(ctx, &entity.GetUserPreferenceParams{
+		User: user,
+		Key:  entity.UserPreferenceKeyReviewColumns,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var pref entity.ReviewColumnsPreference
+	if err := json.Unmarshal(e.Value, &pref); err != nil {
+		return nil, fmt.Errorf("stored review columns of %s: %w", user, err)
+	}
+	return &pref, nil
+}
+
+func (uc *UserPreference) PutReviewColumns(
+	ctx context.Context,
+	user string,
+	pref *entity.ReviewColumnsPreference,
+) (*entity.ReviewColumnsPreference, error) {
+	if err := validateReviewColumns(pref); err != nil {
+		return nil, err
+	}
+	value, err := json.Marshal(pref)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.Put(ctx, &entity.PutUserPreferenceParams{
+		User:  user,
+		Key:   entity.UserPreferenceKeyReviewColumns,
+		Value: value,
+	}); err != nil {
+		return nil, err
+	}
+	return pref, nil
+}
+
+func validateReviewColumns(pref *entity.ReviewColumnsPreference) error {
+	if len(pref.Columns) > maxReviewColumns {
+		return fmt.Errorf("at most %d columns can be stored", maxReviewColumns)
+	}
+	seen := make(map[string]bool, len(pref.Columns))
+	for i, col := range pref.Columns {
+		key := strings.TrimSpace(col.Key)
+		if key == "" {
+			return fmt.Errorf("columns[%d]: key is required", i)
+		}
+		if seen[key] {
+			return fmt.Errorf("columns[%d]: duplicate key %q", i, key)
+		}
+		seen[key] = true
+		pref.Columns[i].Key = key
+		if col.Width != nil && (*col.Width <= 0 || *col.Width > maxReviewColumnWidth) {
+			return fmt.Errorf("columns[%d]: width must be between 1 and %d", i, maxReviewColumnWidth)
+		}
+	}
+	return nil
+}